@@ -20,6 +20,8 @@ const (
 	RANGE_DELETE_RANGE = "/range/delete"
 	RANGE_GET_TOPOLOGY = "/range/getRangeTopoByRange"
 	RANGE_BATCH_RECOVER_RANGE = "/range/batchRecoverRange"
+	RANGE_SPLIT_RANGE = "/range/split"
+	TABLE_SCATTER = "/table/scatter"
 	RANGE_DUPLICATE_GET = "/table/duplicateRange"
 
 	TASK_GET_PRESENT = "/task/getPresentTaskById"
@@ -466,4 +468,62 @@ func (ctrl *RangeBatchRecover) Execute(c *gin.Context) (interface{}, error) {
 		return nil, err
 	}
 	return nil, nil
-}
\ No newline at end of file
+}
+type RangeSplit struct {
+}
+
+func NewRangeSplit() *RangeSplit {
+	return &RangeSplit{}
+}
+
+// 指定splitKey(base64编码的完整key)分裂range, 或者指定表和splitValue(第一主键列的值)分裂表
+func (ctrl *RangeSplit) Execute(c *gin.Context) (interface{}, error) {
+	clusterId := c.PostForm("clusterId")
+	rangeId := c.PostForm("rangeId")
+	splitKey := c.PostForm("splitKey")
+	dbName := c.PostForm("dbName")
+	tableName := c.PostForm("tableName")
+	splitValue := c.PostForm("splitValue")
+	if "" == clusterId || ("" == splitKey && ("" == dbName || "" == tableName || "" == splitValue)) {
+		return nil, common.PARSE_PARAM_ERROR
+	}
+
+	log.Debug("split range: clusterId: %v, rangeId: %v, splitKey: %v, dbName: %v, tableName: %v, splitValue: %v",
+		clusterId, rangeId, splitKey, dbName, tableName, splitValue)
+
+	cId, err := strconv.Atoi(clusterId)
+	if err != nil {
+		return nil, common.PARAM_FORMAT_ERROR
+	}
+
+	err = service.NewService().SplitRange(cId, rangeId, splitKey, dbName, tableName, splitValue)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+type TableScatter struct {
+}
+
+func NewTableScatter() *TableScatter {
+	return &TableScatter{}
+}
+
+func (ctrl *TableScatter) Execute(c *gin.Context) (interface{}, error) {
+	clusterId := c.PostForm("clusterId")
+	dbName := c.PostForm("dbName")
+	tableName := c.PostForm("tableName")
+	if "" == clusterId || "" == dbName || "" == tableName {
+		return nil, common.PARSE_PARAM_ERROR
+	}
+
+	log.Debug("scatter table: clusterId: %v, dbName: %v, tableName: %v", clusterId, dbName, tableName)
+
+	cId, err := strconv.Atoi(clusterId)
+	if err != nil {
+		return nil, common.PARAM_FORMAT_ERROR
+	}
+
+	return service.NewService().ScatterTable(cId, dbName, tableName)
+}
@@ -674,6 +674,12 @@ func (r *Router)StartRouter() *gin.Engine {
 	router.POST(controllers.RANGE_BATCH_RECOVER_RANGE, func(c *gin.Context) {
 		handleAction(c, controllers.NewRangeBatchRecover())
 	})
+	router.POST(controllers.RANGE_SPLIT_RANGE, func(c *gin.Context) {
+		handleAction(c, controllers.NewRangeSplit())
+	})
+	router.POST(controllers.TABLE_SCATTER, func(c *gin.Context) {
+		handleAction(c, controllers.NewTableScatter())
+	})
 	router.POST(controllers.TASK_GET_PRESENT, func(c *gin.Context) {
 		handleAction(c, controllers.NewTaskPresent())
 	})
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 	"strings"
 	"io/ioutil"
@@ -1539,6 +1540,80 @@ func (s *Service) BatchRecoverRange(clusterId int, dbName, tableName string) err
 	return nil
 }
 
+// splitKey为base64编码的完整key; 为空时按splitValue(第一主键列的值)分裂表
+func (s *Service) SplitRange(clusterId int, rangeId, splitKey, dbName, tableName, splitValue string) error {
+	info, err := s.selectClusterById(clusterId)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return common.CLUSTER_NOTEXISTS_ERROR
+	}
+
+	ts := time.Now().Unix()
+	sign := common.CalcMsReqSign(clusterId, info.ClusterToken, ts)
+
+	reqParams := make(map[string]interface{})
+	reqParams["d"] = ts
+	reqParams["s"] = sign
+	if len(rangeId) > 0 {
+		reqParams["rangeId"] = rangeId
+	}
+	if len(splitKey) > 0 {
+		reqParams["splitKey"] = url.QueryEscape(splitKey)
+	} else {
+		reqParams["dbName"] = dbName
+		reqParams["tableName"] = tableName
+		reqParams["splitValue"] = url.QueryEscape(splitValue)
+	}
+
+	var splitRangeResp = struct {
+		Code int    `json:"code"`
+		Msg  string `json:"message"`
+	}{}
+	if err := sendGetReq(info.MasterUrl, "/manage/range/split", reqParams, &splitRangeResp); err != nil {
+		return err
+	}
+	if splitRangeResp.Code != 0 {
+		log.Error("split cluster[%d] range failed. err:[%v]", clusterId, splitRangeResp)
+		return fmt.Errorf(splitRangeResp.Msg)
+	}
+	return nil
+}
+
+func (s *Service) ScatterTable(clusterId int, dbName, tableName string) (interface{}, error) {
+	info, err := s.selectClusterById(clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, common.CLUSTER_NOTEXISTS_ERROR
+	}
+
+	ts := time.Now().Unix()
+	sign := common.CalcMsReqSign(clusterId, info.ClusterToken, ts)
+
+	reqParams := make(map[string]interface{})
+	reqParams["d"] = ts
+	reqParams["s"] = sign
+	reqParams["dbName"] = dbName
+	reqParams["tableName"] = tableName
+
+	var scatterTableResp = struct {
+		Code int            `json:"code"`
+		Msg  string         `json:"message"`
+		Data map[string]int `json:"data"`
+	}{}
+	if err := sendGetReq(info.MasterUrl, "/manage/table/scatter", reqParams, &scatterTableResp); err != nil {
+		return nil, err
+	}
+	if scatterTableResp.Code != 0 {
+		log.Error("scatter cluster[%d] table[%s:%s] failed. err:[%v]", clusterId, dbName, tableName, scatterTableResp)
+		return nil, fmt.Errorf(scatterTableResp.Msg)
+	}
+	return scatterTableResp.Data, nil
+}
+
 func (s *Service) GetPrivilegeInfo(offset, limit int, order string) ([]*models.UserPrivilege, error) {
 	result := make([]*models.UserPrivilege, offset, limit)
 	rows, err := s.db.Query(fmt.Sprintf(`SELECT * FROM %s order by user_name %s limit %d,%d  `, TABLE_NAME_PRIVILEGE, order, offset, limit))
@@ -1737,7 +1812,7 @@ func (s *Service) ApplyLockNamespace(cId int, namespace, applyer string, cTime i
 	}
 
 	var columns []*models.Column
-	lockColumn := &models.Column{Name: LOCK_COLUMN, DataType:1, PrimaryKey: 1, Index:true}
+	lockColumn := &models.Column{Name: LOCK_COLUMN, DataType:1, PrimaryKey: 1}
 	columns = append(columns, lockColumn)
 	err = s.CreateTable(cId, LOCK_DBNAME, namespace, "", "", columns, nil)
 	if err != nil {
@@ -50,6 +50,49 @@ app.controller('rangeList', function($rootScope, $scope, $http, $timeout) {
         });
     };
 
+    //分裂点为base64编码的完整key, 与列表中startkey的格式相同
+    $scope.splitRange = function(rng){
+        swal({
+                title: "分裂range",
+                text: "请输入分裂点(base64编码的key)",
+                type: "input",
+                showCancelButton: true,
+                confirmButtonColor: "#DD6B55",
+                confirmButtonText: "执行",
+                closeOnConfirm: false
+            },
+            function (inputValue) {
+                if (inputValue === false) {
+                    return false;
+                }
+                if (inputValue === "") {
+                    swal.showInputError("请输入分裂点!");
+                    return false;
+                }
+                $.ajax({
+                    url:"/range/split",
+                    type:"post",
+                    contentType:"application/x-www-form-urlencoded; charset=UTF-8",
+                    dataType:"json",
+                    data:{
+                        "clusterId": clusterId,
+                        "rangeId": rng.id,
+                        "splitKey": inputValue
+                    },
+                    success: function(data){
+                        if(data.code === 0){
+                            swal("分裂range成功!", data.msg, "success");
+                        }else {
+                            swal("分裂range失败", data.msg, "error");
+                        }
+                    },
+                    error: function(res){
+                        swal("分裂range失败", res, "error");
+                    }
+                });
+            });
+    };
+
     $scope.viewRangeTopo = function (rng) {
         window.location.href = "/range/getRangeTopo?clusterId=" + clusterId + "&rangeId=" + rng.id;
     }
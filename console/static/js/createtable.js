@@ -132,7 +132,7 @@ function createTable() {
 			  rmarkArray[i] = $(this).val();
 		});
 		for(var i = 0;i < columnsNamesArray.length; i++) {
-			columnsArray.push({name: columnsNamesArray[i], datatype: dataTypeArray[i], primarykey: primaryKeyArray[i],defaultvalue:default_valueArray[i],nullable:nullableArray[i],autoincrement:autoincrementArray[i],rmark:rmarkArray[i],index:true});
+			columnsArray.push({name: columnsNamesArray[i], datatype: dataTypeArray[i], primarykey: primaryKeyArray[i],defaultvalue:default_valueArray[i],nullable:nullableArray[i],autoincrement:autoincrementArray[i],rmark:rmarkArray[i]});
 		}
 		//创建db对象，有 数据库名字，和表名字
     var newarryObject = Object();
@@ -242,6 +242,83 @@ app.controller('mytables', function($rootScope, $scope, $http, $timeout) {
                 });
             });
     }
+    //按第一主键列的值分裂表
+    $scope.splitTable = function(table) {
+        swal({
+                title: "分裂表",
+                text: "请输入分裂点(第一主键列的值)",
+                type: "input",
+                showCancelButton: true,
+                confirmButtonColor: "#DD6B55",
+                confirmButtonText: "执行",
+                closeOnConfirm: false
+            },
+            function (inputValue) {
+                if (inputValue === false) {
+                    return false;
+                }
+                if (inputValue === "") {
+                    swal.showInputError("请输入分裂点!");
+                    return false;
+                }
+                $.ajax({
+                    url: "/range/split",
+                    type: "post",
+                    contentType: "application/x-www-form-urlencoded; charset=UTF-8",
+                    dataType: "json",
+                    data: {
+                        "clusterId": clusterId,
+                        "dbName": table.db_name,
+                        "tableName": table.name,
+                        "splitValue": inputValue
+                    },
+                    success: function (data) {
+                        if (data.code === 0) {
+                            swal("分裂中！", "分裂任务已提交!", "success");
+                        } else {
+                            swal("分裂失败！", data.msg, "error");
+                        }
+                    },
+                    error: function (res) {
+                        swal("分裂失败！", "请联系管理员!", "error");
+                    }
+                });
+            });
+    }
+    //把表的副本和leader均匀分散到所有节点
+    $scope.scatterTable = function(table) {
+        swal({
+                title: "确定打散表的range",
+                type: "warning",
+                showCancelButton: true,
+                confirmButtonColor: "#DD6B55",
+                confirmButtonText: "执行",
+                closeOnConfirm: false
+            },
+            function () {
+                $.ajax({
+                    url: "/table/scatter",
+                    type: "post",
+                    contentType: "application/x-www-form-urlencoded; charset=UTF-8",
+                    dataType: "json",
+                    data: {
+                        "clusterId": clusterId,
+                        "dbName": table.db_name,
+                        "tableName": table.name
+                    },
+                    success: function (data) {
+                        if (data.code === 0) {
+                            swal("打散中！", "迁移副本" + data.data.peers + "个, 切换leader" + data.data.leaders + "个", "success");
+                        } else {
+                            swal("打散失败！", data.msg, "error");
+                        }
+                    },
+                    error: function (res) {
+                        swal("打散失败！", "请联系管理员!", "error");
+                    }
+                });
+            });
+    }
 });
 
 //时间格式化
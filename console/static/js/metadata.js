@@ -111,7 +111,7 @@ function createBbTablesRange() {
 			  rmarkArray[i] = $(this).val();
 		});
 		for(var i = 0;i < columnsNamesArray.length; i++) {
-			columnsArray.push({name: columnsNamesArray[i], datatype: dataTypeArray[i], primarykey: primaryKeyArray[i],defaultvalue:default_valueArray[i],nullable:nullableArray[i],autoincrement:autoincrementArray[i],rmark:rmarkArray[i],index:true});
+			columnsArray.push({name: columnsNamesArray[i], datatype: dataTypeArray[i], primarykey: primaryKeyArray[i],defaultvalue:default_valueArray[i],nullable:nullableArray[i],autoincrement:autoincrementArray[i],rmark:rmarkArray[i]});
 		}
     var rangeObject = new Object();
     	rangeObject = {startkey:startkey,endkey:endkey,peernum:peernum};
@@ -268,21 +268,30 @@ void Range::KVBatchGet(common::ProtoMessage *msg,
     uint64_t total_size = 0;
     auto keys_size = req.req().keys_size();
 
-    for (int i = 0; i < keys_size; ++i) {
-        auto &key = req.req().keys(i);
-        if (key.empty() || !KeyInRange(key)) {
-            FLOG_WARN("range[%" PRIu64 "] KVBatchGet error: %s not in range",
-                      meta_.id(), key.c_str());
-        } else {
-            auto kv = ds_resp->mutable_resp()->add_kvs();
-            auto btime = get_micro_second();
-            auto ret = store_->Get(key, kv->mutable_value());
-            kv->set_key(std::move(key));
-            count++;
-            total_size += kv->key().size() + kv->value().size();
-            total_time += get_micro_second() - btime;
+    do {
+        // 跟Select一样只在leader上读取, 避免旧leader返回过期的值
+        if (!VerifyLeader(err)) {
+            FLOG_WARN("range[%" PRIu64 "] KVBatchGet error: %s", meta_.id(),
+                      err->message().c_str());
+            break;
         }
-    }
+
+        for (int i = 0; i < keys_size; ++i) {
+            auto &key = req.req().keys(i);
+            if (key.empty() || !KeyInRange(key)) {
+                FLOG_WARN("range[%" PRIu64 "] KVBatchGet error: %s not in range",
+                          meta_.id(), key.c_str());
+            } else {
+                auto kv = ds_resp->mutable_resp()->add_kvs();
+                auto btime = get_micro_second();
+                auto ret = store_->Get(key, kv->mutable_value());
+                kv->set_key(std::move(key));
+                count++;
+                total_size += kv->key().size() + kv->value().size();
+                total_time += get_micro_second() - btime;
+            }
+        }
+    } while (false);
 
     context_->run_status->PushTime(monitor::PrintTag::Store, total_time);
 
@@ -1,6 +1,10 @@
 #include "store.h"
 #include <common/ds_config.h>
 
+#include <map>
+#include <memory>
+#include <vector>
+
 #include "aggregate_calc.h"
 #include "base/util.h"
 #include "common/ds_config.h"
@@ -158,57 +162,104 @@ Status Store::selectSimple(const kvrpcpb::SelectRequest& req,
     return s;
 }
 
-Status Store::selectAggre(const kvrpcpb::SelectRequest& req,
-                          kvrpcpb::SelectResponse* resp) {
-    // 暂时不支持带group by的聚合函数
-    if (req.group_bys_size() > 0) {
-        return Status(Status::kNotSupported, "select",
-                      "aggregateion with group by clause");
-    }
+namespace {
+
+// 一个分组的部分聚合结果
+struct AggreGroup {
+    // 聚合函数的计算器, 普通列对应nullptr
+    std::vector<std::unique_ptr<AggreCalculator>> cals;
+    // 普通列(group by列)取分组中第一行的值
+    std::vector<std::unique_ptr<FieldValue>> columns;
+};
 
-    std::vector<std::unique_ptr<AggreCalculator>> aggre_cals;
-    aggre_cals.reserve(req.field_list_size());
+Status newAggreGroup(const kvrpcpb::SelectRequest& req, const RowResult* r,
+                     std::unique_ptr<AggreGroup>* group) {
+    std::unique_ptr<AggreGroup> g(new AggreGroup);
+    g->cals.resize(req.field_list_size());
+    g->columns.resize(req.field_list_size());
     for (int i = 0; i < req.field_list_size(); ++i) {
         const auto& field = req.field_list(i);
-        assert(field.typ() == kvrpcpb::SelectField_Type_AggreFunction);
-        // TODO:
-        auto cal = AggreCalculator::New(
+        if (field.typ() == kvrpcpb::SelectField_Type_Column) {
+            FieldValue* v = r != nullptr ? r->GetField(field.column().id()) : nullptr;
+            if (v != nullptr) {
+                g->columns[i].reset(CopyValue(*v));
+            }
+            continue;
+        }
+        g->cals[i] = AggreCalculator::New(
             field.aggre_func(), field.has_column() ? &field.column() : nullptr);
-        if (cal == nullptr) {
+        if (g->cals[i] == nullptr) {
             return Status(
                 Status::kNotSupported, "select",
                 std::string("aggregate funtion: ") + field.aggre_func());
-        } else {
-            aggre_cals.push_back(std::move(cal));
         }
     }
+    *group = std::move(g);
+    return Status::OK();
+}
 
-    RowFetcher f(*this, req);
+}  // namespace
+
+// 计算聚合函数, 有group by时按group by列的值分组, 每个分组返回一行,
+// 行的key为编码后的group by列的值
+Status Store::selectAggre(const kvrpcpb::SelectRequest& req,
+                          kvrpcpb::SelectResponse* resp) {
+    std::map<std::string, std::unique_ptr<AggreGroup>> groups;
     Status s;
+    // 没有group by时即使没有数据也返回一行
+    if (req.group_bys_size() == 0) {
+        s = newAggreGroup(req, nullptr, &groups[""]);
+        if (!s.ok()) return s;
+    }
+
+    RowFetcher f(*this, req);
     std::unique_ptr<RowResult> r(new RowResult);
+    std::string key;
     bool over = false;
     while (!over && s.ok()) {
         over = false;
         s = f.Next(r.get(), &over);
-        if (s.ok() && !over) {
-            for (size_t i = 0; i < aggre_cals.size(); ++i) {
-                const auto& field = req.field_list(i);
-                if (field.has_column()) {
-                    aggre_cals[i]->Add(r->GetField(field.column().id()));
-                } else {
-                    aggre_cals[i]->Add(nullptr);
-                }
+        if (!s.ok() || over) break;
+
+        key.clear();
+        for (const auto& col : req.group_bys()) {
+            EncodeFieldValue(&key, r->GetField(col.id()));
+        }
+        auto it = groups.find(key);
+        if (it == groups.end()) {
+            std::unique_ptr<AggreGroup> g;
+            s = newAggreGroup(req, r.get(), &g);
+            if (!s.ok()) break;
+            it = groups.emplace(key, std::move(g)).first;
+        }
+        auto& cals = it->second->cals;
+        for (size_t i = 0; i < cals.size(); ++i) {
+            if (cals[i] == nullptr) continue;
+            const auto& field = req.field_list(i);
+            if (field.has_column()) {
+                cals[i]->Add(r->GetField(field.column().id()));
+            } else {
+                cals[i]->Add(nullptr);
             }
         }
     }
-    if (s.ok()) {
+    if (!s.ok()) return s;
+
+    for (auto& kv : groups) {
         std::string buf;
         auto row = resp->add_rows();
-        for (auto& cal : aggre_cals) {
-            auto f = cal->Result();
-            EncodeFieldValue(&buf, f.get());
-            row->add_aggred_counts(cal->Count());
+        auto& g = kv.second;
+        for (size_t i = 0; i < g->cals.size(); ++i) {
+            if (g->cals[i] == nullptr) {
+                EncodeFieldValue(&buf, g->columns[i].get());
+                row->add_aggred_counts(0);
+            } else {
+                auto v = g->cals[i]->Result();
+                EncodeFieldValue(&buf, v.get());
+                row->add_aggred_counts(g->cals[i]->Count());
+            }
         }
+        row->set_key(kv.first);
         row->set_fields(buf);
     }
     return s;
@@ -237,8 +288,10 @@ Status Store::Select(const kvrpcpb::SelectRequest& req,
                                   kvrpcpb::SelectField_Type_Name(type));
         }
     }
-    // 既有聚合函数又有普通的列，暂时不支持
-    if (has_aggre && has_column) {
+    // 既有聚合函数又有普通的列，只支持带group by的查询
+    if (req.group_bys_size() > 0) {
+        return selectAggre(req, resp);
+    } else if (has_aggre && has_column) {
         return Status(Status::kNotSupported, "select",
                       "mixture of aggregate and column select field");
     } else if (has_column) {
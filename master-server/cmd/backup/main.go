@@ -0,0 +1,143 @@
+package main
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"master-server/server"
+	"util/log"
+)
+
+// master元数据的备份和恢复
+// 在线备份: -action backup -addr 127.0.0.1:8887 -cluster-id 1 -token xxx -file fbase.bak
+// 离线备份: -action backup -data-dir /export/data/master -cluster-id 1 -file fbase.bak
+// 校验备份: -action check -file fbase.bak
+// 恢复: -action restore -file fbase.bak -data-dir /export/data/master -new-cluster-id 2
+// 恢复用于初始化新的master集群, 所有初始成员都要用同一个备份文件恢复到空的数据目录后再启动
+var (
+	action       = flag.String("action", "", "Usage : -action backup|restore|check")
+	fileName     = flag.String("file", "", "Usage : -file fbase.bak")
+	addr         = flag.String("addr", "", "Usage : -addr 127.0.0.1:8887")
+	dataPath     = flag.String("data-dir", "", "Usage : -data-dir /export/data/master")
+	clusterId    = flag.Uint64("cluster-id", 0, "Usage : -cluster-id 1")
+	token        = flag.String("token", "", "Usage : -token secret-key")
+	newClusterId = flag.Uint64("new-cluster-id", 0, "Usage : -new-cluster-id 2")
+	logLevel     = flag.String("log-level", "warn", "Usage : -log-level debug")
+)
+
+func main() {
+	flag.Parse()
+	log.SetLevel(*logLevel)
+
+	if *fileName == "" {
+		exit(fmt.Errorf("backup file is required"))
+	}
+	var meta *server.BackupMeta
+	var err error
+	switch *action {
+	case "backup":
+		meta, err = backup()
+	case "check":
+		meta, err = check(*fileName)
+	case "restore":
+		meta, err = restore()
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+	if err != nil {
+		exit(err)
+	}
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		exit(err)
+	}
+	fmt.Println(string(data))
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "%s failed, err %v\n", *action, err)
+	os.Exit(1)
+}
+
+// 先写临时文件, 校验通过后再改名, 避免留下不完整的备份
+func backup() (*server.BackupMeta, error) {
+	tempName := *fileName + ".tmp"
+	f, err := os.Create(tempName)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tempName)
+	switch {
+	case *addr != "":
+		err = download(f)
+	case *dataPath != "":
+		_, err = server.BackupDataDir(f, *dataPath, *clusterId)
+	default:
+		err = fmt.Errorf("addr or data-dir is required")
+	}
+	if _err := f.Close(); err == nil {
+		err = _err
+	}
+	if err != nil {
+		return nil, err
+	}
+	meta, err := check(tempName)
+	if err != nil {
+		return nil, err
+	}
+	return meta, os.Rename(tempName, *fileName)
+}
+
+func download(w io.Writer) error {
+	d := fmt.Sprintf("%d", time.Now().Unix())
+	h := md5.New()
+	h.Write([]byte(fmt.Sprintf("%d", *clusterId)))
+	h.Write([]byte(d))
+	h.Write([]byte(*token))
+	url := fmt.Sprintf("http://%s/manage/backup?d=%s&s=%x", *addr, d, h.Sum(nil))
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("http status %s", resp.Status)
+	}
+	// 出错时master应答json
+	if strings.Contains(resp.Header.Get("content-type"), "json") {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("master reply %s", string(data))
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func check(name string) (*server.BackupMeta, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return server.CheckBackup(f)
+}
+
+func restore() (*server.BackupMeta, error) {
+	if *dataPath == "" {
+		return nil, fmt.Errorf("data-dir is required")
+	}
+	f, err := os.Open(*fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return server.RestoreBackup(f, *dataPath, *newClusterId)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"master-server/server"
+	"util/log"
+)
+
+// 根据导出的集群拓扑离线模拟调度, 用于上线前评估调度策略和配置的效果
+// 拓扑文件可以通过master的/manage/topology/dump接口导出
+var (
+	topologyFileName = flag.String("topology", "", "Usage : -topology topology.json")
+	configFileName   = flag.String("config", "", "Usage : -config conf/config.toml")
+	workers          = flag.String("workers", "", "Usage : -workers balance_leader_worker,evict_leader_worker_3")
+	rounds           = flag.Int("rounds", 1, "Usage : -rounds 10")
+	logLevel         = flag.String("log-level", "warn", "Usage : -log-level debug")
+)
+
+func main() {
+	flag.Parse()
+	log.SetLevel(*logLevel)
+
+	if *topologyFileName == "" {
+		fmt.Fprintln(os.Stderr, "topology file is required")
+		flag.Usage()
+		os.Exit(1)
+	}
+	conf := server.NewDefaultConfig()
+	if *configFileName != "" {
+		if err := conf.LoadFromFile(*configFileName); err != nil {
+			fmt.Fprintf(os.Stderr, "load config file failed, err %v\n", err)
+			os.Exit(1)
+		}
+	}
+	topo, err := server.LoadTopology(*topologyFileName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "load topology file failed, err %v\n", err)
+		os.Exit(1)
+	}
+	var names []string
+	if *workers != "" {
+		names = strings.Split(*workers, ",")
+	}
+	result, err := server.Simulate(topo, conf, names, *rounds)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simulate failed, err %v\n", err)
+		os.Exit(1)
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "marshal result failed, err %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
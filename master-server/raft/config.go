@@ -73,7 +73,18 @@ type Config struct {
 	// LeaseCheck whether to use the lease mechanism.
 	// The default value is false.
 	LeaseCheck bool
-	transport  Transport
+	// LeaseRead whether the leader serves ReadIndex requests in its lease without confirmation, requires LeaseCheck.
+	// The default value is false.
+	LeaseRead bool
+	// PreVote whether a node asks for votes without increasing the term before starting an election,
+	// a partitioned node will not disrupt the cluster when it rejoins.
+	// The default value is false.
+	PreVote bool
+	// CheckQuorum whether the leader steps down when a quorum is not active in an election timeout,
+	// and followers ignore the vote requests when the leader is active.
+	// The default value is false.
+	CheckQuorum bool
+	transport   Transport
 }
 
 type TransportConfig struct {
@@ -117,6 +128,9 @@ func DefaultConfig() *Config {
 		AppBufferSize:   defaultSizeAppBuffer,
 		RetainLogs:      defaultRetainLogs,
 		LeaseCheck:      false,
+		LeaseRead:       false,
+		PreVote:         false,
+		CheckQuorum:     false,
 	}
 	conf.HeartbeatAddr = defaultHeartbeatAddr
 	conf.ReplicateAddr = defaultReplicateAddr
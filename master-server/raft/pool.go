@@ -31,6 +31,7 @@ func (f *poolFactory) getApply() *apply {
 	a := f.applyPool.Get().(*apply)
 	a.command = nil
 	a.future = nil
+	a.read = false
 	return a
 }
 
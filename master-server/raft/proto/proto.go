@@ -26,18 +26,26 @@ const (
 	LocalMsgProp
 	LeaseMsgOffline
 	LeaseMsgTimeout
+	ReqMsgReadIndex
+	RespMsgReadIndex
+	ReqMsgPreVote
+	RespMsgPreVote
 )
 
 const (
 	ConfAddNode    ConfChangeType = 0
 	ConfRemoveNode ConfChangeType = 1
 	ConfUpdateNode ConfChangeType = 2
+	// ConfPromoteNode promotes a learner to a voter.
+	ConfPromoteNode ConfChangeType = 3
 
 	EntryNormal     EntryType = 0
 	EntryConfChange EntryType = 1
 
 	PeerNormal  PeerType = 0
 	PeerArbiter PeerType = 1
+	// PeerLearner receives the log replication and snapshots, but does not vote or count toward commit.
+	PeerLearner PeerType = 2
 )
 
 // The Snapshot interface is supplied by the application to access the snapshot data of application.
@@ -136,6 +144,14 @@ func (t MsgType) String() string {
 		return "LeaseMsgOffline"
 	case 13:
 		return "LeaseMsgTimeout"
+	case 14:
+		return "ReqMsgReadIndex"
+	case 15:
+		return "RespMsgReadIndex"
+	case 16:
+		return "ReqMsgPreVote"
+	case 17:
+		return "RespMsgPreVote"
 	}
 	return "unkown"
 }
@@ -158,6 +174,8 @@ func (t ConfChangeType) String() string {
 		return "ConfRemoveNode"
 	case 2:
 		return "ConfUpdateNode"
+	case 3:
+		return "ConfPromoteNode"
 	}
 	return "unkown"
 }
@@ -168,6 +186,8 @@ func (t PeerType) String() string {
 		return "PeerNormal"
 	case 1:
 		return "PeerArbiter"
+	case 2:
+		return "PeerLearner"
 	}
 	return "unkown"
 }
@@ -176,17 +196,23 @@ func (p Peer) String() string {
 	return fmt.Sprintf(`"nodeID":"%v","priority":"%v","type":"%v"`, p.ID, p.Priority, p.Type.String())
 }
 
+func (p Peer) IsLearner() bool {
+	return p.Type == PeerLearner
+}
+
 func (cc *ConfChange) String() string {
 	return fmt.Sprintf(`{"type":"%v",%v}`, cc.Type, cc.Peer.String())
 }
 
 func (m *Message) IsResponseMsg() bool {
-	return m.Type == RespMsgAppend || m.Type == RespMsgHeartBeat || m.Type == RespMsgVote || m.Type == RespMsgElectAck || m.Type == RespMsgSnapShot
+	return m.Type == RespMsgAppend || m.Type == RespMsgHeartBeat || m.Type == RespMsgVote || m.Type == RespMsgElectAck || m.Type == RespMsgSnapShot ||
+		m.Type == RespMsgReadIndex || m.Type == RespMsgPreVote
 }
 
 func (m *Message) IsElectionMsg() bool {
 	return m.Type == ReqMsgHeartBeat || m.Type == RespMsgHeartBeat || m.Type == ReqMsgVote || m.Type == RespMsgVote ||
-		m.Type == ReqMsgElectAck || m.Type == RespMsgElectAck || m.Type == LeaseMsgOffline || m.Type == LeaseMsgTimeout
+		m.Type == ReqMsgElectAck || m.Type == RespMsgElectAck || m.Type == LeaseMsgOffline || m.Type == LeaseMsgTimeout ||
+		m.Type == ReqMsgPreVote || m.Type == RespMsgPreVote
 }
 
 func (m *Message) IsHeartbeatMsg() bool {
@@ -23,6 +23,8 @@ type apply struct {
 	index   uint64
 	future  *Future
 	command interface{}
+	// read responds the read index request after all the entries before index are applied.
+	read bool
 }
 
 type softState struct {
@@ -44,6 +46,10 @@ func (s *peerState) change(c *proto.ConfChange) {
 		delete(s.peers, c.Peer.ID)
 	case proto.ConfUpdateNode:
 		s.peers[c.Peer.ID] = c.Peer
+	case proto.ConfPromoteNode:
+		peer := c.Peer
+		peer.Type = proto.PeerNormal
+		s.peers[c.Peer.ID] = peer
 	}
 	s.mu.Unlock()
 }
@@ -78,8 +84,11 @@ type raft struct {
 	prevHardSt        proto.HardState
 	peerState         peerState
 	pending           map[uint64]*Future
+	readSeq           uint64
+	reading           map[uint64][]*Future
 	snapping          map[uint64]*snapshotStatus
 	propc             chan *proposal
+	readc             chan *Future
 	applyc            chan *apply
 	recvc             chan *proto.Message
 	snapRecvc         chan *snapshotRequest
@@ -110,10 +119,12 @@ func newRaft(config *Config, raftConfig *RaftConfig) (*raft, error) {
 		config:     config,
 		raftConfig: raftConfig,
 		pending:    make(map[uint64]*Future),
+		reading:    make(map[uint64][]*Future),
 		snapping:   make(map[uint64]*snapshotStatus),
 		recvc:      make(chan *proto.Message, config.ReqBufferSize),
 		applyc:     make(chan *apply, config.AppBufferSize),
 		propc:      make(chan *proposal, 256),
+		readc:      make(chan *Future, 256),
 		snapRecvc:  make(chan *snapshotRequest, 1),
 		truncatec:  make(chan uint64, 1),
 		statusc:    make(chan chan *Status, 1),
@@ -173,6 +184,11 @@ func (s *raft) runApply() {
 			return
 
 		case apply := <-s.applyc:
+			if apply.read {
+				apply.future.respond(apply.index, nil)
+				pool.returnApply(apply)
+				continue
+			}
 			if apply.index <= s.curApplied.Get() {
 				continue
 			}
@@ -201,6 +217,7 @@ func (s *raft) run() {
 	defer func() {
 		s.doStop()
 		s.resetPending(ErrStopped)
+		s.resetReading(ErrStopped)
 		s.stopSnapping()
 		s.raftConfig.Storage.Close()
 		close(s.done)
@@ -259,6 +276,30 @@ func (s *raft) run() {
 			}
 			s.raftFsm.Step(msg)
 
+		case future := <-s.readc:
+			if s.raftFsm.leader != s.config.NodeID {
+				future.respond(nil, ErrNotLeader)
+				break
+			}
+
+			// the read requests arrived together share one leadership confirmation
+			futures := []*Future{future}
+			flag := false
+			for i := 1; i < 64; i++ {
+				select {
+				case f := <-s.readc:
+					futures = append(futures, f)
+				default:
+					flag = true
+				}
+				if flag {
+					break
+				}
+			}
+			s.readSeq++
+			s.reading[s.readSeq] = futures
+			s.raftFsm.addReadIndex(s.readSeq)
+
 		case m := <-s.recvc:
 			if _, ok := s.raftFsm.replicas[m.From]; ok || (!m.IsResponseMsg() && m.Type != proto.ReqMsgVote) ||
 				(m.Type == proto.ReqMsgVote && s.raftFsm.raftLog.isUpToDate(m.Index, m.LogTerm, 0, 0)) {
@@ -285,6 +326,7 @@ func (s *raft) run() {
 		case <-readyc:
 			s.persist()
 			s.apply()
+			s.applyReadIndex()
 			s.advance()
 			// Send all messages.
 			for _, msg := range s.raftFsm.msgs {
@@ -379,6 +421,19 @@ func (s *raft) proposeMemberChange(cc *proto.ConfChange, future *Future) {
 	}
 }
 
+func (s *raft) readIndex(future *Future) {
+	if !s.isLeader() {
+		future.respond(nil, ErrNotLeader)
+		return
+	}
+
+	select {
+	case <-s.stopc:
+		future.respond(nil, ErrStopped)
+	case s.readc <- future:
+	}
+}
+
 func (s *raft) reciveMessage(m *proto.Message) {
 	if s.restoringSnapshot.Get() {
 		return
@@ -479,6 +534,7 @@ func (s *raft) maybeChange() {
 		updated = true
 		s.prevSoftSt.term = s.raftFsm.term
 		s.resetTick()
+		s.resetReading(ErrNotLeader)
 	}
 	preLeader := s.prevSoftSt.leader
 	if preLeader != s.raftFsm.leader {
@@ -486,6 +542,7 @@ func (s *raft) maybeChange() {
 		s.prevSoftSt.leader = s.raftFsm.leader
 		if s.raftFsm.leader != s.config.NodeID {
 			s.resetPending(ErrNotLeader)
+			s.resetReading(ErrNotLeader)
 			s.stopSnapping()
 		}
 		if logger.IsEnableWarn() {
@@ -562,6 +619,32 @@ func (s *raft) apply() {
 	}
 }
 
+// applyReadIndex sends the confirmed read requests to the apply queue,
+// the entries before the read index have been sent by apply.
+func (s *raft) applyReadIndex() {
+	committed := s.raftFsm.raftLog.committed
+	n := 0
+	for _, rs := range s.raftFsm.readStates {
+		if rs.index > committed {
+			break
+		}
+		n++
+		for _, future := range s.reading[rs.seq] {
+			apply := pool.getApply()
+			apply.index = rs.index
+			apply.future = future
+			apply.read = true
+			select {
+			case <-s.stopc:
+				future.respond(nil, ErrStopped)
+			case s.applyc <- apply:
+			}
+		}
+		delete(s.reading, rs.seq)
+	}
+	s.raftFsm.readStates = s.raftFsm.readStates[n:]
+}
+
 func (s *raft) advance() {
 	s.raftFsm.raftLog.appliedTo(s.raftFsm.raftLog.committed)
 	entries := s.raftFsm.raftLog.unstableEntries()
@@ -572,7 +655,8 @@ func (s *raft) advance() {
 
 func (s *raft) containsUpdate() bool {
 	return len(s.raftFsm.raftLog.unstableEntries()) > 0 || s.raftFsm.raftLog.committed > s.raftFsm.raftLog.applied || len(s.raftFsm.msgs) > 0 ||
-		s.raftFsm.raftLog.committed != s.prevHardSt.Commit || s.raftFsm.term != s.prevHardSt.Term || s.raftFsm.vote != s.prevHardSt.Vote
+		s.raftFsm.raftLog.committed != s.prevHardSt.Commit || s.raftFsm.term != s.prevHardSt.Term || s.raftFsm.vote != s.prevHardSt.Vote ||
+		(len(s.raftFsm.readStates) > 0 && s.raftFsm.readStates[0].index <= s.raftFsm.raftLog.committed)
 }
 
 func (s *raft) resetPending(err error) {
@@ -584,6 +668,15 @@ func (s *raft) resetPending(err error) {
 	}
 }
 
+func (s *raft) resetReading(err error) {
+	for seq, futures := range s.reading {
+		for _, future := range futures {
+			future.respond(nil, err)
+		}
+		delete(s.reading, seq)
+	}
+}
+
 func (s *raft) resetTick() {
 	for {
 		select {
@@ -645,6 +738,7 @@ func (s *raft) getStatus() *Status {
 				Active:      p.active,
 				LastActive:  p.lastActive,
 				Inflight:    p.count,
+				Learner:     p.peer.IsLearner(),
 			}
 		}
 	}
@@ -35,8 +35,12 @@ type raftFsm struct {
 	acks        map[uint64]bool
 	replicas    map[uint64]*replica
 	msgs        []*proto.Message
-	step        stepFunc
-	tick        func()
+	// termStartIndex is the index of the first entry appended by the leader in current term.
+	termStartIndex uint64
+	readIndexes    []*readIndexStatus
+	readStates     []readState
+	step           stepFunc
+	tick           func()
 }
 
 func newRaftFsm(config *Config, raftConfig *RaftConfig) (*raftFsm, error) {
@@ -148,10 +152,18 @@ func (r *raftFsm) Step(m *proto.Message) {
 	case m.Term == 0:
 		// local message
 	case m.Term > r.term:
+		// The pre-vote request carries the term of the next election and the granted response carries the term of the request,
+		// they don't change the term.
+		if m.Type == proto.ReqMsgPreVote || (m.Type == proto.RespMsgPreVote && !m.Reject) {
+			break
+		}
 		if logger.IsEnableDebug() {
 			logger.Debug("raft[%v] [Step] [term: %d] received a [%s] message with higher term from [%v term: %d].", r.id, r.term, m.Type, m.From, m.Term)
 		}
 		lead := m.From
+		if m.IsResponseMsg() {
+			lead = NoLeader
+		}
 		if m.Type == proto.ReqMsgVote {
 			lead = NoLeader
 			inLease := r.config.LeaseCheck && r.state == stateFollower && r.leader != NoLeader
@@ -167,15 +179,40 @@ func (r *raftFsm) Step(m *proto.Message) {
 				r.send(nmsg)
 				return
 			}
+			if r.config.CheckQuorum && !m.ForceVote && r.leader != NoLeader && r.leader != m.From && r.electionElapsed < r.config.ElectionTick {
+				if logger.IsEnableInfo() {
+					logger.Info("raft[%v] [Step] [logterm: %d, index: %d, vote: %v] ignored vote from %v [logterm: %d, index: %d] at term %d: leader %v is active.",
+						r.id, r.raftLog.lastTerm(), r.raftLog.lastIndex(), r.vote, m.From, m.LogTerm, m.Index, r.term, r.leader)
+				}
+				return
+			}
 		}
 		r.becomeFollower(m.Term, lead)
 
 	case m.Term < r.term:
+		if m.Type == proto.ReqMsgPreVote {
+			// Reject with the higher term, so that the pre-candidate catches up the term.
+			r.handlePreVote(m)
+			return
+		}
+		if (r.config.PreVote || r.config.CheckQuorum) && m.Type == proto.ReqMsgAppend {
+			// A node with higher term ignores the leader's entries and can't rejoin since its pre-vote or vote is rejected,
+			// responding with the higher term makes the leader step down and start a new election.
+			nmsg := proto.GetMessage()
+			nmsg.Type = proto.RespMsgAppend
+			nmsg.To = m.From
+			r.send(nmsg)
+		}
 		if logger.IsEnableDebug() {
 			logger.Debug("raft[%v] [Step] [term: %d] ignored a %s message with lower term from [%v term: %d].", r.id, r.term, m.Type, m.From, m.Term)
 		}
 		return
 	}
+
+	if m.Type == proto.ReqMsgPreVote {
+		r.handlePreVote(m)
+		return
+	}
 	r.step(r, m)
 }
 
@@ -231,6 +268,8 @@ func (r *raftFsm) applyConfChange(cc *proto.ConfChange) {
 		r.removePeer(cc.Peer)
 	case proto.ConfUpdateNode:
 		r.updatePeer(cc.Peer)
+	case proto.ConfPromoteNode:
+		r.promotePeer(cc.Peer)
 	}
 }
 
@@ -252,6 +291,11 @@ func (r *raftFsm) removePeer(peer proto.Peer) {
 	logger.Info("raft[%d] remove peer(%d)", r.id, peer.ID)
 
 	r.pendingConf = false
+	// Send the commit of this change to the removed peer before dropping it,
+	// otherwise it never learns that it has been removed.
+	if _, ok := r.replicas[peer.ID]; ok && r.state == stateLeader && peer.ID != r.config.NodeID {
+		r.sendAppend(peer.ID)
+	}
 	delete(r.replicas, peer.ID)
 
 	if peer.ID == r.config.NodeID {
@@ -272,14 +316,46 @@ func (r *raftFsm) updatePeer(peer proto.Peer) {
 	}
 }
 
+// promotePeer makes the learner vote and count toward commit.
+func (r *raftFsm) promotePeer(peer proto.Peer) {
+	logger.Info("raft[%d] promote peer(%d)", r.id, peer.ID)
+
+	r.pendingConf = false
+	pr, ok := r.replicas[peer.ID]
+	if !ok || !pr.peer.IsLearner() {
+		return
+	}
+	pr.peer = peer
+	pr.peer.Type = proto.PeerNormal
+	if r.state == stateLeader {
+		if r.maybeCommit() {
+			r.bcastAppend()
+		}
+	}
+}
+
+// isVoter returns whether the replica votes and counts toward commit.
+func (r *raftFsm) isVoter(id uint64) bool {
+	pr, ok := r.replicas[id]
+	return ok && !pr.peer.IsLearner()
+}
+
+// quorum is the majority of the voters, learners are excluded.
 func (r *raftFsm) quorum() int {
-	return len(r.replicas)/2 + 1
+	voters := 0
+	for _, pr := range r.replicas {
+		if !pr.peer.IsLearner() {
+			voters++
+		}
+	}
+	return voters/2 + 1
 }
 
 func (r *raftFsm) send(m *proto.Message) {
 	m.ID = r.id
 	m.From = r.config.NodeID
-	if m.Type != proto.LocalMsgProp {
+	// The term of pre-vote messages is set by the sender.
+	if m.Type != proto.LocalMsgProp && m.Type != proto.ReqMsgPreVote && m.Type != proto.RespMsgPreVote {
 		m.Term = r.term
 	}
 	r.msgs = append(r.msgs, m)
@@ -295,6 +371,9 @@ func (r *raftFsm) reset(term, lasti uint64, isLeader bool) {
 	r.heartbeatElapsed = 0
 	r.votes = make(map[uint64]bool)
 	r.pendingConf = false
+	r.termStartIndex = 0
+	r.readIndexes = nil
+	r.readStates = nil
 
 	if isLeader {
 		r.randElectionTick = r.config.ElectionTick - 1
@@ -2,6 +2,7 @@ package raft
 
 import (
 	"fmt"
+	"math"
 
 	"master-server/raft/logger"
 	"master-server/raft/proto"
@@ -23,6 +24,22 @@ func (r *raftFsm) becomeCandidate() {
 	}
 }
 
+// becomePreCandidate starts the pre-vote phase, the pre-candidate does not increase the term or change the vote.
+func (r *raftFsm) becomePreCandidate() {
+	if r.state == stateLeader {
+		panic(AppPanicError(fmt.Sprintf("[raft->becomePreCandidate][%v] invalid transition [leader -> pre-candidate].", r.id)))
+	}
+
+	r.step = stepCandidate
+	r.reset(r.term, 0, false)
+	r.tick = r.tickElection
+	r.state = statePreCandidate
+
+	if logger.IsEnableInfo() {
+		logger.Info("raft[%v] became pre-candidate at term %d.", r.id, r.term)
+	}
+}
+
 func stepCandidate(r *raftFsm, m *proto.Message) {
 	switch m.Type {
 	case proto.LocalMsgProp:
@@ -42,6 +59,12 @@ func stepCandidate(r *raftFsm, m *proto.Message) {
 		r.becomeFollower(r.term, m.From)
 		return
 
+	case proto.ReqMsgReadIndex:
+		r.becomeFollower(r.term, m.From)
+		r.handleReadIndex(m)
+		proto.ReturnMessage(m)
+		return
+
 	case proto.ReqMsgElectAck:
 		r.becomeFollower(r.term, m.From)
 		nmsg := proto.GetMessage()
@@ -63,7 +86,26 @@ func stepCandidate(r *raftFsm, m *proto.Message) {
 		proto.ReturnMessage(m)
 		return
 
+	case proto.RespMsgPreVote:
+		if r.state != statePreCandidate {
+			return
+		}
+		gr := r.poll(m.From, !m.Reject)
+		if logger.IsEnableInfo() {
+			logger.Info("raft[%v] [q:%d] has received %d pre-votes and %d pre-vote rejections.", r.id, r.quorum(), gr, len(r.votes)-gr)
+		}
+		switch r.quorum() {
+		case gr:
+			r.startElection(false)
+		case len(r.votes) - gr:
+			r.becomeFollower(r.term, NoLeader)
+		}
+
 	case proto.RespMsgVote:
+		// ignore the votes of previous election
+		if r.state != stateCandidate {
+			return
+		}
 		gr := r.poll(m.From, !m.Reject)
 		if logger.IsEnableInfo() {
 			logger.Info("raft[%v] [q:%d] has received %d votes and %d vote rejections.", r.id, r.quorum(), gr, len(r.votes)-gr)
@@ -83,6 +125,20 @@ func stepCandidate(r *raftFsm, m *proto.Message) {
 }
 
 func (r *raftFsm) campaign(force bool) {
+	// the force election(TryToLeader) skips the pre-vote phase
+	if r.config.PreVote && !force {
+		r.becomePreCandidate()
+		if r.quorum() == r.poll(r.config.NodeID, true) {
+			r.startElection(false)
+			return
+		}
+		r.sendVoteRequests(proto.ReqMsgPreVote, r.term+1, false)
+		return
+	}
+	r.startElection(force)
+}
+
+func (r *raftFsm) startElection(force bool) {
 	r.becomeCandidate()
 	if r.quorum() == r.poll(r.config.NodeID, true) {
 		if r.config.LeaseCheck {
@@ -92,19 +148,23 @@ func (r *raftFsm) campaign(force bool) {
 		}
 		return
 	}
+	r.sendVoteRequests(proto.ReqMsgVote, r.term, force)
+}
 
+func (r *raftFsm) sendVoteRequests(typ proto.MsgType, term uint64, force bool) {
+	li, lt := r.raftLog.lastIndexAndTerm()
 	for id := range r.replicas {
-		if id == r.config.NodeID {
+		if id == r.config.NodeID || !r.isVoter(id) {
 			continue
 		}
-		li, lt := r.raftLog.lastIndexAndTerm()
 		if logger.IsEnableDebug() {
-			logger.Debug("raft[%v] campaign: [logterm: %d, index: %d] sent vote request to %v at term %d.", r.id, lt, li, id, r.term)
+			logger.Debug("raft[%v] campaign: [logterm: %d, index: %d] sent %s to %v at term %d.", r.id, lt, li, typ, id, term)
 		}
 
 		m := proto.GetMessage()
 		m.To = id
-		m.Type = proto.ReqMsgVote
+		m.Type = typ
+		m.Term = term
 		m.ForceVote = force
 		m.Index = li
 		m.LogTerm = lt
@@ -112,6 +172,46 @@ func (r *raftFsm) campaign(force bool) {
 	}
 }
 
+// handlePreVote grants the pre-vote if the node would vote for the pre-candidate in the next election,
+// the node which has an active leader rejects it.
+func (r *raftFsm) handlePreVote(m *proto.Message) {
+	fpri, lpri := uint16(math.MaxUint16), uint16(0)
+	if pr, ok := r.replicas[m.From]; ok {
+		fpri = pr.peer.Priority
+	}
+	if pr, ok := r.replicas[r.config.NodeID]; ok {
+		lpri = pr.peer.Priority
+	}
+
+	inLease := r.leader != NoLeader && (r.electionElapsed < r.config.ElectionTick || (r.config.LeaseCheck && r.state == stateFollower))
+	nmsg := proto.GetMessage()
+	nmsg.Type = proto.RespMsgPreVote
+	nmsg.To = m.From
+	if m.Term > r.term && !inLease && r.raftLog.isUpToDate(m.Index, m.LogTerm, fpri, lpri) {
+		if logger.IsEnableInfo() {
+			logger.Info("raft[%v] [logterm: %d, index: %d] granted pre-vote for %v [logterm: %d, index: %d] at term %d.", r.id, r.raftLog.lastTerm(), r.raftLog.lastIndex(), m.From, m.LogTerm, m.Index, r.term)
+		}
+		nmsg.Term = m.Term
+	} else {
+		if logger.IsEnableInfo() {
+			logger.Info("raft[%v] [logterm: %d, index: %d, leader: %v] rejected pre-vote from %v [logterm: %d, index: %d] at term %d.", r.id, r.raftLog.lastTerm(), r.raftLog.lastIndex(), r.leader, m.From, m.LogTerm, m.Index, r.term)
+		}
+		nmsg.Term = r.term
+		nmsg.Reject = true
+	}
+	r.send(nmsg)
+
+	// The heartbeat does not carry the term and is ignored by the node without leader,
+	// the rejoined node learns the leader by the append message.
+	if r.state == stateLeader {
+		if pr, ok := r.replicas[m.From]; ok {
+			pr.resume()
+			r.sendAppend(m.From)
+		}
+	}
+	proto.ReturnMessage(m)
+}
+
 func (r *raftFsm) poll(id uint64, v bool) (granted int) {
 	if logger.IsEnableDebug() {
 		if v {
@@ -120,7 +220,7 @@ func (r *raftFsm) poll(id uint64, v bool) (granted int) {
 			logger.Debug("raft[%v] received vote rejection from %v at term %d.", r.id, id, r.term)
 		}
 	}
-	if _, ok := r.votes[id]; !ok {
+	if _, ok := r.votes[id]; !ok && r.isVoter(id) {
 		r.votes[id] = v
 	}
 	for _, vv := range r.votes {
@@ -43,6 +43,13 @@ func stepFollower(r *raftFsm, m *proto.Message) {
 		r.leader = m.From
 		return
 
+	case proto.ReqMsgReadIndex:
+		r.electionElapsed = 0
+		r.leader = m.From
+		r.handleReadIndex(m)
+		proto.ReturnMessage(m)
+		return
+
 	case proto.ReqMsgElectAck:
 		r.electionElapsed = 0
 		r.leader = m.From
@@ -155,7 +162,7 @@ func (r *raftFsm) handleAppendEntries(m *proto.Message) {
 	}
 }
 
+// promotable returns whether the node can campaign, learners never start an election.
 func (r *raftFsm) promotable() bool {
-	_, ok := r.replicas[r.config.NodeID]
-	return ok
+	return r.isVoter(r.config.NodeID)
 }
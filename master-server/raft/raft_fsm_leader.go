@@ -37,6 +37,7 @@ func (r *raftFsm) becomeLeader() {
 		r.pendingConf = true
 	}
 
+	r.termStartIndex = lasti + 1
 	r.appendEntry(&proto.Entry{Term: r.term, Index: lasti + 1, Data: nil})
 	if logger.IsEnableInfo() {
 		logger.Info("raft[%v] became leader at term %d.", r.id, r.term)
@@ -90,6 +91,7 @@ func stepLeader(r *raftFsm, m *proto.Message) {
 	case proto.RespMsgAppend:
 		pr.active = true
 		pr.lastActive = time.Now()
+		pr.lastAck = pr.lastActive
 
 		if m.Reject {
 			if logger.IsEnableDebug() {
@@ -141,6 +143,14 @@ func stepLeader(r *raftFsm, m *proto.Message) {
 		}
 		return
 
+	case proto.RespMsgReadIndex:
+		pr.active = true
+		pr.lastActive = time.Now()
+		pr.lastAck = pr.lastActive
+		r.recvReadIndexAck(m.From, m.Index)
+		proto.ReturnMessage(m)
+		return
+
 	case proto.LeaseMsgOffline:
 		for id := range r.replicas {
 			if id == r.config.NodeID {
@@ -196,7 +206,7 @@ func (r *raftFsm) becomeElectionAck() {
 	r.tick = r.tickElectionAck
 	r.state = stateElectionACK
 	for id := range r.replicas {
-		if id == r.config.NodeID {
+		if id == r.config.NodeID || !r.isVoter(id) {
 			continue
 		}
 
@@ -226,6 +236,12 @@ func stepElectionAck(r *raftFsm, m *proto.Message) {
 		r.becomeFollower(r.term, m.From)
 		return
 
+	case proto.ReqMsgReadIndex:
+		r.becomeFollower(r.term, m.From)
+		r.handleReadIndex(m)
+		proto.ReturnMessage(m)
+		return
+
 	case proto.ReqMsgElectAck:
 		r.becomeFollower(r.term, m.From)
 		nmsg := proto.GetMessage()
@@ -247,7 +263,9 @@ func stepElectionAck(r *raftFsm, m *proto.Message) {
 	case proto.RespMsgElectAck:
 		r.replicas[m.From].active = true
 		r.replicas[m.From].lastActive = time.Now()
-		r.acks[m.From] = true
+		if r.isVoter(m.From) {
+			r.acks[m.From] = true
+		}
 		if len(r.acks) >= r.quorum() {
 			r.becomeLeader()
 			r.bcastAppend()
@@ -262,7 +280,7 @@ func (r *raftFsm) tickHeartbeat() {
 	r.electionElapsed++
 	if r.pastElectionTimeout() {
 		r.electionElapsed = 0
-		if r.config.LeaseCheck && !r.checkLeaderLease() {
+		if (r.config.LeaseCheck || r.config.CheckQuorum) && !r.checkLeaderLease() {
 			if logger.IsEnableWarn() {
 				logger.Warn("raft[%v] stepped down to follower since quorum is not active.", r.id)
 			}
@@ -302,6 +320,10 @@ func (r *raftFsm) tickElectionAck() {
 func (r *raftFsm) checkLeaderLease() bool {
 	var act int
 	for id := range r.replicas {
+		if !r.isVoter(id) {
+			r.replicas[id].active = false
+			continue
+		}
 		if id == r.config.NodeID || r.replicas[id].state == replicaStateSnapshot {
 			act++
 			continue
@@ -319,7 +341,10 @@ func (r *raftFsm) checkLeaderLease() bool {
 func (r *raftFsm) maybeCommit() bool {
 	mis := make(util.Uint64Slice, 0, len(r.replicas))
 	for _, rp := range r.replicas {
-		mis = append(mis, rp.match)
+		// the learners don't count toward commit
+		if !rp.peer.IsLearner() {
+			mis = append(mis, rp.match)
+		}
 	}
 	sort.Sort(sort.Reverse(mis))
 	mci := mis[r.quorum()-1]
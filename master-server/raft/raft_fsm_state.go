@@ -6,10 +6,11 @@ type (
 )
 
 const (
-	stateFollower    fsmState = 0
-	stateCandidate            = 1
-	stateLeader               = 2
-	stateElectionACK          = 3
+	stateFollower     fsmState = 0
+	stateCandidate             = 1
+	stateLeader                = 2
+	stateElectionACK           = 3
+	statePreCandidate          = 4
 
 	replicaStateProbe     replicaState = 0
 	replicaStateReplicate              = 1
@@ -26,6 +27,8 @@ func (st fsmState) String() string {
 		return "StateLeader"
 	case 3:
 		return "StateElectionACK"
+	case 4:
+		return "StatePreCandidate"
 	}
 	return ""
 }
@@ -0,0 +1,98 @@
+package raft
+
+import (
+	"testing"
+
+	"master-server/raft/proto"
+	"master-server/raft/storage"
+)
+
+func newLearnerTestFsm(t *testing.T, nodeID uint64) *raftFsm {
+	config := DefaultConfig()
+	config.NodeID = nodeID
+	raftConfig := &RaftConfig{
+		ID:           1,
+		Peers:        []proto.Peer{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4, Type: proto.PeerLearner}},
+		Storage:      storage.DefaultMemoryStorage(),
+		StateMachine: &readTestStateMachine{},
+	}
+	r, err := newRaftFsm(config, raftConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func appendAck(r *raftFsm, from, index uint64) {
+	m := proto.GetMessage()
+	m.Type = proto.RespMsgAppend
+	m.ID = r.id
+	m.From = from
+	m.To = r.config.NodeID
+	m.Term = r.term
+	m.Index = index
+	r.Step(m)
+}
+
+func TestLearnerCampaign(t *testing.T) {
+	r := newLearnerTestFsm(t, 1)
+	if r.quorum() != 2 {
+		t.Fatalf("unexpected quorum %d", r.quorum())
+	}
+	r.campaign(false)
+	if len(r.msgs) != 2 {
+		t.Fatalf("unexpected messages %v", r.msgs)
+	}
+	for _, m := range r.msgs {
+		if m.Type != proto.ReqMsgVote || m.To == 4 {
+			t.Fatalf("unexpected message %v", m)
+		}
+	}
+
+	// 学习者不发起选举
+	l := newLearnerTestFsm(t, 4)
+	for i := 0; i < 3*l.config.ElectionTick; i++ {
+		l.tick()
+	}
+	if l.state != stateFollower || l.term != 0 || len(l.msgs) != 0 {
+		t.Fatalf("learner campaigns: state %v term %d", l.state, l.term)
+	}
+}
+
+func TestLearnerCommit(t *testing.T) {
+	r := newLearnerTestFsm(t, 1)
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.bcastAppend()
+	index := r.raftLog.lastIndex()
+	if len(r.msgs) != 3 {
+		t.Fatalf("log is not replicated to the learner: %v", r.msgs)
+	}
+
+	// 学习者的确认不计入提交
+	appendAck(r, 4, index)
+	if r.raftLog.committed >= index {
+		t.Fatalf("entry is committed by the learner")
+	}
+	appendAck(r, 2, index)
+	if r.raftLog.committed != index {
+		t.Fatalf("unexpected commit %d, expected %d", r.raftLog.committed, index)
+	}
+}
+
+func TestLearnerPromote(t *testing.T) {
+	r := newLearnerTestFsm(t, 4)
+	r.applyConfChange(&proto.ConfChange{Type: proto.ConfPromoteNode, Peer: proto.Peer{ID: 4}})
+	if !r.promotable() || r.replicas[4].peer.IsLearner() {
+		t.Fatalf("learner is not promoted")
+	}
+	if r.quorum() != 3 {
+		t.Fatalf("unexpected quorum %d", r.quorum())
+	}
+
+	// 提升不存在的成员没有影响
+	r.applyConfChange(&proto.ConfChange{Type: proto.ConfPromoteNode, Peer: proto.Peer{ID: 5}})
+	if _, ok := r.replicas[5]; ok {
+		t.Fatalf("unknown peer is added by promotion")
+	}
+}
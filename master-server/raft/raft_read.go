@@ -0,0 +1,110 @@
+package raft
+
+import (
+	"time"
+
+	"master-server/raft/logger"
+	"master-server/raft/proto"
+)
+
+// readIndexStatus is a read request waiting for the leadership confirmation of a quorum.
+type readIndexStatus struct {
+	seq   uint64
+	index uint64
+	acks  map[uint64]bool
+}
+
+// readState is a confirmed read request, it can be served once the applied index reaches index.
+type readState struct {
+	seq   uint64
+	index uint64
+}
+
+// addReadIndex registers a read request on the leader.
+// The read index is the commit index when the request arrives, but not less than the first index of the current term,
+// so that all entries committed by previous leaders are applied before the read is served.
+func (r *raftFsm) addReadIndex(seq uint64) {
+	index := r.raftLog.committed
+	if index < r.termStartIndex {
+		index = r.termStartIndex
+	}
+
+	if r.quorum() == 1 || r.inLeaderLease() {
+		r.readStates = append(r.readStates, readState{seq: seq, index: index})
+		return
+	}
+
+	rs := &readIndexStatus{seq: seq, index: index, acks: map[uint64]bool{r.config.NodeID: true}}
+	r.readIndexes = append(r.readIndexes, rs)
+	for id := range r.replicas {
+		if id == r.config.NodeID || !r.isVoter(id) {
+			continue
+		}
+		m := proto.GetMessage()
+		m.Type = proto.ReqMsgReadIndex
+		m.To = id
+		m.Index = seq
+		r.send(m)
+	}
+}
+
+// recvReadIndexAck handles the ack of a follower in the current term.
+// An ack for seq also confirms all the read requests registered before seq.
+func (r *raftFsm) recvReadIndexAck(from, seq uint64) {
+	if !r.isVoter(from) {
+		return
+	}
+	for _, rs := range r.readIndexes {
+		if rs.seq > seq {
+			break
+		}
+		rs.acks[from] = true
+	}
+
+	n := 0
+	for _, rs := range r.readIndexes {
+		if len(rs.acks) < r.quorum() {
+			break
+		}
+		r.readStates = append(r.readStates, readState{seq: rs.seq, index: rs.index})
+		n++
+	}
+	r.readIndexes = r.readIndexes[n:]
+}
+
+// handleReadIndex responds the leader's read request, a follower in the same term confirms the leadership.
+func (r *raftFsm) handleReadIndex(m *proto.Message) {
+	nmsg := proto.GetMessage()
+	nmsg.Type = proto.RespMsgReadIndex
+	nmsg.To = m.From
+	nmsg.Index = m.Index
+	r.send(nmsg)
+}
+
+// inLeaderLease returns whether the leader can serve reads without confirmation.
+// Followers will not vote for others within 2 * ElectionTick after receiving the leader's message when LeaseCheck is enabled,
+// the leader only trusts the acks received in the last ElectionTick.
+// Notice that the force vote(TryToLeader) breaks the lease.
+func (r *raftFsm) inLeaderLease() bool {
+	if !r.config.LeaseCheck || !r.config.LeaseRead {
+		return false
+	}
+
+	lease := time.Duration(r.config.ElectionTick) * r.config.TickInterval
+	act := 0
+	for id, pr := range r.replicas {
+		if pr.peer.IsLearner() {
+			continue
+		}
+		if id == r.config.NodeID || time.Since(pr.lastAck) < lease {
+			act++
+		}
+	}
+	if act >= r.quorum() {
+		return true
+	}
+	if logger.IsEnableDebug() {
+		logger.Debug("raft[%v] leader lease is expired, %d replicas acked in lease.", r.id, act)
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package raft
+
+import (
+	"testing"
+
+	"master-server/raft/proto"
+	"master-server/raft/storage"
+)
+
+type readTestStateMachine struct{}
+
+func (sm *readTestStateMachine) Apply(command []byte, index uint64) (interface{}, error) {
+	return nil, nil
+}
+
+func (sm *readTestStateMachine) ApplyMemberChange(confChange *proto.ConfChange, index uint64) (interface{}, error) {
+	return nil, nil
+}
+
+func (sm *readTestStateMachine) Snapshot() (proto.Snapshot, error) {
+	return nil, nil
+}
+
+func (sm *readTestStateMachine) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error {
+	return nil
+}
+
+func (sm *readTestStateMachine) HandleFatalEvent(err *FatalError) {}
+
+func (sm *readTestStateMachine) HandleLeaderChange(leader uint64) {}
+
+func newReadTestFsm(t *testing.T, nodeID uint64, leaseRead bool) *raftFsm {
+	config := DefaultConfig()
+	config.NodeID = nodeID
+	config.LeaseCheck = leaseRead
+	config.LeaseRead = leaseRead
+	raftConfig := &RaftConfig{
+		ID:           1,
+		Peers:        []proto.Peer{{ID: 1}, {ID: 2}, {ID: 3}},
+		Storage:      storage.DefaultMemoryStorage(),
+		StateMachine: &readTestStateMachine{},
+	}
+	r, err := newRaftFsm(config, raftConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func newReadTestLeader(t *testing.T, leaseRead bool) *raftFsm {
+	r := newReadTestFsm(t, 1, leaseRead)
+	// 任期为0的消息作为本地消息处理, 在任期2当选以便构造旧任期的消息
+	r.becomeCandidate()
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.msgs = nil
+	return r
+}
+
+func readIndexAck(r *raftFsm, from, term, seq uint64) {
+	m := proto.GetMessage()
+	m.Type = proto.RespMsgReadIndex
+	m.ID = r.id
+	m.From = from
+	m.To = r.config.NodeID
+	m.Term = term
+	m.Index = seq
+	r.Step(m)
+}
+
+func TestReadIndexQuorum(t *testing.T) {
+	r := newReadTestLeader(t, false)
+	r.addReadIndex(1)
+	if len(r.readStates) != 0 || len(r.readIndexes) != 1 {
+		t.Fatalf("read is confirmed without quorum")
+	}
+	if len(r.msgs) != 2 {
+		t.Fatalf("unexpected messages %d", len(r.msgs))
+	}
+	for _, m := range r.msgs {
+		if m.Type != proto.ReqMsgReadIndex || m.Index != 1 || m.Term != r.term {
+			t.Fatalf("unexpected message %v", m)
+		}
+	}
+	// 新任期的第一条日志还没有提交, 读取需要等待它应用
+	if r.readIndexes[0].index != r.termStartIndex || r.raftLog.committed >= r.termStartIndex {
+		t.Fatalf("unexpected read index %d, term start %d", r.readIndexes[0].index, r.termStartIndex)
+	}
+
+	// 旧任期的响应不能确认leader身份
+	readIndexAck(r, 2, r.term-1, 1)
+	if len(r.readStates) != 0 {
+		t.Fatalf("read is confirmed by stale term")
+	}
+	readIndexAck(r, 2, r.term, 1)
+	if len(r.readStates) != 1 || r.readStates[0].seq != 1 || len(r.readIndexes) != 0 {
+		t.Fatalf("unexpected read states %v", r.readStates)
+	}
+
+	// 对后面请求的确认同时确认之前的请求
+	r.addReadIndex(2)
+	r.addReadIndex(3)
+	readIndexAck(r, 3, r.term, 3)
+	if len(r.readStates) != 3 || r.readStates[1].seq != 2 || r.readStates[2].seq != 3 {
+		t.Fatalf("unexpected read states %v", r.readStates)
+	}
+}
+
+func TestReadIndexStepDown(t *testing.T) {
+	r := newReadTestLeader(t, false)
+	r.addReadIndex(1)
+	readIndexAck(r, 2, r.term+1, 1)
+	if r.state != stateFollower || len(r.readIndexes) != 0 || len(r.readStates) != 0 {
+		t.Fatalf("pending reads are not dropped after stepping down")
+	}
+}
+
+func TestReadIndexFollower(t *testing.T) {
+	r := newReadTestFsm(t, 2, false)
+	m := proto.GetMessage()
+	m.Type = proto.ReqMsgReadIndex
+	m.ID = r.id
+	m.From = 1
+	m.To = 2
+	m.Term = 1
+	m.Index = 5
+	r.Step(m)
+	if r.leader != 1 || r.term != 1 {
+		t.Fatalf("unexpected leader %d term %d", r.leader, r.term)
+	}
+	if len(r.msgs) != 1 || r.msgs[0].Type != proto.RespMsgReadIndex || r.msgs[0].Index != 5 || r.msgs[0].To != 1 {
+		t.Fatalf("unexpected messages %v", r.msgs)
+	}
+}
+
+func TestReadIndexLease(t *testing.T) {
+	r := newReadTestLeader(t, true)
+	// 新leader没有收到过确认, 需要走ReadIndex
+	r.addReadIndex(1)
+	if len(r.readStates) != 0 {
+		t.Fatalf("read is served before lease is established")
+	}
+	readIndexAck(r, 2, r.term, 1)
+	if len(r.readStates) != 1 {
+		t.Fatalf("unexpected read states %v", r.readStates)
+	}
+	r.msgs = nil
+	r.addReadIndex(2)
+	if len(r.readStates) != 2 || len(r.msgs) != 0 {
+		t.Fatalf("read in lease is not served locally")
+	}
+}
@@ -18,6 +18,8 @@ type replica struct {
 	match, next, committed, pendingSnap uint64
 
 	lastActive time.Time
+	// lastAck is the last time the replica responded in the leader's term, used by the leader lease.
+	lastAck time.Time
 }
 
 func newReplica(peer proto.Peer, maxInflight int) *replica {
@@ -196,6 +196,23 @@ func (rs *RaftServer) ChangeMember(ctx context.Context, id uint64, changeType pr
 	return
 }
 
+// ReadIndex confirms the leadership and waits until the state machine applies the commit index,
+// then the leader can serve linearizable reads from the local state machine.
+// The response is the read index.
+func (rs *RaftServer) ReadIndex(ctx context.Context, id uint64) (future *Future) {
+	rs.mu.RLock()
+	raft, ok := rs.rafts[id]
+	rs.mu.RUnlock()
+
+	future = newFuture(ctx)
+	if !ok {
+		future.respond(nil, ErrRaftNotExists)
+		return
+	}
+	raft.readIndex(future)
+	return
+}
+
 func (rs *RaftServer) Status(id uint64) (status *Status) {
 	rs.mu.RLock()
 	raft, ok := rs.rafts[id]
@@ -22,6 +22,7 @@ type ReplicaStatus struct {
 	Active      bool
 	LastActive  time.Time
 	Inflight    int
+	Learner     bool
 }
 
 // Status raft status
@@ -60,7 +61,7 @@ func (s *Status) String() string {
 			if v.Paused {
 				p = "true"
 			}
-			subj := fmt.Sprintf(`"%v":{"match":"%v","commit":"%v","next":"%v","state":"%v","paused":"%v","inflight":"%v","active":"%v"},`, k, v.Match, v.Commit, v.Next, v.State, p, v.Inflight, v.Active)
+			subj := fmt.Sprintf(`"%v":{"match":"%v","commit":"%v","next":"%v","state":"%v","paused":"%v","inflight":"%v","active":"%v","learner":"%v"},`, k, v.Match, v.Commit, v.Next, v.State, p, v.Inflight, v.Active, v.Learner)
 			j += subj
 		}
 		j = j[:len(j)-1] + "}}"
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
@@ -71,7 +72,7 @@ func (ms *memoryStatemachine) Snapshot() (proto.Snapshot, error) {
 	}
 }
 
-func (ms *memoryStatemachine) ApplySnapshot(iter proto.SnapIterator) error {
+func (ms *memoryStatemachine) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error {
 	ms.Lock()
 	defer ms.Unlock()
 
@@ -100,6 +101,9 @@ func (ms *memoryStatemachine) HandleFatalEvent(err *raft.FatalError) {
 	panic(err.Err)
 }
 
+func (ms *memoryStatemachine) HandleLeaderChange(leader uint64) {
+}
+
 func (ms *memoryStatemachine) Get(key string) (string, error) {
 	ms.RLock()
 	defer ms.RUnlock()
@@ -116,7 +120,7 @@ func (ms *memoryStatemachine) Put(key, value string) error {
 	if data, err := json.Marshal(kv); err != nil {
 		return err
 	} else {
-		resp := ms.raft.Submit(ms.id, data)
+		resp := ms.raft.Submit(context.Background(), ms.id, data)
 		_, err = resp.Response()
 		if err != nil {
 			return errors.New(fmt.Sprintf("Put error[%v].\r\n", err))
@@ -126,7 +130,7 @@ func (ms *memoryStatemachine) Put(key, value string) error {
 }
 
 func (ms *memoryStatemachine) AddNode(peer proto.Peer) error {
-	resp := ms.raft.ChangeMember(ms.id, proto.ConfAddNode, peer, nil)
+	resp := ms.raft.ChangeMember(context.Background(), ms.id, proto.ConfAddNode, peer, nil)
 	_, err := resp.Response()
 	if err != nil {
 		return errors.New("AddNode error.")
@@ -135,7 +139,7 @@ func (ms *memoryStatemachine) AddNode(peer proto.Peer) error {
 }
 
 func (ms *memoryStatemachine) RemoveNode(peer proto.Peer) error {
-	resp := ms.raft.ChangeMember(ms.id, proto.ConfRemoveNode, peer, nil)
+	resp := ms.raft.ChangeMember(context.Background(), ms.id, proto.ConfRemoveNode, peer, nil)
 	_, err := resp.Response()
 	if err != nil {
 		return errors.New("RemoveNode error.")
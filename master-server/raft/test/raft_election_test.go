@@ -2,6 +2,7 @@ package test
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -100,7 +101,7 @@ func TestWithoutLeaseAndDown(t *testing.T) {
 	w.WriteString(fmt.Sprintf("[%s] let leader to leader \r\n", time.Now().Format(format_time)))
 	for _, s := range servers {
 		if lead, _ := s.raft.LeaderTerm(1); s.nodeID == lead {
-			s.raft.TryToLeader(1)
+			s.raft.TryToLeader(context.Background(), 1)
 			break
 		}
 	}
@@ -111,7 +112,7 @@ func TestWithoutLeaseAndDown(t *testing.T) {
 	w.WriteString(fmt.Sprintf("[%s] let follower to leader \r\n", time.Now().Format(format_time)))
 	for _, s := range servers {
 		if lead, _ := s.raft.LeaderTerm(1); s.nodeID != lead {
-			s.raft.TryToLeader(1)
+			s.raft.TryToLeader(context.Background(), 1)
 			break
 		}
 	}
@@ -236,7 +237,7 @@ func TestWithLeaseAndDown(t *testing.T) {
 	w.WriteString(fmt.Sprintf("[%s] let leader to leader \r\n", time.Now().Format(format_time)))
 	for _, s := range servers {
 		if lead, _ := s.raft.LeaderTerm(1); s.nodeID == lead {
-			s.raft.TryToLeader(1)
+			s.raft.TryToLeader(context.Background(), 1)
 			break
 		}
 	}
@@ -247,7 +248,7 @@ func TestWithLeaseAndDown(t *testing.T) {
 	w.WriteString(fmt.Sprintf("[%s] let follower to leader \r\n", time.Now().Format(format_time)))
 	for _, s := range servers {
 		if lead, _ := s.raft.LeaderTerm(1); s.nodeID != lead {
-			s.raft.TryToLeader(1)
+			s.raft.TryToLeader(context.Background(), 1)
 			break
 		}
 	}
@@ -0,0 +1,240 @@
+package test
+
+import (
+	"bufio"
+	"fmt"
+	"testing"
+	"time"
+
+	"master-server/raft"
+	"master-server/raft/proto"
+	"master-server/raft/storage"
+)
+
+type partitionOption struct {
+	preVote     bool
+	checkQuorum bool
+}
+
+func createPartitionServer(nodeId, leader, term uint64, st storage.Storage, opt partitionOption, isolated bool) *testServer {
+	config := newServerConfig(nodeId, false)
+	config.PreVote = opt.preVote
+	config.CheckQuorum = opt.checkQuorum
+	if isolated {
+		config.Resolver = isolatedResolver{}
+	}
+	rs, err := raft.NewRaftServer(config)
+	if err != nil {
+		panic(err)
+	}
+
+	sm := newMemoryStatemachine(1, rs)
+	if st == nil {
+		st = getStorage(rs)
+	}
+	raftConfig := &raft.RaftConfig{
+		ID:           1,
+		Peers:        peers,
+		Term:         term,
+		Leader:       leader,
+		Storage:      st,
+		StateMachine: sm,
+	}
+	if err = rs.CreateRaft(raftConfig); err != nil {
+		panic(err)
+	}
+	return &testServer{
+		nodeID: nodeId,
+		peers:  peers,
+		raft:   rs,
+		sm:     sm,
+		store:  st,
+	}
+}
+
+// 重启节点并隔离, 保留日志和任期, leader重启后继续作为leader
+func isolateServer(s *testServer, opt partitionOption) *testServer {
+	resolver.isolate(s.nodeID)
+	leader, term := s.raft.LeaderTerm(1)
+	if leader != s.nodeID {
+		leader, term = 0, 0
+	}
+	s.raft.Stop()
+	return createPartitionServer(s.nodeID, leader, term, s.store, opt, true)
+}
+
+// 恢复网络后重启节点, 保留日志和任期
+func healServer(s *testServer, opt partitionOption) *testServer {
+	resolver.heal(s.nodeID)
+	s.raft.Stop()
+	return createPartitionServer(s.nodeID, 0, 0, s.store, opt, false)
+}
+
+func splitServers(servers []*testServer, nodeID uint64) (*testServer, []*testServer) {
+	var target *testServer
+	others := make([]*testServer, 0)
+	for _, s := range servers {
+		if s.nodeID == nodeID {
+			target = s
+		} else {
+			others = append(others, s)
+		}
+	}
+	return target, others
+}
+
+func stopServers(servers []*testServer) {
+	for _, s := range servers {
+		s.raft.Stop()
+	}
+	time.Sleep(100 * time.Millisecond)
+}
+
+func electionTimeout() time.Duration {
+	return time.Duration(elcTick) * tickInterval
+}
+
+func waitCondition(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
+func runFollowerPartition(t *testing.T, w *bufio.Writer, opt partitionOption) (uint64, uint64, uint64, uint64) {
+	servers := make([]*testServer, 0)
+	for _, p := range peers {
+		servers = append(servers, createPartitionServer(p.ID, 0, 0, nil, opt, false))
+	}
+	fmt.Println("waiting electing leader....")
+	leader := waitElect(servers, w)
+	_, term := leader.raft.LeaderTerm(1)
+	if err := leader.sm.Put("k1", "v1"); err != nil {
+		t.Fatal(err)
+	}
+	printStatus(servers, w)
+
+	var follower *testServer
+	for _, s := range servers {
+		if s.nodeID != leader.nodeID {
+			follower = s
+			break
+		}
+	}
+	w.WriteString(fmt.Sprintf("[%s] isolate follower %d\r\n", time.Now().Format(format_time), follower.nodeID))
+	follower, others := splitServers(servers, follower.nodeID)
+	follower = isolateServer(follower, opt)
+	time.Sleep(6 * electionTimeout())
+	isolatedTerm := follower.raft.Status(1).Term
+	if l, tm := leader.raft.LeaderTerm(1); l != leader.nodeID || tm != term {
+		t.Fatalf("leader changed during partition: leader %d term %d", l, tm)
+	}
+	printStatus(append(others, follower), w)
+
+	w.WriteString(fmt.Sprintf("[%s] heal follower %d\r\n", time.Now().Format(format_time), follower.nodeID))
+	follower = healServer(follower, opt)
+	servers = append(others, follower)
+	time.Sleep(4 * electionTimeout())
+	fmt.Println("waiting electing leader....")
+	newLeader := waitElect(servers, w)
+	_, newTerm := newLeader.raft.LeaderTerm(1)
+	if v, err := follower.sm.Get("k1"); err != nil || v != "v1" {
+		t.Fatalf("rejoined follower lost data: %v %v", v, err)
+	}
+	printStatus(servers, w)
+	stopServers(servers)
+	return term, isolatedTerm, newLeader.nodeID, newTerm
+}
+
+func TestPreVoteFollowerPartition(t *testing.T) {
+	f, w := getLogFile("preVoteFollowerPartition.log")
+	defer func() {
+		w.Flush()
+		f.Close()
+	}()
+
+	// 没有预投票时, 被隔离的节点不断发起选举增加任期, 重新加入后迫使集群重新选举
+	term, isolatedTerm, _, newTerm := runFollowerPartition(t, w, partitionOption{})
+	if isolatedTerm <= term || newTerm <= term {
+		t.Fatalf("expected term increase without pre-vote: term %d, isolated %d, rejoined %d", term, isolatedTerm, newTerm)
+	}
+
+	// 预投票失败时不增加任期, 重新加入后leader不变
+	opt := partitionOption{preVote: true, checkQuorum: true}
+	term, isolatedTerm, _, newTerm = runFollowerPartition(t, w, opt)
+	if isolatedTerm != term || newTerm != term {
+		t.Fatalf("term changed with pre-vote: term %d, isolated %d, rejoined %d", term, isolatedTerm, newTerm)
+	}
+}
+
+func TestCheckQuorumLeaderPartition(t *testing.T) {
+	f, w := getLogFile("checkQuorumLeaderPartition.log")
+	defer func() {
+		w.Flush()
+		f.Close()
+	}()
+
+	opt := partitionOption{preVote: true, checkQuorum: true}
+	servers := make([]*testServer, 0)
+	for _, p := range peers {
+		servers = append(servers, createPartitionServer(p.ID, 0, 0, nil, opt, false))
+	}
+	fmt.Println("waiting electing leader....")
+	leader := waitElect(servers, w)
+	_, term := leader.raft.LeaderTerm(1)
+	printStatus(servers, w)
+
+	w.WriteString(fmt.Sprintf("[%s] isolate leader %d\r\n", time.Now().Format(format_time), leader.nodeID))
+	oldLeader, others := splitServers(servers, leader.nodeID)
+	oldLeader = isolateServer(oldLeader, opt)
+	if !waitCondition(electionTimeout(), func() bool { return oldLeader.raft.IsLeader(1) }) {
+		t.Fatal("isolated server is not restarted as leader")
+	}
+
+	// 多数派中选出新leader
+	fmt.Println("waiting electing leader....")
+	newLeader := waitElect(others, w)
+	if _, newTerm := newLeader.raft.LeaderTerm(1); newTerm <= term {
+		t.Fatalf("unexpected term %d of new leader, old term %d", newTerm, term)
+	}
+
+	// 被隔离的leader联系不上多数派后退位
+	if !waitCondition(6*electionTimeout(), func() bool { return !oldLeader.raft.IsLeader(1) }) {
+		t.Fatal("isolated leader does not step down")
+	}
+	if st := oldLeader.raft.Status(1); st.Term != term {
+		t.Fatalf("isolated leader's term changed from %d to %d", term, st.Term)
+	}
+	printStatus(append(others, oldLeader), w)
+
+	w.WriteString(fmt.Sprintf("[%s] heal old leader %d\r\n", time.Now().Format(format_time), oldLeader.nodeID))
+	oldLeader = healServer(oldLeader, opt)
+	servers = append(others, oldLeader)
+	time.Sleep(4 * electionTimeout())
+	fmt.Println("waiting electing leader....")
+	if l := waitElect(servers, w); l.nodeID != newLeader.nodeID {
+		t.Fatalf("leader changed from %d to %d after the old leader rejoined", newLeader.nodeID, l.nodeID)
+	}
+	if err := newLeader.sm.Put("k1", "v1"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Second)
+	if v, err := oldLeader.sm.Get("k1"); err != nil || v != "v1" {
+		t.Fatalf("old leader does not replicate after rejoined: %v %v", v, err)
+	}
+	printStatus(servers, w)
+	stopServers(servers)
+}
+
+func TestPreVoteMessage(t *testing.T) {
+	if proto.ReqMsgPreVote.String() != "ReqMsgPreVote" || proto.RespMsgPreVote.String() != "RespMsgPreVote" {
+		t.Fatal("unexpected pre-vote message name")
+	}
+	if !(&proto.Message{Type: proto.RespMsgPreVote}).IsResponseMsg() {
+		t.Fatal("pre-vote response is not a response message")
+	}
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -40,15 +41,20 @@ type replAddr struct {
 	repl  string
 }
 
+var errIsolated = errors.New("node is isolated")
+
 type nodeManager struct {
 	sync.Mutex
 	nodes    map[uint64]int
 	allAddrs map[uint64]replAddr
+	// 被隔离的节点, 其他节点无法连接它, 用来模拟网络分区
+	isolated map[uint64]bool
 }
 
 func newNodeManager() *nodeManager {
 	nm := new(nodeManager)
 	nm.nodes = map[uint64]int{1: 1, 2: 1, 3: 1}
+	nm.isolated = make(map[uint64]bool)
 	nm.allAddrs = map[uint64]replAddr{1: {heart: "127.0.0.1:8000", repl: "127.0.0.1:9000"}, 2: {heart: "127.0.0.1:8001", repl: "127.0.0.1:9001"}, 3: {heart: "127.0.0.1:8002", repl: "127.0.0.1:9002"}, 4: {heart: "127.0.0.1:8003", repl: "127.0.0.1:9003"}}
 	return nm
 }
@@ -78,7 +84,27 @@ func (nm *nodeManager) AllNodes() []uint64 {
 	return nodes
 }
 
+func (nm *nodeManager) isolate(nodeId uint64) {
+	nm.Lock()
+	defer nm.Unlock()
+
+	nm.isolated[nodeId] = true
+}
+
+func (nm *nodeManager) heal(nodeId uint64) {
+	nm.Lock()
+	defer nm.Unlock()
+
+	delete(nm.isolated, nodeId)
+}
+
 func (nm *nodeManager) NodeAddress(nodeID uint64, stype raft.SocketType) (string, error) {
+	nm.Lock()
+	defer nm.Unlock()
+
+	if nm.isolated[nodeID] {
+		return "", errIsolated
+	}
 	addr := nm.allAddrs[nodeID]
 	if stype == raft.HeartBeat {
 		return addr.heart, nil
@@ -86,6 +112,13 @@ func (nm *nodeManager) NodeAddress(nodeID uint64, stype raft.SocketType) (string
 	return addr.repl, nil
 }
 
+// 被隔离的节点使用, 无法连接其他节点
+type isolatedResolver struct{}
+
+func (r isolatedResolver) NodeAddress(nodeID uint64, stype raft.SocketType) (string, error) {
+	return "", errIsolated
+}
+
 func randomStr(size int) string {
 	rand.Seed(time.Now().UnixNano())
 	curr := make([]byte, size)
@@ -114,18 +147,7 @@ func initTestServer(peers []proto.Peer, isLease, clear bool) []*testServer {
 }
 
 func createRaftServer(nodeId, leader, term uint64, peers []proto.Peer, isLease, clear bool) *testServer {
-	config := raft.DefaultConfig()
-	config.NodeID = nodeId
-	config.TickInterval = tickInterval
-	config.HeartbeatTick = htbTick
-	config.ElectionTick = elcTick
-	config.LeaseCheck = isLease
-	config.HeartbeatAddr = resolver.allAddrs[nodeId].heart
-	config.ReplicateAddr = resolver.allAddrs[nodeId].repl
-	config.Resolver = resolver
-	config.RetainLogs = 0
-
-	rs, err := raft.NewRaftServer(config)
+	rs, err := raft.NewRaftServer(newServerConfig(nodeId, isLease))
 	if err != nil {
 		panic(err)
 	}
@@ -157,6 +179,20 @@ func createRaftServer(nodeId, leader, term uint64, peers []proto.Peer, isLease,
 	}
 }
 
+func newServerConfig(nodeId uint64, isLease bool) *raft.Config {
+	config := raft.DefaultConfig()
+	config.NodeID = nodeId
+	config.TickInterval = tickInterval
+	config.HeartbeatTick = htbTick
+	config.ElectionTick = elcTick
+	config.LeaseCheck = isLease
+	config.HeartbeatAddr = resolver.allAddrs[nodeId].heart
+	config.ReplicateAddr = resolver.allAddrs[nodeId].repl
+	config.Resolver = resolver
+	config.RetainLogs = 0
+	return config
+}
+
 func getStorage(raft *raft.RaftServer) storage.Storage {
 	switch storageType {
 	case 0:
@@ -25,7 +25,7 @@ var (
 
 type RaftApplyHandler func( /*req*/ *ms_raftcmdpb.Request, uint64) ( /*resp*/ *ms_raftcmdpb.Response /*err*/, error)
 
-type RaftPeerChangeHandler func( /*confChange*/ *raftproto.ConfChange) ( /*res*/ interface{} /*err*/, error)
+type RaftPeerChangeHandler func( /*confChange*/ *raftproto.ConfChange, uint64) ( /*res*/ interface{} /*err*/, error)
 
 type RaftLeaderChangeHandler func( /*leader*/ uint64)
 
@@ -118,6 +118,13 @@ func (rg *RaftGroup) LeaderTransfer(ctx context.Context, rangeId uint64) error {
 	return nil
 }
 
+// ReadIndex 确认leader身份并等待状态机应用到读取时的提交位置, 之后可以在本地读取
+func (rg *RaftGroup) ReadIndex(ctx context.Context) error {
+	future := rg.raftServer.ReadIndex(ctx, rg.id)
+	_, err := future.Response()
+	return err
+}
+
 func (rg *RaftGroup) Submit(ctx context.Context, cmd []byte) (interface{}, error) {
 	future := rg.raftServer.Submit(ctx, rg.id, cmd)
 	resp, err := future.Response()
@@ -147,10 +154,18 @@ func (rg *RaftGroup) SubmitCommand(ctx context.Context, req *ms_raftcmdpb.Reques
 	return nil, errUnknownResponseType
 }
 
-func (rg *RaftGroup) ChangePeer(ctx context.Context, typ raftproto.ConfChangeType, nodeId uint64) error {
-	ccPeer := raftproto.Peer{Type: raftproto.PeerNormal, ID: nodeId}
+// context随成员变更日志复制到所有副本, 应用变更时传给RaftPeerChangeHandler
+func (rg *RaftGroup) ChangePeer(ctx context.Context, typ raftproto.ConfChangeType, nodeId uint64, context []byte) error {
+	return rg.changePeer(ctx, typ, raftproto.Peer{Type: raftproto.PeerNormal, ID: nodeId}, context)
+}
+
+// AddLearner 增加学习者副本, 学习者接收日志和快照, 不参与选举和提交, 通过ConfPromoteNode提升为正式副本
+func (rg *RaftGroup) AddLearner(ctx context.Context, nodeId uint64, context []byte) error {
+	return rg.changePeer(ctx, raftproto.ConfAddNode, raftproto.Peer{Type: raftproto.PeerLearner, ID: nodeId}, context)
+}
 
-	future := rg.raftServer.ChangeMember(ctx, rg.id, typ, ccPeer, nil)
+func (rg *RaftGroup) changePeer(ctx context.Context, typ raftproto.ConfChangeType, ccPeer raftproto.Peer, context []byte) error {
+	future := rg.raftServer.ChangeMember(ctx, rg.id, typ, ccPeer, context)
 	resp, err := future.Response()
 	if err != nil {
 		return err
@@ -240,7 +255,7 @@ applyErr:
 
 func (rg *RaftGroup) ApplyMemberChange(confChange *raftproto.ConfChange, index uint64) (res interface{}, err error) {
 	if rg.raftPeerChangeHandle != nil {
-		res, err = rg.raftPeerChangeHandle(confChange)
+		res, err = rg.raftPeerChangeHandle(confChange, index)
 	} else {
 		err = errNoPeerChangeHandler
 	}
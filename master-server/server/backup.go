@@ -0,0 +1,411 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"master-server/engine/boltstore"
+	"master-server/engine/model"
+	raftproto "master-server/raft/proto"
+	"master-server/raft/storage/wal"
+	ts "model/pkg/timestamp"
+	"util/log"
+)
+
+// 元数据备份文件格式, 整数都是大端
+// 文件头: magic(8字节) | 版本(4字节) | 集群ID(8字节) | raft应用位置(8字节) | 创建时间(8字节)
+// 记录: 类型(1字节, 1为数据, 0为结束) | key长度(4字节) | key | value长度(4字节) | value | crc32(key+value)(4字节)
+// 文件尾: 汇总信息长度(4字节) | 汇总信息(json) | crc32(4字节, 覆盖之前的全部内容)
+const (
+	backupMagic   = "FBASEBAK"
+	backupVersion = uint32(1)
+
+	backupRecordEnd  = byte(0)
+	backupRecordData = byte(1)
+
+	// 防止文件损坏时按错误的长度分配内存
+	maxBackupRecordSize = 256 * 1024 * 1024
+)
+
+// BackupMeta 备份的汇总信息, 恢复时用来校验
+type BackupMeta struct {
+	Version    uint32 `json:"version"`
+	ClusterId  uint64 `json:"cluster_id"`
+	ApplyIndex uint64 `json:"apply_index"`
+	CreateTime int64  `json:"create_time"`
+	// 备份的key数量
+	Count     uint64 `json:"count"`
+	Databases int    `json:"databases"`
+	Tables    int    `json:"tables"`
+	Ranges    int    `json:"ranges"`
+	Nodes     int    `json:"nodes"`
+	// id生成器已经分配到的位置
+	MaxId uint64 `json:"max_id"`
+}
+
+func (m *BackupMeta) add(key, value []byte) {
+	m.Count++
+	switch {
+	case bytes.HasPrefix(key, []byte(PREFIX_DB)):
+		m.Databases++
+	case bytes.HasPrefix(key, []byte(PREFIX_TABLE)):
+		m.Tables++
+	case bytes.HasPrefix(key, []byte(PREFIX_RANGE)):
+		m.Ranges++
+	case bytes.HasPrefix(key, []byte(PREFIX_NODE)):
+		m.Nodes++
+	case bytes.Equal(key, []byte(AUTO_INCREMENT_ID)):
+		if id, err := bytesToUint64(value); err == nil {
+			m.MaxId = id
+		}
+	}
+}
+
+// WriteBackup 把快照中的全部元数据写成备份文件
+func WriteBackup(w io.Writer, snap model.Snapshot, clusterId uint64) (*BackupMeta, error) {
+	meta := &BackupMeta{
+		Version:    backupVersion,
+		ClusterId:  clusterId,
+		ApplyIndex: snap.ApplyIndex(),
+		CreateTime: time.Now().Unix(),
+	}
+	bw := bufio.NewWriter(w)
+	sum := crc32.NewIEEE()
+	out := io.MultiWriter(bw, sum)
+
+	header := make([]byte, len(backupMagic)+28)
+	copy(header, backupMagic)
+	binary.BigEndian.PutUint32(header[8:], meta.Version)
+	binary.BigEndian.PutUint64(header[12:], meta.ClusterId)
+	binary.BigEndian.PutUint64(header[20:], meta.ApplyIndex)
+	binary.BigEndian.PutUint64(header[28:], uint64(meta.CreateTime))
+	if _, err := out.Write(header); err != nil {
+		return nil, err
+	}
+
+	// 与master raft group的数据范围一致
+	iter := snap.NewIterator([]byte("\x00"), []byte("\xff"))
+	if iter == nil {
+		return nil, fmt.Errorf("create snapshot iterator failed")
+	}
+	defer iter.Release()
+	for iter.Next() {
+		if err := writeBackupRecord(out, iter.Key(), iter.Value()); err != nil {
+			return nil, err
+		}
+		meta.add(iter.Key(), iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if _, err := out.Write([]byte{backupRecordEnd}); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeBackupBytes(out, data); err != nil {
+		return nil, err
+	}
+	if err = binary.Write(bw, binary.BigEndian, sum.Sum32()); err != nil {
+		return nil, err
+	}
+	if err = bw.Flush(); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func writeBackupRecord(w io.Writer, key, value []byte) error {
+	if _, err := w.Write([]byte{backupRecordData}); err != nil {
+		return err
+	}
+	if err := writeBackupBytes(w, key); err != nil {
+		return err
+	}
+	if err := writeBackupBytes(w, value); err != nil {
+		return err
+	}
+	sum := crc32.NewIEEE()
+	sum.Write(key)
+	sum.Write(value)
+	return binary.Write(w, binary.BigEndian, sum.Sum32())
+}
+
+func writeBackupBytes(w io.Writer, data []byte) error {
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// BackupReader 顺序读取备份文件中的元数据, 读取过程中校验每条记录和整个文件的crc
+type BackupReader struct {
+	ClusterId  uint64
+	ApplyIndex uint64
+	CreateTime int64
+
+	r     *bufio.Reader
+	in    io.Reader
+	sum   hash.Hash32
+	count uint64
+	meta  *BackupMeta
+}
+
+func NewBackupReader(r io.Reader) (*BackupReader, error) {
+	br := &BackupReader{r: bufio.NewReader(r), sum: crc32.NewIEEE()}
+	br.in = io.TeeReader(br.r, br.sum)
+
+	header := make([]byte, len(backupMagic)+28)
+	if _, err := io.ReadFull(br.in, header); err != nil {
+		return nil, ErrBackupCorrupted
+	}
+	if string(header[:len(backupMagic)]) != backupMagic {
+		return nil, ErrBackupCorrupted
+	}
+	if version := binary.BigEndian.Uint32(header[8:]); version > backupVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", version)
+	}
+	br.ClusterId = binary.BigEndian.Uint64(header[12:])
+	br.ApplyIndex = binary.BigEndian.Uint64(header[20:])
+	br.CreateTime = int64(binary.BigEndian.Uint64(header[28:]))
+	return br, nil
+}
+
+// Next 返回下一条记录, 全部读完并且校验通过后返回io.EOF
+func (br *BackupReader) Next() (key, value []byte, err error) {
+	if br.meta != nil {
+		return nil, nil, io.EOF
+	}
+	var typ [1]byte
+	if _, err = io.ReadFull(br.in, typ[:]); err != nil {
+		return nil, nil, ErrBackupCorrupted
+	}
+	switch typ[0] {
+	case backupRecordData:
+	case backupRecordEnd:
+		if err = br.readMeta(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, io.EOF
+	default:
+		return nil, nil, ErrBackupCorrupted
+	}
+
+	if key, err = br.readBytes(); err != nil {
+		return nil, nil, err
+	}
+	if value, err = br.readBytes(); err != nil {
+		return nil, nil, err
+	}
+	var crc uint32
+	if err = binary.Read(br.in, binary.BigEndian, &crc); err != nil {
+		return nil, nil, ErrBackupCorrupted
+	}
+	sum := crc32.NewIEEE()
+	sum.Write(key)
+	sum.Write(value)
+	if crc != sum.Sum32() {
+		return nil, nil, ErrBackupCorrupted
+	}
+	br.count++
+	return key, value, nil
+}
+
+// Meta 返回备份的汇总信息, 只有Next返回io.EOF之后才有效
+func (br *BackupReader) Meta() *BackupMeta {
+	return br.meta
+}
+
+func (br *BackupReader) readBytes() ([]byte, error) {
+	var size uint32
+	if err := binary.Read(br.in, binary.BigEndian, &size); err != nil {
+		return nil, ErrBackupCorrupted
+	}
+	if size > maxBackupRecordSize {
+		return nil, ErrBackupCorrupted
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(br.in, data); err != nil {
+		return nil, ErrBackupCorrupted
+	}
+	return data, nil
+}
+
+func (br *BackupReader) readMeta() error {
+	data, err := br.readBytes()
+	if err != nil {
+		return err
+	}
+	expected := br.sum.Sum32()
+	var crc uint32
+	// 文件尾的crc不计入校验
+	if err = binary.Read(br.r, binary.BigEndian, &crc); err != nil || crc != expected {
+		return ErrBackupCorrupted
+	}
+	meta := new(BackupMeta)
+	if err = json.Unmarshal(data, meta); err != nil {
+		return ErrBackupCorrupted
+	}
+	if meta.Count != br.count || meta.ClusterId != br.ClusterId || meta.ApplyIndex != br.ApplyIndex {
+		return ErrBackupCorrupted
+	}
+	br.meta = meta
+	return nil
+}
+
+// Backup 在一致的快照上备份当前节点的全部元数据
+func (s *RaftStore) Backup(w io.Writer, clusterId uint64) (*BackupMeta, error) {
+	snap, err := s.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+	return WriteBackup(w, snap, clusterId)
+}
+
+// BackupDataDir 离线备份已经停止的master的数据目录
+func BackupDataDir(w io.Writer, dataPath string, clusterId uint64) (*BackupMeta, error) {
+	path := filepath.Join(dataPath, "data", "fbase.db")
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	store, _, err := boltstore.NewBoltStore(path)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+	snap, err := store.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+	return WriteBackup(w, snap, clusterId)
+}
+
+// CheckBackup 校验备份文件并返回汇总信息
+func CheckBackup(r io.Reader) (*BackupMeta, error) {
+	br, err := NewBackupReader(r)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, _, err = br.Next(); err == io.EOF {
+			return br.Meta(), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RestoreBackup 用备份文件初始化一个新master节点的数据目录, 新集群的所有初始成员都需要用同一个备份恢复
+// clusterId不为0时元数据中与集群ID相关的key改为新的集群ID
+// 备份中的master成员列表不恢复, 新集群使用配置文件中的成员
+func RestoreBackup(r io.Reader, dataPath string, clusterId uint64) (*BackupMeta, error) {
+	dirPath := filepath.Join(dataPath, "data")
+	path := filepath.Join(dirPath, "fbase.db")
+	raftPath := filepath.Join(dataPath, "raft")
+	if _, err := os.Stat(path); err == nil {
+		return nil, ErrRestoreDataExisted
+	}
+	if files, err := ioutil.ReadDir(raftPath); err == nil && len(files) > 0 {
+		return nil, ErrRestoreDataExisted
+	}
+
+	br, err := NewBackupReader(r)
+	if err != nil {
+		return nil, err
+	}
+	renames := make(map[string]string)
+	if clusterId != 0 && clusterId != br.ClusterId {
+		for _, prefix := range []string{PREFIX_AUTO_TRANSFER, PREFIX_AUTO_FAILOVER} {
+			renames[fmt.Sprintf(prefix, br.ClusterId)] = fmt.Sprintf(prefix, clusterId)
+		}
+	}
+	// raft日志从备份的应用位置之后开始, 之后加入的成员通过快照获取恢复的数据
+	applyIndex := br.ApplyIndex
+	if applyIndex == 0 {
+		applyIndex = 1
+	}
+
+	if err = os.MkdirAll(dirPath, 0755); err != nil {
+		return nil, err
+	}
+	pathTemp := filepath.Join(dirPath, "fbase.db.restore")
+	os.Remove(pathTemp)
+	store, _, err := boltstore.NewBoltStore(pathTemp)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		key, value, _err := br.Next()
+		if _err == io.EOF {
+			break
+		} else if _err == nil {
+			if string(key) == MASTER_MEMBERS {
+				continue
+			}
+			if newKey, find := renames[string(key)]; find {
+				key = []byte(newKey)
+			}
+			_err = store.Put(key, value, 0, ts.Timestamp{}, applyIndex)
+		}
+		if _err != nil {
+			log.Error("restore backup failed, err[%v]", _err)
+			store.Close()
+			os.Remove(pathTemp)
+			return nil, _err
+		}
+	}
+	if err = store.Close(); err != nil {
+		os.Remove(pathTemp)
+		return nil, err
+	}
+
+	// raft目录同样先写到临时目录再改名, 失败时不留下不完整的目录, 可以直接重试
+	raftTemp := raftPath + ".restore"
+	os.RemoveAll(raftTemp)
+	if err = initRestoredRaft(raftTemp, applyIndex); err != nil {
+		os.RemoveAll(raftTemp)
+		os.Remove(pathTemp)
+		return nil, err
+	}
+	if err = os.Rename(raftTemp, raftPath); err != nil {
+		os.RemoveAll(raftTemp)
+		os.Remove(pathTemp)
+		return nil, err
+	}
+	if err = os.Rename(pathTemp, path); err != nil {
+		os.RemoveAll(raftPath)
+		os.Remove(pathTemp)
+		return nil, err
+	}
+	log.Info("restore backup to %s success, cluster[%d -> %d], apply index %d", dataPath, br.ClusterId, clusterId, applyIndex)
+	return br.Meta(), nil
+}
+
+// 初始化恢复节点的raft存储, 日志从applyIndex之后开始
+func initRestoredRaft(raftPath string, applyIndex uint64) error {
+	raftStorage, err := wal.NewStorage(1, raftPath, nil)
+	if err != nil {
+		return err
+	}
+	if err = raftStorage.StoreHardState(raftproto.HardState{Term: 1}); err == nil {
+		err = raftStorage.ApplySnapshot(raftproto.SnapshotMeta{Index: applyIndex, Term: 1})
+	}
+	raftStorage.Close()
+	return err
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"master-server/raft"
+)
+
+func newBackupTestStore(t *testing.T, dataPath string, hbPort, rpPort int) *RaftStore {
+	var lleader uint64
+	hbAddr := fmt.Sprintf("127.0.0.1:%d", hbPort)
+	rpAddr := fmt.Sprintf("127.0.0.1:%d", rpPort)
+	cnf := &StoreConfig{
+		RaftRetainLogs:        int64(100),
+		RaftHeartbeatInterval: time.Millisecond * 500,
+		RaftHeartbeatAddr:     hbAddr,
+		RaftReplicateAddr:     rpAddr,
+		RaftPeers: []*Peer{&Peer{ID: 1, WebManageAddr: "127.0.0.1:8080",
+			RpcServerAddr: "127.0.0.1:8887", RaftHeartbeatAddr: hbAddr, RaftReplicateAddr: rpAddr}},
+
+		NodeID:   uint64(1),
+		DataPath: dataPath,
+
+		LeaderChangeHandler: func(leader uint64) {
+			lleader = leader
+		},
+		FatalHandler: func(err *raft.FatalError) {
+		},
+	}
+	store, err := NewRaftStore(cnf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Open(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Second * 5)
+	if lleader == 0 {
+		t.Fatal("no leader")
+	}
+	return store
+}
+
+func TestBackupRestore(t *testing.T) {
+	dataPath := "/tmp/data_backup"
+	restorePath := "/tmp/data_restore"
+	os.RemoveAll(dataPath)
+	os.RemoveAll(restorePath)
+	defer os.RemoveAll(dataPath)
+	defer os.RemoveAll(restorePath)
+
+	store := newBackupTestStore(t, dataPath, 4254, 4255)
+	// 与Cluster中的集群ID类型一致
+	oldId, newId := uint64(1), uint64(2)
+	kvs := map[string][]byte{
+		PREFIX_DB + "1":                            []byte("db"),
+		PREFIX_TABLE + "1":                         []byte("table"),
+		PREFIX_RANGE + "1":                         []byte("range1"),
+		PREFIX_RANGE + "2":                         []byte("range2"),
+		PREFIX_NODE + "1":                          []byte("node"),
+		AUTO_INCREMENT_ID:                          uint64ToBytes(100),
+		fmt.Sprintf(PREFIX_AUTO_TRANSFER, oldId):   []byte("true"),
+		fmt.Sprintf(PREFIX_AUTO_FAILOVER, oldId):   []byte("false"),
+		fmt.Sprintf(PREFIX_AUTO_FAILOVER_TABLE, 1): []byte("true"),
+	}
+	for k, v := range kvs {
+		if err := store.Put([]byte(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.AddMember(&Peer{ID: 2, WebManageAddr: "127.0.0.1:8081", RpcServerAddr: "127.0.0.1:8888",
+		RaftHeartbeatAddr: "127.0.0.1:4258", RaftReplicateAddr: "127.0.0.1:4259", Learner: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	meta, err := store.Backup(buf, oldId)
+	store.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Count != uint64(len(kvs))+1 || meta.Databases != 1 || meta.Tables != 1 || meta.Ranges != 2 ||
+		meta.Nodes != 1 || meta.MaxId != 100 || meta.ClusterId != oldId || meta.ApplyIndex == 0 {
+		t.Fatalf("unexpected backup meta %v", meta)
+	}
+	data := buf.Bytes()
+	if m, err := CheckBackup(bytes.NewReader(data)); err != nil || *m != *meta {
+		t.Fatalf("check backup failed, meta %v, err %v", m, err)
+	}
+
+	// 损坏和不完整的文件
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	if _, err = CheckBackup(bytes.NewReader(corrupted)); err != ErrBackupCorrupted {
+		t.Fatalf("expected corrupted error, actual %v", err)
+	}
+	if _, err = CheckBackup(bytes.NewReader(data[:len(data)-1])); err != ErrBackupCorrupted {
+		t.Fatalf("expected corrupted error, actual %v", err)
+	}
+	if _, err = RestoreBackup(bytes.NewReader(corrupted), restorePath, newId); err != ErrBackupCorrupted {
+		t.Fatalf("expected corrupted error, actual %v", err)
+	}
+	if _, err = os.Stat(restorePath + "/data/fbase.db"); !os.IsNotExist(err) {
+		t.Fatalf("corrupted backup is restored")
+	}
+	os.RemoveAll(restorePath)
+
+	if _, err = RestoreBackup(bytes.NewReader(data), dataPath, newId); err != ErrRestoreDataExisted {
+		t.Fatalf("expected data existed error, actual %v", err)
+	}
+	// raft目录无法写入时不留下任何文件, 修复后可以重试
+	if err = os.MkdirAll(restorePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(restorePath+"/raft", []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = RestoreBackup(bytes.NewReader(data), restorePath, newId); err == nil {
+		t.Fatal("expected restore to fail when raft dir can not be created")
+	}
+	for _, p := range []string{"/data/fbase.db", "/data/fbase.db.restore", "/raft.restore"} {
+		if _, err = os.Stat(restorePath + p); !os.IsNotExist(err) {
+			t.Fatalf("%s is left after failed restore", p)
+		}
+	}
+	os.Remove(restorePath + "/raft")
+	if _, err = RestoreBackup(bytes.NewReader(data), restorePath, newId); err != nil {
+		t.Fatal(err)
+	}
+
+	// 恢复后的节点作为新集群启动, 集群相关的key改为新的集群ID, 不恢复原来的成员列表
+	restored := newBackupTestStore(t, restorePath, 4256, 4257)
+	defer restored.Close()
+	for k, v := range kvs {
+		switch k {
+		case fmt.Sprintf(PREFIX_AUTO_TRANSFER, oldId):
+			k = fmt.Sprintf(PREFIX_AUTO_TRANSFER, newId)
+		case fmt.Sprintf(PREFIX_AUTO_FAILOVER, oldId):
+			k = fmt.Sprintf(PREFIX_AUTO_FAILOVER, newId)
+		}
+		if value, err := restored.Get([]byte(k)); err != nil || !bytes.Equal(value, v) {
+			t.Fatalf("unexpected value of %s: %v, err %v", k, value, err)
+		}
+	}
+	if members := restored.GetMembers(); len(members) != 1 || members[0].ID != 1 {
+		t.Fatalf("unexpected members %v", members)
+	}
+	if st := restored.raftServer.Status(1); st.Commit <= meta.ApplyIndex {
+		t.Fatalf("raft log does not start after the backup, status %v", st)
+	}
+	if err = restored.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+}
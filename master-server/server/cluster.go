@@ -32,6 +32,7 @@ var AUTO_INCREMENT_ID string = fmt.Sprintf("$auto_increment_id")
 var PREFIX_TASK string = fmt.Sprintf("schema%stask%s", SCHEMA_SPLITOR, SCHEMA_SPLITOR)
 var PREFIX_REPLICA string = fmt.Sprintf("schema%sreplica%s", SCHEMA_SPLITOR, SCHEMA_SPLITOR)
 var PREFIX_PRE_GC string = fmt.Sprintf("schema%spre_gc%s", SCHEMA_SPLITOR, SCHEMA_SPLITOR)
+var PREFIX_LEADER_WORKER string = fmt.Sprintf("schema%sleader_worker%s", SCHEMA_SPLITOR, SCHEMA_SPLITOR)
 var PREFIX_AUTO_TRANSFER string = fmt.Sprintf("$auto_transfer_%d")
 var PREFIX_AUTO_FAILOVER string = fmt.Sprintf("$auto_failover_%d")
 
@@ -41,6 +42,8 @@ type Cluster struct {
 	leader    *Peer
 
 	cli client.SchClient
+	// 回收过期数据时访问DS的数据接口
+	kvCli client.KvClient
 
 	idGener IDGenerator
 	opt     *scheduleOption
@@ -77,6 +80,11 @@ type Cluster struct {
 	store Store
 
 	writeStatistics *lruCache
+	readStatistics  *lruCache
+	// 读写key数超过按负载分裂阈值的range
+	loadStatistics *lruCache
+	// gateway上报的range请求key抽样
+	keySamples *RangeKeySampleCache
 
 	autoFailoverUnable bool
 	autoTransferUnable bool
@@ -87,12 +95,16 @@ func NewCluster(clusterId, nodeId uint64, store Store, opt *scheduleOption) *Clu
 		clusterId:       clusterId,
 		nodeId:          nodeId,
 		cli:             client.NewSchRPCClient(),
+		kvCli:           client.NewRPCClient(),
 		store:           store,
 		opt:             opt,
 		dbs:             NewDbCache(),
 		nodes:           NewNodeCache(),
 		ranges:          NewRangeCache(),
 		writeStatistics: newLRUCache(writeStatLRUMaxLen),
+		readStatistics: newLRUCache(writeStatLRUMaxLen),
+		loadStatistics:  newLRUCache(writeStatLRUMaxLen),
+		keySamples:      NewRangeKeySampleCache(),
 		creatingTables:  NewCreateTableCache(),
 		workingTables:   NewGlobalTableCache(),
 		deletingTables:  NewGlobalTableCache(),
@@ -115,6 +127,9 @@ func (c *Cluster) LoadCache() error {
 	c.nodes = NewNodeCache()
 	c.ranges = NewRangeCache()
 	c.writeStatistics = newLRUCache(writeStatLRUMaxLen)
+	c.readStatistics = newLRUCache(writeStatLRUMaxLen)
+	c.loadStatistics = newLRUCache(writeStatLRUMaxLen)
+	c.keySamples = NewRangeKeySampleCache()
 	c.creatingTables = NewCreateTableCache()
 	c.workingTables = NewGlobalTableCache()
 	c.deletingTables = NewGlobalTableCache()
@@ -162,6 +177,12 @@ func (c *Cluster) LoadCache() error {
 		return err
 	}
 
+	err = c.loadLeaderWorkers()
+	if err != nil {
+		log.Error("load leader workers from store failed, err[%v]", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -177,6 +198,7 @@ func (c *Cluster) Close() {
 		return
 	}
 	c.cli.Close()
+	c.kvCli.Close()
 	//c.coordinator.Stop()
 	c.eventDispatcher.Stop()
 	c.workerManger.Stop()
@@ -196,7 +218,29 @@ func (c *Cluster) FindDatabaseById(id uint64) (*Database, bool) {
 	return c.dbs.FindDbById(id)
 }
 
+// 删除库中所有的表后删除库, 表的数据按正常删除流程延迟回收
 func (c *Cluster) DeleteDatabase(name string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	db, ok := c.FindDatabase(name)
+	if !ok {
+		return ErrNotExistDatabase
+	}
+	db.Lock()
+	defer db.UnLock()
+	for _, t := range db.GetAllTable() {
+		if _, err := c.dropTable(db, t.GetName(), false); err != nil {
+			log.Error("delete table[%s:%s] failed, err[%v]", name, t.GetName(), err)
+			return err
+		}
+	}
+	key := []byte(fmt.Sprintf("%s%d", PREFIX_DB, db.GetId()))
+	if err := c.store.Delete(key); err != nil {
+		log.Error("delete database[%s] failed, err[%v]", name, err)
+		return err
+	}
+	c.dbs.Delete(name)
+	log.Info("delete database[%s] success", name)
 	return nil
 }
 
@@ -312,6 +356,12 @@ func encodeSplitKeys(keys [][]byte, columns []*metapb.Column) ([][]byte, error)
 // step 2. create range in remote
 // step 3. add range in cache and disk
 func (c *Cluster) CreateTable(dbName, tableName string, columns, regxs []*metapb.Column, pkDupCheck bool, sliceKeys [][]byte) (*Table, error) {
+	return c.CreateTableWithIndexes(dbName, tableName, columns, regxs, nil, pkDupCheck, sliceKeys)
+}
+
+// 建表的同时创建索引, 表为空, 索引直接处于可用状态
+func (c *Cluster) CreateTableWithIndexes(dbName, tableName string, columns, regxs []*metapb.Column, indexes []*IndexProperty,
+	pkDupCheck bool, sliceKeys [][]byte) (*Table, error) {
 	for _, col := range columns {
 		if isSqlReservedWord(col.Name) {
 			log.Warn("col[%s] is sql reserved word", col.Name)
@@ -326,11 +376,30 @@ func (c *Cluster) CreateTable(dbName, tableName string, columns, regxs []*metapb
 	}
 	db.Lock()
 	defer db.UnLock()
+	return c.createTable(db, tableName, columns, regxs, indexes, pkDupCheck, sliceKeys)
+}
+
+// 调用方需要持有db的锁
+func (c *Cluster) createTable(db *Database, tableName string, columns, regxs []*metapb.Column, indexes []*IndexProperty,
+	pkDupCheck bool, sliceKeys [][]byte) (*Table, error) {
+	dbName := db.GetName()
 	_t, find := db.FindTable(tableName)
 	if find {
 		log.Warn("dup Table %v", _t)
 		return nil, ErrDupTable
 	}
+	// 主键列和Binary列不能创建索引
+	for _, col := range columns {
+		if col.GetIndex() && (col.GetPrimaryKey() > 0 || col.GetDataType() == metapb.DataType_Binary) {
+			log.Warn("column[%s:%s:%s] can not be indexed", dbName, tableName, col.GetName())
+			return nil, ErrInvalidIndexColumn
+		}
+	}
+	indexDefs, err := tableIndexDefs(columns, indexes)
+	if err != nil {
+		log.Warn("invalid indexes of table[%s:%s], err[%v]", dbName, tableName, err)
+		return nil, err
+	}
 
 	// create table
 	tableId, err := c.idGener.GenID()
@@ -353,6 +422,16 @@ func (c *Cluster) CreateTable(dbName, tableName string, columns, regxs []*metapb
 		CreateTime: time.Now().Unix(),
 		PkDupCheck: pkDupCheck,
 	}
+	// 表为空不需要回填
+	for _, def := range indexDefs {
+		index, err := c.createIndexTable(db, t, def.name, def.columnIds)
+		if err != nil {
+			log.Error("create index %s of table[%s:%s] failed, err[%v]", def.name, dbName, tableName, err)
+			return nil, err
+		}
+		index.State = metapb.IndexState_IndexPublic
+		t.Indexes = append(t.Indexes, index)
+	}
 
 	var sharingKeys [][]byte
 	table := NewTable(t)
@@ -399,6 +478,21 @@ func (c *Cluster) DeleteTable(dbName, tableName string, fast bool) (*Table, erro
 	}
 	db.Lock()
 	defer db.UnLock()
+	table, err := c.dropTable(db, tableName, fast)
+	if err != nil {
+		return nil, err
+	}
+	// 同时删除索引表
+	for _, index := range table.GetIndexes() {
+		if _, err := c.dropTable(db, IndexTableName(table.GetId(), index.GetName()), fast); err != nil {
+			log.Warn("delete index table of %s[%s:%s] failed, err[%v]", index.GetName(), dbName, tableName, err)
+		}
+	}
+	return table, nil
+}
+
+// 调用方需要持有db的锁
+func (c *Cluster) dropTable(db *Database, tableName string, fast bool) (*Table, error) {
 	table, find := db.FindTable(tableName)
 	if !find {
 		return nil, ErrNotExistTable
@@ -529,6 +623,10 @@ func (c *Cluster) GetAllWorker() map[string]bool {
 		_, found := temp[s]
 		workers[s] = found
 	}
+	// 按节点添加的worker不在pool中
+	for name := range temp {
+		workers[name] = true
+	}
 	return workers
 }
 
@@ -564,8 +662,50 @@ func (c *Cluster) AddBalanceNodeOpsWorker() {
 	c.workerManger.addWorker(NewBalanceNodeOpsWorker(c.workerManger, 5 * defaultWorkerInterval))
 }
 
+func (c *Cluster) AddBalanceStorageWorker() {
+	c.workerManger.addWorker(NewBalanceNodeStorageWorker(c.workerManger, 5 * defaultWorkerInterval))
+}
+
+func (c *Cluster) AddHotRangeWorker() {
+	c.workerManger.addWorker(NewHotRangeWorker(c.workerManger, 5 * defaultWorkerInterval))
+}
+
+func (c *Cluster) AddTTLGCWorker() {
+	c.workerManger.addWorker(NewTTLGCWorker(c.workerManger, time.Minute))
+}
+
+func (c *Cluster) AddLoadSplitWorker() {
+	c.workerManger.addWorker(NewLoadSplitWorker(c.workerManger, 10*defaultWorkerInterval))
+}
+
+func (c *Cluster) AddEvictLeaderWorker(nodeId uint64) error {
+	if c.FindNodeById(nodeId) == nil {
+		return ErrNotExistNode
+	}
+	if c.isGrantLeaderNode(nodeId) {
+		return ErrLeaderWorkerConflict
+	}
+	return c.addLeaderWorker(NewEvictLeaderWorker(c.workerManger, 2 * defaultWorkerInterval, nodeId))
+}
+
+func (c *Cluster) AddGrantLeaderWorker(nodeId uint64) error {
+	if c.FindNodeById(nodeId) == nil {
+		return ErrNotExistNode
+	}
+	if c.isEvictLeaderNode(nodeId) {
+		return ErrLeaderWorkerConflict
+	}
+	return c.addLeaderWorker(NewGrantLeaderWorker(c.workerManger, 2 * defaultWorkerInterval, nodeId))
+}
+
 func (c *Cluster) RemoveWorker(name string) error {
-	return c.workerManger.removeWorker(name)
+	if err := c.workerManger.removeWorker(name); err != nil {
+		return err
+	}
+	if isLeaderWorkerName(name) {
+		return c.deleteLeaderWorker(name)
+	}
+	return nil
 }
 
 func (c *Cluster) GetAllEvent() []RangeEvent {
@@ -804,6 +944,9 @@ func (c *Cluster) loadDatabases() error {
 		database := NewDatabase(db)
 		c.dbs.Add(database)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -824,8 +967,9 @@ func (c *Cluster) loadTables() error {
 			return err
 		}
 
+		// 已删除的库中只会有等待回收的表
 		db, find := c.FindDatabase(t.DbName)
-		if !find {
+		if !find && t.GetStatus() != metapb.TableStatus_TableDelete && t.GetStatus() != metapb.TableStatus_TableDeleting {
 			log.Error("database[%s] not found", t.DbName)
 			return ErrNotExistDatabase
 		}
@@ -860,6 +1004,9 @@ func (c *Cluster) loadTables() error {
 			return ErrInternalError
 		}
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -884,6 +1031,9 @@ func (c *Cluster) loadRanges() error {
 		rr := NewRange(r, leader)
 		c.AddRange(rr)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	// 删除垃圾分片(无归属分片)
 	var batch Batch
 	count := 0
@@ -931,6 +1081,9 @@ func (c *Cluster) loadTrashReplicas() error {
 		node := c.FindNodeById(rep.GetPeer().GetNodeId())
 		node.AddTrashReplica(rep)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -953,6 +1106,9 @@ func (c *Cluster) loadDeletedRanges() error {
 		}
 		c.deletedRanges.Add(r)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	return nil
 
 }
@@ -975,6 +1131,9 @@ func (c *Cluster) loadPreGCRanges() error {
 		}
 		c.preGCRanges.Add(r)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -997,6 +1156,9 @@ func (c *Cluster) loadNodes() error {
 		node := NewNode(n)
 		c.nodes.Add(node)
 	}
+	if err := it.Error(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1095,8 +1257,8 @@ func (c *Cluster) allocPeer(nodeId uint64) (*metapb.Peer, error) {
 /**
 选择节点需要排除已有peer相同的IP
  */
-func (c *Cluster) allocPeerAndSelectNode(rng *Range) (*metapb.Peer, error) {
-	node := c.selectNodeForAddPeer(rng)
+func (c *Cluster) allocPeerAndSelectNode(rng *Range, selectors ...NodeSelector) (*metapb.Peer, error) {
+	node := c.selectNodeForAddPeer(rng, selectors...)
 	if node == nil {
 		return nil, ERR_NO_SELECTED_NODE
 	}
@@ -1159,23 +1321,35 @@ func initWorkerPool() map[string]bool {
 	pool[balanceRangeWorkerName] = true
 	pool[balanceLeaderWorkerName] = true
 	pool[balanceNodeOpsWorkerName] = true
+	pool[balanceStorageWorkerName] = true
+	pool[hotRangeWorkerName] = true
+	pool[ttlGCWorkerName] = true
+	pool[loadSplitWorkerName] = true
 	return pool
 }
 
-func (c *Cluster) selectNodeForAddPeer(rng *Range) *Node {
-
+// 选择添加副本的节点, 配置了位置标签时优先选择跟已有副本隔离程度最高的节点
+func (c *Cluster) selectNodeForAddPeer(rng *Range, selectors ...NodeSelector) *Node {
+	rangeNodes := rng.GetNodes(c)
 	newSelectors := []NodeSelector{
 		NewNodeLoginSelector(c.opt),
-		NewDifferIPSelector(rng.GetNodes(c)),
+		NewDifferIPSelector(rangeNodes),
 		NewWriterOpsThresholdSelector(c.opt),
 		NewStorageThresholdSelector(c.opt),
 	}
+	newSelectors = append(newSelectors, selectors...)
+	bestNode, _ := c.selectLocationNode(rangeNodes, newSelectors)
+	log.Debug("best node %v", bestNode)
+	return bestNode
+}
 
+// 在满足selectors的节点中选择跟rangeNodes隔离程度最高的节点, 隔离程度相同时选择range数最少的
+func (c *Cluster) selectLocationNode(rangeNodes []*Node, selectors []NodeSelector) (*Node, float64) {
 	log.Debug("select node for add Peer node size:%d",len(c.GetAllNode()))
 	nodes := make([]*Node, 0)
 	for _, node := range c.GetAllNode() {
 		flag := true
-		for _, selector := range newSelectors {
+		for _, selector := range selectors {
 			if !selector.CanSelect(node) {
 				log.Debug("addPeer: node %v cannot select, because of %v", node.GetId(), selector.Name())
 				flag = false
@@ -1187,16 +1361,75 @@ func (c *Cluster) selectNodeForAddPeer(rng *Range) *Node {
 		}
 	}
 	log.Debug("selected node size:%d",len(nodes))
-	//TODO：选择一个最好的节点，目前只通过range数来判断
+	labels := c.opt.GetLocationLabels()
 	var bestNode *Node
+	var bestScore float64
 	for _, node := range nodes {
-		if bestNode == nil || node.GetRangesCount() < bestNode.GetRangesCount() {
+		score := distinctScore(labels, rangeNodes, node)
+		if bestNode == nil || score > bestScore ||
+			(score == bestScore && node.GetRangesCount() < bestNode.GetRangesCount()) {
 			bestNode = node
+			bestScore = score
 		}
 	}
-	log.Debug("best node %v", bestNode)
-	return bestNode
+	return bestNode, bestScore
+}
 
+// 副本的隔离程度低于配置的位置标签能达到的级别时, 返回能替换隔离程度最低的副本的节点
+func (c *Cluster) selectBetterLocationNode(rng *Range) *Node {
+	labels := c.opt.GetLocationLabels()
+	if len(labels) == 0 {
+		return nil
+	}
+	rangeNodes := rng.GetNodes(c)
+	if len(rangeNodes) < 2 || len(rangeNodes) != len(rng.GetPeers()) {
+		return nil
+	}
+	worstNodes, worstScore := worstLocationNodes(labels, rangeNodes)
+	others := make([]*Node, 0, len(rangeNodes))
+	for _, n := range rangeNodes {
+		if n.GetId() != worstNodes[0].GetId() {
+			others = append(others, n)
+		}
+	}
+	selectors := []NodeSelector{
+		NewNodeLoginSelector(c.opt),
+		NewDifferIPSelector(rangeNodes),
+		NewWriterOpsThresholdSelector(c.opt),
+		NewStorageThresholdSelector(c.opt),
+	}
+	node, score := c.selectLocationNode(others, selectors)
+	if node == nil || score <= worstScore {
+		return nil
+	}
+	log.Warn("range %d replicas on nodes %v violate location isolation, node %d is better than node %d",
+		rng.GetId(), nodeIds(rangeNodes), node.GetId(), worstNodes[0].GetId())
+	return node
+}
+
+// 跟其他副本隔离程度最低的节点
+func worstLocationNodes(labels []string, nodes []*Node) ([]*Node, float64) {
+	var worst []*Node
+	var worstScore float64
+	for _, n := range nodes {
+		score := distinctScore(labels, nodes, n)
+		switch {
+		case len(worst) == 0 || score < worstScore:
+			worst = []*Node{n}
+			worstScore = score
+		case score == worstScore:
+			worst = append(worst, n)
+		}
+	}
+	return worst, worstScore
+}
+
+func nodeIds(nodes []*Node) []uint64 {
+	ids := make([]uint64, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.GetId())
+	}
+	return ids
 }
 
 func (c *Cluster) checkSameIpNode(nodes []*Node) (string, bool) {
@@ -1236,6 +1469,9 @@ func (c *Cluster) selectWorstPeer(rng *Range) *metapb.Peer {
 			}
 			return
 		}()
+	} else if labels := c.opt.GetLocationLabels(); len(labels) > 0 && len(allNodes) == len(rng.GetPeers()) {
+		// 优先删除跟其他副本隔离程度最低的peer
+		nodes, _ = worstLocationNodes(labels, allNodes)
 	} else {
 		nodes = c.getRangeNodes(rng)
 	}
@@ -171,6 +171,18 @@ func (c *Cluster) LoginNode(nodeId uint64, force bool) error {
 	return nil
 }
 
+// 设置节点的位置标签, 已有的标签覆盖原来的值
+func (c *Cluster) SetNodeLabels(nodeId uint64, labels []*metapb.NodeLabel) error {
+	node := c.FindNodeById(nodeId)
+	if node == nil {
+		return ErrNotExistNode
+	}
+	node.lock.Lock()
+	defer node.lock.Unlock()
+	node.mergeLabels(labels)
+	return c.storeNode(node.Node)
+}
+
 func (c *Cluster) LogoutNode(nodeId uint64) error {
 	log.Warn("node %v logout", nodeId)
 	node := c.FindNodeById(nodeId)
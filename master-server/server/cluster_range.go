@@ -156,6 +156,7 @@ func (c *Cluster) updateStatus(region *Range, stats *mspb.RangeStats) {
 	region.ApproximateSize = stats.GetApproximateSize()
 
 	region.opsStat.Hit(region.BytesWritten)
+	c.updateHotStat(region, stats)
 
 }
 
@@ -25,6 +25,11 @@ const (
 	defaultNodeRangeBalanceTime     = 2 * time.Minute
 	defaultStorageAvailableThreshold  = 20
 	defaultWriteByteOpsThreshold  = 30*1024*1024
+	defaultHotRangeWriteBytesThreshold = 1024*1024
+	defaultHotRangeReadKeysThreshold   = 1000
+	defaultTTLGCBatchSize              = 1000
+	defaultTTLGCRowsPerSecond          = 10000
+	defaultLoadSplitKeysThreshold      = 5000
 )
 const DefaultFactor = 0.75
 
@@ -55,6 +60,16 @@ raft-ports = [8877,8867]
 [raft]
 heartbeat-interval = "500ms"
 retain-logs-count = 100
+# confirm the leadership with a quorum before reading metadata,
+# old members do not answer read index requests, so enable it after all members are upgraded
+read-index = false
+# with read-index, the leader serves reads within its lease without confirming with a quorum,
+# it changes how followers vote, so enable it on all members at the same time
+lease-read = false
+# a partitioned node asks for votes without increasing the term, and an isolated leader steps down,
+# old members do not understand pre-vote messages, so enable them after all members are upgraded
+pre-vote = false
+check-quorum = false
 
 [log]
 dir = "/tmp/sharkstore/log"
@@ -83,6 +98,14 @@ replica-schedule-limit = 24
 node-range-balance-time = "120s"
 storage-available-threshold = 20
 writeByte-ops-threshold = 31457280
+# a range is hot when its written bytes or read keys per second exceed the threshold
+hot-range-write-bytes-threshold = 1048576
+hot-range-read-keys-threshold = 1000
+# expired rows of ttl tables are deleted in batches, and the deleted rows per second are limited
+ttl-gc-batch-size = 1000
+ttl-gc-rows-per-second = 10000
+# a range is split by load when its read and written keys per second exceed the threshold
+load-split-keys-threshold = 5000
 
 [replication]
 # The number of replicas for each region.
@@ -197,6 +220,8 @@ type ClusterPeer struct {
 	HttpPort int       `toml:"http-port,omitempty" json:"http-port"`
 	RpcPort  int       `toml:"rpc-port,omitempty" json:"rpc-port"`
 	RaftPorts []int       `toml:"raft-ports,omitempty" json:"raft-ports"`
+	// 学习者只复制数据, 不参与选举和提交
+	Learner   bool        `toml:"learner,omitempty" json:"learner"`
 }
 
 type ClusterConfig struct {
@@ -239,6 +264,11 @@ func (c *ClusterConfig) adjust() error {
 type RaftConfig struct {
 	HeartbeatInterval util.Duration `toml:"heartbeat-interval,omitempty" json:"heartbeat-interval"`
 	RetainLogsCount  uint64  `toml:"retain-logs-count,omitempty" json:"retain-logs-count"`
+	// 以下选项需要所有成员都支持, 所有成员升级后再同时开启
+	ReadIndex   bool `toml:"read-index,omitempty" json:"read-index"`
+	LeaseRead   bool `toml:"lease-read,omitempty" json:"lease-read"`
+	PreVote     bool `toml:"pre-vote,omitempty" json:"pre-vote"`
+	CheckQuorum bool `toml:"check-quorum,omitempty" json:"check-quorum"`
 }
 
 func (c *RaftConfig) adjust() error {
@@ -317,6 +347,14 @@ type ScheduleConfig struct {
 	NodeRangeBalanceTime util.Duration `toml:"node-range-balance-time,omitempty" json:"node-range-balance-time"`
 	StorageAvailableThreshold uint64 `toml:"storage-available-threshold,omitempty" json:"storage-available-threshold"`
 	WriteByteOpsThreshold uint64 `toml:"writeByte-ops-threshold,omitempty" json:"writeByte-ops-threshold"`
+	// range每秒写入的字节数或者读取的key数超过阈值时作为热点
+	HotRangeWriteBytesThreshold uint64 `toml:"hot-range-write-bytes-threshold,omitempty" json:"hot-range-write-bytes-threshold"`
+	HotRangeReadKeysThreshold uint64 `toml:"hot-range-read-keys-threshold,omitempty" json:"hot-range-read-keys-threshold"`
+	// 过期数据回收每次删除的最大行数和每秒删除的最大行数, 避免影响正常读写
+	TTLGCBatchSize uint64 `toml:"ttl-gc-batch-size,omitempty" json:"ttl-gc-batch-size"`
+	TTLGCRowsPerSecond uint64 `toml:"ttl-gc-rows-per-second,omitempty" json:"ttl-gc-rows-per-second"`
+	// range每秒读写的key数持续超过阈值时按请求key的分布分裂, 不受range大小限制
+	LoadSplitKeysThreshold uint64 `toml:"load-split-keys-threshold,omitempty" json:"load-split-keys-threshold"`
 }
 
 func (c *ScheduleConfig) adjust() {
@@ -330,6 +368,11 @@ func (c *ScheduleConfig) adjust() {
 	adjustDuration(&c.NodeRangeBalanceTime, defaultNodeRangeBalanceTime)
 	adjustUint64(&c.StorageAvailableThreshold, defaultStorageAvailableThreshold)
 	adjustUint64(&c.WriteByteOpsThreshold, defaultWriteByteOpsThreshold)
+	adjustUint64(&c.HotRangeWriteBytesThreshold, defaultHotRangeWriteBytesThreshold)
+	adjustUint64(&c.HotRangeReadKeysThreshold, defaultHotRangeReadKeysThreshold)
+	adjustUint64(&c.TTLGCBatchSize, defaultTTLGCBatchSize)
+	adjustUint64(&c.TTLGCRowsPerSecond, defaultTTLGCRowsPerSecond)
+	adjustUint64(&c.LoadSplitKeysThreshold, defaultLoadSplitKeysThreshold)
 
 }
 
@@ -346,7 +389,7 @@ type ReplicationConfig struct {
 }
 
 func (c *ReplicationConfig) clone() *ReplicationConfig {
-	locationLabels := make(util.StringSlice, 0, len(c.LocationLabels))
+	locationLabels := make(util.StringSlice, len(c.LocationLabels))
 	copy(locationLabels, c.LocationLabels)
 	return &ReplicationConfig{
 		MaxReplicas:    c.MaxReplicas,
@@ -371,8 +414,14 @@ type scheduleOption struct {
 	ReplicaScheduleLimit uint64
 	StorageAvailableThreshold uint64
 	WriteByteOpsThreshold uint64
+	HotRangeWriteBytesThreshold uint64
+	HotRangeReadKeysThreshold uint64
+	TTLGCBatchSize uint64
+	TTLGCRowsPerSecond uint64
+	LoadSplitKeysThreshold uint64
 	//rep *Replication
 	MaxReplicas uint64
+	LocationLabels []string
 	MetricAddr  string
 	MetricInterval time.Duration
 }
@@ -389,9 +438,15 @@ func newScheduleOption(cfg *Config) *scheduleOption {
 		NodeRangeBalanceTime: cfg.Schedule.NodeRangeBalanceTime.Duration,
 		StorageAvailableThreshold: cfg.Schedule.StorageAvailableThreshold,
 		WriteByteOpsThreshold:cfg.Schedule.WriteByteOpsThreshold,
+		HotRangeWriteBytesThreshold: cfg.Schedule.HotRangeWriteBytesThreshold,
+		HotRangeReadKeysThreshold: cfg.Schedule.HotRangeReadKeysThreshold,
+		TTLGCBatchSize: cfg.Schedule.TTLGCBatchSize,
+		TTLGCRowsPerSecond: cfg.Schedule.TTLGCRowsPerSecond,
+		LoadSplitKeysThreshold: cfg.Schedule.LoadSplitKeysThreshold,
 		MetricAddr: cfg.Metric.Address,
 		MetricInterval: cfg.Metric.Interval.Duration,
 		MaxReplicas: cfg.Replication.MaxReplicas,
+		LocationLabels: cfg.Replication.LocationLabels,
 	}
 
 	//o.rep = newReplication(&cfg.Replication)
@@ -410,6 +465,11 @@ func (o *scheduleOption) SetMaxReplicas(replicas int) {
 	o.MaxReplicas = uint64(replicas)
 }
 
+// 副本隔离的位置标签, 按从高到低的层级排列, 例如zone, rack, host
+func (o *scheduleOption) GetLocationLabels() []string {
+	return o.LocationLabels
+}
+
 func (o *scheduleOption) GetMaxSnapshotCount() uint64 {
 	return o.MaxSnapshotCount
 }
@@ -438,6 +498,26 @@ func (o *scheduleOption) GetWriteByteOpsThreshold() uint64 {
 	return o.WriteByteOpsThreshold
 }
 
+func (o *scheduleOption) GetHotRangeWriteBytesThreshold() uint64 {
+	return o.HotRangeWriteBytesThreshold
+}
+
+func (o *scheduleOption) GetHotRangeReadKeysThreshold() uint64 {
+	return o.HotRangeReadKeysThreshold
+}
+
+func (o *scheduleOption) GetTTLGCBatchSize() uint64 {
+	return o.TTLGCBatchSize
+}
+
+func (o *scheduleOption) GetTTLGCRowsPerSecond() uint64 {
+	return o.TTLGCRowsPerSecond
+}
+
+func (o *scheduleOption) GetLoadSplitKeysThreshold() uint64 {
+	return o.LoadSplitKeysThreshold
+}
+
 func (o *scheduleOption) GetLeaderScheduleLimit() uint64 {
 	return o.LeaderScheduleLimit
 }
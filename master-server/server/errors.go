@@ -25,6 +25,14 @@ var (
 	ErrPkMustNotNull            = errors.New("primary key must be not nullable")
 	ErrMissingPk                = errors.New("missing primary key")
 	ErrPkMustNotSetDefaultValue = errors.New("primary key should not set defaultvalue")
+	ErrNotExistColumn           = errors.New("column not exist")
+	ErrColumnInIndex            = errors.New("column is used by index")
+	ErrInvalidIndexColumn       = errors.New("primary key or binary column can not be indexed")
+	ErrColumnNotAllowNotNull    = errors.New("nullable column is not allowed to change to not null")
+	ErrTableSchemaStale         = errors.New("table schema is stale")
+	ErrColumnIsTTL              = errors.New("column is used by table ttl")
+	ErrInvalidTTLColumn         = errors.New("ttl column must be a not primary key bigint column")
+	ErrTTLWithIndex             = errors.New("table with index can not set ttl")
 	ErrNodeRejectNewPeer        = errors.New("node reject new peer")
 	ErrNodeBlocked                = errors.New("node is blocked")
 	ErrNodeStateConfused        = errors.New("confused node state")
@@ -32,11 +40,21 @@ var (
 	ErrSchedulerNotFound        = errors.New("scheduler is not found")
 	ErrWorkerExisted            = errors.New("worker is existed")
 	ErrWorkerNotFound           = errors.New("worker is not found")
+	ErrLeaderWorkerConflict     = errors.New("evict leader and grant leader worker of the same node conflict")
 	ErrSqlReservedWord    = errors.New("sql reserved word")
 	ErrSQLSyntaxError     = errors.New("Syntax error")
 	ErrRangeMetaConflict  = errors.New("range meta conflict")
 	ErrNotFound           = errors.New("entity not found")
 	ErrNotAllowSplit      = errors.New("not allow split")
+	ErrInvalidSplitKey    = errors.New("split key is out of range")
+	ErrMasterMemberExisted      = errors.New("master member is existed")
+	ErrMasterMemberNotExist     = errors.New("master member not exist")
+	ErrRemoveMasterLeader       = errors.New("master leader can not be removed, transfer leader first")
+	ErrRemoveLastMaster         = errors.New("the last master member can not be removed")
+	ErrMasterMemberNotLearner   = errors.New("master member is not learner")
+	ErrMasterMemberIsLearner    = errors.New("master member is learner, promote it first")
+	ErrBackupCorrupted          = errors.New("backup file is corrupted")
+	ErrRestoreDataExisted       = errors.New("data dir is not empty, restore needs a fresh data dir")
 	ErrNotCancel          = errors.New("not allow cancel")
 	ErrNotAllowDelete     = errors.New("not allow delete")
 
@@ -17,6 +17,7 @@ var (
 	DefaultDelRangeTimeout     time.Duration = time.Second * time.Duration(30)
 	DefaultAddPeerTimeout      time.Duration = time.Second * time.Duration(300)
 	DefaultDelPeerTimeout      time.Duration = time.Second * time.Duration(30)
+	DefaultSplitRangeTimeout   time.Duration = time.Second * time.Duration(60)
 )
 
 type hb_range_manager struct {
@@ -127,6 +128,23 @@ func (manager *hb_range_manager) CheckRange(cluster *Cluster, rng *Range) RangeE
 		return NewAddPeerEvent(id, rng.GetId(), newPeer, "hb same IP")
 	}
 
+	// 检查副本是否满足位置标签的隔离级别, 同样先添加隔离程度更高的副本, 后面再删除隔离程度最低的副本
+	if node := cluster.selectBetterLocationNode(rng); node != nil {
+		newPeer, err := cluster.allocPeer(node.GetId())
+		if err != nil {
+			log.Error("rangeId:%d,%s", rng.GetId(), err.Error())
+			return nil
+		}
+		node.stats.RangeCount++
+
+		id, err := cluster.GenId()
+		if err != nil {
+			log.Error("rangeId:%d,%s", rng.GetId(), err.Error())
+			return nil
+		}
+		return NewAddPeerEvent(id, rng.GetId(), newPeer, "hb location isolation")
+	}
+
 	return nil
 }
 
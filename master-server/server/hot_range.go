@@ -0,0 +1,196 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"model/pkg/mspb"
+	"model/pkg/statspb"
+)
+
+const (
+	// 心跳间隔小于1秒时按1秒计算速率, 避免速率突变
+	minHotRangeReportInterval = 1
+	// 滑动平均中新采样的权重
+	hotRangeRateWeight = 0.5
+)
+
+// range的读写热度, 按心跳上报的读写量计算每秒的速率并做滑动平均
+type RangeHotStat struct {
+	RangeId          uint64   `json:"range_id"`
+	LeaderNodeId     uint64   `json:"leader_node_id"`
+	NodeIds          []uint64 `json:"node_ids"`
+	WrittenBytesRate float64  `json:"written_bytes_rate"`
+	WrittenKeysRate  float64  `json:"written_keys_rate"`
+	ReadBytesRate    float64  `json:"read_bytes_rate"`
+	ReadKeysRate     float64  `json:"read_keys_rate"`
+	// 连续超过阈值时递增, 否则递减, 减到0时从缓存中删除
+	HotDegree      int       `json:"hot_degree"`
+	LastUpdateTime time.Time `json:"last_update_time"`
+}
+
+func (s *RangeHotStat) update(stats *mspb.RangeStats, interval float64, first bool) {
+	rate := func(old float64, v uint64) float64 {
+		r := float64(v) / interval
+		if first {
+			return r
+		}
+		return hotRangeRateWeight*r + (1-hotRangeRateWeight)*old
+	}
+	s.WrittenBytesRate = rate(s.WrittenBytesRate, stats.GetBytesWritten())
+	s.WrittenKeysRate = rate(s.WrittenKeysRate, stats.GetKeysWritten())
+	s.ReadBytesRate = rate(s.ReadBytesRate, stats.GetBytesRead())
+	s.ReadKeysRate = rate(s.ReadKeysRate, stats.GetKeysRead())
+}
+
+func (s *RangeHotStat) isHot() bool {
+	return s.HotDegree >= hotRegionLowThreshold
+}
+
+// 根据range心跳更新读写热度
+func (c *Cluster) updateHotStat(region *Range, stats *mspb.RangeStats) {
+	now := time.Now()
+	writeThreshold := float64(c.opt.GetHotRangeWriteBytesThreshold())
+	readThreshold := float64(c.opt.GetHotRangeReadKeysThreshold())
+	loadThreshold := float64(c.opt.GetLoadSplitKeysThreshold())
+	updateHotCache(c.writeStatistics, region, stats, now, func(s *RangeHotStat) bool {
+		return writeThreshold > 0 && s.WrittenBytesRate >= writeThreshold
+	})
+	updateHotCache(c.readStatistics, region, stats, now, func(s *RangeHotStat) bool {
+		return readThreshold > 0 && s.ReadKeysRate >= readThreshold
+	})
+	updateHotCache(c.loadStatistics, region, stats, now, func(s *RangeHotStat) bool {
+		return loadThreshold > 0 && s.ReadKeysRate+s.WrittenKeysRate >= loadThreshold
+	})
+}
+
+// cache中只保存最近超过阈值的range
+func updateHotCache(cache *lruCache, region *Range, stats *mspb.RangeStats, now time.Time, overThreshold func(*RangeHotStat) bool) {
+	interval := float64(regionHeartBeatReportInterval)
+	stat := &RangeHotStat{RangeId: region.GetId()}
+	first := true
+	if v, ok := cache.peek(region.GetId()); ok {
+		old := v.(*RangeHotStat)
+		*stat = *old
+		first = false
+		interval = now.Sub(old.LastUpdateTime).Seconds()
+		if interval < minHotRangeReportInterval {
+			interval = minHotRangeReportInterval
+		}
+	}
+	stat.update(stats, interval, first)
+	stat.LastUpdateTime = now
+	stat.LeaderNodeId = region.GetLeader().GetNodeId()
+	stat.NodeIds = make([]uint64, 0, len(region.GetPeers()))
+	for _, peer := range region.GetPeers() {
+		stat.NodeIds = append(stat.NodeIds, peer.GetNodeId())
+	}
+	if overThreshold(stat) {
+		stat.HotDegree++
+	} else {
+		stat.HotDegree--
+	}
+	if stat.HotDegree <= 0 {
+		cache.remove(region.GetId())
+		return
+	}
+	cache.add(region.GetId(), stat)
+}
+
+// 热点range, 按速率从高到低排序
+func (c *Cluster) hotRanges(write bool) []*RangeHotStat {
+	cache := c.readStatistics
+	if write {
+		cache = c.writeStatistics
+	}
+	var stats []*RangeHotStat
+	for _, item := range cache.elems() {
+		stat := item.value.(*RangeHotStat)
+		if !stat.isHot() || c.FindRange(stat.RangeId) == nil {
+			continue
+		}
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if write {
+			return stats[i].WrittenBytesRate > stats[j].WrittenBytesRate
+		}
+		return stats[i].ReadKeysRate > stats[j].ReadKeysRate
+	})
+	return stats
+}
+
+// 节点上的热点统计, 写热点的所有副本都要写入, 读热点只统计leader
+type NodeHotStat struct {
+	NodeId                uint64  `json:"node_id"`
+	NodeAddr              string  `json:"node_addr"`
+	HotWriteRangeAsPeer   int     `json:"hot_write_range_as_peer"`
+	WrittenBytesAsPeer    float64 `json:"written_bytes_as_peer"`
+	HotWriteRangeAsLeader int     `json:"hot_write_range_as_leader"`
+	WrittenBytesAsLeader  float64 `json:"written_bytes_as_leader"`
+	HotReadRangeAsLeader  int     `json:"hot_read_range_as_leader"`
+	ReadKeysAsLeader      float64 `json:"read_keys_as_leader"`
+}
+
+func (c *Cluster) nodeHotStats(writes, reads []*RangeHotStat) map[uint64]*NodeHotStat {
+	result := make(map[uint64]*NodeHotStat)
+	for _, node := range c.GetAllActiveNode() {
+		result[node.GetId()] = &NodeHotStat{NodeId: node.GetId(), NodeAddr: node.GetServerAddr()}
+	}
+	for _, s := range writes {
+		for _, id := range s.NodeIds {
+			if n, ok := result[id]; ok {
+				n.HotWriteRangeAsPeer++
+				n.WrittenBytesAsPeer += s.WrittenBytesRate
+			}
+		}
+		if n, ok := result[s.LeaderNodeId]; ok {
+			n.HotWriteRangeAsLeader++
+			n.WrittenBytesAsLeader += s.WrittenBytesRate
+		}
+	}
+	for _, s := range reads {
+		if n, ok := result[s.LeaderNodeId]; ok {
+			n.HotReadRangeAsLeader++
+			n.ReadKeysAsLeader += s.ReadKeysRate
+		}
+	}
+	return result
+}
+
+// 热点查询结果
+type HotSpotInfo struct {
+	WriteRanges []*RangeHotStat `json:"write_ranges"`
+	ReadRanges  []*RangeHotStat `json:"read_ranges"`
+	Nodes       []*NodeHotStat  `json:"nodes"`
+}
+
+func (c *Cluster) GetHotSpotInfo() *HotSpotInfo {
+	info := &HotSpotInfo{
+		WriteRanges: c.hotRanges(true),
+		ReadRanges:  c.hotRanges(false),
+	}
+	for _, n := range c.nodeHotStats(info.WriteRanges, info.ReadRanges) {
+		info.Nodes = append(info.Nodes, n)
+	}
+	sort.Slice(info.Nodes, func(i, j int) bool {
+		return info.Nodes[i].NodeId < info.Nodes[j].NodeId
+	})
+	return info
+}
+
+func (c *Cluster) collectHotSpotMetrics() []*statspb.HotSpotStats {
+	info := c.GetHotSpotInfo()
+	hotspots := make([]*statspb.HotSpotStats, 0, len(info.Nodes))
+	for _, n := range info.Nodes {
+		hotspots = append(hotspots, &statspb.HotSpotStats{
+			NodeId:                    n.NodeId,
+			NodeAddr:                  n.NodeAddr,
+			TotalWrittenBytesAsPeer:   n.WrittenBytesAsPeer,
+			HotWriteRegionAsPeer:      uint64(n.HotWriteRangeAsPeer),
+			TotalWrittenBytesAsLeader: n.WrittenBytesAsLeader,
+			HotWriteRegionAsLeader:    uint64(n.HotWriteRangeAsLeader),
+		})
+	}
+	return hotspots
+}
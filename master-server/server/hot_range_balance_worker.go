@@ -0,0 +1,195 @@
+package server
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/metapb"
+	"util/log"
+)
+
+// 分散热点range: 写热点的所有副本都要写入, 先迁移副本再切换leader; 读热点只由leader处理, 只切换leader
+type hotRangeWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+}
+
+func NewHotRangeWorker(wm *WorkerManager, interval time.Duration) *hotRangeWorker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &hotRangeWorker{
+		name:     hotRangeWorkerName,
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+	}
+}
+
+func (w *hotRangeWorker) GetName() string {
+	return w.name
+}
+
+func (w *hotRangeWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	writes := cluster.hotRanges(true)
+	reads := cluster.hotRanges(false)
+	if len(writes) == 0 && len(reads) == 0 {
+		log.Debug("%v: no hot range", w.GetName())
+		return
+	}
+	stats := cluster.nodeHotStats(writes, reads)
+
+	if rng, oldPeer, target := selectHotWritePeer(cluster, writes, stats); rng != nil {
+		id, err := cluster.GenId()
+		if err != nil {
+			return
+		}
+		newPeer, err := cluster.allocPeer(target.GetId())
+		if err != nil {
+			log.Error("create peer nodeId:%d error:%s", target.GetId(), err.Error())
+			return
+		}
+		cluster.metric.CollectScheduleCounter(w.GetName(), "move_peer")
+		log.Info("start to move hot write range peer, range:[%v], old peer:[%v], old node:[%v], new node:[%v]",
+			rng.GetId(), oldPeer.GetId(), oldPeer.GetNodeId(), target.GetId())
+		cluster.eventDispatcher.pushEvent(NewChangePeerEvent(id, rng, oldPeer, newPeer, w.GetName()))
+		return
+	}
+
+	for _, write := range []bool{true, false} {
+		hots := reads
+		count := func(s *NodeHotStat) int { return s.HotReadRangeAsLeader }
+		if write {
+			hots = writes
+			count = func(s *NodeHotStat) int { return s.HotWriteRangeAsLeader }
+		}
+		rng, newLeader := selectHotLeader(cluster, hots, stats, count)
+		if rng == nil {
+			continue
+		}
+		id, err := cluster.GenId()
+		if err != nil {
+			return
+		}
+		cluster.metric.CollectScheduleCounter(w.GetName(), "transfer_leader")
+		log.Info("start to transfer hot range leader, range:[%v], write:[%v], old leader node:[%v], new leader node:[%v]",
+			rng.GetId(), write, rng.GetLeader().GetNodeId(), newLeader.GetNodeId())
+		cluster.eventDispatcher.pushEvent(NewTryChangeLeaderEvent(id, rng.GetId(), rng.GetLeader(), newLeader, w.GetName()))
+		return
+	}
+	log.Debug("%v: hot ranges are balanced", w.GetName())
+}
+
+func (w *hotRangeWorker) AllowWork(cluster *Cluster) bool {
+	if cluster.autoFailoverUnable {
+		return false
+	}
+	return true
+}
+
+func (w *hotRangeWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *hotRangeWorker) Stop() {
+	w.cancel()
+}
+
+// 热点数最多的节点
+func mostHotNode(stats map[uint64]*NodeHotStat, count func(*NodeHotStat) int) *NodeHotStat {
+	var most *NodeHotStat
+	for _, s := range stats {
+		if most == nil || count(s) > count(most) {
+			most = s
+		}
+	}
+	return most
+}
+
+// 把写热点最多的节点上的一个follower副本迁到写热点较少的节点
+// 迁移后源节点的热点数仍不少于目标节点, 避免来回迁移
+func selectHotWritePeer(cluster *Cluster, hots []*RangeHotStat, stats map[uint64]*NodeHotStat) (*Range, *metapb.Peer, *Node) {
+	count := func(s *NodeHotStat) int { return s.HotWriteRangeAsPeer }
+	source := mostHotNode(stats, count)
+	if source == nil || count(source) < 2 {
+		return nil, nil, nil
+	}
+	sourceNode := cluster.FindNodeById(source.NodeId)
+	selectors := []NodeSelector{
+		NewNodeLoginSelector(cluster.opt),
+		NewSnapshotCountLimitSelector(cluster.opt),
+		NewStorageThresholdSelector(cluster.opt),
+		NewDifferCacheNodeSelector(cluster.hbManager.dealIngNodes),
+	}
+	labels := cluster.opt.GetLocationLabels()
+	for _, hot := range hots {
+		r := cluster.FindRange(hot.RangeId)
+		if r == nil || !r.require(cluster) || r.GetLeader().GetNodeId() == source.NodeId {
+			continue
+		}
+		peer := r.GetNodePeer(source.NodeId)
+		if peer == nil {
+			continue
+		}
+		rangeNodes := r.GetNodes(cluster)
+		rangeSelectors := append(selectors,
+			NewDifferIPSelector(rangeNodes),
+			NewDistinctScoreSelector(labels, rangeNodes, sourceNode))
+		var target *Node
+		var targetCount int
+		for id, s := range stats {
+			if id == source.NodeId || count(s)+1 >= count(source) {
+				continue
+			}
+			node := cluster.FindNodeById(id)
+			if node == nil || !canSelectNode(node, rangeSelectors) {
+				continue
+			}
+			if target == nil || count(s) < targetCount {
+				target, targetCount = node, count(s)
+			}
+		}
+		if target != nil {
+			return r, peer, target
+		}
+	}
+	return nil, nil, nil
+}
+
+// 把热点leader最多的节点上的一个热点range的leader切换到热点leader较少的follower
+func selectHotLeader(cluster *Cluster, hots []*RangeHotStat, stats map[uint64]*NodeHotStat, count func(*NodeHotStat) int) (*Range, *metapb.Peer) {
+	source := mostHotNode(stats, count)
+	if source == nil || count(source) < 2 || cluster.isGrantLeaderNode(source.NodeId) {
+		return nil, nil
+	}
+	for _, hot := range hots {
+		r := cluster.FindRange(hot.RangeId)
+		if r == nil || r.GetLeader().GetNodeId() != source.NodeId {
+			continue
+		}
+		var newLeader *metapb.Peer
+		var leaderCount int
+		for _, peer := range r.GetPeers() {
+			s, ok := stats[peer.GetNodeId()]
+			if !ok || peer.GetNodeId() == source.NodeId || count(s)+1 >= count(source) || cluster.isEvictLeaderNode(peer.GetNodeId()) {
+				continue
+			}
+			if r.GetDownPeer(peer.GetId()) != nil || r.GetPendingPeer(peer.GetId()) != nil {
+				continue
+			}
+			node := cluster.FindNodeById(peer.GetNodeId())
+			if node == nil || !node.IsLogin() {
+				continue
+			}
+			if newLeader == nil || count(s) < leaderCount {
+				newLeader, leaderCount = peer, count(s)
+			}
+		}
+		if newLeader != nil {
+			return r, newLeader
+		}
+	}
+	return nil, nil
+}
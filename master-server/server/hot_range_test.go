@@ -0,0 +1,68 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+)
+
+func TestUpdateHotCache(t *testing.T) {
+	peers := []*metapb.Peer{{Id: 1, NodeId: 1}, {Id: 2, NodeId: 2}, {Id: 3, NodeId: 3}}
+	rng := NewRange(&metapb.Range{Id: 10, Peers: peers}, nil)
+	cache := newLRUCache(10)
+	overThreshold := func(s *RangeHotStat) bool { return s.WrittenBytesRate >= 100 }
+
+	now := time.Now()
+	// 不是热点时不进入缓存
+	updateHotCache(cache, rng, &mspb.RangeStats{BytesWritten: 500}, now, overThreshold)
+	if cache.len() != 0 {
+		t.Fatalf("expected empty cache, actual %d", cache.len())
+	}
+
+	// 连续hotRegionLowThreshold次超过阈值才是热点
+	for i := 1; i <= hotRegionLowThreshold; i++ {
+		now = now.Add(10 * time.Second)
+		updateHotCache(cache, rng, &mspb.RangeStats{BytesWritten: 2000, KeysRead: 100}, now, overThreshold)
+		v, ok := cache.peek(rng.GetId())
+		if !ok {
+			t.Fatalf("range is not in cache")
+		}
+		stat := v.(*RangeHotStat)
+		if stat.HotDegree != i || stat.isHot() != (i == hotRegionLowThreshold) {
+			t.Fatalf("unexpected hot degree %d", stat.HotDegree)
+		}
+		if stat.WrittenBytesRate != 200 || stat.ReadKeysRate != 10 || stat.LeaderNodeId != 1 || len(stat.NodeIds) != 3 {
+			t.Fatalf("unexpected stat %+v", stat)
+		}
+	}
+
+	// 按滑动平均计算速率, 低于阈值后热度递减
+	now = now.Add(10 * time.Second)
+	updateHotCache(cache, rng, &mspb.RangeStats{}, now, overThreshold)
+	v, _ := cache.peek(rng.GetId())
+	if stat := v.(*RangeHotStat); stat.WrittenBytesRate != 100 || stat.HotDegree != hotRegionLowThreshold+1 {
+		t.Fatalf("unexpected stat %+v", stat)
+	}
+	for i := 0; i < hotRegionLowThreshold+1; i++ {
+		now = now.Add(10 * time.Second)
+		updateHotCache(cache, rng, &mspb.RangeStats{}, now, overThreshold)
+	}
+	if cache.len() != 0 {
+		t.Fatalf("expected cold range removed from cache")
+	}
+}
+
+func TestMostHotNode(t *testing.T) {
+	stats := map[uint64]*NodeHotStat{
+		1: {NodeId: 1, HotWriteRangeAsPeer: 3, HotReadRangeAsLeader: 1},
+		2: {NodeId: 2, HotWriteRangeAsPeer: 1, HotReadRangeAsLeader: 4},
+	}
+	if n := mostHotNode(stats, func(s *NodeHotStat) int { return s.HotWriteRangeAsPeer }); n.NodeId != 1 {
+		t.Errorf("expected node 1, actual %d", n.NodeId)
+	}
+	if n := mostHotNode(stats, func(s *NodeHotStat) int { return s.HotReadRangeAsLeader }); n.NodeId != 2 {
+		t.Errorf("expected node 2, actual %d", n.NodeId)
+	}
+}
@@ -81,9 +81,14 @@ const (
 	HTTP_RANGE_ID = "rangeId"
 	HTTP_NODE_ID = "nodeId"
 	HTTP_NODE_IDS = "nodeIds"
+	HTTP_NODE_LABELS = "labels"
 	HTTP_PEER_ID = "peerId"
 	HTTP_NAME = "name"
 	HTTP_PROPERTIES = "properties"
+	HTTP_TTL_COLUMN = "ttlColumn"
+	HTTP_TTL_DURATION = "ttlDuration"
+	HTTP_SPLIT_KEY = "splitKey"
+	HTTP_SPLIT_VALUE = "splitValue"
 	HTTP_PKDUPCHECK = "pkDupCheck"
 	HTTP_RANGEKEYS_NUM = "rangeKeysNum"
 	HTTP_RANGEKEYS_START = "rangeKeysStart"
@@ -97,6 +102,10 @@ const (
 	HTTP_SERVER_PORT = "serverPort"
 	HTTP_RAFT_HEARTBEAT_PORT = "raftHeartbeatPort"
 	HTTP_RAFT_REPLICA_PORT = "raftReplicaPort"
+	HTTP_IP = "ip"
+	HTTP_LEARNER = "learner"
+	HTTP_HTTP_PORT = "httpPort"
+	HTTP_RPC_PORT = "rpcPort"
 	HTTP_TASK_ID = "taskId"
 	HTTP_TASK_IDS = "taskIds"
 	HTTP_MACHINES = "machines"
@@ -107,6 +116,8 @@ const (
 	HTTP_FAST = "fast"
 	HTTP_STARTKEY = "startKey"
 	HTTP_ENDKEY = "endKey"
+	HTTP_ROUNDS = "rounds"
+	HTTP_SCHEDULE_CONFIG = "scheduleConfig"
 )
 
 const (
@@ -300,7 +311,7 @@ func (service *Server) handleTableCreate(w http.ResponseWriter, r *http.Request)
 	if len(pkDupCheck) == 0 {
 		pkDupCheck = "false"
 	}
-	columns, regxs, err := ParseProperties(properties)
+	columns, regxs, indexes, err := ParseProperties(properties)
 	if err != nil {
 		log.Error("parse cols: %s failed, err: %v", properties, err)
 		reply.Code = HTTP_ERROR_INVALID_PARAM
@@ -324,7 +335,7 @@ func (service *Server) handleTableCreate(w http.ResponseWriter, r *http.Request)
 			return
 		}
 	}
-	_, err = service.cluster.CreateTable(dbName, tName, columns, regxs, pkDupCheck != "false", sliceKeys)
+	_, err = service.cluster.CreateTableWithIndexes(dbName, tName, columns, regxs, indexes, pkDupCheck != "false", sliceKeys)
 	if err != nil {
 		if err == ErrDupTable {
 			log.Warn("http create table repeat %s",tName)
@@ -439,6 +450,84 @@ func (service *Server) handleSchedulerGetAll(w http.ResponseWriter, r *http.Requ
 	return
 }
 
+// 查询读写热点range和各节点的热点统计
+func (service *Server) handleHotSpotQuery(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+	reply.Data = service.cluster.GetHotSpotInfo()
+}
+
+// 在集群快照上模拟调度, 返回会生成的事件和事件执行后各节点的分布, 不会下发任何任务
+// name: 逗号分隔的worker名字, 为空时模拟正在运行的调度worker, 按节点添加的worker名字带上节点id
+// scheduleConfig: json格式, 覆盖当前调度配置中的字段, 例如{"LoadSplitKeysThreshold":10000}
+func (service *Server) handleSchedulerDryRun(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+	cluster := service.cluster
+	var names []string
+	if v := r.FormValue(HTTP_NAME); v != "" {
+		names = strings.Split(v, ",")
+	}
+	rounds := 1
+	if v := r.FormValue(HTTP_ROUNDS); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			log.Error("http scheduler dry run: rounds is not int: %v", err)
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = http_error_invalid_parameter
+			return
+		}
+		rounds = n
+	}
+	opt := *cluster.opt
+	if v := r.FormValue(HTTP_SCHEDULE_CONFIG); v != "" {
+		if err := json.Unmarshal([]byte(v), &opt); err != nil {
+			log.Error("http scheduler dry run: invalid schedule config: %v", err)
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = http_error_invalid_parameter
+			return
+		}
+	}
+	result, err := cluster.DryRunSchedule(names, rounds, &opt)
+	if err != nil {
+		log.Warn("http scheduler dry run failed, err[%v]", err)
+		reply.Code = -1
+		reply.Message = err.Error()
+		return
+	}
+	reply.Data = result
+}
+
+// 导出集群拓扑, 保存的文件可以用于离线模拟调度
+func (service *Server) handleTopologyDump(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+	reply.Data = service.cluster.dumpTopology()
+}
+
+// 备份全部元数据, 应答是备份文件, 出错时应答json
+func (service *Server) handleBackup(w http.ResponseWriter, r *http.Request) {
+	snap, err := service.raftStore.GetSnapshot()
+	if err != nil {
+		log.Error("http backup: get snapshot failed, err[%v]", err)
+		sendReply(w, &httpReply{Code: HTTP_ERROR, Message: err.Error()})
+		return
+	}
+	defer snap.Release()
+
+	clusterId := service.conf.Cluster.ClusterID
+	fileName := fmt.Sprintf("fbase_%d_%s.bak", clusterId, time.Now().Format("20060102150405"))
+	w.Header().Set("content-type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
+	// 已经开始写应答, 出错时客户端通过校验和发现文件不完整
+	meta, err := WriteBackup(w, snap, clusterId)
+	if err != nil {
+		log.Error("http backup failed, err[%v]", err)
+		return
+	}
+	log.Info("backup success, apply index[%d], count[%d]", meta.ApplyIndex, meta.Count)
+}
+
 func (service *Server) handleAddScheduler(w http.ResponseWriter, r *http.Request) {
 	reply := &httpReply{}
 	defer sendReply(w, reply)
@@ -461,6 +550,34 @@ func (service *Server) handleAddScheduler(w http.ResponseWriter, r *http.Request
 		 cluster.AddBalanceLeaderWorker()
 	case balanceNodeOpsWorkerName:
 		cluster.AddBalanceNodeOpsWorker()
+	case balanceStorageWorkerName:
+		cluster.AddBalanceStorageWorker()
+	case hotRangeWorkerName:
+		cluster.AddHotRangeWorker()
+	case ttlGCWorkerName:
+		cluster.AddTTLGCWorker()
+	case loadSplitWorkerName:
+		cluster.AddLoadSplitWorker()
+	case evictLeaderWorkerName, grantLeaderWorkerName:
+		nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+		if err != nil {
+			log.Error("http add scheduler %s: node id is not int: %v", name, err)
+			reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+			reply.Message = http_error_parameter_not_enough
+			return
+		}
+		if name == evictLeaderWorkerName {
+			err = cluster.AddEvictLeaderWorker(nodeId)
+		} else {
+			err = cluster.AddGrantLeaderWorker(nodeId)
+		}
+		if err != nil {
+			log.Warn("http add scheduler %s for node %d failed, err[%v]", name, nodeId, err)
+			reply.Code = -1
+			reply.Message = err.Error()
+			return
+		}
+		log.Info("add scheduler %s for node %d", name, nodeId)
 
 	default:
 		log.Warn("unknown worker %s", name)
@@ -475,6 +592,17 @@ func (service *Server) handleRemoveScheduler(w http.ResponseWriter, r *http.Requ
 	defer sendReply(w, reply)
 	cluster := service.cluster
 	name := r.FormValue("name")
+	// 按节点添加的worker, 也可以直接用带节点id的名字删除
+	if name == evictLeaderWorkerName || name == grantLeaderWorkerName {
+		nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+		if err != nil {
+			log.Error("http remove scheduler %s: node id is not int: %v", name, err)
+			reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+			reply.Message = http_error_parameter_not_enough
+			return
+		}
+		name = leaderWorkerName(name, nodeId)
+	}
 	err := cluster.RemoveWorker(name)
 	if err != nil {
 		reply.Code = -1
@@ -594,6 +722,125 @@ func (service *Server) handleMasterGetAll(w http.ResponseWriter, r *http.Request
 		Node: service.getRaftMembers()}
 }
 
+// 增加master成员, 新成员需要先用包含所有成员的配置启动, learner=true时作为学习者加入
+func (service *Server) handleMasterMemberAdd(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+	ip := r.FormValue(HTTP_IP)
+	var ports []uint64
+	for _, name := range []string{HTTP_HTTP_PORT, HTTP_RPC_PORT, HTTP_RAFT_HEARTBEAT_PORT, HTTP_RAFT_REPLICA_PORT} {
+		port, _err := strconv.ParseUint(r.FormValue(name), 10, 16)
+		if _err != nil {
+			err = _err
+		}
+		ports = append(ports, port)
+	}
+	if err != nil || nodeId == 0 || ip == "" {
+		log.Error("http add master member: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+	peer := &Peer{
+		ID:                nodeId,
+		WebManageAddr:     fmt.Sprintf("%s:%d", ip, ports[0]),
+		RpcServerAddr:     fmt.Sprintf("%s:%d", ip, ports[1]),
+		RaftHeartbeatAddr: fmt.Sprintf("%s:%d", ip, ports[2]),
+		RaftReplicateAddr: fmt.Sprintf("%s:%d", ip, ports[3]),
+		Learner:           r.FormValue(HTTP_LEARNER) == "true",
+	}
+	if err := service.raftStore.AddMember(peer); err != nil {
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("add master member[%d] %v success", nodeId, peer)
+}
+
+func (service *Server) handleMasterMemberRemove(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+	if err != nil {
+		log.Error("http remove master member: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+	if err := service.raftStore.RemoveMember(nodeId); err != nil {
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("remove master member[%d] success", nodeId)
+}
+
+// 学习者追上日志后提升为正式成员
+func (service *Server) handleMasterMemberPromote(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+	if err != nil {
+		log.Error("http promote master member: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+	if err := service.raftStore.PromoteMember(nodeId); err != nil {
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("promote master member[%d] success", nodeId)
+}
+
+// 把master leader转移到指定成员, 请求转发到该成员上由其发起选举
+func (service *Server) handleMasterMemberTransfer(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+
+	nodeId, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+	if err != nil {
+		log.Error("http transfer master leader: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		sendReply(w, reply)
+		return
+	}
+	member := service.raftStore.GetMember(nodeId)
+	if member != nil && member.Learner {
+		// 学习者不参与选举
+		reply.Code = HTTP_ERROR
+		reply.Message = ErrMasterMemberIsLearner.Error()
+		sendReply(w, reply)
+		return
+	}
+	if nodeId != service.conf.NodeId {
+		if member == nil {
+			reply.Code = HTTP_ERROR
+			reply.Message = ErrMasterMemberNotExist.Error()
+			sendReply(w, reply)
+			return
+		}
+		proxy := &Proxy{targetHost: member.WebManageAddr}
+		proxy.proxy(w, r)
+		return
+	}
+	defer sendReply(w, reply)
+	if service.IsLeader() {
+		return
+	}
+	if err := service.raftStore.TransferLeader(); err != nil {
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("transfer master leader to member[%d] success", nodeId)
+}
+
 func (service *Server) handleRangeGetLeader(w http.ResponseWriter, r *http.Request) {
 	reply := &httpReply{}
 	defer sendReply(w, reply)
@@ -1736,6 +1983,159 @@ func (service *Server) handleTableEdit(w http.ResponseWriter, r *http.Request) {
 	log.Info("edit table[%s:%s] success", dbName, tName)
 }
 
+// 设置表的过期策略, ttlColumn为空时取消过期; ttlDuration(毫秒)大于0时插入没有指定过期列的行自动填充过期时间
+func (service *Server) handleTableTTL(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	dbName := r.FormValue(HTTP_DB_NAME)
+	tName := r.FormValue(HTTP_TABLE_NAME)
+	if dbName == "" || tName == "" {
+		log.Error("http table ttl: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+	column := r.FormValue(HTTP_TTL_COLUMN)
+	var duration int64
+	if len(r.FormValue(HTTP_TTL_DURATION)) > 0 {
+		var err error
+		if duration, err = strconv.ParseInt(r.FormValue(HTTP_TTL_DURATION), 10, 64); err != nil {
+			log.Error("http table ttl: duration is not int: %v", err)
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = err.Error()
+			return
+		}
+	}
+	db, find := service.cluster.FindDatabase(dbName)
+	if !find {
+		log.Warn("db[%s] not exist", dbName)
+		reply.Code = HTTP_ERROR
+		reply.Message = ErrNotExistDatabase.Error()
+		return
+	}
+	table, find := db.FindTable(tName)
+	if !find {
+		log.Warn("table[%s:%s] not exist", dbName, tName)
+		reply.Code = HTTP_ERROR
+		reply.Message = ErrNotExistTable.Error()
+		return
+	}
+	if err := table.SetTTL(column, duration, service.cluster); err != nil {
+		log.Warn("set table[%s:%s] ttl failed, err %v", dbName, tName, err)
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("set table[%s:%s] ttl column[%s] duration[%d] success", dbName, tName, column, duration)
+}
+
+// 手动分裂range, splitKey为base64编码的完整key, 也可以通过dbName, tableName和splitValue指定第一主键列的值
+// 指定rangeId时校验分裂点在该range内, 否则按分裂点查找range
+func (service *Server) handleRangeSplit(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	cluster := service.cluster
+	var splitKey []byte
+	if len(r.FormValue(HTTP_SPLIT_KEY)) > 0 {
+		var err error
+		if splitKey, err = base64.StdEncoding.DecodeString(r.FormValue(HTTP_SPLIT_KEY)); err != nil {
+			log.Error("http range split: decode split key failed, err %v", err)
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = err.Error()
+			return
+		}
+	} else if len(r.FormValue(HTTP_SPLIT_VALUE)) > 0 {
+		dbName := r.FormValue(HTTP_DB_NAME)
+		tName := r.FormValue(HTTP_TABLE_NAME)
+		db, find := cluster.FindDatabase(dbName)
+		if !find {
+			reply.Code = HTTP_ERROR
+			reply.Message = ErrNotExistDatabase.Error()
+			return
+		}
+		table, find := db.FindTable(tName)
+		if !find {
+			reply.Code = HTTP_ERROR
+			reply.Message = ErrNotExistTable.Error()
+			return
+		}
+		var err error
+		if splitKey, err = encodeTableSplitKey(table, []byte(r.FormValue(HTTP_SPLIT_VALUE))); err != nil {
+			log.Error("http range split: encode split value of table[%s:%s] failed, err %v", dbName, tName, err)
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = err.Error()
+			return
+		}
+	} else {
+		log.Error("http range split: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+
+	var region *Range
+	if len(r.FormValue(HTTP_RANGE_ID)) > 0 {
+		rangeId, err := strconv.ParseUint(r.FormValue(HTTP_RANGE_ID), 10, 64)
+		if err != nil {
+			reply.Code = HTTP_ERROR_INVALID_PARAM
+			reply.Message = err.Error()
+			return
+		}
+		region = cluster.FindRange(rangeId)
+	} else {
+		region = cluster.SearchRange(splitKey)
+	}
+	if region == nil {
+		log.Error("http range split: range of split key[%v] is not existed", splitKey)
+		reply.Code = HTTP_ERROR_RANGE_FIND
+		reply.Message = http_error_range_find
+		return
+	}
+	if err := cluster.SplitRange(region, splitKey, "console"); err != nil {
+		log.Warn("http range split: split range[%d] failed, err %v", region.GetId(), err)
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	reply.Data = region.GetId()
+}
+
+// 把表的range副本和leader均匀分散到所有节点, 用于向已有的表批量导入数据之前
+func (service *Server) handleTableScatter(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+
+	dbName := r.FormValue(HTTP_DB_NAME)
+	tName := r.FormValue(HTTP_TABLE_NAME)
+	if dbName == "" || tName == "" {
+		log.Error("http table scatter: %s", http_error_parameter_not_enough)
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = http_error_parameter_not_enough
+		return
+	}
+	db, find := service.cluster.FindDatabase(dbName)
+	if !find {
+		reply.Code = HTTP_ERROR
+		reply.Message = ErrNotExistDatabase.Error()
+		return
+	}
+	table, find := db.FindTable(tName)
+	if !find {
+		reply.Code = HTTP_ERROR
+		reply.Message = ErrNotExistTable.Error()
+		return
+	}
+	peers, leaders, err := service.cluster.ScatterTable(table, "console")
+	if err != nil {
+		log.Warn("http table scatter: scatter table[%s:%s] failed, err %v", dbName, tName, err)
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+	}
+	reply.Data = map[string]int{"peers": peers, "leaders": leaders}
+}
+
 func (service *Server) handleNodeDelete(w http.ResponseWriter, r *http.Request) {
 	reply := &httpReply{}
 	defer sendReply(w, reply)
@@ -1789,6 +2189,52 @@ func (s *Server)handleHttpNodeLogin(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// 设置节点的位置标签, labels格式为zone=z1,rack=r1,host=h1
+func (service *Server) handleHttpNodeSetLabels(w http.ResponseWriter, r *http.Request) {
+	reply := &httpReply{}
+	defer sendReply(w, reply)
+	id, err := strconv.ParseUint(r.FormValue(HTTP_NODE_ID), 10, 64)
+	if err != nil {
+		log.Error("http set node labels: %v", err.Error())
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = err.Error()
+		return
+	}
+	labels, err := parseNodeLabels(r.FormValue(HTTP_NODE_LABELS))
+	if err != nil {
+		log.Error("http set node labels: %v", err.Error())
+		reply.Code = HTTP_ERROR_PARAMETER_NOT_ENOUGH
+		reply.Message = err.Error()
+		return
+	}
+	if err := service.cluster.SetNodeLabels(id, labels); err != nil {
+		log.Error("http set node %d labels failed. error:[%v]", id, err.Error())
+		reply.Code = HTTP_ERROR
+		reply.Message = err.Error()
+		return
+	}
+	log.Info("node[%d] set labels %v success", id, labels)
+}
+
+func parseNodeLabels(value string) ([]*metapb.NodeLabel, error) {
+	var labels []*metapb.NodeLabel
+	for _, kv := range strings.Split(value, ",") {
+		kv = strings.TrimSpace(kv)
+		if len(kv) == 0 {
+			continue
+		}
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) != 2 || len(strings.TrimSpace(pair[0])) == 0 {
+			return nil, fmt.Errorf("invalid node label %s", kv)
+		}
+		labels = append(labels, &metapb.NodeLabel{Key: strings.TrimSpace(pair[0]), Value: strings.TrimSpace(pair[1])})
+	}
+	if len(labels) == 0 {
+		return nil, ErrInvalidParam
+	}
+	return labels, nil
+}
+
 func (service *Server) handleHttpNodeLogout(w http.ResponseWriter, r *http.Request) {
 	reply := &httpReply{}
 	defer sendReply(w, reply)
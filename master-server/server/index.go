@@ -0,0 +1,235 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"model/pkg/metapb"
+	"util/deepcopy"
+	"util/log"
+)
+
+var (
+	ErrDupIndex      = errors.New("duplicate index")
+	ErrNotExistIndex = errors.New("index not exist")
+)
+
+// 存放索引数据的表名, 以$开头对用户不可见
+func IndexTableName(tableId uint64, indexName string) string {
+	return fmt.Sprintf("$index_%d_%s", tableId, indexName)
+}
+
+// 索引表的列: 索引列和原表的主键列依次作为主键, 列名与原表相同
+func indexTableColumns(t *metapb.Table, columnIds []uint64) ([]*metapb.Column, error) {
+	var columns []*metapb.Column
+	added := make(map[uint64]bool)
+	addColumn := func(col *metapb.Column) {
+		newCol := deepcopy.Iface(col).(*metapb.Column)
+		newCol.Id = uint64(len(columns) + 1)
+		newCol.PrimaryKey = uint64(len(columns) + 1)
+		newCol.Nullable = false
+		newCol.Index = false
+		newCol.DefaultValue = nil
+		columns = append(columns, newCol)
+		added[col.GetId()] = true
+	}
+	for _, id := range columnIds {
+		var col *metapb.Column
+		for _, c := range t.GetColumns() {
+			if c.GetId() == id {
+				col = c
+				break
+			}
+		}
+		if col == nil || added[id] {
+			return nil, ErrInvalidColumn
+		}
+		if col.GetDataType() == metapb.DataType_Binary {
+			log.Warn("binary column[%s:%s:%s] can not be indexed", t.GetDbName(), t.GetName(), col.GetName())
+			return nil, ErrInvalidIndexColumn
+		}
+		addColumn(col)
+	}
+	pks := make([]*metapb.Column, 0)
+	for _, c := range t.GetColumns() {
+		if c.GetPrimaryKey() > 0 {
+			pks = append(pks, c)
+		}
+	}
+	for i := 1; i <= len(pks); i++ {
+		for _, c := range pks {
+			if c.GetPrimaryKey() == uint64(i) && !added[c.GetId()] {
+				addColumn(c)
+			}
+		}
+	}
+	return columns, nil
+}
+
+type indexDef struct {
+	name      string
+	columnIds []uint64
+}
+
+// 建表时需要创建的索引: 标记为索引的列各自创建单列索引, 以及建表语句中指定的索引
+// 在分配表ID之前检查, 避免创建部分索引表后才失败
+func tableIndexDefs(columns []*metapb.Column, indexes []*IndexProperty) ([]*indexDef, error) {
+	var defs []*indexDef
+	names := make(map[string]bool)
+	addDef := func(name string, columnIds []uint64) error {
+		if names[name] {
+			return ErrDupIndex
+		}
+		names[name] = true
+		defs = append(defs, &indexDef{name: name, columnIds: columnIds})
+		return nil
+	}
+	for _, col := range columns {
+		if !col.GetIndex() {
+			continue
+		}
+		if err := addDef(col.GetName(), []uint64{col.GetId()}); err != nil {
+			return nil, err
+		}
+	}
+	for _, index := range indexes {
+		if len(index.Name) == 0 || len(index.Columns) == 0 {
+			return nil, ErrInvalidParam
+		}
+		var columnIds []uint64
+		added := make(map[uint64]bool)
+		for _, name := range index.Columns {
+			var col *metapb.Column
+			for _, c := range columns {
+				if c.GetName() == name {
+					col = c
+					break
+				}
+			}
+			if col == nil || added[col.GetId()] {
+				return nil, ErrInvalidColumn
+			}
+			if col.GetDataType() == metapb.DataType_Binary {
+				return nil, ErrInvalidIndexColumn
+			}
+			added[col.GetId()] = true
+			columnIds = append(columnIds, col.GetId())
+		}
+		if err := addDef(index.Name, columnIds); err != nil {
+			return nil, err
+		}
+	}
+	return defs, nil
+}
+
+// 创建存放索引数据的表, 调用方需要持有db的锁
+func (c *Cluster) createIndexTable(db *Database, t *metapb.Table, indexName string, columnIds []uint64) (*metapb.Index, error) {
+	for _, index := range t.GetIndexes() {
+		if index.GetName() == indexName {
+			return nil, ErrDupIndex
+		}
+	}
+	columns, err := indexTableColumns(t, columnIds)
+	if err != nil {
+		return nil, err
+	}
+	indexTable, err := c.createTable(db, IndexTableName(t.GetId(), indexName), columns, nil, nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &metapb.Index{
+		Name:      indexName,
+		ColumnIds: columnIds,
+		TableId:   indexTable.GetId(),
+		State:     metapb.IndexState_IndexWriteOnly,
+	}, nil
+}
+
+// 在已有的表上创建索引, 索引处于只写状态, 存量数据回填完成后由gateway修改为可用状态
+func (c *Cluster) CreateIndex(dbName, tableName, indexName string, columnNames []string) (*metapb.Index, error) {
+	if len(indexName) == 0 || len(columnNames) == 0 {
+		return nil, ErrInvalidParam
+	}
+	db, find := c.FindDatabase(dbName)
+	if !find {
+		return nil, ErrNotExistDatabase
+	}
+	db.Lock()
+	defer db.UnLock()
+	t, find := db.FindTable(tableName)
+	if !find {
+		return nil, ErrNotExistTable
+	}
+	t.schemaLock.Lock()
+	defer t.schemaLock.Unlock()
+	if t.Status != metapb.TableStatus_TableRunning {
+		log.Warn("table[%s:%s] is not running, can not create index", dbName, tableName)
+		return nil, ErrNotExistTable
+	}
+	if t.GetTtl().GetColumn() != "" {
+		log.Warn("table[%s:%s] has ttl, can not create index", dbName, tableName)
+		return nil, ErrTTLWithIndex
+	}
+
+	var columnIds []uint64
+	for _, name := range columnNames {
+		col, find := t.GetColumnByName(name)
+		if !find {
+			log.Warn("column[%s:%s:%s] not exist", dbName, tableName, name)
+			return nil, ErrInvalidColumn
+		}
+		columnIds = append(columnIds, col.GetId())
+	}
+	index, err := c.createIndexTable(db, t.Table, indexName, columnIds)
+	if err != nil {
+		log.Error("create index %s of table[%s:%s] failed, err[%v]", indexName, dbName, tableName, err)
+		return nil, err
+	}
+
+	table := deepcopy.Iface(t.Table).(*metapb.Table)
+	table.Indexes = append(table.Indexes, index)
+	table.Epoch.ConfVer++
+	if err = c.storeTable(table); err != nil {
+		log.Error("store table failed, err[%v]", err)
+		return nil, err
+	}
+	t.Table = table
+	log.Info("create index %s of table[%s:%s], index table %d", indexName, dbName, tableName, index.GetTableId())
+	return index, nil
+}
+
+func (c *Cluster) SetIndexState(dbName, tableName, indexName string, state metapb.IndexState) error {
+	db, find := c.FindDatabase(dbName)
+	if !find {
+		return ErrNotExistDatabase
+	}
+	t, find := db.FindTable(tableName)
+	if !find {
+		return ErrNotExistTable
+	}
+	t.schemaLock.Lock()
+	defer t.schemaLock.Unlock()
+	table := deepcopy.Iface(t.Table).(*metapb.Table)
+	var index *metapb.Index
+	for _, idx := range table.GetIndexes() {
+		if idx.GetName() == indexName {
+			index = idx
+			break
+		}
+	}
+	if index == nil {
+		return ErrNotExistIndex
+	}
+	if index.State == state {
+		return nil
+	}
+	index.State = state
+	table.Epoch.ConfVer++
+	if err := c.storeTable(table); err != nil {
+		log.Error("store table failed, err[%v]", err)
+		return err
+	}
+	t.Table = table
+	log.Info("set index %s of table[%s:%s] state %v", indexName, dbName, tableName, state)
+	return nil
+}
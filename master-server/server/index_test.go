@@ -0,0 +1,97 @@
+package server
+
+import (
+	"testing"
+
+	"model/pkg/metapb"
+)
+
+func TestCreateTableInvalidIndexColumn(t *testing.T) {
+	cluster := NewCluster(1, 1, nil, newScheduleOption(NewDefaultConfig()))
+	defer cluster.workerManger.Stop()
+	db := NewDatabase(&metapb.DataBase{Name: DB_NAME, Id: 1})
+
+	// 主键列和Binary列标记为索引时拒绝建表
+	columnss := [][]*metapb.Column{
+		{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1, Index: true},
+			{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Index: true},
+		},
+		{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "data", Id: 2, DataType: metapb.DataType_Binary, Index: true},
+		},
+	}
+	for i, columns := range columnss {
+		if _, err := cluster.createTable(db, TABLE_NAME, columns, nil, nil, false, nil); err != ErrInvalidIndexColumn {
+			t.Fatalf("case %d: expected invalid index column error, actual %v", i, err)
+		}
+		if _, find := db.FindTable(TABLE_NAME); find {
+			t.Fatalf("case %d: table is created", i)
+		}
+	}
+}
+
+func TestTableIndexDefs(t *testing.T) {
+	columns := []*metapb.Column{
+		{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+		{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Index: true},
+		{Name: "age", Id: 3, DataType: metapb.DataType_Int},
+		{Name: "data", Id: 4, DataType: metapb.DataType_Binary},
+	}
+	defs, err := tableIndexDefs(columns, []*IndexProperty{{Name: "idx_age_name", Columns: []string{"age", "name"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defs) != 2 || defs[0].name != "name" || len(defs[0].columnIds) != 1 || defs[0].columnIds[0] != 2 ||
+		defs[1].name != "idx_age_name" || len(defs[1].columnIds) != 2 || defs[1].columnIds[0] != 3 || defs[1].columnIds[1] != 2 {
+		t.Fatalf("unexpected index defs %v", defs)
+	}
+
+	invalid := map[error][]*IndexProperty{
+		ErrDupIndex:           {{Name: "name", Columns: []string{"age"}}},
+		ErrInvalidColumn:      {{Name: "idx", Columns: []string{"age", "age"}}},
+		ErrInvalidIndexColumn: {{Name: "idx", Columns: []string{"data"}}},
+		ErrInvalidParam:       {{Name: "idx"}},
+	}
+	for expected, indexes := range invalid {
+		if _, err = tableIndexDefs(columns, indexes); err != expected {
+			t.Fatalf("expected %v, actual %v", expected, err)
+		}
+	}
+}
+
+func TestIndexTableColumns(t *testing.T) {
+	table := &metapb.Table{
+		Name:   TABLE_NAME,
+		DbName: DB_NAME,
+		Columns: []*metapb.Column{
+			{Name: "k1", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 2},
+			{Name: "k2", Id: 2, DataType: metapb.DataType_Varchar, PrimaryKey: 1},
+			{Name: "name", Id: 3, DataType: metapb.DataType_Varchar, Nullable: true, Index: true},
+			{Name: "data", Id: 4, DataType: metapb.DataType_Binary},
+		},
+	}
+	// 索引列在前, 之后按顺序是原表的主键列, 已经是索引列的主键列不重复
+	columns, err := indexTableColumns(table, []uint64{3, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"name", "k1", "k2"}
+	if len(columns) != len(expected) {
+		t.Fatalf("unexpected columns %v", columns)
+	}
+	for i, col := range columns {
+		if col.GetName() != expected[i] || col.GetId() != uint64(i+1) || col.GetPrimaryKey() != uint64(i+1) ||
+			col.GetNullable() || col.GetIndex() {
+			t.Fatalf("unexpected column %d: %v", i, col)
+		}
+	}
+
+	if _, err = indexTableColumns(table, []uint64{4}); err != ErrInvalidIndexColumn {
+		t.Fatalf("expected invalid index column error, actual %v", err)
+	}
+	if _, err = indexTableColumns(table, []uint64{3, 3}); err != ErrInvalidColumn {
+		t.Fatalf("expected invalid column error, actual %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/metapb"
+	"util/log"
+)
+
+const (
+	// 每次调度最多切换的leader数
+	leaderScheduleBatchSize = 8
+)
+
+func leaderWorkerName(prefix string, nodeId uint64) string {
+	return fmt.Sprintf("%s_%d", prefix, nodeId)
+}
+
+func isLeaderWorkerName(name string) bool {
+	return strings.HasPrefix(name, evictLeaderWorkerName+"_") || strings.HasPrefix(name, grantLeaderWorkerName+"_")
+}
+
+// 按节点添加的worker保存到store中, master重启或者切换leader后恢复, 避免节点维护期间leader被切回来
+func (c *Cluster) addLeaderWorker(w Worker) error {
+	if err := c.workerManger.addWorker(w); err != nil {
+		return err
+	}
+	key := []byte(PREFIX_LEADER_WORKER + w.GetName())
+	if err := c.store.Put(key, []byte(w.GetName())); err != nil {
+		log.Error("store leader worker %s failed, err[%v]", w.GetName(), err)
+		c.workerManger.removeWorker(w.GetName())
+		return err
+	}
+	return nil
+}
+
+func (c *Cluster) deleteLeaderWorker(name string) error {
+	if err := c.store.Delete([]byte(PREFIX_LEADER_WORKER + name)); err != nil {
+		log.Error("delete leader worker %s from store failed, err[%v]", name, err)
+		return err
+	}
+	return nil
+}
+
+func (c *Cluster) loadLeaderWorkers() error {
+	startKey, limitKey := bytesPrefix([]byte(PREFIX_LEADER_WORKER))
+	it := c.store.Scan(startKey, limitKey)
+	defer it.Release()
+	var names []string
+	for it.Next() {
+		names = append(names, string(it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	for _, name := range names {
+		w, err := newScheduleWorker(c.workerManger, name)
+		if err != nil {
+			log.Warn("invalid leader worker %s, err[%v]", name, err)
+			continue
+		}
+		var nodeId uint64
+		switch lw := w.(type) {
+		case *evictLeaderWorker:
+			nodeId = lw.nodeId
+		case *grantLeaderWorker:
+			nodeId = lw.nodeId
+		}
+		// 节点已经删除时不再恢复
+		if c.FindNodeById(nodeId) == nil {
+			log.Warn("node of leader worker %s is not exist, remove it", name)
+			if err = c.deleteLeaderWorker(name); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = c.workerManger.addWorker(w); err != nil {
+			return err
+		}
+		log.Info("leader worker %s is restored", name)
+	}
+	return nil
+}
+
+// 节点上的leader正在被驱逐, 其他调度不能再把leader切换到这个节点
+func (c *Cluster) isEvictLeaderNode(nodeId uint64) bool {
+	return c.workerManger.hasWorkerName(leaderWorkerName(evictLeaderWorkerName, nodeId))
+}
+
+// leader固定在这个节点上, 其他调度不能把leader从这个节点切走
+func (c *Cluster) isGrantLeaderNode(nodeId uint64) bool {
+	return c.workerManger.hasWorkerName(leaderWorkerName(grantLeaderWorkerName, nodeId))
+}
+
+// 节点维护(例如滚动重启DS)前把节点上的leader全部切走, 删除worker之前一直保持节点上没有leader
+// 避免重启leader多的节点时大量range同时选举
+type evictLeaderWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+	nodeId   uint64
+}
+
+func NewEvictLeaderWorker(wm *WorkerManager, interval time.Duration, nodeId uint64) *evictLeaderWorker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &evictLeaderWorker{
+		name:     leaderWorkerName(evictLeaderWorkerName, nodeId),
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+		nodeId:   nodeId,
+	}
+}
+
+func (w *evictLeaderWorker) GetName() string {
+	return w.name
+}
+
+func (w *evictLeaderWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	node := cluster.FindNodeById(w.nodeId)
+	if node == nil {
+		log.Warn("%v: node %d not found", w.GetName(), w.nodeId)
+		return
+	}
+	var count int
+	for _, r := range node.GetAllRanges() {
+		if r.GetLeader().GetNodeId() != w.nodeId || cluster.GetEvent(r.GetId()) != nil {
+			continue
+		}
+		newLeader := selectEvictLeaderTarget(cluster, r, w.nodeId)
+		if newLeader == nil {
+			log.Debug("%v: range %d has no follower to transfer leader", w.GetName(), r.GetId())
+			continue
+		}
+		id, err := cluster.GenId()
+		if err != nil {
+			return
+		}
+		log.Info("start to evict leader, range:[%v], old leader node:[%v], new leader node:[%v]",
+			r.GetId(), w.nodeId, newLeader.GetNodeId())
+		if cluster.eventDispatcher.pushEvent(NewTryChangeLeaderEvent(id, r.GetId(), r.GetLeader(), newLeader, w.GetName())) {
+			cluster.metric.CollectScheduleCounter(w.GetName(), "transfer_leader")
+			count++
+		}
+		if count >= leaderScheduleBatchSize {
+			return
+		}
+	}
+}
+
+func (w *evictLeaderWorker) AllowWork(cluster *Cluster) bool {
+	if cluster.autoFailoverUnable {
+		return false
+	}
+	return true
+}
+
+func (w *evictLeaderWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *evictLeaderWorker) Stop() {
+	w.cancel()
+}
+
+// 选择leader最少的健康follower作为新的leader
+func selectEvictLeaderTarget(cluster *Cluster, r *Range, nodeId uint64) *metapb.Peer {
+	var newLeader *metapb.Peer
+	var leaderCount uint32
+	for _, peer := range r.GetPeers() {
+		if peer.GetNodeId() == nodeId || cluster.isEvictLeaderNode(peer.GetNodeId()) {
+			continue
+		}
+		if r.GetDownPeer(peer.GetId()) != nil || r.GetPendingPeer(peer.GetId()) != nil {
+			continue
+		}
+		node := cluster.FindNodeById(peer.GetNodeId())
+		if node == nil || !node.IsLogin() {
+			continue
+		}
+		if newLeader == nil || node.GetLeaderCount() < leaderCount {
+			newLeader, leaderCount = peer, node.GetLeaderCount()
+		}
+	}
+	return newLeader
+}
+
+// 把节点上副本的leader都切换到这个节点, 删除worker之前其他调度不会把leader切走
+type grantLeaderWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+	nodeId   uint64
+}
+
+func NewGrantLeaderWorker(wm *WorkerManager, interval time.Duration, nodeId uint64) *grantLeaderWorker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &grantLeaderWorker{
+		name:     leaderWorkerName(grantLeaderWorkerName, nodeId),
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+		nodeId:   nodeId,
+	}
+}
+
+func (w *grantLeaderWorker) GetName() string {
+	return w.name
+}
+
+func (w *grantLeaderWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	node := cluster.FindNodeById(w.nodeId)
+	if node == nil {
+		log.Warn("%v: node %d not found", w.GetName(), w.nodeId)
+		return
+	}
+	if !node.IsLogin() {
+		log.Debug("%v: node %d is not login", w.GetName(), w.nodeId)
+		return
+	}
+	var count int
+	for _, r := range node.GetAllRanges() {
+		if r.GetLeader() == nil || r.GetLeader().GetNodeId() == w.nodeId || cluster.GetEvent(r.GetId()) != nil {
+			continue
+		}
+		peer := r.GetNodePeer(w.nodeId)
+		if peer == nil || r.GetDownPeer(peer.GetId()) != nil || r.GetPendingPeer(peer.GetId()) != nil {
+			continue
+		}
+		id, err := cluster.GenId()
+		if err != nil {
+			return
+		}
+		log.Info("start to grant leader, range:[%v], old leader node:[%v], new leader node:[%v]",
+			r.GetId(), r.GetLeader().GetNodeId(), w.nodeId)
+		if cluster.eventDispatcher.pushEvent(NewTryChangeLeaderEvent(id, r.GetId(), r.GetLeader(), peer, w.GetName())) {
+			cluster.metric.CollectScheduleCounter(w.GetName(), "transfer_leader")
+			count++
+		}
+		if count >= leaderScheduleBatchSize {
+			return
+		}
+	}
+}
+
+func (w *grantLeaderWorker) AllowWork(cluster *Cluster) bool {
+	if cluster.autoFailoverUnable {
+		return false
+	}
+	return true
+}
+
+func (w *grantLeaderWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *grantLeaderWorker) Stop() {
+	w.cancel()
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+)
+
+func TestRemoveLeaderWorker(t *testing.T) {
+	wm := NewWorkerManager(nil, nil)
+	defer wm.Stop()
+	w := NewEvictLeaderWorker(wm, time.Hour, 3)
+	if w.GetName() != "evict_leader_worker_3" {
+		t.Fatalf("unexpected worker name %v", w.GetName())
+	}
+	if err := wm.addWorker(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := wm.addWorker(NewEvictLeaderWorker(wm, time.Hour, 3)); err != ErrWorkerExisted {
+		t.Fatalf("expected worker existed, actual %v", err)
+	}
+	if !wm.hasWorkerName(leaderWorkerName(evictLeaderWorkerName, 3)) || wm.hasWorkerName(leaderWorkerName(grantLeaderWorkerName, 3)) {
+		t.Fatal("unexpected workers")
+	}
+
+	// 删除后worker停止调度
+	if err := wm.removeWorker(w.GetName()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-w.ctx.Done():
+	default:
+		t.Fatal("worker is not stopped")
+	}
+	if wm.hasWorker(w) || wm.hasWorkerName(w.GetName()) {
+		t.Fatal("worker is not removed")
+	}
+}
+
+func TestLeaderWorkerRestore(t *testing.T) {
+	initDataPath()
+	defer clearData()
+	store, err := NewLevelDBDriver(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	cluster := NewCluster(1, 1, store, newScheduleOption(NewDefaultConfig()))
+	for _, id := range []uint64{1, 2, 3} {
+		node := &metapb.Node{Id: id, State: metapb.NodeState_N_Login}
+		cluster.nodes.Add(NewNode(node))
+		if err = cluster.storeNode(node); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = cluster.AddEvictLeaderWorker(1); err != nil {
+		t.Fatal(err)
+	}
+	if err = cluster.AddGrantLeaderWorker(2); err != nil {
+		t.Fatal(err)
+	}
+	if err = cluster.AddEvictLeaderWorker(3); err != nil {
+		t.Fatal(err)
+	}
+	if err = cluster.RemoveWorker(leaderWorkerName(evictLeaderWorkerName, 3)); err != nil {
+		t.Fatal(err)
+	}
+	cluster.workerManger.Stop()
+
+	// 切换leader后新的cluster从store中恢复按节点添加的worker
+	restored := NewCluster(1, 1, store, newScheduleOption(NewDefaultConfig()))
+	defer restored.workerManger.Stop()
+	if err = restored.LoadCache(); err != nil {
+		t.Fatal(err)
+	}
+	if !restored.isEvictLeaderNode(1) || !restored.isGrantLeaderNode(2) || restored.isEvictLeaderNode(3) {
+		t.Fatalf("unexpected workers %v", restored.workerManger.GetAllWorker())
+	}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"model/pkg/mspb"
+	"util/log"
+)
+
+const (
+	// 每个range最多保存的抽样key数
+	maxRangeKeySamples = 256
+	// 抽样key太少时分裂点不准确, 不分裂
+	minLoadSplitKeySamples = 16
+	// 长时间没有更新的抽样不能反映当前的请求分布
+	rangeKeySampleExpireTime = 5 * time.Minute
+)
+
+type rangeKeySample struct {
+	// 分裂或者合并后range的version变化, 之前的抽样失效
+	version uint64
+	keys    [][]byte
+	// 收到的抽样key总数, 超过maxRangeKeySamples后按蓄水池抽样替换
+	total      uint64
+	updateTime time.Time
+}
+
+// gateway上报的每个range的请求key抽样
+type RangeKeySampleCache struct {
+	lock    sync.Mutex
+	samples map[uint64]*rangeKeySample
+}
+
+func NewRangeKeySampleCache() *RangeKeySampleCache {
+	return &RangeKeySampleCache{samples: make(map[uint64]*rangeKeySample)}
+}
+
+func (c *RangeKeySampleCache) add(rangeId, version uint64, keys [][]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	s, ok := c.samples[rangeId]
+	if !ok || s.version != version || time.Since(s.updateTime) > rangeKeySampleExpireTime {
+		s = &rangeKeySample{version: version}
+		c.samples[rangeId] = s
+	}
+	for _, key := range keys {
+		s.total++
+		if len(s.keys) < maxRangeKeySamples {
+			s.keys = append(s.keys, key)
+		} else if i := rand.Int63n(int64(s.total)); i < maxRangeKeySamples {
+			s.keys[i] = key
+		}
+	}
+	s.updateTime = time.Now()
+}
+
+func (c *RangeKeySampleCache) get(rangeId, version uint64) [][]byte {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	s, ok := c.samples[rangeId]
+	if !ok {
+		return nil
+	}
+	if s.version != version || time.Since(s.updateTime) > rangeKeySampleExpireTime {
+		delete(c.samples, rangeId)
+		return nil
+	}
+	keys := make([][]byte, len(s.keys))
+	copy(keys, s.keys)
+	return keys
+}
+
+func (c *RangeKeySampleCache) remove(rangeId uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.samples, rangeId)
+}
+
+// 保存gateway上报的请求key抽样, 版本跟当前range不一致的抽样丢弃
+func (c *Cluster) AddKeySamples(samples []*mspb.RangeKeySamples) {
+	for _, s := range samples {
+		r := c.FindRange(s.GetRangeId())
+		if r == nil {
+			continue
+		}
+		if r.GetRangeEpoch().GetVersion() != s.GetRangeEpoch().GetVersion() {
+			log.Debug("range[%d] key samples version %d is stale, current %d",
+				r.GetId(), s.GetRangeEpoch().GetVersion(), r.GetRangeEpoch().GetVersion())
+			continue
+		}
+		c.keySamples.add(r.GetId(), r.GetRangeEpoch().GetVersion(), s.GetKeys())
+	}
+}
+
+// 选择请求key的中位数作为分裂点, 使分裂后两侧的请求数大致相同
+// 分裂点左侧必须有请求, 所有请求都集中在一个key上时无法分裂
+func selectLoadSplitKey(r *Range, samples [][]byte) []byte {
+	var keys [][]byte
+	for _, key := range samples {
+		if bytes.Compare(key, r.GetStartKey()) <= 0 {
+			continue
+		}
+		if len(r.GetEndKey()) > 0 && bytes.Compare(key, r.GetEndKey()) >= 0 {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) < minLoadSplitKeySamples {
+		return nil
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	for i := len(keys) / 2; i < len(keys); i++ {
+		if bytes.Compare(keys[i], keys[0]) > 0 {
+			return keys[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"model/pkg/taskpb"
+)
+
+// 创建一个测试用的range, 副本依次位于nodes上, leader位于leaderNode上
+func newTestRange(id uint64, start, end string, version uint64, leaderNode uint64, nodes ...uint64) *Range {
+	r := &metapb.Range{
+		Id:         id,
+		StartKey:   []byte(start),
+		EndKey:     []byte(end),
+		RangeEpoch: &metapb.RangeEpoch{ConfVer: 1, Version: version},
+	}
+	var leader *metapb.Peer
+	for _, nodeId := range nodes {
+		peer := &metapb.Peer{Id: id*10 + nodeId, NodeId: nodeId}
+		if nodeId == leaderNode {
+			leader = peer
+		}
+		r.Peers = append(r.Peers, peer)
+	}
+	return NewRange(r, leader)
+}
+
+func loadSplitTestKeys(format string, n int) [][]byte {
+	var keys [][]byte
+	for i := 0; i < n; i++ {
+		keys = append(keys, []byte(fmt.Sprintf(format, i)))
+	}
+	return keys
+}
+
+func TestSelectLoadSplitKey(t *testing.T) {
+	r := newTestRange(1, "a", "c", 1, 1, 1)
+	// 抽样太少不分裂
+	if key := selectLoadSplitKey(r, loadSplitTestKeys("b%02d", minLoadSplitKeySamples-1)); key != nil {
+		t.Fatalf("unexpected split key %s", key)
+	}
+	// range外和等于起始key的抽样不计入
+	samples := loadSplitTestKeys("b%02d", minLoadSplitKeySamples)
+	samples = append(samples, []byte("a"), []byte("c"), []byte("d"))
+	if key := selectLoadSplitKey(r, samples); !bytes.Equal(key, []byte(fmt.Sprintf("b%02d", minLoadSplitKeySamples/2))) {
+		t.Fatalf("unexpected split key %s", key)
+	}
+	// 请求集中在一个key上时无法分裂
+	var hot [][]byte
+	for i := 0; i < minLoadSplitKeySamples; i++ {
+		hot = append(hot, []byte("b"))
+	}
+	if key := selectLoadSplitKey(r, hot); key != nil {
+		t.Fatalf("unexpected split key %s", key)
+	}
+	// 中位数等于最小key时向后找
+	hot = append(hot, []byte("b1"))
+	if key := selectLoadSplitKey(r, hot); !bytes.Equal(key, []byte("b1")) {
+		t.Fatalf("unexpected split key %s", key)
+	}
+}
+
+func TestRangeKeySampleCache(t *testing.T) {
+	c := NewRangeKeySampleCache()
+	c.add(1, 1, loadSplitTestKeys("k%d", 10))
+	if keys := c.get(1, 1); len(keys) != 10 {
+		t.Fatalf("unexpected samples %d", len(keys))
+	}
+	// 版本变化后之前的抽样失效
+	if keys := c.get(1, 2); keys != nil {
+		t.Fatalf("unexpected samples %d", len(keys))
+	}
+	if keys := c.get(1, 1); keys != nil {
+		t.Fatalf("stale samples are not removed")
+	}
+	c.add(1, 2, loadSplitTestKeys("k%d", maxRangeKeySamples*2))
+	if keys := c.get(1, 2); len(keys) != maxRangeKeySamples {
+		t.Fatalf("unexpected samples %d", len(keys))
+	}
+	c.remove(1)
+	if keys := c.get(1, 2); keys != nil {
+		t.Fatalf("samples are not removed")
+	}
+}
+
+func TestLoadSplitWorker(t *testing.T) {
+	cluster := NewCluster(1, 1, nil, newScheduleOption(NewDefaultConfig()))
+	defer cluster.workerManger.Stop()
+	cluster.idGener = newMockIDAllocator()
+	cluster.metric = &Metric{scheduleCounter: make(map[string]map[string]uint64)}
+	if cluster.opt.GetMaxReplicas() != 3 {
+		t.Skipf("max replicas is %d", cluster.opt.GetMaxReplicas())
+	}
+
+	r := newTestRange(100, "a", "c", 1, 1, 1, 2, 3)
+	r.TableId = 10
+	cluster.ranges.Add(r)
+	cluster.workingTables.Add(NewTable(&metapb.Table{
+		Name:   TABLE_NAME,
+		DbName: DB_NAME,
+		Id:     10,
+		Status: metapb.TableStatus_TableRunning,
+		Epoch:  &metapb.TableEpoch{ConfVer: 1, Version: 1},
+	}))
+	cluster.loadStatistics.add(r.GetId(), &RangeHotStat{RangeId: r.GetId(), ReadKeysRate: 10000, HotDegree: hotRegionLowThreshold})
+
+	w := NewLoadSplitWorker(cluster.workerManger, time.Hour)
+	// 没有抽样时不分裂
+	w.Work(cluster)
+	if cluster.GetEvent(r.GetId()) != nil {
+		t.Fatal("unexpected split without samples")
+	}
+	// 版本不一致的抽样被丢弃
+	cluster.AddKeySamples([]*mspb.RangeKeySamples{{RangeId: r.GetId(), RangeEpoch: &metapb.RangeEpoch{Version: 2}, Keys: loadSplitTestKeys("b%02d", 32)}})
+	w.Work(cluster)
+	if cluster.GetEvent(r.GetId()) != nil {
+		t.Fatal("unexpected split with stale samples")
+	}
+	if count := cluster.metric.scheduleCounter[loadSplitWorkerName]["no_split_key"]; count != 2 {
+		t.Fatalf("unexpected no split key metric %d", count)
+	}
+
+	cluster.AddKeySamples([]*mspb.RangeKeySamples{{RangeId: r.GetId(), RangeEpoch: r.GetRangeEpoch(), Keys: loadSplitTestKeys("b%02d", 32)}})
+	w.Work(cluster)
+	e, ok := cluster.GetEvent(r.GetId()).(*SplitRangeEvent)
+	if !ok {
+		t.Fatal("split event is not created")
+	}
+	if cluster.keySamples.get(r.GetId(), 1) != nil {
+		t.Fatal("samples are not removed after split")
+	}
+
+	// 下发分裂任务, 分裂完成后version增加
+	_, task, err := e.Execute(cluster, r)
+	if err != nil || task.GetType() != taskpb.TaskType_RangeSplit || !bytes.Equal(task.GetRangeSplit().GetSplitKey(), []byte("b16")) {
+		t.Fatalf("unexpected task %v, err %v", task, err)
+	}
+	if next, _, _ := e.Execute(cluster, r); next || e.GetStatus() != EVENT_STATUS_DEALING {
+		t.Fatalf("unexpected status %s", ToEventStatusName(e.GetStatus()))
+	}
+	split := r.clone()
+	split.RangeEpoch = &metapb.RangeEpoch{ConfVer: 1, Version: 2}
+	if next, _, _ := e.Execute(cluster, split); !next || e.GetStatus() != EVENT_STATUS_FINISH {
+		t.Fatalf("unexpected status %s", ToEventStatusName(e.GetStatus()))
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/metapb"
+	"util/log"
+)
+
+const (
+	// 每次调度最多分裂的range数
+	loadSplitBatchSize = 4
+)
+
+// range的数据量不大但是读写请求很多时, 按请求key的分布分裂range, 分裂后再由热点调度分散到不同节点
+type loadSplitWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+}
+
+func NewLoadSplitWorker(wm *WorkerManager, interval time.Duration) *loadSplitWorker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &loadSplitWorker{
+		name:     loadSplitWorkerName,
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+	}
+}
+
+func (w *loadSplitWorker) GetName() string {
+	return w.name
+}
+
+func (w *loadSplitWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	var count int
+	for _, item := range cluster.loadStatistics.elems() {
+		stat := item.value.(*RangeHotStat)
+		if !stat.isHot() {
+			continue
+		}
+		r := cluster.FindRange(stat.RangeId)
+		if r == nil || !canLoadSplitRange(cluster, r) {
+			continue
+		}
+		splitKey := selectLoadSplitKey(r, cluster.keySamples.get(r.GetId(), r.GetRangeEpoch().GetVersion()))
+		if splitKey == nil {
+			log.Debug("%v: range %d has no split key", w.GetName(), r.GetId())
+			cluster.metric.CollectScheduleCounter(w.GetName(), "no_split_key")
+			continue
+		}
+		id, err := cluster.GenId()
+		if err != nil {
+			return
+		}
+		log.Info("start to split range by load, range:[%v], read keys:[%.0f/s], written keys:[%.0f/s], split key:[%v]",
+			r.GetId(), stat.ReadKeysRate, stat.WrittenKeysRate, splitKey)
+		if cluster.eventDispatcher.pushEvent(NewSplitRangeEvent(id, r, splitKey, w.GetName())) {
+			cluster.metric.CollectScheduleCounter(w.GetName(), "split")
+			// 分裂后的请求分布需要重新抽样
+			cluster.keySamples.remove(r.GetId())
+			count++
+		}
+		if count >= loadSplitBatchSize {
+			return
+		}
+	}
+}
+
+func canLoadSplitRange(cluster *Cluster, r *Range) bool {
+	if !r.require(cluster) || r.GetLeader() == nil {
+		return false
+	}
+	table, find := cluster.FindTableById(r.GetTableId())
+	if !find || table.GetStatus() != metapb.TableStatus_TableRunning {
+		return false
+	}
+	return cluster.GetEvent(r.GetId()) == nil
+}
+
+func (w *loadSplitWorker) AllowWork(cluster *Cluster) bool {
+	if cluster.autoFailoverUnable {
+		return false
+	}
+	return true
+}
+
+func (w *loadSplitWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *loadSplitWorker) Stop() {
+	w.cancel()
+}
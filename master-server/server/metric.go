@@ -78,7 +78,7 @@ func (m *Metric) run() {
 				continue
 			}
 			// 上报热点统计
-			//m.hotspotStats()
+			m.hotspotStats()
 		}
 	}
 }
@@ -112,6 +112,11 @@ func (m *Metric) CollectEvent(event RangeEvent) {
 }
 
 func (m *Metric) CollectScheduleCounter(name, label string) {
+	m.AddScheduleCounter(name, label, 1)
+}
+
+// AddScheduleCounter 计数一次增加多个, 例如回收的过期行数
+func (m *Metric) AddScheduleCounter(name, label string, n uint64) {
 	if m == nil {
 		return
 	}
@@ -125,7 +130,7 @@ func (m *Metric) CollectScheduleCounter(name, label string) {
 		m.scheduleCounter[name] = labels
 	}
 	count = labels[label]
-	count++
+	count += n
 	labels[label] = count
 }
 
@@ -175,9 +180,8 @@ func (m *Metric) scheduleCounterStats() error {
 	return nil
 }
 
-/**
 func (m *Metric) hotspotStats() error {
-	hotspots := m.cluster.coordinator.collectHotSpotMetrics()
+	hotspots := m.cluster.collectHotSpotMetrics()
 	for _, hotspot := range hotspots {
 		err := metricHotSpot(m.cli, m.cluster.GetClusterId(), m.addr, hotspot)
 		if err != nil {
@@ -186,7 +190,7 @@ func (m *Metric) hotspotStats() error {
 	}
 	return nil
 }
-*/
+
 func (m *Metric) clusterInfoStats() error {
 	stats := &statspb.ClusterStats{}
 	cluster := m.cluster
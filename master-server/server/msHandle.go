@@ -349,6 +349,12 @@ func (service *Server) handleReportSplit(ctx context.Context, req *mspb.ReportSp
 	return
 }
 
+func (service *Server) handleReportKeySamples(ctx context.Context, req *mspb.ReportKeySamplesRequest) (resp *mspb.ReportKeySamplesResponse, err error) {
+	service.cluster.AddKeySamples(req.GetSamples())
+	resp = &mspb.ReportKeySamplesResponse{Header: &mspb.ResponseHeader{}}
+	return
+}
+
 func (service *Server) handleNodeLogin(ctx context.Context, req *mspb.NodeLoginRequest) (*mspb.NodeLoginResponse, error) {
 	err := service.cluster.NodeLogin(req.GetNodeId())
 	if err != nil {
@@ -494,6 +500,27 @@ func (service *Server) handleAddColumns(ctx context.Context, req *mspb.AddColumn
 	return
 }
 
+func (service *Server) handleAlterColumns(ctx context.Context, req *mspb.AlterColumnRequest) (resp *mspb.AlterColumnResponse, err error) {
+	resp = new(mspb.AlterColumnResponse)
+	resp.Header = &mspb.ResponseHeader{}
+	if req.GetTableId() == 0 || len(req.GetAlterations()) == 0 {
+		return nil, errors.New("parameter is nil")
+	}
+	t, ok := service.cluster.FindTableById(req.GetTableId())
+	if !ok {
+		err = schemaErrorHeader(resp.Header, ErrNotExistTable)
+		return
+	}
+	table, err := t.AlterColumns(req.GetConfVer(), req.GetAlterations(), service.cluster)
+	if err != nil {
+		log.Error("alter columns of table[%s:%s] failed, err[%v]", t.GetDbName(), t.GetName(), err)
+		err = schemaErrorHeader(resp.Header, err)
+		return
+	}
+	resp.Table = deepcopy.Iface(table).(*metapb.Table)
+	return
+}
+
 func (service *Server) handleGetColumnByName(ctx context.Context, req *mspb.GetColumnByNameRequest) (resp *mspb.GetColumnByNameResponse, err error) {
 	resp = new(mspb.GetColumnByNameResponse)
 	resp.Header = &mspb.ResponseHeader{}
@@ -581,12 +608,14 @@ func (service *Server) handleGetNodeId(ctx context.Context, req *mspb.GetNodeIdR
 }
 
 func (service *Server) handleCreateDatabase(ctx context.Context, req *mspb.CreateDatabaseRequest) (resp *mspb.CreateDatabaseResponse, err error) {
-	_, err = service.cluster.CreateDatabase(req.GetDbName(), "")
-	if err != nil {
-		return
-	}
 	resp = new(mspb.CreateDatabaseResponse)
 	resp.Header = &mspb.ResponseHeader{}
+
+	if _, err = service.cluster.CreateDatabase(req.GetDbName(), ""); err != nil {
+		log.Error("create database[%s] failed, err[%v]", req.GetDbName(), err)
+		err = schemaErrorHeader(resp.Header, err)
+		return
+	}
 	return
 }
 
@@ -594,16 +623,118 @@ func (service *Server) handleCreateTable(ctx context.Context, req *mspb.CreateTa
 	resp = new(mspb.CreateTableResponse)
 	resp.Header = &mspb.ResponseHeader{}
 
-	columns, regxs, err := ParseProperties(req.GetProperties())
+	columns, regxs, indexes, err := ParseProperties(req.GetProperties())
 	if err != nil {
 		log.Error("parse cols[%s] failed, err[%v]", req.GetProperties(), err)
 		err = errors.New("invalid properties")
 		return
 	}
-	if _, err = service.cluster.CreateTable(req.GetDbName(), req.GetTableName(), columns, regxs, false, nil); err != nil {
+	if _, err = service.cluster.CreateTableWithIndexes(req.GetDbName(), req.GetTableName(), columns, regxs, indexes, false, req.GetSplitKeys()); err != nil {
 		log.Error("http sql table create : %v", err)
+		err = schemaErrorHeader(resp.Header, err)
 		return
 	}
 	log.Info("create table[%s:%s] success", req.GetDbName(), req.GetTableName())
 	return
 }
+
+func (service *Server) handleDeleteDatabase(ctx context.Context, req *mspb.DeleteDatabaseRequest) (resp *mspb.DeleteDatabaseResponse, err error) {
+	resp = new(mspb.DeleteDatabaseResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	if err = service.cluster.DeleteDatabase(req.GetDbName()); err != nil {
+		log.Error("delete database[%s] failed, err[%v]", req.GetDbName(), err)
+		err = schemaErrorHeader(resp.Header, err)
+		return
+	}
+	return
+}
+
+func (service *Server) handleDeleteTable(ctx context.Context, req *mspb.DeleteTableRequest) (resp *mspb.DeleteTableResponse, err error) {
+	resp = new(mspb.DeleteTableResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	if _, err = service.cluster.DeleteTable(req.GetDbName(), req.GetTableName(), false); err != nil {
+		log.Error("delete table[%s:%s] failed, err[%v]", req.GetDbName(), req.GetTableName(), err)
+		err = schemaErrorHeader(resp.Header, err)
+		return
+	}
+	log.Info("delete table[%s:%s] success", req.GetDbName(), req.GetTableName())
+	return
+}
+
+func (service *Server) handleCreateIndex(ctx context.Context, req *mspb.CreateIndexRequest) (resp *mspb.CreateIndexResponse, err error) {
+	resp = new(mspb.CreateIndexResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	index, err := service.cluster.CreateIndex(req.GetDbName(), req.GetTableName(), req.GetIndexName(), req.GetColumns())
+	if err != nil {
+		log.Error("create index %s of table[%s:%s] failed, err[%v]", req.GetIndexName(), req.GetDbName(), req.GetTableName(), err)
+		err = schemaErrorHeader(resp.Header, err)
+		return
+	}
+	resp.Index = index
+	return
+}
+
+func (service *Server) handleSetIndexState(ctx context.Context, req *mspb.SetIndexStateRequest) (resp *mspb.SetIndexStateResponse, err error) {
+	resp = new(mspb.SetIndexStateResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	if err = service.cluster.SetIndexState(req.GetDbName(), req.GetTableName(), req.GetIndexName(), req.GetState()); err != nil {
+		log.Error("set index %s of table[%s:%s] state failed, err[%v]", req.GetIndexName(), req.GetDbName(), req.GetTableName(), err)
+		return
+	}
+	return
+}
+
+func (service *Server) handleGetDatabases(ctx context.Context, req *mspb.GetDatabasesRequest) (resp *mspb.GetDatabasesResponse, err error) {
+	resp = new(mspb.GetDatabasesResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	for _, db := range service.cluster.GetAllDatabase() {
+		resp.Dbs = append(resp.Dbs, deepcopy.Iface(db.DataBase).(*metapb.DataBase))
+	}
+	return
+}
+
+func (service *Server) handleGetTables(ctx context.Context, req *mspb.GetTablesRequest) (resp *mspb.GetTablesResponse, err error) {
+	resp = new(mspb.GetTablesResponse)
+	resp.Header = &mspb.ResponseHeader{}
+
+	db, ok := service.cluster.FindDatabase(req.GetDbName())
+	if !ok {
+		log.Error("invalid database[%s]", req.GetDbName())
+		return nil, ErrNotExistDatabase
+	}
+	for _, t := range db.GetAllTable() {
+		if t.Status != metapb.TableStatus_TableRunning {
+			continue
+		}
+		resp.Tables = append(resp.Tables, deepcopy.Iface(t.Table).(*metapb.Table))
+	}
+	return
+}
+
+// 元数据操作中调用方需要区分的错误
+var schemaErrorCodes = map[error]mspb.SchemaErrorCode{
+	ErrDupDatabase:           mspb.SchemaErrorCode_SchemaErrDupDatabase,
+	ErrNotExistDatabase:      mspb.SchemaErrorCode_SchemaErrNotExistDatabase,
+	ErrDupTable:              mspb.SchemaErrorCode_SchemaErrDupTable,
+	ErrNotExistTable:         mspb.SchemaErrorCode_SchemaErrNotExistTable,
+	ErrTableSchemaStale:      mspb.SchemaErrorCode_SchemaErrTableSchemaStale,
+	ErrColumnInIndex:         mspb.SchemaErrorCode_SchemaErrColumnInIndex,
+	ErrColumnNotAllowNotNull: mspb.SchemaErrorCode_SchemaErrColumnNotAllowNotNull,
+	ErrDupIndex:              mspb.SchemaErrorCode_SchemaErrDupIndex,
+	ErrInvalidIndexColumn:    mspb.SchemaErrorCode_SchemaErrInvalidIndexColumn,
+}
+
+// 有错误码的错误放在应答头中返回, 其他错误仍作为rpc错误返回
+func schemaErrorHeader(header *mspb.ResponseHeader, err error) error {
+	code, ok := schemaErrorCodes[err]
+	if !ok {
+		return err
+	}
+	header.Error = &mspb.Error{SchemaError: &mspb.SchemaError{Code: code, Message: err.Error()}}
+	return nil
+}
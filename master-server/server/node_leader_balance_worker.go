@@ -97,6 +97,7 @@ func selectChangeLeader(cluster *Cluster, workerName string) (*Range, *metapb.Pe
 		NewWriterOpsThresholdSelector(cluster.opt),
 		NewStorageThresholdSelector(cluster.opt),
 		NewDifferCacheNodeSelector(cluster.hbManager.dealIngNodes),
+		NewEvictLeaderSelector(cluster),
 	}
 
 	//todo avg 应该 是过滤后的node的平均值
@@ -114,7 +115,8 @@ func selectChangeLeader(cluster *Cluster, workerName string) (*Range, *metapb.Pe
 		log.Debug("%v: mostLeaderNum  %v, leastLeaderNum %v, avg leader num :%v", workerName, mostLeaderNum, leastLeaderNum, avgLeaderNum)
 	}
 
-	if (mostLeaderNum - avgLeaderNum) > float64(Min_leader_balance_num) {
+	// leader固定在节点上时不能切走
+	if (mostLeaderNum - avgLeaderNum) > float64(Min_leader_balance_num) && !cluster.isGrantLeaderNode(mostLeaderNode.GetId()) {
 		// 在Node上选择一个leader
 		for _, r := range mostLeaderNode.GetAllRanges() {
 			if r.GetLeader().GetNodeId() == mostLeaderNode.GetId() && r.require(cluster) {
@@ -132,6 +134,9 @@ func selectChangeLeader(cluster *Cluster, workerName string) (*Range, *metapb.Pe
 		for _, r := range leastLeaderNode.GetAllRanges() {
 			if r.GetLeader().GetNodeId() != leastLeaderNode.GetId() && r.require(cluster) {
 				leaderNode := cluster.getLeaderNode(r)
+				if leaderNode == nil || cluster.isGrantLeaderNode(leaderNode.GetId()) {
+					continue
+				}
 				if float64(leaderNode.GetLeaderCount()- leastLeaderNode.GetLeaderCount()) > float64(Min_leader_balance_num) {
 					return r, r.GetNodePeer(leastLeaderNode.GetId())
 				}
@@ -89,7 +89,7 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 		NewDifferCacheNodeSelector(cluster.hbManager.dealIngNodes),
 	}
 
-	mostRangeNode, leastRangeNode, force := SelectMostAndLeastRangeNode(cluster.opt, nodes, newSelectors)
+	mostRangeNode, leastRangeNode := SelectMostAndLeastRangeNode(nodes, newSelectors)
 	var mostRangeNum, leastRangeNum = uint32(0), uint32(0)
 	if mostRangeNode != nil {
 		mostRangeNum = mostRangeNode.GetRangesCount()
@@ -101,7 +101,7 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 		return nil, nil, 0
 	}
 
-	if !force && mostRangeNum-leastRangeNum < uint32(Min_range_balance_num) {
+	if mostRangeNum < leastRangeNum+uint32(Min_range_balance_num) {
 		log.Debug("mostNode %v mostRangeNum %v , leastNode %v leastRangeNum %v, don't need balance",
 			mostRangeNode.GetId(), mostRangeNum, leastRangeNode.GetId(), leastRangeNum)
 		return nil, nil, 0
@@ -111,8 +111,7 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 	var rng *Range
 	for _, r := range mostRangeNode.GetAllRanges() {
 		if r.GetLeader().GetNodeId() != mostRangeNode.GetId() && r.require(cluster) {
-			ipSelector := NewDifferIPSelector(r.GetNodes(cluster))
-			if !ipSelector.CanSelect(leastRangeNode) {
+			if !canMovePeer(cluster, r, mostRangeNode, leastRangeNode) {
 				continue
 			}
 			rng = r
@@ -124,8 +123,7 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 		log.Debug("%v: select follower range than exclude leastRangeNode %v is nil ", workerName, leastRangeNode)
 		for _, r := range mostRangeNode.GetAllRanges() {
 			if r.GetLeader().GetNodeId() == mostRangeNode.GetId() && r.require(cluster) {
-				ipSelector := NewDifferIPSelector(r.GetNodes(cluster))
-				if !ipSelector.CanSelect(leastRangeNode) {
+				if !canMovePeer(cluster, r, mostRangeNode, leastRangeNode) {
 					continue
 				}
 				rng = r
@@ -138,7 +136,8 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 		log.Debug("%v: select leader range that exclude leastRangeNode %v is nil ", workerName, leastRangeNode)
 		for _, r := range mostRangeNode.GetAllRanges() {
 			if r.GetLeader().GetNodeId() != mostRangeNode.GetId() && r.require(cluster) {
-				leastRangeNode = cluster.selectNodeForAddPeer(rng)
+				leastRangeNode = cluster.selectNodeForAddPeer(r,
+					NewDistinctScoreSelector(cluster.opt.GetLocationLabels(), r.GetNodes(cluster), mostRangeNode))
 				if leastRangeNode != nil {
 					rng = r
 					break
@@ -151,7 +150,8 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 		log.Debug("%v: select follow range to best node is nil  %v", workerName, leastRangeNode)
 		for _, r := range mostRangeNode.GetAllRanges() {
 			if r.GetLeader().GetNodeId() == mostRangeNode.GetId() && r.require(cluster)  {
-				leastRangeNode = cluster.selectNodeForAddPeer(rng)
+				leastRangeNode = cluster.selectNodeForAddPeer(r,
+					NewDistinctScoreSelector(cluster.opt.GetLocationLabels(), r.GetNodes(cluster), mostRangeNode))
 				if leastRangeNode != nil {
 					rng = r
 					break
@@ -169,3 +169,12 @@ func selectRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer
 
 	return rng, rng.GetNodePeer(mostRangeNode.GetId()), leastRangeNode.GetId()
 }
+
+// range在source上的副本能否迁移到target, 不能跟其他副本同IP, 也不能降低副本的隔离程度
+func canMovePeer(cluster *Cluster, r *Range, source, target *Node) bool {
+	nodes := r.GetNodes(cluster)
+	if !NewDifferIPSelector(nodes).CanSelect(target) {
+		return false
+	}
+	return NewDistinctScoreSelector(cluster.opt.GetLocationLabels(), nodes, source).CanSelect(target)
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
-	"util/log"
+	"math"
 	"strings"
+
+	"util/log"
 )
 
 /**
@@ -80,6 +82,22 @@ func (sel *DifferCacheNodeSelector) CanSelect(node *Node) bool {
 	return !cached
 }
 
+// 正在驱逐leader的节点不能作为新的leader
+type EvictLeaderSelector struct {
+	cluster *Cluster
+}
+
+func NewEvictLeaderSelector(cluster *Cluster) *EvictLeaderSelector {
+	return &EvictLeaderSelector{cluster: cluster}
+}
+
+func (sel *EvictLeaderSelector) Name() string {
+	return "evict_leader"
+}
+
+func (sel *EvictLeaderSelector) CanSelect(node *Node) bool {
+	return !sel.cluster.isEvictLeaderNode(node.GetId())
+}
 
 type NodeLoginSelector struct {
 	opt *scheduleOption
@@ -155,4 +173,52 @@ func (sel *StorageThresholdSelector) Name() string {
 func (sel *StorageThresholdSelector) CanSelect(node *Node) bool {
 	ok := node.availableRatio()*100  > float64(sel.opt.GetStorageAvailableThreshold()) / float64(DefaultFactor)
 	return ok
-}
\ No newline at end of file
+}
+// 不同位置的得分基数, 在越高的标签层级上位置不同得分越高
+const replicaBaseScore = 100
+
+// 计算other跟nodes中其他节点的隔离程度, 得分越高隔离越好
+func distinctScore(labels []string, nodes []*Node, other *Node) float64 {
+	var score float64
+	for _, n := range nodes {
+		if n.GetId() == other.GetId() {
+			continue
+		}
+		if index := n.compareLocation(other, labels); index != -1 {
+			score += math.Pow(replicaBaseScore, float64(len(labels)-index-1))
+		}
+	}
+	return score
+}
+
+// DistinctScoreSelector 迁移副本时目标节点的隔离程度不能低于被替换的源节点
+type DistinctScoreSelector struct {
+	labels    []string
+	nodes     []*Node
+	safeScore float64
+}
+
+func NewDistinctScoreSelector(labels []string, rangeNodes []*Node, source *Node) *DistinctScoreSelector {
+	nodes := make([]*Node, 0, len(rangeNodes))
+	for _, n := range rangeNodes {
+		if n.GetId() != source.GetId() {
+			nodes = append(nodes, n)
+		}
+	}
+	return &DistinctScoreSelector{
+		labels:    labels,
+		nodes:     nodes,
+		safeScore: distinctScore(labels, nodes, source),
+	}
+}
+
+func (sel *DistinctScoreSelector) Name() string {
+	return "distinct-score"
+}
+
+func (sel *DistinctScoreSelector) CanSelect(node *Node) bool {
+	if len(sel.labels) == 0 {
+		return true
+	}
+	return distinctScore(sel.labels, sel.nodes, node) >= sel.safeScore
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"model/pkg/metapb"
+)
+
+func newLabelTestNode(id uint64, zone, rack, host string) *Node {
+	return NewNode(&metapb.Node{
+		Id:         id,
+		ServerAddr: fmt.Sprintf("10.0.0.%d:6060", id),
+		Labels: []*metapb.NodeLabel{
+			{Key: "zone", Value: zone},
+			{Key: "rack", Value: rack},
+			{Key: "host", Value: host},
+		},
+	})
+}
+
+func TestDistinctScore(t *testing.T) {
+	labels := []string{"zone", "rack", "host"}
+	z1r1 := newLabelTestNode(1, "z1", "r1", "h1")
+	z1r2 := newLabelTestNode(2, "z1", "r2", "h2")
+	z2r1 := newLabelTestNode(3, "z2", "r1", "h3")
+	z3r1 := newLabelTestNode(4, "z3", "r1", "h4")
+	z1r1h5 := newLabelTestNode(5, "z1", "r1", "h5")
+	nodes := []*Node{z1r1, z1r2, z2r1}
+
+	tests := []struct {
+		node     *Node
+		expected float64
+	}{
+		{z1r1, 100 + 10000},
+		{z1r2, 100 + 10000},
+		{z2r1, 10000 + 10000},
+		{z3r1, 10000 * 3},
+		{z1r1h5, 1 + 100 + 10000},
+	}
+	for _, tt := range tests {
+		if score := distinctScore(labels, nodes, tt.node); score != tt.expected {
+			t.Errorf("node %d: expected score %v, actual %v", tt.node.GetId(), tt.expected, score)
+		}
+	}
+	if score := distinctScore(nil, nodes, z3r1); score != 0 {
+		t.Errorf("expected score 0 without labels, actual %v", score)
+	}
+
+	worst, score := worstLocationNodes(labels, nodes)
+	if fmt.Sprint(nodeIds(worst)) != "[1 2]" || score != 10100 {
+		t.Errorf("unexpected worst nodes %v, score %v", nodeIds(worst), score)
+	}
+
+	// 替换z1r2上的副本, 目标节点的隔离程度不能降低
+	sel := NewDistinctScoreSelector(labels, nodes, z1r2)
+	if !sel.CanSelect(z3r1) || !sel.CanSelect(newLabelTestNode(6, "z1", "r3", "h6")) || sel.CanSelect(z1r1h5) {
+		t.Error("unexpected distinct score selector result")
+	}
+	if !NewDistinctScoreSelector(nil, nodes, z1r2).CanSelect(z1r1h5) {
+		t.Error("distinct score selector should select any node without labels")
+	}
+}
+
+func TestParseNodeLabels(t *testing.T) {
+	labels, err := parseNodeLabels(" zone=z1, rack = r1,host=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(labels) != fmt.Sprint([]*metapb.NodeLabel{{Key: "zone", Value: "z1"}, {Key: "rack", Value: "r1"}, {Key: "host"}}) {
+		t.Errorf("unexpected labels %v", labels)
+	}
+	for _, value := range []string{"", "zone", "=z1"} {
+		if _, err := parseNodeLabels(value); err == nil {
+			t.Errorf("%q: expected error", value)
+		}
+	}
+
+	node := newLabelTestNode(1, "z1", "r1", "h1")
+	node.mergeLabels([]*metapb.NodeLabel{{Key: "rack", Value: "r2"}, {Key: "dc", Value: "d1"}})
+	if node.getLabelValue("rack") != "r2" || node.getLabelValue("dc") != "d1" || len(node.GetLabels()) != 4 {
+		t.Errorf("unexpected merged labels %v", node.GetLabels())
+	}
+}
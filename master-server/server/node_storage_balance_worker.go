@@ -1 +1,181 @@
 package server
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/metapb"
+	"util/log"
+)
+
+// 按磁盘使用率均衡, 把副本从可用空间低于StorageAvailableThreshold的节点迁出
+// 节点的磁盘容量可能不同, 所以按使用率而不是使用量比较
+type balanceNodeStorageWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+}
+
+func NewBalanceNodeStorageWorker(wm *WorkerManager, interval time.Duration) *balanceNodeStorageWorker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &balanceNodeStorageWorker{
+		name:     balanceStorageWorkerName,
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+	}
+}
+
+func (w *balanceNodeStorageWorker) GetName() string {
+	return w.name
+}
+
+func (w *balanceNodeStorageWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	rng, oldPeer, targetNodeId := selectStorageRemovePeer(cluster, w.GetName())
+	if rng == nil {
+		log.Debug("no range need storage balance")
+		return
+	}
+
+	id, err := cluster.GenId()
+	if err != nil {
+		return
+	}
+	newPeer, err := cluster.allocPeer(targetNodeId)
+	if err != nil {
+		log.Error("create peer nodeId:%d error:%s", targetNodeId, err.Error())
+		return
+	}
+	cluster.metric.CollectScheduleCounter(w.GetName(), "new_operator")
+	log.Info("start to balance storage and remove peer, region:[%v], size:[%v], old peer:[%v], old node:[%v], new node:[%v]",
+		rng.GetId(), rng.ApproximateSize, oldPeer.GetId(), oldPeer.GetNodeId(), targetNodeId)
+	cluster.eventDispatcher.pushEvent(NewChangePeerEvent(id, rng, oldPeer, newPeer, w.GetName()))
+}
+
+func (w *balanceNodeStorageWorker) AllowWork(cluster *Cluster) bool {
+	if cluster.autoFailoverUnable {
+		return false
+	}
+	return true
+}
+
+func (w *balanceNodeStorageWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *balanceNodeStorageWorker) Stop() {
+	w.cancel()
+}
+
+// 节点增加delta字节后的磁盘使用率
+func storageScore(node *Node, delta int64) float64 {
+	capacity := node.stats.GetCapacity()
+	if capacity == 0 {
+		return 0
+	}
+	var used int64
+	if available := node.stats.GetAvailable(); available < capacity {
+		used = int64(capacity - available)
+	}
+	return float64(used+delta) / float64(capacity)
+}
+
+// 迁移size字节的副本后, source的使用率仍然不低于target, 避免来回迁移
+// 迁移后两个节点的range数差距也不能达到balance_range_worker的阈值, 避免balance_range_worker再迁回去
+func shouldBalanceStorage(source, target *Node, size uint64) bool {
+	if storageScore(source, -int64(size)) < storageScore(target, int64(size)) {
+		return false
+	}
+	return int64(target.GetRangesCount())+1-(int64(source.GetRangesCount())-1) < int64(Min_range_balance_num)
+}
+
+// 选择可用空间低于阈值、使用率最高的节点, 把上面最大的一个副本迁到使用率最低的节点
+func selectStorageRemovePeer(cluster *Cluster, workerName string) (*Range, *metapb.Peer, uint64) {
+	nodes := cluster.GetAllActiveNode()
+	if len(nodes) == 0 {
+		log.Debug("%v: node is nil", workerName)
+		cluster.metric.CollectScheduleCounter(workerName, "no_node")
+		return nil, nil, 0
+	}
+
+	threshold := float64(cluster.opt.GetStorageAvailableThreshold())
+	var source *Node
+	for _, node := range nodes {
+		if !node.require() || node.stats.GetCapacity() == 0 || cluster.hbManager.dealIngNodes.get(node.GetId()) {
+			continue
+		}
+		if node.availableRatio()*100 >= threshold {
+			continue
+		}
+		if source == nil || storageScore(node, 0) > storageScore(source, 0) {
+			source = node
+		}
+	}
+	if source == nil {
+		log.Debug("%v: no node is under storage available threshold", workerName)
+		return nil, nil, 0
+	}
+
+	// 先迁移大的副本, follower优先
+	ranges := source.GetAllRanges()
+	sort.Slice(ranges, func(i, j int) bool {
+		iLeader := ranges[i].GetLeader().GetNodeId() == source.GetId()
+		jLeader := ranges[j].GetLeader().GetNodeId() == source.GetId()
+		if iLeader != jLeader {
+			return !iLeader
+		}
+		return ranges[i].ApproximateSize > ranges[j].ApproximateSize
+	})
+
+	selectors := []NodeSelector{
+		NewNodeLoginSelector(cluster.opt),
+		NewSnapshotCountLimitSelector(cluster.opt),
+		NewWriterOpsThresholdSelector(cluster.opt),
+		NewStorageThresholdSelector(cluster.opt),
+		NewDifferCacheNodeSelector(cluster.hbManager.dealIngNodes),
+	}
+	labels := cluster.opt.GetLocationLabels()
+	for _, r := range ranges {
+		if r.ApproximateSize == 0 || !r.require(cluster) {
+			continue
+		}
+		rangeNodes := r.GetNodes(cluster)
+		rangeSelectors := append(selectors,
+			NewDifferIPSelector(rangeNodes),
+			NewDistinctScoreSelector(labels, rangeNodes, source))
+		var target *Node
+		for _, node := range nodes {
+			if node.GetId() == source.GetId() || node.stats.GetCapacity() == 0 {
+				continue
+			}
+			if !canSelectNode(node, rangeSelectors) || !shouldBalanceStorage(source, node, r.ApproximateSize) {
+				continue
+			}
+			if target == nil || storageScore(node, 0) < storageScore(target, 0) {
+				target = node
+			}
+		}
+		if target != nil {
+			return r, r.GetNodePeer(source.GetId()), target.GetId()
+		}
+	}
+
+	log.Debug("%v: no target node for node %v", workerName, source.GetId())
+	cluster.metric.CollectScheduleCounter(workerName, "no_peer")
+	cluster.hbManager.dealIngNodes.set(source.GetId())
+	return nil, nil, 0
+}
+
+func canSelectNode(node *Node, selectors []NodeSelector) bool {
+	for _, selector := range selectors {
+		if !selector.CanSelect(node) {
+			log.Debug("node %v cannot select, because of %v", node.GetId(), selector.Name())
+			return false
+		}
+	}
+	return true
+}
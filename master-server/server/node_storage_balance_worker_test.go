@@ -0,0 +1,51 @@
+package server
+
+import (
+	"testing"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+)
+
+func newStorageTestNode(id uint64, capacity, available uint64, rangeCount uint32) *Node {
+	node := NewNode(&metapb.Node{Id: id})
+	node.stats = &mspb.NodeStats{Capacity: capacity, Available: available, RangeCount: rangeCount}
+	return node
+}
+
+func TestStorageScore(t *testing.T) {
+	node := newStorageTestNode(1, 1000, 250, 10)
+	if score := storageScore(node, 0); score != 0.75 {
+		t.Errorf("expected score 0.75, actual %v", score)
+	}
+	if score := storageScore(node, -250); score != 0.5 {
+		t.Errorf("expected score 0.5, actual %v", score)
+	}
+	if score := storageScore(newStorageTestNode(2, 0, 0, 0), 100); score != 0 {
+		t.Errorf("expected score 0 without capacity, actual %v", score)
+	}
+}
+
+func TestShouldBalanceStorage(t *testing.T) {
+	// 容量不同的节点按使用率比较
+	source := newStorageTestNode(1, 1000, 100, 20)
+	large := newStorageTestNode(2, 4000, 2000, 20)
+	tests := []struct {
+		target   *Node
+		size     uint64
+		expected bool
+	}{
+		{large, 100, true},
+		// 迁移后源节点的使用率低于目标节点, 会被迁回来
+		{large, 800, false},
+		{newStorageTestNode(3, 1000, 200, 20), 100, false},
+		// 迁移后range数差距达到balance_range_worker的阈值
+		{newStorageTestNode(4, 4000, 2000, 28), 100, false},
+		{newStorageTestNode(5, 4000, 2000, 27), 100, true},
+	}
+	for i, tt := range tests {
+		if actual := shouldBalanceStorage(source, tt.target, tt.size); actual != tt.expected {
+			t.Errorf("test %d: expected %v, actual %v", i, tt.expected, actual)
+		}
+	}
+}
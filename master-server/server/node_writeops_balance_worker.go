@@ -41,7 +41,8 @@ func (w *balanceNodeOpsWorker) Work(cluster *Cluster) {
 	}
 
 	sourceNode := cluster.FindNodeById(oldPeer.GetNodeId())
-	newPeer, err := cluster.allocPeerAndSelectNode(rng)
+	newPeer, err := cluster.allocPeerAndSelectNode(rng,
+		NewDistinctScoreSelector(cluster.opt.GetLocationLabels(), rng.GetNodes(cluster), sourceNode))
 	if newPeer == nil || err != nil {
 		cluster.metric.CollectScheduleCounter(w.GetName(), "no_peer")
 		log.Error("alloc peer failure rngId:%d err:%s", rng.GetId(), err.Error())
@@ -195,7 +195,6 @@ func (rc *RangeCache) GetTableAllRanges(tableId uint64) []*Range {
 
 func (rc *RangeCache) GetTableAllRangesFromTopology(tableId uint64) []*metapb.Range {
 	var ranges []*metapb.Range
-	var startKeyTopy, endKeyTopy []byte
 
 	rc.lock.RLock()
 	defer rc.lock.RUnlock()
@@ -204,10 +203,6 @@ func (rc *RangeCache) GetTableAllRangesFromTopology(tableId uint64) []*metapb.Ra
 		if rng.GetTableId() != tableId {
 			return true
 		}
-		if startKeyTopy == nil {
-			startKeyTopy = rng.StartKey
-		}
-		endKeyTopy = rng.EndKey
 		ranges = append(ranges, rng)
 		return true
 	})
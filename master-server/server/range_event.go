@@ -22,6 +22,7 @@ const (
 	EVENT_TYPE_DEL_PEER
 	EVENT_TYPE_CHANGE_LEADER
 	EVENT_TYPE_DEL_RANGE
+	EVENT_TYPE_SPLIT_RANGE
 )
 
 func ToEventTypeName(eventType EventType) string {
@@ -36,6 +37,8 @@ func ToEventTypeName(eventType EventType) string {
 		return "change leader"
 	case EVENT_TYPE_DEL_RANGE:
 		return "del range"
+	case EVENT_TYPE_SPLIT_RANGE:
+		return "split range"
 	default:
 		return "invalid type"
 	}
@@ -155,6 +158,14 @@ func (m *RangeEventMeta) Next() RangeEvent {
 	return m.next
 }
 
+func (m *RangeEventMeta) getCreator() string {
+	return m.creator
+}
+
+func (m *RangeEventMeta) getTask() *taskpb.Task {
+	return m.task
+}
+
 func (m *RangeEventMeta) String() string {
 	str := fmt.Sprintf("[id:%d, type:%s, start:%s, exec time:%v s, rId:%v, creator:%s, status:%s, retry:%d]",
 		m.id, ToEventTypeName(m.eventType), m.start.Format(DefaultTimeFormat), m.ExecTime().Seconds(), m.rangeId,
@@ -459,6 +470,75 @@ func NewDelRangeEvent(id, rangeId uint64, creator string) *DelRangeEvent {
 	return &DelRangeEvent{RangeEventMeta: NewRangeEvent(id, rangeId, EVENT_TYPE_DEL_RANGE, DefaultDelPeerTimeout, creator, nil),}
 }
 
+// 按指定的key分裂range, 由range的心跳驱动, DS通过AskSplit/ReportSplit完成分裂
+type SplitRangeEvent struct {
+	RangeEventMeta
+	lock  sync.Mutex
+	epoch *metapb.RangeEpoch
+	r     *Range
+}
+
+func NewSplitRangeEvent(id uint64, r *Range, splitKey []byte, creator string) *SplitRangeEvent {
+	epoch := deepcopy.Iface(r.GetRangeEpoch()).(*metapb.RangeEpoch)
+	t := &taskpb.Task{
+		Type: taskpb.TaskType_RangeSplit,
+		RangeSplit: &taskpb.TaskRangeSplit{
+			SplitKey:   splitKey,
+			RangeEpoch: epoch,
+		},
+	}
+	return &SplitRangeEvent{RangeEventMeta: NewRangeEvent(id, r.GetId(), EVENT_TYPE_SPLIT_RANGE, DefaultSplitRangeTimeout, creator, t),
+		epoch: epoch}
+}
+
+func (e *SplitRangeEvent) Execute(cluster *Cluster, r *Range) (ExecNextEvent, *taskpb.Task, error) {
+	/**
+	不在锁里嵌套
+	 */
+	if e.GetStatus() == EVENT_STATUS_FINISH {
+		if next := e.Next(); next != nil {
+			return next.Execute(cluster, r)
+		} else {
+			return false, nil, nil
+		}
+	} else if e.GetStatus() == EVENT_STATUS_TIMEOUT || e.GetStatus() == EVENT_STATUS_FAILURE {
+		return false, nil, nil
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.IsTimeout() {
+		e.status = EVENT_STATUS_TIMEOUT
+		return false, nil, nil
+	}
+
+	e.r = r.clone()
+	e.end = time.Now()
+	switch e.GetStatus() {
+	case EVENT_STATUS_CREATE:
+		// range已经分裂或者合并过, 分裂点可能已经不在range中了
+		if r.GetRangeEpoch().GetVersion() != e.epoch.GetVersion() {
+			log.Warn("range[%v] version changed before split, old[%v], new[%v]", r.GetId(), e.epoch, r.GetRangeEpoch())
+			e.status = EVENT_STATUS_FAILURE
+			return false, nil, ErrRangeStatusErr
+		}
+		e.status = EVENT_STATUS_DEALING
+		return false, e.task, nil
+	case EVENT_STATUS_DEALING:
+		// 分裂后左侧range保留原来的id, version加1
+		if r.GetRangeEpoch().GetVersion() > e.epoch.GetVersion() {
+			e.status = EVENT_STATUS_FINISH
+			return true, nil, nil
+		}
+		//还没有分裂完成, 再下发一次, DS需要防止重复
+		e.retryTimes += 1
+		return false, e.task, nil
+	default:
+		return false, nil, errors.New(fmt.Sprintf("SplitRangeEvent err status %s", ToEventStatusName(e.GetStatus())))
+	}
+}
+
 func prepareAddPeer(cluster *Cluster, r *Range, peer *metapb.Peer) error {
 	rng := deepcopy.Iface(r.Range).(*metapb.Range)
 	nodeId := peer.GetNodeId()
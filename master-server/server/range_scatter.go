@@ -0,0 +1,184 @@
+package server
+
+import (
+	"bytes"
+	"sort"
+
+	"model/pkg/metapb"
+	"util"
+	"util/log"
+)
+
+// 按第一主键列的值编码表的分裂点
+func encodeTableSplitKey(t *Table, value []byte) ([]byte, error) {
+	keys, err := encodeSplitKeys([][]byte{value}, t.GetColumns())
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, ErrMissingPk
+	}
+	return append(util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()), keys[0]...), nil
+}
+
+// 手动分裂range, splitKey为编码后的完整key, 必须在range内且不能等于range的起始key
+func (c *Cluster) SplitRange(r *Range, splitKey []byte, creator string) error {
+	if bytes.Compare(splitKey, r.GetStartKey()) <= 0 ||
+		(len(r.GetEndKey()) > 0 && bytes.Compare(splitKey, r.GetEndKey()) >= 0) {
+		return ErrInvalidSplitKey
+	}
+	if !r.require(c) || r.GetLeader() == nil || c.GetEvent(r.GetId()) != nil {
+		return ErrNotAllowSplit
+	}
+	id, err := c.GenId()
+	if err != nil {
+		return err
+	}
+	if !c.eventDispatcher.pushEvent(NewSplitRangeEvent(id, r, splitKey, creator)) {
+		return ErrNotAllowSplit
+	}
+	log.Info("split range[%d] at key[%v] by %s", r.GetId(), splitKey, creator)
+	return nil
+}
+
+type scatterPeerMove struct {
+	rng     *Range
+	oldPeer *metapb.Peer
+	target  *Node
+}
+
+type scatterLeaderMove struct {
+	rng       *Range
+	newLeader *metapb.Peer
+}
+
+// 计算打散表的调度: 先把副本从表内副本最多的节点迁移到最少的节点, 再在副本之间切换leader使leader数均衡
+// 每个range最多产生一个调度, 一次打散不均衡时可以再次执行
+func planTableScatter(cluster *Cluster, ranges []*Range, nodes []*Node) ([]*scatterPeerMove, []*scatterLeaderMove) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GetId() < nodes[j].GetId() })
+	sort.Slice(ranges, func(i, j int) bool { return bytes.Compare(ranges[i].GetStartKey(), ranges[j].GetStartKey()) < 0 })
+	nodeMap := make(map[uint64]*Node)
+	peerCount := make(map[uint64]int)
+	leaderCount := make(map[uint64]int)
+	for _, n := range nodes {
+		nodeMap[n.GetId()] = n
+		peerCount[n.GetId()] = 0
+		leaderCount[n.GetId()] = 0
+	}
+	for _, r := range ranges {
+		for _, p := range r.GetPeers() {
+			if _, ok := peerCount[p.GetNodeId()]; ok {
+				peerCount[p.GetNodeId()]++
+			}
+		}
+		if _, ok := leaderCount[r.GetLeader().GetNodeId()]; ok {
+			leaderCount[r.GetLeader().GetNodeId()]++
+		}
+	}
+
+	var candidates []*Range
+	for _, r := range ranges {
+		if r.require(cluster) && r.GetLeader() != nil && cluster.GetEvent(r.GetId()) == nil {
+			candidates = append(candidates, r)
+		}
+	}
+
+	moved := make(map[uint64]bool)
+	var peerMoves []*scatterPeerMove
+	for _, r := range candidates {
+		var oldPeer *metapb.Peer
+		for _, p := range r.GetPeers() {
+			if _, ok := peerCount[p.GetNodeId()]; !ok {
+				continue
+			}
+			if oldPeer == nil || peerCount[p.GetNodeId()] > peerCount[oldPeer.GetNodeId()] {
+				oldPeer = p
+			}
+		}
+		if oldPeer == nil {
+			continue
+		}
+		source := nodeMap[oldPeer.GetNodeId()]
+		var target *Node
+		for _, n := range nodes {
+			if r.GetNodePeer(n.GetId()) != nil || !canMovePeer(cluster, r, source, n) {
+				continue
+			}
+			if target == nil || peerCount[n.GetId()] < peerCount[target.GetId()] {
+				target = n
+			}
+		}
+		if target == nil || peerCount[source.GetId()]-peerCount[target.GetId()] <= 1 {
+			continue
+		}
+		peerCount[source.GetId()]--
+		peerCount[target.GetId()]++
+		moved[r.GetId()] = true
+		peerMoves = append(peerMoves, &scatterPeerMove{rng: r, oldPeer: oldPeer, target: target})
+	}
+
+	var leaderMoves []*scatterLeaderMove
+	for _, r := range candidates {
+		if moved[r.GetId()] {
+			continue
+		}
+		leaderNodeId := r.GetLeader().GetNodeId()
+		if _, ok := leaderCount[leaderNodeId]; !ok {
+			continue
+		}
+		var newLeader *metapb.Peer
+		for _, p := range r.GetPeers() {
+			if p.GetId() == r.GetLeader().GetId() {
+				continue
+			}
+			if _, ok := leaderCount[p.GetNodeId()]; !ok {
+				continue
+			}
+			if newLeader == nil || leaderCount[p.GetNodeId()] < leaderCount[newLeader.GetNodeId()] {
+				newLeader = p
+			}
+		}
+		if newLeader == nil || leaderCount[leaderNodeId]-leaderCount[newLeader.GetNodeId()] <= 1 {
+			continue
+		}
+		leaderCount[leaderNodeId]--
+		leaderCount[newLeader.GetNodeId()]++
+		leaderMoves = append(leaderMoves, &scatterLeaderMove{rng: r, newLeader: newLeader})
+	}
+	return peerMoves, leaderMoves
+}
+
+// 把表的range副本和leader均匀分散到所有正常节点上, 返回发起的副本迁移数和leader切换数
+func (c *Cluster) ScatterTable(t *Table, creator string) (int, int, error) {
+	nodes := c.GetAllActiveNode()
+	if len(nodes) == 0 {
+		return 0, 0, ErrNotExistNode
+	}
+	peerMoves, leaderMoves := planTableScatter(c, c.GetTableAllRanges(t.GetId()), nodes)
+	var peers, leaders int
+	for _, m := range peerMoves {
+		id, err := c.GenId()
+		if err != nil {
+			return peers, leaders, err
+		}
+		newPeer, err := c.allocPeer(m.target.GetId())
+		if err != nil {
+			return peers, leaders, err
+		}
+		if c.eventDispatcher.pushEvent(NewChangePeerEvent(id, m.rng, m.oldPeer, newPeer, creator)) {
+			peers++
+		}
+	}
+	for _, m := range leaderMoves {
+		id, err := c.GenId()
+		if err != nil {
+			return peers, leaders, err
+		}
+		if c.eventDispatcher.pushEvent(NewTryChangeLeaderEvent(id, m.rng.GetId(), m.rng.GetLeader(), m.newLeader, creator)) {
+			leaders++
+		}
+	}
+	log.Info("scatter table[%s:%s] by %s, move %d peers, transfer %d leaders",
+		t.GetDbName(), t.GetName(), creator, peers, leaders)
+	return peers, leaders, nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"model/pkg/metapb"
+	"util"
+)
+
+func newScatterTestCluster(t *testing.T, nodeNum int) *Cluster {
+	cluster := NewCluster(1, 1, nil, newScheduleOption(NewDefaultConfig()))
+	cluster.idGener = newMockIDAllocator()
+	if cluster.opt.GetMaxReplicas() != 3 {
+		t.Skipf("max replicas is %d", cluster.opt.GetMaxReplicas())
+	}
+	for i := 1; i <= nodeNum; i++ {
+		cluster.nodes.Add(NewNode(&metapb.Node{Id: uint64(i), ServerAddr: fmt.Sprintf("127.0.0.%d:6060", i), State: metapb.NodeState_N_Login}))
+	}
+	return cluster
+}
+
+func TestPlanTableScatter(t *testing.T) {
+	cluster := newScatterTestCluster(t, 4)
+	defer cluster.workerManger.Stop()
+	var ranges []*Range
+	for i, key := range []string{"a", "b", "c", "d"} {
+		r := newTestRange(uint64(100+i), key, string([]byte{key[0] + 1}), 1, 1, 1, 2, 3)
+		r.TableId = 10
+		cluster.ranges.Add(r)
+		ranges = append(ranges, r)
+	}
+
+	peerMoves, leaderMoves := planTableScatter(cluster, ranges, cluster.GetAllActiveNode())
+	// 12个副本分散到4个节点, 每个节点3个
+	if len(peerMoves) != 3 {
+		t.Fatalf("unexpected peer moves %d", len(peerMoves))
+	}
+	count := map[uint64]int{1: 4, 2: 4, 3: 4}
+	for _, m := range peerMoves {
+		if m.target.GetId() != 4 {
+			t.Fatalf("unexpected target node %d", m.target.GetId())
+		}
+		count[m.oldPeer.GetNodeId()]--
+	}
+	for nodeId, n := range count {
+		if n != 3 {
+			t.Fatalf("node %d has %d peers after scatter", nodeId, n)
+		}
+	}
+	// 没有迁移副本的range切换leader
+	if len(leaderMoves) != 1 || leaderMoves[0].rng.GetId() != 103 || leaderMoves[0].newLeader.GetNodeId() == 1 {
+		t.Fatalf("unexpected leader moves %v", leaderMoves)
+	}
+
+	// 副本已经均衡时只切换leader
+	nodes := []*Node{cluster.FindNodeById(1), cluster.FindNodeById(2), cluster.FindNodeById(3)}
+	peerMoves, leaderMoves = planTableScatter(cluster, ranges, nodes)
+	if len(peerMoves) != 0 || len(leaderMoves) != 2 {
+		t.Fatalf("unexpected moves %d %d", len(peerMoves), len(leaderMoves))
+	}
+}
+
+func TestSplitRange(t *testing.T) {
+	cluster := newScatterTestCluster(t, 3)
+	defer cluster.workerManger.Stop()
+	r := newTestRange(100, "a", "c", 1, 1, 1, 2, 3)
+	cluster.ranges.Add(r)
+
+	for _, key := range []string{"a", "c", "d"} {
+		if err := cluster.SplitRange(r, []byte(key), "test"); err != ErrInvalidSplitKey {
+			t.Fatalf("split at %s: expected invalid split key, actual %v", key, err)
+		}
+	}
+	if err := cluster.SplitRange(r, []byte("b"), "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cluster.GetEvent(r.GetId()).(*SplitRangeEvent); !ok {
+		t.Fatal("split event is not created")
+	}
+	// 上一次分裂还没有完成
+	if err := cluster.SplitRange(r, []byte("b"), "test"); err != ErrNotAllowSplit {
+		t.Fatalf("expected not allow split, actual %v", err)
+	}
+}
+
+func TestEncodeTableSplitKey(t *testing.T) {
+	table := NewTable(&metapb.Table{
+		Id: 10,
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "v", Id: 2, DataType: metapb.DataType_Varchar},
+		},
+	})
+	key, err := encodeTableSplitKey(table, []byte("100"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := util.EncodeStorePrefix(util.Store_Prefix_KV, 10)
+	if !bytes.HasPrefix(key, prefix) || len(key) == len(prefix) {
+		t.Fatalf("unexpected split key %v", key)
+	}
+	if _, err := encodeTableSplitKey(table, []byte("abc")); err == nil {
+		t.Fatal("expected encode error")
+	}
+	if _, err := encodeTableSplitKey(NewTable(&metapb.Table{Id: 10}), []byte("100")); err != ErrMissingPk {
+		t.Fatalf("expected missing pk, actual %v", err)
+	}
+}
@@ -66,6 +66,14 @@ func (service *Server) ReportSplit(ctx context.Context, req *mspb.ReportSplitReq
 	return service.handleReportSplit(ctx, req)
 }
 
+func (service *Server) ReportKeySamples(ctx context.Context, req *mspb.ReportKeySamplesRequest) (*mspb.ReportKeySamplesResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.ReportKeySamplesResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+	return service.handleReportKeySamples(ctx, req)
+}
+
 func (service *Server) NodeLogin(ctx context.Context, req *mspb.NodeLoginRequest) (*mspb.NodeLoginResponse, error) {
 	if err := service.checkClusterValid(); err != nil {
 		resp := &mspb.NodeLoginResponse{Header: &mspb.ResponseHeader{Error: err}}
@@ -150,6 +158,14 @@ func (service *Server) AddColumn(ctx context.Context, req *mspb.AddColumnRequest
 	return service.handleAddColumns(ctx, req)
 }
 
+func (service *Server) AlterColumn(ctx context.Context, req *mspb.AlterColumnRequest) (*mspb.AlterColumnResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.AlterColumnResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+	return service.handleAlterColumns(ctx, req)
+}
+
 func (service *Server) CreateDatabase(ctx context.Context, req *mspb.CreateDatabaseRequest) (*mspb.CreateDatabaseResponse, error) {
 	if err := service.checkClusterValid(); err != nil {
 		resp := &mspb.CreateDatabaseResponse{Header: &mspb.ResponseHeader{Error: err}}
@@ -165,4 +181,58 @@ func (service *Server) CreateTable(ctx context.Context, req *mspb.CreateTableReq
 	}
 
 	return service.handleCreateTable(ctx, req)
-}
\ No newline at end of file
+}
+
+func (service *Server) DeleteDatabase(ctx context.Context, req *mspb.DeleteDatabaseRequest) (*mspb.DeleteDatabaseResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.DeleteDatabaseResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleDeleteDatabase(ctx, req)
+}
+
+func (service *Server) DeleteTable(ctx context.Context, req *mspb.DeleteTableRequest) (*mspb.DeleteTableResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.DeleteTableResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleDeleteTable(ctx, req)
+}
+
+func (service *Server) CreateIndex(ctx context.Context, req *mspb.CreateIndexRequest) (*mspb.CreateIndexResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.CreateIndexResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleCreateIndex(ctx, req)
+}
+
+func (service *Server) SetIndexState(ctx context.Context, req *mspb.SetIndexStateRequest) (*mspb.SetIndexStateResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.SetIndexStateResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleSetIndexState(ctx, req)
+}
+
+func (service *Server) GetDatabases(ctx context.Context, req *mspb.GetDatabasesRequest) (*mspb.GetDatabasesResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.GetDatabasesResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleGetDatabases(ctx, req)
+}
+
+func (service *Server) GetTables(ctx context.Context, req *mspb.GetTablesRequest) (*mspb.GetTablesResponse, error) {
+	if err := service.checkClusterValid(); err != nil {
+		resp := &mspb.GetTablesResponse{Header: &mspb.ResponseHeader{Error: err}}
+		return resp, nil
+	}
+
+	return service.handleGetTables(ctx, req)
+}
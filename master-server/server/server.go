@@ -18,12 +18,13 @@ import (
 )
 
 type Server struct {
-	raftPeers         map[uint64]*Peer
 	conf *Config
 	opt  *scheduleOption
 
 	cluster   *Cluster
 	store     Store
+	// master集群成员变更
+	raftStore *RaftStore
 
 	server      *server.Server
 	rpcServer   *grpc.Server
@@ -31,6 +32,7 @@ type Server struct {
 	metricServer *metric.Metric
 
 	leaderChangeNotify chan uint64
+	quitOnce sync.Once
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -38,7 +40,6 @@ type Server struct {
 
 func (service *Server) ParseClusterInfo() []*Peer {
 	var peers []*Peer
-	raftPeers := make(map[uint64]*Peer)
 	for _, peer := range service.conf.Cluster.Peers {
 		node := &Peer{}
 		node.ID = peer.ID
@@ -46,11 +47,10 @@ func (service *Server) ParseClusterInfo() []*Peer {
 		node.RpcServerAddr = fmt.Sprintf("%s:%d", peer.Host, peer.RpcPort)
 		node.RaftHeartbeatAddr = fmt.Sprintf("%s:%d", peer.Host, peer.RaftPorts[0])
 		node.RaftReplicateAddr = fmt.Sprintf("%s:%d", peer.Host, peer.RaftPorts[1])
+		node.Learner = peer.Learner
 		peers = append(peers, node)
-		raftPeers[node.ID] = node
 	}
 
-	service.raftPeers = raftPeers
 	return peers
 }
 
@@ -62,10 +62,13 @@ func (service *Server) initHttpHandler() (){
 
 	s.Handle("/manage/table/cancel", NewHandler(service.validRequest, service.handleTableCancel))
 	s.Handle("/manage/table/edit", NewHandler(service.validRequest, service.handleTableEdit))
+	s.Handle("/manage/table/ttl", NewHandler(service.validRequest, service.handleTableTTL))
+	s.Handle("/manage/table/scatter", NewHandler(service.validRequest, service.handleTableScatter))
 	s.Handle("/manage/table/delete", NewHandler(service.validRequest, service.handleTableDelete))
 	s.Handle("/manage/table/delete/fast", NewHandler(service.validRequest, service.handleTableFastDelete))
 	s.Handle("/manage/node/login", NewHandler(service.validRequest, service.handleHttpNodeLogin))
 	s.Handle("/manage/node/logout", NewHandler(service.validRequest, service.handleHttpNodeLogout))
+	s.Handle("/manage/node/setLabels", NewHandler(service.validRequest, service.handleHttpNodeSetLabels))
 	s.Handle("/manage/node/delete", NewHandler(service.validRequest, service.handleHttpNodeDelete))
 	s.Handle("/manage/node/upgrade", NewHandler(service.validRequest, service.handleNodeUpgrade))
 	s.Handle("/manage/node/setLogLevel", NewHandler(service.validRequest, service.handleNodeSetLogLevel))
@@ -74,12 +77,21 @@ func (service *Server) initHttpHandler() (){
 	s.Handle("/manage/scheduler/getall", NewHandler(service.validRequest, service.handleSchedulerGetAll))
 	s.Handle("/manage/scheduler/add", NewHandler(service.validRequest, service.handleAddScheduler))
 	s.Handle("/manage/scheduler/remove", NewHandler(service.validRequest, service.handleRemoveScheduler))
+	s.Handle("/manage/scheduler/dryrun", NewHandler(service.validRequest, service.handleSchedulerDryRun))
+	s.Handle("/manage/topology/dump", NewHandler(service.validRequest, service.handleTopologyDump))
+	s.Handle("/manage/backup", NewHandler(service.validRequest, service.handleBackup))
+	s.Handle("/manage/hotspot/query", NewHandler(service.validRequest, service.handleHotSpotQuery))
 	s.Handle("/manage/database/getall", NewHandler(service.validRequest, service.handleDBGetAll))
 	s.Handle("/manage/table/getall", NewHandler(service.validRequest, service.handleTableGetAll))
 	s.Handle("/manage/get/table", NewHandler(service.validRequest, service.handleTableGet))
 	s.Handle("/manage/node/getall", NewHandler(service.validRequest, service.handleNodeGetAll))
 	s.Handle("/manage/master/getleader", NewHandler(service.validRequest, service.handleMasterGetLeader))
 	s.Handle("/manage/master/getall", NewHandler(service.validRequest, service.handleMasterGetAll))
+	s.Handle("/manage/master/member/add", NewHandler(service.validRequest, service.handleMasterMemberAdd))
+	s.Handle("/manage/master/member/remove", NewHandler(service.validRequest, service.handleMasterMemberRemove))
+	s.Handle("/manage/master/member/promote", NewHandler(service.validRequest, service.handleMasterMemberPromote))
+	// 由目标成员处理, 不转发到leader
+	s.Handle("/manage/master/member/transfer", NewHandler(service.verifier, service.handleMasterMemberTransfer))
 	//s.Handle("/manage/range/getleader", NewHandler(service.verifier, service.handleRangeGetLeader))
 	//s.Handle("/manage/range/getpeerinfo", NewHandler(service.verifier, service.handleRangeGetPeerInfo))
 	s.Handle("/manage/task/getTypeAll", NewHandler(service.validRequest, service.handleTaskTypeGetAll))
@@ -95,6 +107,7 @@ func (service *Server) initHttpHandler() (){
 	s.Handle("/manage/range/add/peer", NewHandler(service.validRequest, service.handleRangeAddPeer))
 	s.Handle("/manage/range/del/peer", NewHandler(service.validRequest, service.handleRangeDelPeer))
 	s.Handle("/manage/range/leader/transfer", NewHandler(service.validRequest, service.handleRangeLeaderTransfer))
+	s.Handle("/manage/range/split", NewHandler(service.validRequest, service.handleRangeSplit))
 	s.Handle("/manage/range/task/query", NewHandler(service.validRequest, service.handleRangeTaskQuery))
 	s.Handle("/manage/range/unhealthy/recover", NewHandler(service.validRequest, service.handleUnhealthyRangeRecover))
 	s.Handle("/manage/range/rebuildRange", NewHandler(service.validRequest, service.handleRangeRecreate))
@@ -141,6 +154,10 @@ func (service *Server) InitMasterServer(conf *Config) {
 		RaftHeartbeatAddr: conf.raftHeartbeatAddr,
 		RaftReplicateAddr: conf.raftReplicaAddr,
 		RaftPeers:         peers,
+		RaftReadIndex:     conf.Raft.ReadIndex,
+		RaftLeaseRead:     conf.Raft.LeaseRead,
+		RaftPreVote:       conf.Raft.PreVote,
+		RaftCheckQuorum:   conf.Raft.CheckQuorum,
 
 		NodeID:         conf.NodeId,
 		DataPath:       service.conf.DataPath,
@@ -152,6 +169,10 @@ func (service *Server) InitMasterServer(conf *Config) {
 			// TODO event
 			log.Error("raft fatal: id[%d], err[%v]", err.ID, err.Err)
 		},
+		RemovedHandler: func() {
+			log.Warn("master node[%d] is removed from cluster, server exit", conf.NodeId)
+			service.Quit()
+		},
 	}
 	saveStore, err := NewRaftStore(cnf)
 	if err != nil {
@@ -159,6 +180,7 @@ func (service *Server) InitMasterServer(conf *Config) {
 		return
 	}
 	service.store = saveStore
+	service.raftStore = saveStore
 	opt := newScheduleOption(conf)
 	service.opt = opt
 	service.cluster = NewCluster(uint64(conf.Cluster.ClusterID), uint64(conf.NodeId), saveStore, opt)
@@ -267,12 +289,7 @@ func (service *Server) IsLeader() bool {
 }
 
 func (service *Server) getRaftMembers() []*Peer  {
-	var raftMembers []*Peer
-	for _, p := range service.raftPeers {
-		raftMembers = append(raftMembers, p)
-	}
-	return raftMembers
-
+	return service.raftStore.GetMembers()
 }
 
 func (service *Server) RaftLeaderChange(leaderId uint64) {
@@ -284,7 +301,7 @@ func (service *Server) RaftLeaderChange(leaderId uint64) {
 	}
 	// 本节点当选为leader
 
-	raftLeader := service.raftPeers[leaderId]
+	raftLeader := service.raftStore.GetMember(leaderId)
 	if service.conf.NodeId == leaderId {
 		log.Info("be elected leader")
 		cluster := NewCluster(uint64(service.conf.Cluster.ClusterID), uint64(service.conf.NodeId), service.store, service.opt)
@@ -322,8 +339,22 @@ func (service *Server) background() {
 	go service.watchLeader()
 }
 
-// Quit 保存退出
+// Quit 停止服务, Start返回
 func (service *Server) Quit() {
-	// TODO: safe quit
+	service.quitOnce.Do(func() {
+		service.cancel()
+		if service.cluster != nil {
+			service.cluster.Close()
+		}
+		if service.rpcServer != nil {
+			service.rpcServer.Stop()
+		}
+		if service.server != nil {
+			service.server.Close()
+		}
+		if service.raftStore != nil {
+			service.raftStore.Close()
+		}
+	})
 }
 
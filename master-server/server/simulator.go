@@ -0,0 +1,546 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"model/pkg/taskpb"
+	"util/deepcopy"
+	"util/log"
+)
+
+const (
+	// 一次模拟最多执行的轮数, 每一轮所有worker各执行一次
+	maxSimulateRounds = 100
+)
+
+// 集群拓扑, 可以从运行中的集群导出保存成文件, 用于离线模拟调度
+type Topology struct {
+	Nodes          []*TopologyNode  `json:"nodes"`
+	Tables         []*metapb.Table  `json:"tables"`
+	Ranges         []*TopologyRange `json:"ranges"`
+	HotWriteRanges []*RangeHotStat  `json:"hot_write_ranges"`
+	HotReadRanges  []*RangeHotStat  `json:"hot_read_ranges"`
+
+	AutoFailoverUnable bool `json:"auto_failover_unable"`
+}
+
+type TopologyNode struct {
+	Node *metapb.Node `json:"node"`
+	// 为空时按拓扑中的range统计range数和leader数
+	Stats   *mspb.NodeStats `json:"stats,omitempty"`
+	Blocked bool            `json:"blocked"`
+	// 最近心跳上报的最大写入量
+	WriteOps uint64 `json:"write_ops"`
+}
+
+type TopologyRange struct {
+	Range        *metapb.Range     `json:"range"`
+	Leader       *metapb.Peer      `json:"leader"`
+	DownPeers    []*mspb.PeerStats `json:"down_peers,omitempty"`
+	PendingPeers []*metapb.Peer    `json:"pending_peers,omitempty"`
+	Size         uint64            `json:"size"`
+	State        metapb.RangeState `json:"state"`
+	WriteOps     uint64            `json:"write_ops"`
+	// 收到的心跳次数, 没有收到过心跳的range大小未知, 不会被合并
+	HeartbeatCount uint64 `json:"heartbeat_count"`
+}
+
+// 导出当前集群的拓扑, 元数据都是拷贝, 模拟时修改不会影响集群
+func (c *Cluster) dumpTopology() *Topology {
+	topo := &Topology{AutoFailoverUnable: c.autoFailoverUnable}
+	for _, n := range c.GetAllNode() {
+		n.lock.RLock()
+		node := deepcopy.Iface(n.Node).(*metapb.Node)
+		n.lock.RUnlock()
+		topo.Nodes = append(topo.Nodes, &TopologyNode{
+			Node:     node,
+			Stats:    deepcopy.Iface(n.stats).(*mspb.NodeStats),
+			Blocked:  n.isBlocked(),
+			WriteOps: n.opsStat.GetMax(),
+		})
+	}
+	for _, t := range c.workingTables.GetAllTable() {
+		topo.Tables = append(topo.Tables, deepcopy.Iface(t.Table).(*metapb.Table))
+	}
+	for _, r := range c.GetAllRanges() {
+		tr := &TopologyRange{
+			Range:          deepcopy.Iface(r.Range).(*metapb.Range),
+			Size:           r.ApproximateSize,
+			State:          r.State,
+			WriteOps:       r.opsStat.GetMax(),
+			HeartbeatCount: atomic.LoadUint64(&r.opsStat.hit),
+		}
+		if leader := r.GetLeader(); leader != nil {
+			tr.Leader = deepcopy.Iface(leader).(*metapb.Peer)
+		}
+		for _, down := range r.DownPeers {
+			tr.DownPeers = append(tr.DownPeers, deepcopy.Iface(down).(*mspb.PeerStats))
+		}
+		for _, pending := range r.PendingPeers {
+			tr.PendingPeers = append(tr.PendingPeers, deepcopy.Iface(pending).(*metapb.Peer))
+		}
+		topo.Ranges = append(topo.Ranges, tr)
+	}
+	sort.Slice(topo.Nodes, func(i, j int) bool {
+		return topo.Nodes[i].Node.GetId() < topo.Nodes[j].Node.GetId()
+	})
+	sort.Slice(topo.Ranges, func(i, j int) bool {
+		return topo.Ranges[i].Range.GetId() < topo.Ranges[j].Range.GetId()
+	})
+	topo.HotWriteRanges = dumpHotStats(c.writeStatistics)
+	topo.HotReadRanges = dumpHotStats(c.readStatistics)
+	return topo
+}
+
+// 按最近更新的顺序导出
+func dumpHotStats(cache *lruCache) []*RangeHotStat {
+	var stats []*RangeHotStat
+	for _, item := range cache.elems() {
+		stat := *(item.value.(*RangeHotStat))
+		stats = append(stats, &stat)
+	}
+	return stats
+}
+
+// 读取拓扑文件, 也可以直接使用/manage/topology/dump接口的应答
+func LoadTopology(path string) (*Topology, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	reply := struct {
+		Code    int       `json:"code"`
+		Message string    `json:"message"`
+		Data    *Topology `json:"data"`
+	}{}
+	if err := json.Unmarshal(data, &reply); err == nil && reply.Data != nil {
+		if reply.Code != HTTP_OK {
+			return nil, fmt.Errorf("topology reply code %d, message %s", reply.Code, reply.Message)
+		}
+		return reply.Data, nil
+	}
+	topo := new(Topology)
+	if err := json.Unmarshal(data, topo); err != nil {
+		return nil, err
+	}
+	return topo, nil
+}
+
+type simulateIDGenerator struct {
+	id uint64
+}
+
+func (g *simulateIDGenerator) GenID() (uint64, error) {
+	return atomic.AddUint64(&g.id, 1), nil
+}
+
+// 模拟集群只在一次模拟中使用, 不启动过期清理的协程
+func newSimulateIDCache(ttl time.Duration) *idCache {
+	return &idCache{expireRegionCache: &expireRegionCache{items: make(map[uint64]cacheItem), ttl: ttl}}
+}
+
+// 根据拓扑构建模拟集群, 没有存储和metric, 给DS的请求都直接返回成功
+// 拓扑中的元数据直接被模拟集群使用, 模拟后不能再次使用
+func newSimulateCluster(topo *Topology, opt *scheduleOption) *Cluster {
+	cluster := &Cluster{
+		cli:                &LocalDSClient{},
+		opt:                opt,
+		dbs:                NewDbCache(),
+		nodes:              NewNodeCache(),
+		ranges:             NewRangeCache(),
+		writeStatistics:    newLRUCache(writeStatLRUMaxLen),
+		readStatistics:     newLRUCache(writeStatLRUMaxLen),
+		loadStatistics:     newLRUCache(writeStatLRUMaxLen),
+		keySamples:         NewRangeKeySampleCache(),
+		creatingTables:     NewCreateTableCache(),
+		workingTables:      NewGlobalTableCache(),
+		deletingTables:     NewGlobalTableCache(),
+		preGCRanges:        NewGlobalPreGCRange(),
+		deletedRanges:      NewGlobalDeletedRange(),
+		autoFailoverUnable: topo.AutoFailoverUnable,
+	}
+	cluster.workerManger = NewWorkerManager(cluster, opt)
+	cluster.hbManager = &hb_range_manager{cluster: cluster, dealIngNodes: newSimulateIDCache(3 * time.Second)}
+	cluster.eventDispatcher = NewEventDispatcher(cluster, opt)
+
+	// 模拟生成的id从拓扑中最大的id之后开始, 避免跟已有的peer混淆
+	var maxId uint64
+	updateMaxId := func(id uint64) {
+		if id > maxId {
+			maxId = id
+		}
+	}
+	var refresh bool
+	for _, tn := range topo.Nodes {
+		if tn.Node == nil {
+			continue
+		}
+		node := NewNode(tn.Node)
+		if tn.Stats != nil {
+			node.stats = tn.Stats
+		} else {
+			refresh = true
+		}
+		node.blocked = tn.Blocked
+		node.opsStat.Hit(tn.WriteOps)
+		cluster.nodes.Add(node)
+		updateMaxId(node.GetId())
+	}
+	for _, t := range topo.Tables {
+		cluster.workingTables.Add(NewTable(t))
+		updateMaxId(t.GetId())
+	}
+	for _, tr := range topo.Ranges {
+		if tr.Range == nil {
+			continue
+		}
+		r := NewRange(tr.Range, tr.Leader)
+		r.DownPeers = tr.DownPeers
+		r.PendingPeers = tr.PendingPeers
+		r.ApproximateSize = tr.Size
+		r.State = tr.State
+		r.opsStat.writeOps[0] = tr.WriteOps
+		r.opsStat.hit = tr.HeartbeatCount
+		cluster.AddRange(r)
+		updateMaxId(r.GetId())
+		for _, peer := range r.GetPeers() {
+			updateMaxId(peer.GetId())
+		}
+	}
+	loadHotStats(cluster.writeStatistics, topo.HotWriteRanges)
+	loadHotStats(cluster.readStatistics, topo.HotReadRanges)
+	cluster.idGener = &simulateIDGenerator{id: maxId}
+	if refresh {
+		cluster.refreshSimulateNodeStats()
+	}
+	return cluster
+}
+
+func loadHotStats(cache *lruCache, stats []*RangeHotStat) {
+	for i := len(stats) - 1; i >= 0; i-- {
+		stat := *stats[i]
+		cache.add(stat.RangeId, &stat)
+	}
+}
+
+// 模拟产生的事件
+type SimulateEvent struct {
+	Round   int      `json:"round"`
+	Id      uint64   `json:"id"`
+	Type    string   `json:"type"`
+	RangeId uint64   `json:"range_id"`
+	Creator string   `json:"creator"`
+	Steps   []string `json:"steps"`
+}
+
+// 模拟结束后节点上的分布
+type SimulateNode struct {
+	NodeId      uint64 `json:"node_id"`
+	Addr        string `json:"addr"`
+	State       string `json:"state"`
+	RangeCount  uint32 `json:"range_count"`
+	LeaderCount uint32 `json:"leader_count"`
+	UsedSize    uint64 `json:"used_size"`
+	Available   uint64 `json:"available"`
+}
+
+type SimulateResult struct {
+	Workers []string `json:"workers"`
+	// 实际执行的轮数, 没有新的事件时提前结束
+	Rounds int              `json:"rounds"`
+	Events []*SimulateEvent `json:"events"`
+	Nodes  []*SimulateNode  `json:"nodes"`
+}
+
+// 事件中每一步的任务, 用于模拟时应用事件的结果
+type simulateTaskGetter interface {
+	getCreator() string
+	getTask() *taskpb.Task
+}
+
+func newSimulateEvent(round int, e RangeEvent) *SimulateEvent {
+	se := &SimulateEvent{
+		Round:   round,
+		Id:      e.GetId(),
+		Type:    ToEventTypeName(e.GetType()),
+		RangeId: e.GetRangeID(),
+	}
+	if g, ok := e.(simulateTaskGetter); ok {
+		se.Creator = g.getCreator()
+	}
+	for cur := e; cur != nil; cur = cur.Next() {
+		if cur.GetType() == EVENT_TYPE_DEL_RANGE {
+			se.Steps = append(se.Steps, "del range")
+			continue
+		}
+		g, ok := cur.(simulateTaskGetter)
+		if !ok {
+			continue
+		}
+		task := g.getTask()
+		switch task.GetType() {
+		case taskpb.TaskType_RangeAddPeer:
+			peer := task.GetRangeAddPeer().GetPeer()
+			se.Steps = append(se.Steps, fmt.Sprintf("add peer %d on node %d", peer.GetId(), peer.GetNodeId()))
+		case taskpb.TaskType_RangeDelPeer:
+			peer := task.GetRangeDelPeer().GetPeer()
+			se.Steps = append(se.Steps, fmt.Sprintf("del peer %d on node %d", peer.GetId(), peer.GetNodeId()))
+		case taskpb.TaskType_RangeLeaderTransfer:
+			se.Steps = append(se.Steps, fmt.Sprintf("transfer leader to node %d", task.GetRangeLeaderTransfer().GetExpLeader().GetNodeId()))
+		}
+	}
+	return se
+}
+
+// 在模拟集群上同步执行worker, 不启动调度协程, 产生的事件不会下发给DS
+// 每一轮结束后假设事件都执行成功, 把结果应用到模拟集群上, 下一轮在新的分布上继续调度
+func (wm *WorkerManager) simulate(workers []Worker, rounds int) (int, []*SimulateEvent) {
+	cluster := wm.cluster
+	// evict/grant leader的节点通过worker是否存在判断
+	wm.lock.Lock()
+	for _, w := range workers {
+		wm.workers[w.GetName()] = w
+	}
+	wm.lock.Unlock()
+	defer wm.Stop()
+
+	var result []*SimulateEvent
+	round := 1
+	for ; round <= rounds; round++ {
+		existed := make(map[RangeEvent]bool)
+		for _, e := range cluster.GetAllEvent() {
+			existed[e] = true
+		}
+		for _, w := range workers {
+			if !w.AllowWork(cluster) {
+				log.Debug("simulate: worker cannot exec, %v", w.GetName())
+				continue
+			}
+			w.Work(cluster)
+		}
+		var events []RangeEvent
+		for _, e := range cluster.GetAllEvent() {
+			if !existed[e] {
+				events = append(events, e)
+			}
+		}
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].GetId() < events[j].GetId()
+		})
+		for _, e := range events {
+			result = append(result, newSimulateEvent(round, e))
+		}
+
+		for _, e := range cluster.GetAllEvent() {
+			cluster.applySimulateEvent(e)
+			cluster.RemoveEvent(e)
+		}
+		cluster.refreshSimulateNodeStats()
+		// 正在调度的节点在下一个调度周期之前已经过期
+		cluster.hbManager.dealIngNodes = newSimulateIDCache(3 * time.Second)
+		if len(events) == 0 {
+			break
+		}
+	}
+	if round > rounds {
+		round = rounds
+	}
+	return round, result
+}
+
+// 按顺序执行事件中每一步的任务
+func (c *Cluster) applySimulateEvent(e RangeEvent) {
+	r := c.FindRange(e.GetRangeID())
+	if r == nil {
+		return
+	}
+	for cur := e; cur != nil; cur = cur.Next() {
+		if cur.GetType() == EVENT_TYPE_DEL_RANGE {
+			c.simulateDeleteRange(r)
+			return
+		}
+		g, ok := cur.(simulateTaskGetter)
+		if !ok {
+			continue
+		}
+		task := g.getTask()
+		switch task.GetType() {
+		case taskpb.TaskType_RangeAddPeer:
+			c.simulateAddPeer(r, task.GetRangeAddPeer().GetPeer())
+		case taskpb.TaskType_RangeDelPeer:
+			c.simulateDelPeer(r, task.GetRangeDelPeer().GetPeer())
+		case taskpb.TaskType_RangeLeaderTransfer:
+			if peer := r.GetPeer(task.GetRangeLeaderTransfer().GetExpLeader().GetId()); peer != nil {
+				r.Leader = peer
+			}
+		}
+	}
+}
+
+// 正在执行中的事件可能已经完成了一部分, 已经完成的步骤跳过
+func (c *Cluster) simulateAddPeer(r *Range, peer *metapb.Peer) {
+	if r.GetPeer(peer.GetId()) != nil || r.GetNodePeer(peer.GetNodeId()) != nil {
+		return
+	}
+	r.Peers = append(r.Peers, peer)
+	node := c.FindNodeById(peer.GetNodeId())
+	if node == nil {
+		return
+	}
+	node.AddRange(r)
+	node.stats.UsedSize += r.ApproximateSize
+	if node.stats.Available > r.ApproximateSize {
+		node.stats.Available -= r.ApproximateSize
+	} else {
+		node.stats.Available = 0
+	}
+}
+
+func (c *Cluster) simulateDelPeer(r *Range, peer *metapb.Peer) {
+	var peers []*metapb.Peer
+	for _, p := range r.GetPeers() {
+		if p.GetId() != peer.GetId() {
+			peers = append(peers, p)
+		}
+	}
+	if len(peers) == len(r.GetPeers()) {
+		return
+	}
+	r.Peers = peers
+	var downPeers []*mspb.PeerStats
+	for _, down := range r.DownPeers {
+		if down.GetPeer().GetId() != peer.GetId() {
+			downPeers = append(downPeers, down)
+		}
+	}
+	r.DownPeers = downPeers
+	var pendingPeers []*metapb.Peer
+	for _, pending := range r.PendingPeers {
+		if pending.GetId() != peer.GetId() {
+			pendingPeers = append(pendingPeers, pending)
+		}
+	}
+	r.PendingPeers = pendingPeers
+	if r.GetLeader().GetId() == peer.GetId() && len(peers) > 0 {
+		r.Leader = peers[0]
+	}
+	c.simulateReleaseSpace(peer.GetNodeId(), r)
+}
+
+func (c *Cluster) simulateReleaseSpace(nodeId uint64, r *Range) {
+	node := c.FindNodeById(nodeId)
+	if node == nil {
+		return
+	}
+	node.DeleteRange(r.GetId())
+	if node.stats.UsedSize > r.ApproximateSize {
+		node.stats.UsedSize -= r.ApproximateSize
+	} else {
+		node.stats.UsedSize = 0
+	}
+	node.stats.Available += r.ApproximateSize
+}
+
+func (c *Cluster) simulateDeleteRange(r *Range) {
+	for _, peer := range r.GetPeers() {
+		c.simulateReleaseSpace(peer.GetNodeId(), r)
+	}
+	c.DeleteRange(r.GetId())
+}
+
+// 按模拟集群中range的分布更新节点的range数和leader数
+func (c *Cluster) refreshSimulateNodeStats() {
+	for _, node := range c.GetAllNode() {
+		ranges := node.GetAllRanges()
+		var leaderCount uint32
+		for _, r := range ranges {
+			if r.GetLeader().GetNodeId() == node.GetId() {
+				leaderCount++
+			}
+		}
+		node.stats.RangeCount = uint32(len(ranges))
+		node.stats.RangeLeaderCount = leaderCount
+	}
+}
+
+func (c *Cluster) simulateNodes() []*SimulateNode {
+	var nodes []*SimulateNode
+	for _, node := range c.GetAllNode() {
+		nodes = append(nodes, &SimulateNode{
+			NodeId:      node.GetId(),
+			Addr:        node.GetServerAddr(),
+			State:       node.GetState().String(),
+			RangeCount:  node.stats.GetRangeCount(),
+			LeaderCount: node.stats.GetRangeLeaderCount(),
+			UsedSize:    node.stats.GetUsedSize(),
+			Available:   node.stats.GetAvailable(),
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeId < nodes[j].NodeId
+	})
+	return nodes
+}
+
+func simulate(topo *Topology, opt *scheduleOption, events []RangeEvent, names []string, rounds int) (*SimulateResult, error) {
+	if rounds <= 0 {
+		rounds = 1
+	}
+	if rounds > maxSimulateRounds {
+		rounds = maxSimulateRounds
+	}
+	cluster := newSimulateCluster(topo, opt)
+	// 正在执行的事件涉及的range不会被再次调度
+	for _, e := range events {
+		cluster.AddEvent(e)
+	}
+	wm := cluster.workerManger
+	var workers []Worker
+	for _, name := range names {
+		w, err := newScheduleWorker(wm, name)
+		if err != nil {
+			wm.Stop()
+			return nil, fmt.Errorf("worker %s: %v", name, err)
+		}
+		workers = append(workers, w)
+	}
+	result := &SimulateResult{Workers: names}
+	result.Rounds, result.Events = wm.simulate(workers, rounds)
+	result.Nodes = cluster.simulateNodes()
+	return result, nil
+}
+
+// 离线模拟调度, names为空时模拟所有的均衡调度
+func Simulate(topo *Topology, cfg *Config, names []string, rounds int) (*SimulateResult, error) {
+	if len(names) == 0 {
+		for name := range scheduleWorkerCreators {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	return simulate(topo, newScheduleOption(cfg), nil, names, rounds)
+}
+
+// 在当前集群的快照上模拟调度, 返回会生成的事件, 不会下发任何任务
+// names为空时模拟正在运行的调度worker, opt为空时使用当前的配置
+func (c *Cluster) DryRunSchedule(names []string, rounds int, opt *scheduleOption) (*SimulateResult, error) {
+	if len(names) == 0 {
+		for _, name := range c.workerManger.GetAllWorker() {
+			if isScheduleWorker(name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	if opt == nil {
+		opt = c.opt
+	}
+	return simulate(c.dumpTopology(), opt, c.GetAllEvent(), names, rounds)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"model/pkg/metapb"
+)
+
+func newSimulateTestTopology(rangeNum int) *Topology {
+	topo := &Topology{}
+	for id := uint64(1); id <= 3; id++ {
+		topo.Nodes = append(topo.Nodes, &TopologyNode{Node: &metapb.Node{
+			Id:         id,
+			ServerAddr: fmt.Sprintf("127.0.0.%d:6060", id),
+			State:      metapb.NodeState_N_Login,
+		}})
+	}
+	for i := 0; i < rangeNum; i++ {
+		r := newTestRange(uint64(100+i), fmt.Sprintf("%03d", i), fmt.Sprintf("%03d", i+1), 1, 1, 1, 2, 3)
+		r.TableId = 10
+		topo.Ranges = append(topo.Ranges, &TopologyRange{Range: r.Range, Leader: r.GetLeader(), HeartbeatCount: 1})
+	}
+	return topo
+}
+
+func TestSimulateEvictLeader(t *testing.T) {
+	result, err := Simulate(newSimulateTestTopology(10), NewDefaultConfig(), []string{"evict_leader_worker_1"}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每轮最多切换8个leader, 第三轮没有新的事件
+	if result.Rounds != 3 || len(result.Events) != 10 {
+		t.Fatalf("unexpected rounds %d, events %d", result.Rounds, len(result.Events))
+	}
+	for _, e := range result.Events {
+		if e.Creator != "evict_leader_worker_1" || len(e.Steps) != 1 || e.Steps[0] == "transfer leader to node 1" {
+			t.Fatalf("unexpected event %v", e)
+		}
+	}
+	var leaderCount uint32
+	for _, n := range result.Nodes {
+		if n.NodeId == 1 && n.LeaderCount != 0 {
+			t.Fatalf("node 1 still has %d leaders", n.LeaderCount)
+		}
+		leaderCount += n.LeaderCount
+	}
+	if leaderCount != 10 {
+		t.Fatalf("unexpected leader count %d", leaderCount)
+	}
+}
+
+func TestSimulateUnknownWorker(t *testing.T) {
+	if _, err := Simulate(newSimulateTestTopology(1), NewDefaultConfig(), []string{failoverWorkerName}, 1); err == nil {
+		t.Fatal("expected unknown worker error")
+	}
+}
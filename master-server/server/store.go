@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,6 +40,9 @@ var DefaultRaftLogCount uint64 = 10000
 var ErrUnknownCommandType = errors.New("unknown command type")
 var DefaultMaxSubmitTimeout time.Duration = time.Second * 60
 
+// master集群成员, 发生过成员变更后以此为准, 不再使用配置文件中的成员列表
+var MASTER_MEMBERS string = fmt.Sprintf("$master_members")
+
 type Iterator interface {
 	// return false if over or error
 	Next() bool
@@ -51,6 +56,30 @@ type Iterator interface {
 	Release()
 }
 
+// 读取失败时返回的空迭代器
+type errIterator struct {
+	err error
+}
+
+func (it *errIterator) Next() bool {
+	return false
+}
+
+func (it *errIterator) Key() []byte {
+	return nil
+}
+
+func (it *errIterator) Value() []byte {
+	return nil
+}
+
+func (it *errIterator) Error() error {
+	return it.err
+}
+
+func (it *errIterator) Release() {
+}
+
 type Store interface {
 	Open() error
 	Put(key, value []byte) error
@@ -83,6 +112,7 @@ type Peer struct {
 	RpcServerAddr     string `json:"rpc_addr"`
 	RaftHeartbeatAddr string `json:"raft_hb_addr"`
 	RaftReplicateAddr string `json:"raft_rp_addr"`
+	Learner           bool   `json:"learner,omitempty"`
 }
 
 func (p *Peer) GetId() uint64 {
@@ -92,18 +122,34 @@ func (p *Peer) GetId() uint64 {
 	return p.ID
 }
 
+func (p *Peer) raftPeer() raftproto.Peer {
+	if p.Learner {
+		return raftproto.Peer{Type: raftproto.PeerLearner, ID: p.ID}
+	}
+	return raftproto.Peer{Type: raftproto.PeerNormal, ID: p.ID}
+}
+
 type StoreConfig struct {
 	RaftRetainLogs        int64
 	RaftHeartbeatInterval time.Duration
 	RaftHeartbeatAddr     string
 	RaftReplicateAddr     string
 	RaftPeers             []*Peer
+	// 读取元数据前通过ReadIndex确认leader身份, 旧版本的成员不响应ReadIndex
+	RaftReadIndex bool
+	// leader租约内直接响应ReadIndex, 不需要多数派确认
+	RaftLeaseRead bool
+	// 网络分区恢复后的节点不触发重新选举, 被隔离的leader主动退位
+	RaftPreVote     bool
+	RaftCheckQuorum bool
 
 	NodeID   uint64
 	DataPath string
 
 	LeaderChangeHandler raftgroup.RaftLeaderChangeHandler
 	FatalHandler        raftgroup.RaftFatalEventHandler
+	// 本节点被移出master集群, raft已经停止
+	RemovedHandler func()
 }
 
 type RaftStore struct {
@@ -112,10 +158,19 @@ type RaftStore struct {
 	raft       *raftgroup.RaftGroup
 	raftServer *raft.RaftServer
 	raftConfig *raft.RaftConfig
+	resolver   *Resolver
 	localRead  bool
 	ctx        context.Context
 	cancel     context.CancelFunc
 	wg         sync.WaitGroup
+
+	// 关闭时直接读取本地数据
+	readIndexEnabled bool
+	// 等待ReadIndex确认的最长时间
+	readTimeout time.Duration
+
+	nodeId         uint64
+	removedHandler func()
 }
 
 func NewRaftStore(conf *StoreConfig) (*RaftStore, error) {
@@ -125,8 +180,7 @@ func NewRaftStore(conf *StoreConfig) (*RaftStore, error) {
 	nodes = make(map[uint64]*Peer)
 	raftPeers = make([]raftproto.Peer, 0, len(conf.RaftPeers))
 	for _, p := range conf.RaftPeers {
-		peer := raftproto.Peer{Type: raftproto.PeerNormal, ID: p.ID}
-		raftPeers = append(raftPeers, peer)
+		raftPeers = append(raftPeers, p.raftPeer())
 		nodes[p.ID] = p
 	}
 	rc := raft.DefaultConfig()
@@ -134,8 +188,14 @@ func NewRaftStore(conf *StoreConfig) (*RaftStore, error) {
 	rc.TickInterval = conf.RaftHeartbeatInterval
 	rc.HeartbeatAddr = conf.RaftHeartbeatAddr
 	rc.ReplicateAddr = conf.RaftReplicateAddr
+	rc.PreVote = conf.RaftPreVote
+	rc.CheckQuorum = conf.RaftCheckQuorum
+	// 租约读依赖租约检查: 租约内follower不投票, leader失去多数派后租约过期即退位
+	rc.LeaseCheck = conf.RaftLeaseRead
+	rc.LeaseRead = conf.RaftLeaseRead
 	// master server cluster
-	rc.Resolver = NewResolver(nodes)
+	resolver := NewResolver(nodes)
+	rc.Resolver = resolver
 	rc.NodeID = uint64(conf.NodeID)
 	rs, err := raft.NewRaftServer(rc)
 	if err != nil {
@@ -156,6 +216,19 @@ func NewRaftStore(conf *StoreConfig) (*RaftStore, error) {
 		log.Error("open store failed, err[%v]", err)
 		return nil, err
 	}
+	members, err := loadMembers(rowStore)
+	if err != nil {
+		log.Error("load master members failed, err[%v]", err)
+		return nil, err
+	}
+	if len(members) > 0 {
+		raftPeers = make([]raftproto.Peer, 0, len(members))
+		for _, p := range members {
+			raftPeers = append(raftPeers, p.raftPeer())
+		}
+		resolver.reset(members)
+		log.Info("load %d master members from store", len(members))
+	}
 	// raft group create at end !!!!!!!
 	path = filepath.Join(conf.DataPath, "raft")
 	raftStorage, err := wal.NewStorage(1, path, nil)
@@ -184,8 +257,14 @@ func NewRaftStore(conf *StoreConfig) (*RaftStore, error) {
 	store.raft = raftGroup
 	store.raftServer = rs
 	store.raftConfig = raftConfig
+	store.resolver = resolver
 	store.localRead = true
+	store.readIndexEnabled = conf.RaftReadIndex
+	// 一个选举周期内得不到确认时, 本节点已经不是leader或者多数派不可用, 不再继续等待
+	store.readTimeout = rc.TickInterval * time.Duration(rc.ElectionTick)
 	store.dataPath = conf.DataPath
+	store.nodeId = conf.NodeID
+	store.removedHandler = conf.RemovedHandler
 	return store, nil
 }
 
@@ -268,8 +347,26 @@ func (s *RaftStore) Delete(key []byte) error {
 	return nil
 }
 
+// 读取前通过ReadIndex确认本节点仍是leader, 并等待状态机应用到确认时的提交位置,
+// 避免已经失去leader身份的节点读到旧的元数据; 没有开启时直接读取本地数据
+func (s *RaftStore) readIndex() error {
+	if !s.readIndexEnabled {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.readTimeout)
+	defer cancel()
+	if err := s.raft.ReadIndex(ctx); err != nil {
+		log.Warn("raft read index failed, err[%v]", err)
+		return err
+	}
+	return nil
+}
+
 func (s *RaftStore) Get(key []byte) ([]byte, error) {
 	if s.localRead {
+		if err := s.readIndex(); err != nil {
+			return nil, err
+		}
 		return s.store.Get(key, ts.MaxTimestamp)
 	}
 	req := &ms_raftcmdpb.Request{
@@ -289,6 +386,9 @@ func (s *RaftStore) Get(key []byte) ([]byte, error) {
 }
 
 func (s *RaftStore) Scan(startKey, limitKey []byte) Iterator {
+	if err := s.readIndex(); err != nil {
+		return &errIterator{err: err}
+	}
 	return s.store.NewIterator(startKey, limitKey, ts.MaxTimestamp)
 }
 
@@ -447,22 +547,151 @@ func (s *RaftStore) ApplySnapshot(iter *raftgroup.SnapshotKVIterator) error {
 	return nil
 }
 
-func (s *RaftStore) HandlePeerChange(confChange *raftproto.ConfChange) (res interface{}, err error) {
+// 每个节点应用成员变更日志时更新成员地址, 并保存完整的成员列表
+func (s *RaftStore) HandlePeerChange(confChange *raftproto.ConfChange, raftIndex uint64) (res interface{}, err error) {
 	switch confChange.Type {
-	case raftproto.ConfAddNode:
-
-		res, err = nil, nil
+	case raftproto.ConfAddNode, raftproto.ConfUpdateNode, raftproto.ConfPromoteNode:
+		if len(confChange.Context) > 0 {
+			peer := new(Peer)
+			if err = json.Unmarshal(confChange.Context, peer); err != nil {
+				log.Error("decode master member[%d] failed, err[%v]", confChange.Peer.ID, err)
+				return nil, err
+			}
+			s.resolver.AddNode(peer)
+		} else if s.resolver.GetNode(confChange.Peer.ID) == nil {
+			log.Warn("master member[%d] has no address", confChange.Peer.ID)
+		}
 	case raftproto.ConfRemoveNode:
-
-		res, err = nil, nil
-	case raftproto.ConfUpdateNode:
-		log.Debug("update range peer")
-		res, err = nil, nil
+		s.resolver.DeleteNode(confChange.Peer.ID)
 	default:
-		res, err = nil, ErrUnknownCommandType
+		return nil, ErrUnknownCommandType
+	}
+	log.Info("master member change: type[%v], member[%d], index[%d]", confChange.Type, confChange.Peer.ID, raftIndex)
+	err = s.saveMembers(raftIndex)
+	if confChange.Type == raftproto.ConfRemoveNode && confChange.Peer.ID == s.nodeId {
+		// apply流程中不能停止raft, 异步停止
+		go s.removed()
 	}
+	return nil, err
+}
 
-	return
+// 本节点已经被移出集群, 停止raft, 不再参与选举和复制
+func (s *RaftStore) removed() {
+	log.Warn("master member[%d] is removed from cluster, stop raft", s.nodeId)
+	s.raft.Release()
+	if s.removedHandler != nil {
+		s.removedHandler()
+	}
+}
+
+func (s *RaftStore) saveMembers(raftIndex uint64) error {
+	data, err := json.Marshal(s.resolver.GetAllNodes())
+	if err != nil {
+		return err
+	}
+	return s.store.Put([]byte(MASTER_MEMBERS), data, 0, ts.Timestamp{WallTime: int64(raftIndex)}, raftIndex)
+}
+
+func loadMembers(store model.Store) ([]*Peer, error) {
+	data, err := store.Get([]byte(MASTER_MEMBERS), ts.MaxTimestamp)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	var members []*Peer
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// GetMember 返回master成员的地址, 不存在时返回nil
+func (s *RaftStore) GetMember(id uint64) *Peer {
+	return s.resolver.GetNode(id)
+}
+
+func (s *RaftStore) GetMembers() []*Peer {
+	return s.resolver.GetAllNodes()
+}
+
+// AddMember 增加master成员, 只能在leader上调用
+// 新成员启动时配置文件中的成员列表需要包含所有成员, 加入后从leader接收快照
+// 学习者成员不影响多数派, 可以作为只读备份, 或者在追上日志后再提升为正式成员
+func (s *RaftStore) AddMember(peer *Peer) error {
+	if s.resolver.GetNode(peer.GetId()) != nil {
+		return ErrMasterMemberExisted
+	}
+	data, err := json.Marshal(peer)
+	if err != nil {
+		return err
+	}
+	// 提前记录新成员的地址, 变更提交后leader立即向新成员复制日志
+	s.resolver.AddNode(peer)
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultMaxSubmitTimeout)
+	defer cancel()
+	if peer.Learner {
+		err = s.raft.AddLearner(ctx, peer.GetId(), data)
+	} else {
+		err = s.raft.ChangePeer(ctx, raftproto.ConfAddNode, peer.GetId(), data)
+	}
+	if err != nil {
+		if _, find := s.raftServer.Status(1).Replicas[peer.GetId()]; !find {
+			s.resolver.DeleteNode(peer.GetId())
+		}
+		log.Error("add master member[%d] failed, err[%v]", peer.GetId(), err)
+		return err
+	}
+	return nil
+}
+
+// RemoveMember 删除master成员, 只能在leader上调用, 删除leader前需要先转移leader
+func (s *RaftStore) RemoveMember(id uint64) error {
+	if s.resolver.GetNode(id) == nil {
+		return ErrMasterMemberNotExist
+	}
+	if leader, _ := s.raft.LeaderTerm(); leader == id {
+		return ErrRemoveMasterLeader
+	}
+	if len(s.resolver.GetAllNodes()) <= 1 {
+		return ErrRemoveLastMaster
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultMaxSubmitTimeout)
+	defer cancel()
+	if err := s.raft.ChangePeer(ctx, raftproto.ConfRemoveNode, id, nil); err != nil {
+		log.Error("remove master member[%d] failed, err[%v]", id, err)
+		return err
+	}
+	return nil
+}
+
+// PromoteMember 把学习者提升为正式成员, 只能在leader上调用
+func (s *RaftStore) PromoteMember(id uint64) error {
+	member := s.resolver.GetNode(id)
+	if member == nil {
+		return ErrMasterMemberNotExist
+	}
+	if !member.Learner {
+		return ErrMasterMemberNotLearner
+	}
+	peer := *member
+	peer.Learner = false
+	data, err := json.Marshal(&peer)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultMaxSubmitTimeout)
+	defer cancel()
+	if err = s.raft.ChangePeer(ctx, raftproto.ConfPromoteNode, id, data); err != nil {
+		log.Error("promote master member[%d] failed, err[%v]", id, err)
+		return err
+	}
+	return nil
+}
+
+// TransferLeader 本节点发起选举成为leader
+func (s *RaftStore) TransferLeader() error {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultMaxSubmitTimeout)
+	defer cancel()
+	return s.raft.LeaderTransfer(ctx, 1)
 }
 
 ////TODO
@@ -473,7 +702,19 @@ func (s *RaftStore) HandleGetSnapshot() (model.Snapshot, error) {
 
 func (s *RaftStore) HandleApplySnapshot(peers []raftproto.Peer, iter *raftgroup.SnapshotKVIterator) error {
 	log.Info("apply snapshot")
-	return s.ApplySnapshot(iter)
+	if err := s.ApplySnapshot(iter); err != nil {
+		return err
+	}
+	// 快照中包含成员变更后的成员列表
+	members, err := loadMembers(s.store)
+	if err != nil {
+		log.Error("load master members from snapshot failed, err[%v]", err)
+		return err
+	}
+	if len(members) > 0 {
+		s.resolver.reset(members)
+	}
+	return nil
 }
 
 type SaveBatch struct {
@@ -538,6 +779,7 @@ func (b *SaveBatch) Commit() error {
 }
 
 type Resolver struct {
+	lock    sync.RWMutex
 	cluster map[uint64]*Peer
 }
 
@@ -545,16 +787,56 @@ func NewResolver(nodes map[uint64]*Peer) *Resolver {
 	return &Resolver{cluster: nodes}
 }
 
+func (r *Resolver) AddNode(node *Peer) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cluster[node.GetId()] = node
+}
+
+func (r *Resolver) DeleteNode(nodeID uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.cluster, nodeID)
+}
+
+func (r *Resolver) GetNode(nodeID uint64) *Peer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.cluster[nodeID]
+}
+
+// 按id排序
+func (r *Resolver) GetAllNodes() []*Peer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	nodes := make([]*Peer, 0, len(r.cluster))
+	for _, node := range r.cluster {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GetId() < nodes[j].GetId() })
+	return nodes
+}
+
+func (r *Resolver) reset(nodes []*Peer) {
+	cluster := make(map[uint64]*Peer)
+	for _, node := range nodes {
+		cluster[node.GetId()] = node
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cluster = cluster
+}
+
 func (r *Resolver) NodeAddress(nodeID uint64, stype raft.SocketType) (addr string, err error) {
 	switch stype {
 	case raft.HeartBeat:
-		node := r.cluster[nodeID]
+		node := r.GetNode(nodeID)
 		if node == nil {
 			return "", errors.New("invalid node")
 		}
 		return node.RaftHeartbeatAddr, nil
 	case raft.Replicate:
-		node := r.cluster[nodeID]
+		node := r.GetNode(nodeID)
 		if node == nil {
 			return "", errors.New("invalid node")
 		}
@@ -6,6 +6,9 @@ import (
 	"bytes"
 	"time"
 	"encoding/binary"
+	"os"
+
+	ts "model/pkg/timestamp"
 )
 
 func TestRaftStore(t *testing.T) {
@@ -270,4 +273,232 @@ func TestRaftStoreSnapshot(t *testing.T) {
 		return
 	}
 
-}
\ No newline at end of file
+}
+func TestRaftStoreMember(t *testing.T) {
+	var lleader uint64
+	dataPath := "/tmp/data_member"
+	os.RemoveAll(dataPath)
+	defer os.RemoveAll(dataPath)
+	cnf := &StoreConfig{
+		RaftRetainLogs:        int64(100),
+		RaftHeartbeatInterval: time.Millisecond * 500,
+		RaftHeartbeatAddr:     "127.0.0.1:4234",
+		RaftReplicateAddr:     "127.0.0.1:4235",
+		RaftPeers: []*Peer{&Peer{ID: 1, WebManageAddr: "127.0.0.1:8080",
+			RpcServerAddr: "127.0.0.1:8887", RaftHeartbeatAddr: "127.0.0.1:4234", RaftReplicateAddr: "127.0.0.1:4235"}},
+
+		NodeID:   uint64(1),
+		DataPath: dataPath,
+
+		LeaderChangeHandler: func(leader uint64) {
+			lleader = leader
+		},
+		FatalHandler: func(err *raft.FatalError) {
+		},
+	}
+	saveStore, err := NewRaftStore(cnf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = saveStore.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer saveStore.Close()
+	time.Sleep(time.Second * 5)
+	if lleader == 0 {
+		t.Fatal("no leader")
+	}
+
+	peer := &Peer{ID: 2, WebManageAddr: "127.0.0.1:8081", RpcServerAddr: "127.0.0.1:8888",
+		RaftHeartbeatAddr: "127.0.0.1:4236", RaftReplicateAddr: "127.0.0.1:4237"}
+	if err = saveStore.AddMember(peer); err != nil {
+		t.Fatal(err)
+	}
+	if err = saveStore.AddMember(peer); err != ErrMasterMemberExisted {
+		t.Fatalf("expected member existed, actual %v", err)
+	}
+	if m := saveStore.GetMember(2); m == nil || m.WebManageAddr != peer.WebManageAddr {
+		t.Fatalf("unexpected member %v", m)
+	}
+	// 成员列表保存在元数据中, 重启后使用
+	members, err := loadMembers(saveStore.store)
+	if err != nil || len(members) != 2 || members[0].ID != 1 || members[1].ID != 2 {
+		t.Fatalf("unexpected members %v, err %v", members, err)
+	}
+	if err = saveStore.RemoveMember(1); err != ErrRemoveMasterLeader {
+		t.Fatalf("expected remove leader error, actual %v", err)
+	}
+	if err = saveStore.RemoveMember(3); err != ErrMasterMemberNotExist {
+		t.Fatalf("expected member not exist, actual %v", err)
+	}
+}
+
+func TestRaftStoreLearner(t *testing.T) {
+	var lleader uint64
+	dataPath := "/tmp/data_learner"
+	os.RemoveAll(dataPath)
+	defer os.RemoveAll(dataPath)
+	cnf := &StoreConfig{
+		RaftRetainLogs:        int64(100),
+		RaftHeartbeatInterval: time.Millisecond * 500,
+		RaftHeartbeatAddr:     "127.0.0.1:4244",
+		RaftReplicateAddr:     "127.0.0.1:4245",
+		RaftPeers: []*Peer{&Peer{ID: 1, WebManageAddr: "127.0.0.1:8080",
+			RpcServerAddr: "127.0.0.1:8887", RaftHeartbeatAddr: "127.0.0.1:4244", RaftReplicateAddr: "127.0.0.1:4245"}},
+
+		NodeID:   uint64(1),
+		DataPath: dataPath,
+
+		LeaderChangeHandler: func(leader uint64) {
+			lleader = leader
+		},
+		FatalHandler: func(err *raft.FatalError) {
+		},
+	}
+	saveStore, err := NewRaftStore(cnf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = saveStore.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer saveStore.Close()
+	time.Sleep(time.Second * 5)
+	if lleader == 0 {
+		t.Fatal("no leader")
+	}
+
+	// 学习者没有启动, 不影响写入
+	peer := &Peer{ID: 2, WebManageAddr: "127.0.0.1:8081", RpcServerAddr: "127.0.0.1:8888",
+		RaftHeartbeatAddr: "127.0.0.1:4246", RaftReplicateAddr: "127.0.0.1:4247", Learner: true}
+	if err = saveStore.AddMember(peer); err != nil {
+		t.Fatal(err)
+	}
+	if st := saveStore.raftServer.Status(1); st.Replicas[2] == nil || !st.Replicas[2].Learner {
+		t.Fatalf("unexpected replicas %v", st)
+	}
+	if err = saveStore.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	members, err := loadMembers(saveStore.store)
+	if err != nil || len(members) != 2 || !members[1].Learner {
+		t.Fatalf("unexpected members %v, err %v", members, err)
+	}
+
+	if err = saveStore.PromoteMember(1); err != ErrMasterMemberNotLearner {
+		t.Fatalf("expected member not learner, actual %v", err)
+	}
+	if err = saveStore.PromoteMember(3); err != ErrMasterMemberNotExist {
+		t.Fatalf("expected member not exist, actual %v", err)
+	}
+	if err = saveStore.PromoteMember(2); err != nil {
+		t.Fatal(err)
+	}
+	if st := saveStore.raftServer.Status(1); st.Replicas[2] == nil || st.Replicas[2].Learner {
+		t.Fatalf("unexpected replicas %v", st)
+	}
+	if m := saveStore.GetMember(2); m == nil || m.Learner {
+		t.Fatalf("unexpected member %v", m)
+	}
+}
+
+func TestRaftStoreRemoveSelf(t *testing.T) {
+	var lleader uint64
+	dataPath := "/tmp/data_remove"
+	os.RemoveAll(dataPath)
+	defer os.RemoveAll(dataPath)
+	peer1 := &Peer{ID: 1, WebManageAddr: "127.0.0.1:8080", RpcServerAddr: "127.0.0.1:8887",
+		RaftHeartbeatAddr: "127.0.0.1:4264", RaftReplicateAddr: "127.0.0.1:4265"}
+	peer2 := &Peer{ID: 2, WebManageAddr: "127.0.0.1:8081", RpcServerAddr: "127.0.0.1:8888",
+		RaftHeartbeatAddr: "127.0.0.1:4266", RaftReplicateAddr: "127.0.0.1:4267", Learner: true}
+	cnf := &StoreConfig{
+		RaftRetainLogs:        int64(100),
+		RaftHeartbeatInterval: time.Millisecond * 500,
+		RaftHeartbeatAddr:     peer1.RaftHeartbeatAddr,
+		RaftReplicateAddr:     peer1.RaftReplicateAddr,
+		RaftPeers:             []*Peer{peer1},
+
+		NodeID:   uint64(1),
+		DataPath: dataPath + "/1",
+
+		LeaderChangeHandler: func(leader uint64) {
+			lleader = leader
+		},
+		FatalHandler: func(err *raft.FatalError) {
+		},
+		RemovedHandler: func() {
+			t.Error("leader is removed")
+		},
+	}
+	leader, err := NewRaftStore(cnf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = leader.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer leader.Close()
+	time.Sleep(time.Second * 5)
+	if lleader == 0 {
+		t.Fatal("no leader")
+	}
+	if err = leader.AddMember(peer2); err != nil {
+		t.Fatal(err)
+	}
+	if err = leader.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+
+	removed := make(chan struct{})
+	member, err := NewRaftStore(&StoreConfig{
+		RaftRetainLogs:        int64(100),
+		RaftHeartbeatInterval: time.Millisecond * 500,
+		RaftHeartbeatAddr:     peer2.RaftHeartbeatAddr,
+		RaftReplicateAddr:     peer2.RaftReplicateAddr,
+		RaftPeers:             []*Peer{peer1, peer2},
+
+		NodeID:   uint64(2),
+		DataPath: dataPath + "/2",
+
+		LeaderChangeHandler: func(leader uint64) {},
+		FatalHandler:        func(err *raft.FatalError) {},
+		RemovedHandler: func() {
+			close(removed)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = member.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer member.Close()
+	for i := 0; ; i++ {
+		if value, err := member.store.Get([]byte("key"), ts.MaxTimestamp); err == nil && string(value) == "value" {
+			break
+		}
+		if i >= 50 {
+			t.Fatal("member does not catch up with leader")
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	// 被删除的成员应用删除自己的变更后停止
+	if err = leader.RemoveMember(2); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-removed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("removed member does not stop")
+	}
+	if st := member.raftServer.Status(1); !st.Stopped {
+		t.Fatalf("raft of removed member is not stopped, status %v", st)
+	}
+	if m := leader.GetMember(2); m != nil {
+		t.Fatalf("member is not removed from leader, %v", m)
+	}
+	if err = leader.Put([]byte("key"), []byte("value2")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"model/pkg/metapb"
+	"model/pkg/mspb"
 	"util/deepcopy"
 	"util/log"
 
@@ -42,7 +43,8 @@ type rangeToCreate struct {
 }
 
 func NewTable(t *metapb.Table) *Table {
-	var maxColId uint64
+	// 删除列后最大的列ID可能已经不在列定义中
+	maxColId := t.GetMaxColumnId()
 	for _, col := range t.GetColumns() {
 		if col.GetId() > maxColId {
 			maxColId = col.GetId()
@@ -65,6 +67,13 @@ func (t *Table) GenColId() uint64 {
 type TableProperty struct {
 	Columns []*metapb.Column `json:"columns"`
 	Regxs   []*metapb.Column `json:"regxs"`
+	Indexes []*IndexProperty `json:"indexes,omitempty"`
+}
+
+// 建表时同时创建的索引
+type IndexProperty struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
 }
 
 func (t *Table) Name() string {
@@ -169,10 +178,155 @@ func (t *Table) MergeColumn(source []*metapb.Column, cluster *Cluster) error {
 	return nil
 }
 
+// AlterColumns 在线修改表结构: 删除列, 重命名列, 修改默认值和是否可以为空
+// 列数据按列ID编码, 修改后已有数据不需要重写; 每次修改表结构版本(epoch conf_ver)加1,
+// confVer不为0时必须和当前版本一致, 避免基于过期的表结构修改
+func (t *Table) AlterColumns(confVer uint64, alters []*mspb.ColumnAlteration, cluster *Cluster) (*metapb.Table, error) {
+	t.schemaLock.Lock()
+	defer t.schemaLock.Unlock()
+	if confVer != 0 && confVer != t.GetEpoch().GetConfVer() {
+		log.Warn("table[%s:%s] schema is stale, conf version %d, current %d",
+			t.GetDbName(), t.GetName(), confVer, t.GetEpoch().GetConfVer())
+		return nil, ErrTableSchemaStale
+	}
+	table := deepcopy.Iface(t.Table).(*metapb.Table)
+
+	for _, alter := range alters {
+		name := strings.ToLower(alter.GetName())
+		var col *metapb.Column
+		var pos int
+		for i, c := range table.Columns {
+			if c.GetName() == name {
+				col, pos = c, i
+				break
+			}
+		}
+		if col == nil {
+			log.Warn("column[%s:%s:%s] is not exist", t.GetDbName(), t.GetName(), name)
+			return nil, ErrNotExistColumn
+		}
+		if col.GetPrimaryKey() > 0 {
+			log.Warn("pk column is not allow change")
+			return nil, ErrInvalidColumn
+		}
+		switch alter.GetType() {
+		case mspb.AlterColumnType_AlterColumnDrop:
+			for _, index := range table.GetIndexes() {
+				for _, id := range index.GetColumnIds() {
+					if id == col.GetId() {
+						log.Warn("column[%s:%s:%s] is used by index %s", t.GetDbName(), t.GetName(), name, index.GetName())
+						return nil, ErrColumnInIndex
+					}
+				}
+			}
+			if table.GetTtl().GetColumn() == name {
+				log.Warn("column[%s:%s:%s] is used by table ttl", t.GetDbName(), t.GetName(), name)
+				return nil, ErrColumnIsTTL
+			}
+			table.Columns = append(table.Columns[:pos], table.Columns[pos+1:]...)
+		case mspb.AlterColumnType_AlterColumnRename:
+			newName := strings.ToLower(alter.GetNewName())
+			if len(newName) == 0 {
+				return nil, ErrInvalidColumn
+			}
+			if len(newName) > MAX_COLUMN_NAME_LENGTH {
+				return nil, ErrColumnNameTooLong
+			}
+			if isSqlReservedWord(newName) {
+				log.Warn("col[%s] is sql reserved word", newName)
+				return nil, ErrSqlReservedWord
+			}
+			for _, c := range table.Columns {
+				if c.GetName() == newName && c != col {
+					log.Warn("column[%s:%s:%s] is already existed", t.GetDbName(), t.GetName(), newName)
+					return nil, ErrDupColumnName
+				}
+			}
+			if table.GetTtl().GetColumn() == col.GetName() {
+				table.Ttl.Column = newName
+			}
+			col.Name = newName
+		case mspb.AlterColumnType_AlterColumnDefault:
+			col.DefaultValue = alter.GetDefaultValue()
+		case mspb.AlterColumnType_AlterColumnNullable:
+			// 已有数据中可能存在空值, 不允许改为非空
+			if col.GetNullable() && !alter.GetNullable() {
+				return nil, ErrColumnNotAllowNotNull
+			}
+			col.Nullable = alter.GetNullable()
+		default:
+			return nil, ErrInvalidParam
+		}
+	}
+
+	props, err := ToTableProperty(table.Columns)
+	if err != nil {
+		return nil, err
+	}
+	t.lock.Lock()
+	table.MaxColumnId = t.maxColId
+	t.lock.Unlock()
+	table.Properties = props
+	table.Epoch.ConfVer++
+	if err := cluster.storeTable(table); err != nil {
+		log.Error("store table failed, err[%v]", err)
+		return nil, err
+	}
+	t.Table = table
+	log.Info("table[%s:%s] schema altered, conf version %d", t.GetDbName(), t.GetName(), table.GetEpoch().GetConfVer())
+	return table, nil
+}
+
 func checkTTLDataType(dataType metapb.DataType) bool {
 	return metapb.DataType_BigInt == dataType
 }
 
+// SetTTL 设置表的过期策略, column为空表示取消过期
+// 过期列保存毫秒时间戳; duration大于0时插入没有指定过期列的行, 过期时间为插入时间加上duration(毫秒)
+// 过期数据由master直接在range上删除, 不能同时清理索引, 有索引的表不能设置过期
+func (t *Table) SetTTL(column string, duration int64, cluster *Cluster) error {
+	t.schemaLock.Lock()
+	defer t.schemaLock.Unlock()
+	table := deepcopy.Iface(t.Table).(*metapb.Table)
+
+	column = strings.ToLower(column)
+	if len(column) == 0 {
+		table.Ttl = nil
+	} else {
+		if duration < 0 {
+			return ErrInvalidParam
+		}
+		if len(table.GetIndexes()) > 0 {
+			log.Warn("table[%s:%s] has index, can not set ttl", t.GetDbName(), t.GetName())
+			return ErrTTLWithIndex
+		}
+		var col *metapb.Column
+		for _, c := range table.Columns {
+			if c.GetName() == column {
+				col = c
+				break
+			}
+		}
+		if col == nil {
+			log.Warn("column[%s:%s:%s] is not exist", t.GetDbName(), t.GetName(), column)
+			return ErrNotExistColumn
+		}
+		if col.GetPrimaryKey() > 0 || !checkTTLDataType(col.GetDataType()) {
+			log.Warn("column[%s:%s:%s] can not be used as ttl column", t.GetDbName(), t.GetName(), column)
+			return ErrInvalidTTLColumn
+		}
+		table.Ttl = &metapb.TableTTL{Column: column, Duration: duration}
+	}
+	table.Epoch.ConfVer++
+	if err := cluster.storeTable(table); err != nil {
+		log.Error("store table failed, err[%v]", err)
+		return err
+	}
+	t.Table = table
+	log.Info("table[%s:%s] ttl changed to %v, conf version %d", t.GetDbName(), t.GetName(), table.GetTtl(), table.GetEpoch().GetConfVer())
+	return nil
+}
+
 func (t *Table) UpdateSchema(columns []*metapb.Column, store Store) ([]*metapb.Column, error) {
 	t.schemaLock.Lock()
 	defer t.schemaLock.Unlock()
@@ -210,6 +364,11 @@ func (t *Table) UpdateSchema(columns []*metapb.Column, store Store) ([]*metapb.C
 				break
 			}
 		}
+		if ok, err := t.addExplicitColumn(newCol, &cols, &allCols, colMap); err != nil {
+			return nil, err
+		} else if ok {
+			match = true
+		}
 	}
 	if match == false {
 		return nil, errors.New("none of columns matches")
@@ -237,6 +396,32 @@ func (t *Table) UpdateSchema(columns []*metapb.Column, store Store) ([]*metapb.C
 	return cols, nil
 }
 
+// addExplicitColumn 添加显式指定了类型的新列(ALTER TABLE ADD COLUMN)
+func (t *Table) addExplicitColumn(newCol *metapb.Column, cols, allCols *[]*metapb.Column, colMap map[string]*metapb.Column) (bool, error) {
+	if newCol.GetDataType() == metapb.DataType_Invalid {
+		return false, nil
+	}
+	for _, c := range *allCols {
+		if c.GetName() == newCol.GetName() {
+			return false, nil
+		}
+	}
+	if newCol.GetPrimaryKey() > 0 {
+		return false, errors.New("can not add primary key column")
+	}
+	if isSqlReservedWord(newCol.GetName()) {
+		log.Warn("col[%s:%s:%s] is sql reserved word",
+			t.GetDbName(), t.GetName(), newCol.GetName())
+		return false, ErrSqlReservedWord
+	}
+	addCol := deepcopy.Iface(newCol).(*metapb.Column)
+	addCol.Id = t.GenColId()
+	*cols = append(*cols, addCol)
+	*allCols = append(*allCols, addCol)
+	colMap[addCol.GetName()] = addCol
+	return true, nil
+}
+
 type TableCache struct {
 	lock    sync.RWMutex
 	tableIs map[uint64]*Table
@@ -524,31 +709,36 @@ func parseColumn(cols []*metapb.Column) error {
 	return nil
 }
 
-func ParseProperties(properties string) ([]*metapb.Column, []*metapb.Column, error) {
+func ParseProperties(properties string) ([]*metapb.Column, []*metapb.Column, []*IndexProperty, error) {
 	tp := new(TableProperty)
 	if err := json.Unmarshal([]byte(properties), tp); err != nil {
 		log.Error("deserialize table property failed, err:[%v]", err)
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if tp.Columns == nil {
 		log.Error("column is nil")
-		return nil, nil, ErrInvalidColumn
+		return nil, nil, nil, ErrInvalidColumn
 	}
 
 	err := parseColumn(tp.Columns)
 	if err != nil {
 		log.Error("parse table column failed, err:[%v]", err)
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	for _, c := range tp.Regxs {
 		// TODO check regx compile if error or not, error return
 		if c.DataType == metapb.DataType_Invalid {
-			return nil, nil, ErrInvalidColumn
+			return nil, nil, nil, ErrInvalidColumn
+		}
+	}
+	for _, index := range tp.Indexes {
+		for i, name := range index.Columns {
+			index.Columns[i] = strings.ToLower(name)
 		}
 	}
 
-	return tp.Columns, tp.Regxs, nil
+	return tp.Columns, tp.Regxs, tp.Indexes, nil
 }
 
 func GetTypeByName(name string) metapb.DataType {
@@ -3,6 +3,7 @@ package server
 import (
 	"testing"
 	"model/pkg/metapb"
+	"model/pkg/mspb"
 	"time"
 )
 
@@ -212,3 +213,159 @@ func TestDeletingTable(t *testing.T) {
 		return
 	}
 }
+
+func TestAlterTableColumns(t *testing.T) {
+	initDataPath()
+	defer clearData()
+	store, err := NewLevelDBDriver(path)
+	if err != nil {
+		t.Fatalf("new store failed, err %v", err)
+	}
+	if err = store.Open(); err != nil {
+		t.Fatalf("open store failed, err %v", err)
+	}
+	defer store.Close()
+	cluster := NewCluster(1, 1, store, newScheduleOption(NewDefaultConfig()))
+
+	table := NewTable(&metapb.Table{
+		Name:   TABLE_NAME,
+		DbName: DB_NAME,
+		Id:     10,
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Nullable: true},
+			{Name: "age", Id: 3, DataType: metapb.DataType_Int, Nullable: true},
+			{Name: "city", Id: 4, DataType: metapb.DataType_Varchar},
+		},
+		Indexes: []*metapb.Index{{Name: "idx_name", ColumnIds: []uint64{2}}},
+		Epoch:   &metapb.TableEpoch{ConfVer: 1, Version: 1},
+	})
+
+	// 基于过期的表结构修改
+	if _, err := table.AlterColumns(10, []*mspb.ColumnAlteration{{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "age"}}, cluster); err != ErrTableSchemaStale {
+		t.Fatalf("expected stale schema, actual %v", err)
+	}
+	failures := []struct {
+		alter *mspb.ColumnAlteration
+		err   error
+	}{
+		{&mspb.ColumnAlteration{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "id"}, ErrInvalidColumn},
+		{&mspb.ColumnAlteration{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "name"}, ErrColumnInIndex},
+		{&mspb.ColumnAlteration{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "none"}, ErrNotExistColumn},
+		{&mspb.ColumnAlteration{Type: mspb.AlterColumnType_AlterColumnRename, Name: "age", NewName: "City"}, ErrDupColumnName},
+		{&mspb.ColumnAlteration{Type: mspb.AlterColumnType_AlterColumnNullable, Name: "age"}, ErrColumnNotAllowNotNull},
+	}
+	for _, f := range failures {
+		if _, err := table.AlterColumns(1, []*mspb.ColumnAlteration{f.alter}, cluster); err != f.err {
+			t.Fatalf("alter %v: expected %v, actual %v", f.alter, f.err, err)
+		}
+	}
+	if table.GetEpoch().GetConfVer() != 1 || len(table.GetColumns()) != 4 {
+		t.Fatalf("table changed by failed alter: %v", table.Table)
+	}
+
+	alters := []*mspb.ColumnAlteration{
+		{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "city"},
+		{Type: mspb.AlterColumnType_AlterColumnRename, Name: "age", NewName: "Years"},
+		{Type: mspb.AlterColumnType_AlterColumnDefault, Name: "years", DefaultValue: []byte("18")},
+		{Type: mspb.AlterColumnType_AlterColumnNullable, Name: "years", Nullable: true},
+	}
+	if _, err := table.AlterColumns(1, alters, cluster); err != nil {
+		t.Fatal(err)
+	}
+	if table.GetEpoch().GetConfVer() != 2 || len(table.GetColumns()) != 3 {
+		t.Fatalf("unexpected table %v", table.Table)
+	}
+	if _, find := table.GetColumnByName("age"); find {
+		t.Fatal("column age is not renamed")
+	}
+	col, find := table.GetColumnByName("years")
+	if !find || col.GetId() != 3 || string(col.GetDefaultValue()) != "18" {
+		t.Fatalf("unexpected column %v", col)
+	}
+
+	// 重新加载后删除的列ID不能复用
+	stored, err := cluster.loadTable(table.GetId())
+	if err != nil || stored == nil {
+		t.Fatalf("load table failed, err %v", err)
+	}
+	if id := NewTable(stored).GenColId(); id != 5 {
+		t.Fatalf("unexpected column id %d", id)
+	}
+}
+
+func TestTableTTL(t *testing.T) {
+	initDataPath()
+	defer clearData()
+	store, err := NewLevelDBDriver(path)
+	if err != nil {
+		t.Fatalf("new store failed, err %v", err)
+	}
+	if err = store.Open(); err != nil {
+		t.Fatalf("open store failed, err %v", err)
+	}
+	defer store.Close()
+	cluster := NewCluster(1, 1, store, newScheduleOption(NewDefaultConfig()))
+
+	table := NewTable(&metapb.Table{
+		Name:   TABLE_NAME,
+		DbName: DB_NAME,
+		Id:     10,
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Nullable: true},
+			{Name: "expire_at", Id: 3, DataType: metapb.DataType_BigInt, Nullable: true},
+		},
+		Epoch: &metapb.TableEpoch{ConfVer: 1, Version: 1},
+	})
+
+	failures := []struct {
+		column   string
+		duration int64
+		err      error
+	}{
+		{"none", 0, ErrNotExistColumn},
+		{"id", 0, ErrInvalidTTLColumn},
+		{"name", 0, ErrInvalidTTLColumn},
+		{"expire_at", -1, ErrInvalidParam},
+	}
+	for _, f := range failures {
+		if err := table.SetTTL(f.column, f.duration, cluster); err != f.err {
+			t.Fatalf("set ttl %s: expected %v, actual %v", f.column, f.err, err)
+		}
+	}
+	if err := table.SetTTL("Expire_At", 3600000, cluster); err != nil {
+		t.Fatal(err)
+	}
+	if table.GetTtl().GetColumn() != "expire_at" || table.GetTtl().GetDuration() != 3600000 || table.GetEpoch().GetConfVer() != 2 {
+		t.Fatalf("unexpected table %v", table.Table)
+	}
+
+	// 过期列不能删除, 重命名后过期策略跟着修改
+	if _, err := table.AlterColumns(0, []*mspb.ColumnAlteration{{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "expire_at"}}, cluster); err != ErrColumnIsTTL {
+		t.Fatalf("expected ttl column error, actual %v", err)
+	}
+	if _, err := table.AlterColumns(0, []*mspb.ColumnAlteration{{Type: mspb.AlterColumnType_AlterColumnRename, Name: "expire_at", NewName: "deadline"}}, cluster); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := cluster.loadTable(table.GetId())
+	if err != nil || stored == nil {
+		t.Fatalf("load table failed, err %v", err)
+	}
+	if stored.GetTtl().GetColumn() != "deadline" {
+		t.Fatalf("unexpected ttl %v", stored.GetTtl())
+	}
+
+	if err := table.SetTTL("", 0, cluster); err != nil {
+		t.Fatal(err)
+	}
+	if table.GetTtl() != nil {
+		t.Fatalf("ttl is not removed: %v", table.GetTtl())
+	}
+
+	// 有索引的表不能设置过期
+	table.Indexes = []*metapb.Index{{Name: "name", ColumnIds: []uint64{2}, TableId: 11, State: metapb.IndexState_IndexPublic}}
+	if err := table.SetTTL("deadline", 0, cluster); err != ErrTTLWithIndex {
+		t.Fatalf("expected ttl with index error, actual %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"util/log"
+)
+
+// 回收设置了过期策略的表中已经过期的行
+// 按range先查询一批过期行, 再删除查询到的key范围内的过期行, 每秒删除的行数受ttl-gc-rows-per-second限制
+// 有二级索引的表删除行时需要同时删除索引, 暂不回收
+type ttlGCWorker struct {
+	name     string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+}
+
+func NewTTLGCWorker(wm *WorkerManager, interval time.Duration) Worker {
+	ctx, cancel := context.WithCancel(wm.ctx)
+	return &ttlGCWorker{
+		name:     ttlGCWorkerName,
+		ctx:      ctx,
+		cancel:   cancel,
+		interval: interval,
+	}
+}
+
+func (w *ttlGCWorker) GetName() string {
+	return w.name
+}
+
+func (w *ttlGCWorker) Work(cluster *Cluster) {
+	log.Debug("start %s", w.GetName())
+	cluster.metric.CollectScheduleCounter(w.GetName(), "schedule")
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for _, t := range cluster.workingTables.GetAllTable() {
+		select {
+		case <-w.ctx.Done():
+			return
+		default:
+		}
+		if t.GetTtl().GetColumn() == "" {
+			continue
+		}
+		// 设置过期和创建索引互斥, 这里只防止删除数据后留下无效的索引项
+		if len(t.GetIndexes()) > 0 {
+			log.Warn("%v: table[%s:%s] has index, skip", w.GetName(), t.GetDbName(), t.GetName())
+			continue
+		}
+		col, find := t.GetColumnByName(t.GetTtl().GetColumn())
+		if !find {
+			log.Warn("%v: ttl column[%s:%s:%s] is not exist", w.GetName(), t.GetDbName(), t.GetName(), t.GetTtl().GetColumn())
+			continue
+		}
+		var total uint64
+		for _, r := range cluster.GetTableAllRanges(t.GetId()) {
+			deleted, err := w.gcRange(cluster, r, col, now)
+			total += deleted
+			if err != nil {
+				log.Warn("%v: gc range[%d] of table[%s:%s] failed, err %v", w.GetName(), r.GetId(), t.GetDbName(), t.GetName(), err)
+				cluster.metric.CollectScheduleCounter(w.GetName(), "gc_failed")
+			}
+		}
+		if total > 0 {
+			log.Info("%v: table[%s:%s] reclaimed %d expired rows", w.GetName(), t.GetDbName(), t.GetName(), total)
+		}
+	}
+}
+
+// 回收一个range中过期时间不晚于now(毫秒)的行, 返回删除的行数
+func (w *ttlGCWorker) gcRange(cluster *Cluster, r *Range, col *metapb.Column, now int64) (uint64, error) {
+	batchSize := cluster.opt.GetTTLGCBatchSize()
+	rowsPerSecond := cluster.opt.GetTTLGCRowsPerSecond()
+	filters := []*kvrpcpb.Match{{
+		Column:    col,
+		Threshold: []byte(strconv.FormatInt(now, 10)),
+		MatchType: kvrpcpb.MatchType_LessOrEqual,
+	}}
+	start := r.GetStartKey()
+	var total uint64
+	for {
+		node := cluster.getLeaderNode(r)
+		if node == nil {
+			return total, fmt.Errorf("range %d leader node not found", r.GetId())
+		}
+		header := &kvrpcpb.RequestHeader{
+			ClusterId:  cluster.GetClusterId(),
+			RangeId:    r.GetId(),
+			RangeEpoch: r.GetRangeEpoch(),
+		}
+		sresp, err := cluster.kvCli.Select(w.ctx, node.GetServerAddr(), &kvrpcpb.DsSelectRequest{
+			Header: header,
+			Req: &kvrpcpb.SelectRequest{
+				Scope:        &kvrpcpb.Scope{Start: start, Limit: r.GetEndKey()},
+				FieldList:    []*kvrpcpb.SelectField{{Typ: kvrpcpb.SelectField_Column, Column: col}},
+				WhereFilters: filters,
+				Limit:        &kvrpcpb.Limit{Count: batchSize},
+				Timestamp:    &timestamp.Timestamp{WallTime: time.Now().UnixNano()},
+			},
+		})
+		if err != nil {
+			return total, err
+		}
+		if sresp.GetHeader().GetError() != nil {
+			return total, fmt.Errorf("select expired rows error: %v", sresp.GetHeader().GetError())
+		}
+		if sresp.GetResp().GetCode() != 0 {
+			return total, fmt.Errorf("select expired rows code: %d", sresp.GetResp().GetCode())
+		}
+		rows := sresp.GetResp().GetRows()
+		if len(rows) == 0 {
+			return total, nil
+		}
+		// 只删除查询到的最后一行之前的过期行, 限制单次删除的行数
+		last := rows[len(rows)-1].GetKey()
+		limit := make([]byte, len(last)+1)
+		copy(limit, last)
+		dresp, err := cluster.kvCli.Delete(w.ctx, node.GetServerAddr(), &kvrpcpb.DsDeleteRequest{
+			Header: header,
+			Req: &kvrpcpb.DeleteRequest{
+				Scope:        &kvrpcpb.Scope{Start: start, Limit: limit},
+				WhereFilters: filters,
+				Timestamp:    &timestamp.Timestamp{WallTime: time.Now().UnixNano()},
+			},
+		})
+		if err != nil {
+			return total, err
+		}
+		if dresp.GetHeader().GetError() != nil {
+			return total, fmt.Errorf("delete expired rows error: %v", dresp.GetHeader().GetError())
+		}
+		if dresp.GetResp().GetCode() != 0 {
+			return total, fmt.Errorf("delete expired rows code: %d", dresp.GetResp().GetCode())
+		}
+		deleted := dresp.GetResp().GetAffectedKeys()
+		total += deleted
+		cluster.metric.AddScheduleCounter(w.GetName(), "expired_rows", deleted)
+		if uint64(len(rows)) < batchSize {
+			return total, nil
+		}
+		start = limit
+
+		// 限速
+		if rowsPerSecond == 0 {
+			continue
+		}
+		select {
+		case <-w.ctx.Done():
+			return total, nil
+		case <-time.After(time.Duration(deleted) * time.Second / time.Duration(rowsPerSecond)):
+		}
+	}
+}
+
+func (w *ttlGCWorker) AllowWork(cluster *Cluster) bool {
+	return true
+}
+
+func (w *ttlGCWorker) GetInterval() time.Duration {
+	return w.interval
+}
+
+func (w *ttlGCWorker) Stop() {
+	w.cancel()
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"pkg-go/ds_client"
+)
+
+// 按key保存每行的过期时间, 模拟DS按过期列过滤查询和删除
+type ttlTestKvClient struct {
+	client.KvClient
+	rows    map[string]int64
+	selects int
+	deletes int
+}
+
+func (c *ttlTestKvClient) matchKeys(scope *kvrpcpb.Scope, filters []*kvrpcpb.Match) []string {
+	threshold, _ := strconv.ParseInt(string(filters[0].GetThreshold()), 10, 64)
+	var keys []string
+	for k, expireAt := range c.rows {
+		if bytes.Compare([]byte(k), scope.GetStart()) < 0 || bytes.Compare([]byte(k), scope.GetLimit()) >= 0 {
+			continue
+		}
+		if expireAt <= threshold {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *ttlTestKvClient) Select(ctx context.Context, addr string, req *kvrpcpb.DsSelectRequest) (*kvrpcpb.DsSelectResponse, error) {
+	c.selects++
+	keys := c.matchKeys(req.GetReq().GetScope(), req.GetReq().GetWhereFilters())
+	if count := int(req.GetReq().GetLimit().GetCount()); len(keys) > count {
+		keys = keys[:count]
+	}
+	resp := &kvrpcpb.SelectResponse{}
+	for _, k := range keys {
+		resp.Rows = append(resp.Rows, &kvrpcpb.Row{Key: []byte(k)})
+	}
+	return &kvrpcpb.DsSelectResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: resp}, nil
+}
+
+func (c *ttlTestKvClient) Delete(ctx context.Context, addr string, req *kvrpcpb.DsDeleteRequest) (*kvrpcpb.DsDeleteResponse, error) {
+	c.deletes++
+	keys := c.matchKeys(req.GetReq().GetScope(), req.GetReq().GetWhereFilters())
+	for _, k := range keys {
+		delete(c.rows, k)
+	}
+	return &kvrpcpb.DsDeleteResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: &kvrpcpb.DeleteResponse{AffectedKeys: uint64(len(keys))}}, nil
+}
+
+func TestTTLGCWorker(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Schedule.TTLGCBatchSize = 3
+	cluster := NewCluster(1, 1, nil, newScheduleOption(cfg))
+	defer cluster.workerManger.Stop()
+	kvCli := &ttlTestKvClient{rows: make(map[string]int64)}
+	cluster.kvCli = kvCli
+	cluster.metric = &Metric{scheduleCounter: make(map[string]map[string]uint64)}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for i := 0; i < 10; i++ {
+		// 偶数行已经过期
+		expireAt := now + int64(time.Hour/time.Millisecond)
+		if i%2 == 0 {
+			expireAt = now - 1000
+		}
+		kvCli.rows[string([]byte{'a' + byte(i)})] = expireAt
+	}
+	cluster.nodes.Add(NewNode(&metapb.Node{Id: 1, ServerAddr: "127.0.0.1:6060", State: metapb.NodeState_N_Login}))
+	for _, r := range []*Range{newTestRange(100, "a", "f", 1, 1, 1), newTestRange(101, "f", "z", 1, 1, 1)} {
+		r.TableId = 10
+		cluster.ranges.Add(r)
+	}
+	table := NewTable(&metapb.Table{
+		Name:   TABLE_NAME,
+		DbName: DB_NAME,
+		Id:     10,
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_Varchar, PrimaryKey: 1},
+			{Name: "expire_at", Id: 2, DataType: metapb.DataType_BigInt, Nullable: true},
+		},
+		Epoch: &metapb.TableEpoch{ConfVer: 1, Version: 1},
+	})
+	cluster.workingTables.Add(table)
+
+	w := NewTTLGCWorker(cluster.workerManger, time.Hour)
+	// 没有设置过期策略的表不回收
+	w.Work(cluster)
+	if kvCli.selects != 0 || len(kvCli.rows) != 10 {
+		t.Fatalf("unexpected gc without ttl, selects %d, rows %d", kvCli.selects, len(kvCli.rows))
+	}
+
+	table.Ttl = &metapb.TableTTL{Column: "expire_at"}
+	w.Work(cluster)
+	if len(kvCli.rows) != 5 {
+		t.Fatalf("unexpected rows %v", kvCli.rows)
+	}
+	for k, expireAt := range kvCli.rows {
+		if expireAt <= now {
+			t.Fatalf("expired row %s is not deleted", k)
+		}
+	}
+	// range a-f有3个过期行, 第一批查询满了需要再查一次; range f-z有2个过期行
+	if kvCli.selects != 3 || kvCli.deletes != 2 {
+		t.Fatalf("unexpected selects %d, deletes %d", kvCli.selects, kvCli.deletes)
+	}
+	if count := cluster.metric.scheduleCounter[ttlGCWorkerName]["expired_rows"]; count != 5 {
+		t.Fatalf("unexpected expired rows metric %d", count)
+	}
+}
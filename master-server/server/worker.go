@@ -3,6 +3,8 @@ package server
 import (
 	"time"
 	"sync"
+	"strconv"
+	"strings"
 	"golang.org/x/net/context"
 	"util/log"
 )
@@ -25,15 +27,65 @@ const (
 	balanceRangeWorkerName   	 = "balance_range_worker"
 	balanceLeaderWorkerName   	 = "balance_leader_worker"
 	balanceNodeOpsWorkerName     = "balance_node_ops_worker"
-
-	//balanceStorageWorkerName 	= "balance_node_storage_worker"
-	//hotRegionWorkerName        = "balance_hotregion_worker"
-	//grantLeaderWorkerName      = "grant_leader_worker"
-	//evictLeaderWorkerName      = "evict_leader_worker"
+	balanceStorageWorkerName 	 = "balance_node_storage_worker"
+	hotRangeWorkerName           = "balance_hotregion_worker"
+	ttlGCWorkerName              = "ttl_gc_worker"
+	loadSplitWorkerName          = "load_split_worker"
+
+	// 按节点添加, 实际的worker名字带上节点id
+	grantLeaderWorkerName        = "grant_leader_worker"
+	evictLeaderWorkerName        = "evict_leader_worker"
 	//shuffleLeaderWorkerName    = "shuffle_leader_worker"
 	//shuffleRangeWorkerName    = "shuffle_range_worker"
 )
 
+// 调度类的worker只根据集群状态生成range事件, 可以在集群快照上模拟执行
+var scheduleWorkerCreators = map[string]func(wm *WorkerManager) Worker{
+	balanceLeaderWorkerName: func(wm *WorkerManager) Worker {
+		return NewBalanceNodeLeaderWorker(wm, 10*defaultWorkerInterval)
+	},
+	balanceRangeWorkerName: func(wm *WorkerManager) Worker {
+		return NewBalanceNodeRangeWorker(wm, 10*defaultWorkerInterval)
+	},
+	balanceNodeOpsWorkerName: func(wm *WorkerManager) Worker {
+		return NewBalanceNodeOpsWorker(wm, 30*defaultWorkerInterval)
+	},
+	balanceStorageWorkerName: func(wm *WorkerManager) Worker {
+		return NewBalanceNodeStorageWorker(wm, 30*defaultWorkerInterval)
+	},
+	hotRangeWorkerName: func(wm *WorkerManager) Worker {
+		return NewHotRangeWorker(wm, 10*defaultWorkerInterval)
+	},
+}
+
+// 按名字创建调度worker, 按节点添加的worker名字需要带上节点id, 例如evict_leader_worker_3
+func newScheduleWorker(wm *WorkerManager, name string) (Worker, error) {
+	if creator, ok := scheduleWorkerCreators[name]; ok {
+		return creator(wm), nil
+	}
+	for _, prefix := range []string{evictLeaderWorkerName, grantLeaderWorkerName} {
+		if !strings.HasPrefix(name, prefix+"_") {
+			continue
+		}
+		nodeId, err := strconv.ParseUint(strings.TrimPrefix(name, prefix+"_"), 10, 64)
+		if err != nil {
+			break
+		}
+		if prefix == evictLeaderWorkerName {
+			return NewEvictLeaderWorker(wm, 2*defaultWorkerInterval, nodeId), nil
+		}
+		return NewGrantLeaderWorker(wm, 2*defaultWorkerInterval, nodeId), nil
+	}
+	return nil, ErrWorkerNotFound
+}
+
+func isScheduleWorker(name string) bool {
+	if _, ok := scheduleWorkerCreators[name]; ok {
+		return true
+	}
+	return strings.HasPrefix(name, evictLeaderWorkerName+"_") || strings.HasPrefix(name, grantLeaderWorkerName+"_")
+}
+
 
 type Worker interface {
 	GetName() string
@@ -76,6 +128,10 @@ func (wm *WorkerManager) Run() {
 	wm.addWorker(NewBalanceNodeLeaderWorker(wm, 10 * defaultWorkerInterval))
 	wm.addWorker(NewBalanceNodeRangeWorker(wm, 10 * defaultWorkerInterval))
 	wm.addWorker(NewBalanceNodeOpsWorker(wm, 30 * defaultWorkerInterval))
+	wm.addWorker(NewBalanceNodeStorageWorker(wm, 30 * defaultWorkerInterval))
+	wm.addWorker(NewHotRangeWorker(wm, 10 * defaultWorkerInterval))
+	wm.addWorker(NewTTLGCWorker(wm, time.Minute))
+	wm.addWorker(NewLoadSplitWorker(wm, 10 * defaultWorkerInterval))
 }
 
 func (wm *WorkerManager) Stop() {
@@ -109,6 +165,10 @@ func (wm *WorkerManager) runWorker(w Worker) {
 		case <-wm.ctx.Done():
 			return
 		case <-timer.C:
+			// worker已经被删除
+			if !wm.hasWorker(w) {
+				return
+			}
 			timer.Reset(w.GetInterval())
 		    if !w.AllowWork(wm.cluster) {
 		    	log.Debug("worker cannot exec, %v", w.GetName())
@@ -123,15 +183,30 @@ func (wm *WorkerManager) removeWorker(name string) error {
 	wm.lock.Lock()
 	defer wm.lock.Unlock()
 
-	_, ok := wm.workers[name]
+	w, ok := wm.workers[name]
 	if !ok {
 		return ErrWorkerNotFound
 	}
 
+	w.Stop()
 	delete(wm.workers, name)
 	return nil
 }
 
+func (wm *WorkerManager) hasWorker(w Worker) bool {
+	wm.lock.RLock()
+	defer wm.lock.RUnlock()
+	cur, ok := wm.workers[w.GetName()]
+	return ok && cur == w
+}
+
+func (wm *WorkerManager) hasWorkerName(name string) bool {
+	wm.lock.RLock()
+	defer wm.lock.RUnlock()
+	_, ok := wm.workers[name]
+	return ok
+}
+
 func (wm *WorkerManager) GetAllWorker() []string  {
 	wm.lock.Lock()
 	defer wm.lock.Unlock()
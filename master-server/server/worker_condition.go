@@ -84,25 +84,19 @@ func SelectMostAndLeastLeaderNode(nodes []*Node, selectors []NodeSelector) (*Nod
 
 /**
 return:
-	the normal node of the most leader number or available ration low node
-    the normal node of the least leader number
+	the normal node of the most range number
+    the normal node of the least range number
+磁盘空间不足的节点由balance_node_storage_worker按使用率迁移
  */
-func SelectMostAndLeastRangeNode(opt *scheduleOption, nodes []*Node, selectors []NodeSelector) (*Node, *Node, bool) {
+func SelectMostAndLeastRangeNode(nodes []*Node, selectors []NodeSelector) (*Node, *Node) {
 	var most, least *Node
-	force := false
 	for _, node := range nodes {
 		if !node.require() {
 			continue
 		}
 
-		if !force || most == nil {
-			if node.availableRatio()*100 < float64(opt.GetStorageAvailableThreshold()) {
-				log.Debug("node %v available ratio under threshold", node.GetId())
-				force = true
-				most = node
-			} else if most == nil || most.GetRangesCount() < node.GetRangesCount() {
-				most = node
-			}
+		if most == nil || most.GetRangesCount() < node.GetRangesCount() {
+			most = node
 		}
 
 		/**
@@ -122,7 +116,7 @@ func SelectMostAndLeastRangeNode(opt *scheduleOption, nodes []*Node, selectors [
 			}
 		}
 	}
-	return most, least, force
+	return most, least
 }
 
 func SelectLeaderNode(nodes []*Node, selectors []NodeSelector, mostLeaderNum float64) *Node {
@@ -0,0 +1,8 @@
+#!/bin/sh
+
+source ./test_config.sh
+calc_sign
+
+#--------correct--------------
+# 应答为备份文件, 可以用cmd/backup -action check校验
+curl -o fbase.bak $TEST_HOST"/manage/backup?d=$ts&s=$sign"
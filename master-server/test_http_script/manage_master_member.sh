@@ -0,0 +1,18 @@
+#!/bin/sh
+
+source ./test_config.sh
+calc_sign
+
+#--------correct--------------
+# 新成员先用包含所有成员的配置启动, 再加入集群
+curl -v $TEST_HOST"/manage/master/member/add?d=$ts&s=$sign&nodeId=4&ip=127.0.0.1&httpPort=8897&rpcPort=18897&raftHeartbeatPort=8878&raftReplicaPort=8868"
+# 以学习者加入, 追上日志后提升为正式成员
+curl -v $TEST_HOST"/manage/master/member/add?d=$ts&s=$sign&nodeId=5&ip=127.0.0.1&httpPort=8898&rpcPort=18898&raftHeartbeatPort=8879&raftReplicaPort=8869&learner=true"
+curl -v $TEST_HOST"/manage/master/member/promote?d=$ts&s=$sign&nodeId=5"
+# 删除leader前先把leader转移到其他成员
+curl -v $TEST_HOST"/manage/master/member/transfer?d=$ts&s=$sign&nodeId=4"
+curl -v $TEST_HOST"/manage/master/member/remove?d=$ts&s=$sign&nodeId=1"
+
+
+#--------incorrect--------------
+#curl -v $TEST_HOST"/manage/master/member/add?d=$ts&s=$sign&nodeId=4&ip=127.0.0.1"
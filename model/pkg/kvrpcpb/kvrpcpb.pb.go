@@ -97,6 +97,8 @@
 		UnlockForceRequest
 		DsUnlockForceRequest
 		DsUnlockForceResponse
+		TxnMutation
+		TxnRecord
 */
 package kvrpcpb
 
@@ -158,16 +160,29 @@ const (
 	MatchType_LessOrEqual   MatchType = 4
 	MatchType_Larger        MatchType = 5
 	MatchType_LargerOrEqual MatchType = 6
+	// 以下类型dataserver不支持, 只在gateway中计算
+	MatchType_In        MatchType = 7
+	MatchType_NotIn     MatchType = 8
+	MatchType_Like      MatchType = 9
+	MatchType_NotLike   MatchType = 10
+	MatchType_IsNull    MatchType = 11
+	MatchType_IsNotNull MatchType = 12
 )
 
 var MatchType_name = map[int32]string{
-	0: "Invalid",
-	1: "Equal",
-	2: "NotEqual",
-	3: "Less",
-	4: "LessOrEqual",
-	5: "Larger",
-	6: "LargerOrEqual",
+	0:  "Invalid",
+	1:  "Equal",
+	2:  "NotEqual",
+	3:  "Less",
+	4:  "LessOrEqual",
+	5:  "Larger",
+	6:  "LargerOrEqual",
+	7:  "In",
+	8:  "NotIn",
+	9:  "Like",
+	10: "NotLike",
+	11: "IsNull",
+	12: "IsNotNull",
 }
 var MatchType_value = map[string]int32{
 	"Invalid":       0,
@@ -177,6 +192,12 @@ var MatchType_value = map[string]int32{
 	"LessOrEqual":   4,
 	"Larger":        5,
 	"LargerOrEqual": 6,
+	"In":            7,
+	"NotIn":         8,
+	"Like":          9,
+	"NotLike":       10,
+	"IsNull":        11,
+	"IsNotNull":     12,
 }
 
 func (x MatchType) String() string {
@@ -248,6 +269,32 @@ func (x Operation) String() string {
 }
 func (Operation) EnumDescriptor() ([]byte, []int) { return fileDescriptorKvrpcpb, []int{3} }
 
+type TxnStatus int32
+
+const (
+	TxnStatus_TXN_Invalid TxnStatus = 0
+	// 已通过冲突检查, 提交点
+	TxnStatus_TXN_Committed TxnStatus = 1
+	// 加锁的gateway超时没有提交, 被其他gateway回滚
+	TxnStatus_TXN_Aborted TxnStatus = 2
+)
+
+var TxnStatus_name = map[int32]string{
+	0: "TXN_Invalid",
+	1: "TXN_Committed",
+	2: "TXN_Aborted",
+}
+var TxnStatus_value = map[string]int32{
+	"TXN_Invalid":   0,
+	"TXN_Committed": 1,
+	"TXN_Aborted":   2,
+}
+
+func (x TxnStatus) String() string {
+	return proto.EnumName(TxnStatus_name, int32(x))
+}
+func (TxnStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorKvrpcpb, []int{4} }
+
 type SelectField_Type int32
 
 const (
@@ -2722,6 +2769,96 @@ func (m *DsUnlockForceResponse) GetResp() *LockResponse {
 	return nil
 }
 
+type TxnMutation struct {
+	DbName    string `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// true为删除, 否则写入kv
+	IsDelete bool      `protobuf:"varint,3,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	Kv       *KeyValue `protobuf:"bytes,4,opt,name=kv" json:"kv,omitempty"`
+}
+
+func (m *TxnMutation) Reset()                    { *m = TxnMutation{} }
+func (m *TxnMutation) String() string            { return proto.CompactTextString(m) }
+func (*TxnMutation) ProtoMessage()               {}
+func (*TxnMutation) Descriptor() ([]byte, []int) { return fileDescriptorKvrpcpb, []int{89} }
+
+func (m *TxnMutation) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TxnMutation) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *TxnMutation) GetIsDelete() bool {
+	if m != nil {
+		return m.IsDelete
+	}
+	return false
+}
+
+func (m *TxnMutation) GetKv() *KeyValue {
+	if m != nil {
+		return m.Kv
+	}
+	return nil
+}
+
+// 事务记录, 提交时写入dataserver的txn_record表, 用于其他gateway恢复未完成的写入
+type TxnRecord struct {
+	TxnId     string               `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	Status    TxnStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=kvrpcpb.TxnStatus" json:"status,omitempty"`
+	StartTs   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_ts,json=startTs" json:"start_ts,omitempty"`
+	CommitTs  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=commit_ts,json=commitTs" json:"commit_ts,omitempty"`
+	Mutations []*TxnMutation       `protobuf:"bytes,5,rep,name=mutations" json:"mutations,omitempty"`
+}
+
+func (m *TxnRecord) Reset()                    { *m = TxnRecord{} }
+func (m *TxnRecord) String() string            { return proto.CompactTextString(m) }
+func (*TxnRecord) ProtoMessage()               {}
+func (*TxnRecord) Descriptor() ([]byte, []int) { return fileDescriptorKvrpcpb, []int{90} }
+
+func (m *TxnRecord) GetTxnId() string {
+	if m != nil {
+		return m.TxnId
+	}
+	return ""
+}
+
+func (m *TxnRecord) GetStatus() TxnStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxnStatus_TXN_Invalid
+}
+
+func (m *TxnRecord) GetStartTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTs
+	}
+	return nil
+}
+
+func (m *TxnRecord) GetCommitTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CommitTs
+	}
+	return nil
+}
+
+func (m *TxnRecord) GetMutations() []*TxnMutation {
+	if m != nil {
+		return m.Mutations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*KvPair)(nil), "kvrpcpb.KvPair")
 	proto.RegisterType((*RequestHeader)(nil), "kvrpcpb.RequestHeader")
@@ -2812,10 +2949,13 @@ func init() {
 	proto.RegisterType((*UnlockForceRequest)(nil), "kvrpcpb.UnlockForceRequest")
 	proto.RegisterType((*DsUnlockForceRequest)(nil), "kvrpcpb.DsUnlockForceRequest")
 	proto.RegisterType((*DsUnlockForceResponse)(nil), "kvrpcpb.DsUnlockForceResponse")
+	proto.RegisterType((*TxnMutation)(nil), "kvrpcpb.TxnMutation")
+	proto.RegisterType((*TxnRecord)(nil), "kvrpcpb.TxnRecord")
 	proto.RegisterEnum("kvrpcpb.ExecuteType", ExecuteType_name, ExecuteType_value)
 	proto.RegisterEnum("kvrpcpb.MatchType", MatchType_name, MatchType_value)
 	proto.RegisterEnum("kvrpcpb.ExistCase", ExistCase_name, ExistCase_value)
 	proto.RegisterEnum("kvrpcpb.Operation", Operation_name, Operation_value)
+	proto.RegisterEnum("kvrpcpb.TxnStatus", TxnStatus_name, TxnStatus_value)
 	proto.RegisterEnum("kvrpcpb.SelectField_Type", SelectField_Type_name, SelectField_Type_value)
 }
 func (m *KvPair) Marshal() (dAtA []byte, err error) {
@@ -6146,6 +6286,117 @@ func (m *DsUnlockForceResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TxnMutation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnMutation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
+	}
+	if len(m.TableName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.TableName)))
+		i += copy(dAtA[i:], m.TableName)
+	}
+	if m.IsDelete {
+		dAtA[i] = 0x18
+		i++
+		if m.IsDelete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Kv != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Kv.Size()))
+		n101, err := m.Kv.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	return i, nil
+}
+
+func (m *TxnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxnId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(len(m.TxnId)))
+		i += copy(dAtA[i:], m.TxnId)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.Status))
+	}
+	if m.StartTs != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.StartTs.Size()))
+		n102, err := m.StartTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	if m.CommitTs != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKvrpcpb(dAtA, i, uint64(m.CommitTs.Size()))
+		n103, err := m.CommitTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	if len(m.Mutations) > 0 {
+		for _, msg := range m.Mutations {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintKvrpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintKvrpcpb(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -7520,6 +7771,54 @@ func (m *DsUnlockForceResponse) Size() (n int) {
 	return n
 }
 
+func (m *TxnMutation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.IsDelete {
+		n += 2
+	}
+	if m.Kv != nil {
+		l = m.Kv.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	return n
+}
+
+func (m *TxnRecord) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TxnId)
+	if l > 0 {
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKvrpcpb(uint64(m.Status))
+	}
+	if m.StartTs != nil {
+		l = m.StartTs.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if m.CommitTs != nil {
+		l = m.CommitTs.Size()
+		n += 1 + l + sovKvrpcpb(uint64(l))
+	}
+	if len(m.Mutations) > 0 {
+		for _, e := range m.Mutations {
+			l = e.Size()
+			n += 1 + l + sovKvrpcpb(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovKvrpcpb(x uint64) (n int) {
 	for {
 		n++
@@ -18164,6 +18463,362 @@ func (m *DsUnlockForceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxnMutation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnMutation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnMutation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDelete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kv == nil {
+				m.Kv = &KeyValue{}
+			}
+			if err := m.Kv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKvrpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (TxnStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTs == nil {
+				m.StartTs = &timestamp.Timestamp{}
+			}
+			if err := m.StartTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitTs == nil {
+				m.CommitTs = &timestamp.Timestamp{}
+			}
+			if err := m.CommitTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKvrpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutations = append(m.Mutations, &TxnMutation{})
+			if err := m.Mutations[len(m.Mutations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKvrpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKvrpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKvrpcpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18272,151 +18927,166 @@ var (
 func init() { proto.RegisterFile("kvrpcpb.proto", fileDescriptorKvrpcpb) }
 
 var fileDescriptorKvrpcpb = []byte{
-	// 2322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4b, 0x73, 0xdb, 0xc8,
-	0x11, 0x36, 0x08, 0x92, 0x22, 0x9b, 0x0f, 0x51, 0x63, 0x59, 0x96, 0xed, 0xb5, 0xe2, 0x60, 0xbd,
-	0x5a, 0xad, 0x1c, 0x4b, 0xbb, 0x52, 0xa5, 0x52, 0x9b, 0xcd, 0x21, 0xb6, 0x5e, 0xab, 0xa2, 0x76,
-	0xa5, 0x1a, 0x79, 0x7d, 0xc8, 0x61, 0x59, 0x10, 0x30, 0xa2, 0x10, 0x42, 0x00, 0x0c, 0x80, 0x94,
-	0x98, 0xca, 0xeb, 0x96, 0x53, 0x2e, 0x49, 0x0e, 0xf9, 0x09, 0xb9, 0xe4, 0x9e, 0x9f, 0xb0, 0x87,
-	0xa4, 0x2a, 0x3f, 0x21, 0xe5, 0xfc, 0x91, 0xd4, 0x3c, 0x40, 0x60, 0x40, 0x50, 0xa2, 0x28, 0x6a,
-	0x73, 0x12, 0x66, 0xa6, 0xd1, 0x8f, 0xef, 0xeb, 0x69, 0xf4, 0x0c, 0x05, 0xb5, 0x4e, 0xcf, 0xf7,
-	0x0c, 0xef, 0x64, 0xcd, 0xf3, 0xdd, 0xd0, 0x45, 0x33, 0x62, 0xf8, 0xb8, 0x7a, 0x4e, 0x42, 0x3d,
-	0x9a, 0x7e, 0x5c, 0x23, 0xbe, 0xef, 0xfa, 0x83, 0xe1, 0x6c, 0x68, 0x9d, 0x93, 0x20, 0xd4, 0xcf,
-	0x3d, 0x31, 0x31, 0xdf, 0x76, 0xdb, 0x2e, 0x7b, 0x5c, 0xa7, 0x4f, 0x7c, 0x56, 0xfb, 0x14, 0x8a,
-	0xcd, 0xde, 0x91, 0x6e, 0xf9, 0xa8, 0x01, 0x6a, 0x87, 0xf4, 0x17, 0x95, 0x67, 0xca, 0x4a, 0x15,
-	0xd3, 0x47, 0x34, 0x0f, 0x85, 0x9e, 0x6e, 0x77, 0xc9, 0x62, 0x8e, 0xcd, 0xf1, 0x81, 0xf6, 0x2f,
-	0x05, 0x6a, 0x98, 0xbc, 0xeb, 0x92, 0x20, 0xfc, 0x92, 0xe8, 0x26, 0xf1, 0xd1, 0x53, 0x00, 0xc3,
-	0xee, 0x06, 0x21, 0xf1, 0x5b, 0x96, 0xc9, 0x14, 0xe4, 0x71, 0x59, 0xcc, 0xec, 0x9b, 0x68, 0x03,
-	0xca, 0x03, 0x5f, 0x98, 0xaa, 0xca, 0xc6, 0xfc, 0x5a, 0xec, 0xdd, 0x9b, 0xe8, 0x09, 0xc7, 0x62,
-	0xe8, 0x11, 0x94, 0x42, 0x5f, 0x37, 0x08, 0x55, 0xa8, 0x32, 0x85, 0x33, 0x6c, 0xbc, 0x6f, 0xd2,
-	0x25, 0x5f, 0x77, 0xda, 0x6c, 0x29, 0xcf, 0x97, 0xd8, 0x78, 0xdf, 0x44, 0x9b, 0x50, 0xe1, 0x4b,
-	0xc4, 0x73, 0x8d, 0xb3, 0xc5, 0x02, 0xb3, 0x85, 0xd6, 0x04, 0x4c, 0x98, 0x2e, 0xed, 0xd0, 0x15,
-	0x0c, 0xfe, 0xe0, 0x59, 0xfb, 0xa7, 0x02, 0x75, 0x4c, 0x02, 0xcf, 0x75, 0x02, 0xf2, 0x7f, 0x09,
-	0x68, 0x19, 0x54, 0xc7, 0xbd, 0x60, 0xb1, 0x8c, 0x52, 0x44, 0x05, 0xd0, 0x73, 0x28, 0x30, 0x8a,
-	0x45, 0x5c, 0xf5, 0xb5, 0x88, 0xf0, 0x1d, 0xfa, 0x17, 0xf3, 0x45, 0xcd, 0x85, 0xb9, 0xed, 0xa0,
-	0xd9, 0xc3, 0xfa, 0xc5, 0x1e, 0x09, 0x05, 0x4f, 0x68, 0x0d, 0x8a, 0x67, 0x2c, 0x34, 0x16, 0x4c,
-	0x65, 0x63, 0x61, 0x2d, 0x4a, 0x29, 0x89, 0x49, 0x2c, 0xa4, 0xd0, 0x2a, 0xa8, 0x3e, 0x79, 0x27,
-	0x62, 0x5b, 0x1c, 0x08, 0xa7, 0xd4, 0x62, 0x2a, 0xa4, 0x85, 0x80, 0x92, 0x06, 0x39, 0x90, 0x68,
-	0x3d, 0x65, 0xf1, 0x61, 0xc2, 0x62, 0x12, 0xeb, 0x81, 0xc9, 0x97, 0x90, 0xf7, 0x49, 0x10, 0xe1,
-	0xf9, 0x28, 0xc3, 0x26, 0x7f, 0x0d, 0x33, 0x31, 0xed, 0x43, 0x98, 0x4d, 0x07, 0x39, 0x94, 0xc0,
-	0xda, 0xcf, 0xa0, 0x31, 0xe4, 0x18, 0x82, 0xbc, 0xe1, 0x9a, 0x84, 0x89, 0x15, 0x30, 0x7b, 0x1e,
-	0x91, 0xe8, 0x31, 0x92, 0x47, 0xdd, 0x3b, 0x41, 0x32, 0x56, 0x9b, 0x46, 0x92, 0xad, 0xdc, 0x09,
-	0x92, 0x09, 0xcd, 0x02, 0xc9, 0xcf, 0x05, 0x92, 0x89, 0x20, 0xc7, 0x2d, 0x05, 0xcb, 0x02, 0xdf,
-	0xa4, 0xbb, 0x19, 0xf8, 0x6a, 0x5d, 0x98, 0x17, 0x81, 0x6d, 0x13, 0x9b, 0x84, 0x64, 0x52, 0x30,
-	0x5f, 0x26, 0xc1, 0x7c, 0x22, 0x07, 0x26, 0x69, 0xe6, 0x78, 0xfe, 0x0a, 0x1e, 0xa4, 0xcc, 0x4e,
-	0x0a, 0xe9, 0xa7, 0x12, 0xa4, 0x1f, 0x64, 0x5b, 0x96, 0x50, 0x5d, 0x06, 0x94, 0x11, 0xf0, 0x70,
-	0x8a, 0x7e, 0x02, 0xf7, 0xb3, 0x3c, 0xcc, 0x42, 0xf1, 0x84, 0xa2, 0x4d, 0x4b, 0x35, 0xd6, 0x2f,
-	0x76, 0x2e, 0x89, 0xd1, 0x0d, 0x09, 0x7a, 0x0e, 0x39, 0xd3, 0x65, 0x52, 0xf5, 0x8d, 0xf9, 0x81,
-	0x5b, 0x62, 0xf5, 0x4d, 0xdf, 0x23, 0x38, 0x67, 0xba, 0x68, 0x05, 0x66, 0x3a, 0xbd, 0x96, 0xa7,
-	0x5b, 0xbe, 0x88, 0x60, 0x36, 0x11, 0x01, 0xd3, 0x58, 0xec, 0xb0, 0xbf, 0xda, 0xc5, 0x00, 0x32,
-	0xa1, 0x63, 0x52, 0xaa, 0xd6, 0x92, 0x54, 0xa5, 0x00, 0x93, 0x55, 0x73, 0xae, 0x7e, 0x0d, 0x0b,
-	0x69, 0xc3, 0x93, 0x92, 0xf5, 0x99, 0x44, 0xd6, 0xd3, 0x11, 0xb6, 0x25, 0xb6, 0x76, 0x05, 0x0b,
-	0xa9, 0xa0, 0xd7, 0xa1, 0x40, 0x2e, 0x89, 0x11, 0x2c, 0x2a, 0xcf, 0xd4, 0xd4, 0x56, 0x92, 0x79,
-	0xc0, 0x5c, 0x4e, 0x5b, 0x85, 0xf9, 0xcc, 0x18, 0xb2, 0xe8, 0xdc, 0x84, 0xc2, 0xb1, 0xe1, 0x7a,
-	0xac, 0xfa, 0x04, 0xa1, 0xee, 0x87, 0x22, 0x2d, 0xf8, 0x80, 0xce, 0xda, 0xd6, 0xb9, 0x15, 0x46,
-	0x3b, 0x8e, 0x0d, 0xb4, 0xbf, 0x29, 0x50, 0x39, 0x26, 0x36, 0x31, 0xc2, 0x5d, 0x8b, 0xd8, 0x26,
-	0x7a, 0x01, 0x6a, 0xd8, 0xf7, 0x44, 0x02, 0xc4, 0xfe, 0x25, 0x44, 0xd6, 0x58, 0x16, 0x50, 0x29,
-	0xfa, 0x59, 0xd3, 0xdb, 0x6d, 0x9f, 0xb4, 0x4e, 0xbb, 0x8e, 0xc1, 0xf4, 0x96, 0x71, 0x99, 0xcd,
-	0xec, 0x76, 0x1d, 0x03, 0x2d, 0x43, 0xd1, 0x70, 0xed, 0xee, 0xb9, 0xc3, 0x3e, 0x50, 0xf4, 0x03,
-	0x23, 0x3e, 0x9c, 0x5b, 0x6c, 0x16, 0x8b, 0x55, 0xed, 0x23, 0xc8, 0x53, 0x9d, 0x08, 0xa0, 0xc8,
-	0x57, 0x1a, 0xf7, 0xd0, 0x1c, 0xd4, 0x5e, 0x45, 0x8a, 0x42, 0xcb, 0x75, 0x1a, 0x8a, 0xf6, 0x7b,
-	0x05, 0x0a, 0x5f, 0xe9, 0xa1, 0x71, 0x96, 0x50, 0xac, 0x5c, 0xa5, 0x18, 0x7d, 0x00, 0xe5, 0xf0,
-	0xcc, 0x27, 0xc1, 0x99, 0x6b, 0x9b, 0x22, 0xec, 0x78, 0x02, 0x7d, 0x06, 0x70, 0x4e, 0xd5, 0xb5,
-	0xc2, 0xbe, 0x47, 0x98, 0x8b, 0xf5, 0x0d, 0x34, 0x88, 0x98, 0x59, 0x62, 0xa1, 0x96, 0xcf, 0xa3,
-	0x47, 0xed, 0xc7, 0x50, 0x38, 0xa0, 0xb0, 0xa1, 0x05, 0x28, 0xba, 0xa7, 0xa7, 0x01, 0x09, 0xc5,
-	0xc7, 0x5c, 0x8c, 0x28, 0xc8, 0x86, 0xdb, 0x75, 0x38, 0xc8, 0x79, 0xcc, 0x07, 0x5a, 0x07, 0x66,
-	0xb7, 0x03, 0x0e, 0xe1, 0xa4, 0xe9, 0xbf, 0x92, 0x4c, 0xff, 0x85, 0x14, 0x2f, 0x52, 0xe2, 0xff,
-	0x23, 0x07, 0x35, 0xd9, 0xd6, 0x70, 0xf5, 0x7d, 0x0e, 0x85, 0x80, 0xa6, 0x8a, 0xd0, 0x57, 0x8f,
-	0xf5, 0xd1, 0x59, 0xcc, 0x17, 0xd1, 0x26, 0xc0, 0x29, 0x65, 0xbc, 0x65, 0x5b, 0x41, 0xb8, 0xa8,
-	0xb2, 0x94, 0x9d, 0xcf, 0x4a, 0x09, 0x5c, 0x66, 0x72, 0x07, 0x56, 0x10, 0xa2, 0x4d, 0xa8, 0x5d,
-	0x9c, 0x11, 0x9a, 0x13, 0x96, 0x1d, 0x12, 0x3f, 0x58, 0xcc, 0xb3, 0xf7, 0xea, 0x32, 0xb0, 0xb8,
-	0xca, 0x84, 0x76, 0xb9, 0x0c, 0x7a, 0x01, 0xe5, 0xb6, 0xef, 0x76, 0xbd, 0xd6, 0x49, 0x3f, 0x58,
-	0x2c, 0x88, 0x17, 0x64, 0x4e, 0x4b, 0x4c, 0xe0, 0x75, 0x3f, 0xa0, 0xce, 0xf3, 0x44, 0x2e, 0xa6,
-	0x9c, 0x67, 0xd4, 0x88, 0xc4, 0x96, 0x7b, 0xaa, 0x99, 0xb1, 0x7a, 0x2a, 0xed, 0x0d, 0xa8, 0xd8,
-	0xbd, 0xc8, 0xc0, 0x6b, 0x01, 0x8a, 0x2c, 0xc2, 0x40, 0x64, 0x91, 0x18, 0xa1, 0x0f, 0xa1, 0xc6,
-	0xd2, 0xdd, 0x6c, 0x31, 0xa2, 0x03, 0x06, 0x92, 0x8a, 0xab, 0x7c, 0x72, 0x8b, 0xcd, 0x69, 0x1e,
-	0x34, 0x62, 0xf6, 0x27, 0xad, 0x41, 0x2f, 0xa4, 0x1a, 0xf4, 0x70, 0x28, 0x01, 0xa4, 0xea, 0xf3,
-	0x2d, 0xd4, 0x53, 0xf6, 0xb2, 0x9a, 0x94, 0x67, 0x90, 0xf7, 0xdd, 0x0b, 0x1a, 0x12, 0xc5, 0xbb,
-	0x1a, 0x7b, 0xe0, 0x5e, 0x60, 0xb6, 0x92, 0xc8, 0x72, 0x35, 0x99, 0xe5, 0xda, 0xd7, 0x50, 0x6a,
-	0x92, 0xfe, 0x5b, 0xfa, 0xc9, 0xa6, 0x60, 0x35, 0x63, 0xb0, 0x9a, 0xfc, 0xd3, 0xfe, 0x36, 0xf9,
-	0x69, 0xe7, 0x72, 0x8f, 0xa1, 0xb4, 0x73, 0xe9, 0x59, 0x3e, 0x79, 0xc5, 0xb5, 0xa9, 0x78, 0x30,
-	0xe6, 0xfb, 0x63, 0xdf, 0x09, 0x88, 0x3f, 0xed, 0xfd, 0x21, 0x29, 0xe5, 0xfb, 0x83, 0xd1, 0x11,
-	0xcd, 0x4f, 0x9b, 0x0e, 0x59, 0xaf, 0xa0, 0xe3, 0xcf, 0x0a, 0xd4, 0xe4, 0xe8, 0x3e, 0x12, 0xd0,
-	0xf3, 0xcf, 0xc0, 0x5c, 0xfc, 0x19, 0x10, 0xa8, 0x0a, 0xfc, 0x3f, 0x86, 0x59, 0xe3, 0x8c, 0x18,
-	0x9d, 0x96, 0xd9, 0xf5, 0x6c, 0xcb, 0xd0, 0x43, 0x8e, 0x69, 0x09, 0xd7, 0xd9, 0xf4, 0x76, 0x34,
-	0x2b, 0x27, 0xbb, 0x3a, 0x5e, 0xb2, 0x3b, 0x50, 0x4f, 0xa1, 0x90, 0x95, 0x24, 0x34, 0xc3, 0x4f,
-	0x4f, 0x89, 0x11, 0x12, 0xb3, 0xd5, 0x21, 0xfd, 0x40, 0x14, 0xb6, 0x6a, 0x34, 0xd9, 0x24, 0x7d,
-	0xb6, 0x0d, 0x06, 0x1e, 0x52, 0x29, 0xe6, 0x42, 0x15, 0x57, 0x07, 0x93, 0x4d, 0xd2, 0xd7, 0x7e,
-	0x0e, 0xe8, 0x35, 0xdd, 0xfa, 0x32, 0x12, 0xab, 0x14, 0xc8, 0x77, 0x11, 0x12, 0xa3, 0x88, 0x63,
-	0x32, 0xda, 0x36, 0xdc, 0x97, 0x34, 0x08, 0xb7, 0x5f, 0x42, 0x81, 0xc2, 0x1c, 0x25, 0xf2, 0x48,
-	0x32, 0xb8, 0x14, 0x4f, 0xb6, 0xdb, 0xb5, 0x8d, 0x23, 0x92, 0x2d, 0xa3, 0x63, 0x64, 0xc9, 0x76,
-	0xdb, 0x66, 0x71, 0x54, 0xb2, 0x65, 0xf6, 0x89, 0xdf, 0x29, 0x50, 0xbb, 0xa6, 0x47, 0x1c, 0xbb,
-	0xfc, 0xa7, 0x2a, 0xb9, 0x3a, 0x46, 0x25, 0x5f, 0x80, 0xa2, 0xe5, 0x98, 0xe4, 0x92, 0xd7, 0xfd,
-	0x3c, 0x16, 0x23, 0x39, 0x43, 0x61, 0xbc, 0x0c, 0xdd, 0x87, 0xfa, 0xf5, 0x5d, 0xec, 0x58, 0x19,
-	0xaa, 0xfd, 0x14, 0x0a, 0xbc, 0xbf, 0x79, 0x02, 0x65, 0xde, 0x1c, 0xc4, 0x07, 0xf1, 0x12, 0x9f,
-	0xd8, 0x37, 0x47, 0x1c, 0x4a, 0x7e, 0x02, 0x35, 0x4c, 0x4c, 0x2b, 0x48, 0x96, 0xbc, 0xb1, 0x4e,
-	0x33, 0xbf, 0x81, 0x19, 0xf6, 0xe2, 0xb6, 0x3b, 0xee, 0x2b, 0x48, 0x83, 0x9c, 0xeb, 0x0d, 0xf5,
-	0x22, 0x87, 0x1e, 0xf1, 0x75, 0xda, 0x05, 0xe1, 0x9c, 0xeb, 0xa1, 0x65, 0xc8, 0x1b, 0x7a, 0x40,
-	0xd8, 0xf9, 0x3e, 0x29, 0xb5, 0x73, 0x69, 0x05, 0xe1, 0x96, 0x4e, 0x33, 0x81, 0xae, 0x6b, 0xdf,
-	0x42, 0xb5, 0xd9, 0x3b, 0x8e, 0x8f, 0xb3, 0xcb, 0x90, 0xeb, 0xf4, 0x32, 0x32, 0x3c, 0x11, 0x1a,
-	0xce, 0x75, 0x7a, 0x03, 0xfd, 0xb9, 0x6b, 0xf4, 0x7f, 0x09, 0x35, 0xa1, 0xff, 0xb6, 0xec, 0x58,
-	0x50, 0xa7, 0xbd, 0xfa, 0xf1, 0xe4, 0xf7, 0x0b, 0x1f, 0x27, 0x77, 0xe4, 0x83, 0x44, 0x5b, 0x7d,
-	0x9c, 0xba, 0x5c, 0x70, 0xe8, 0xee, 0x97, 0xdd, 0xbe, 0xf1, 0x7e, 0x5c, 0x95, 0xf6, 0xe3, 0x42,
-	0xda, 0x9a, 0xb4, 0x1d, 0x9f, 0x51, 0x12, 0xae, 0xbc, 0x53, 0xf8, 0x9c, 0xc2, 0x38, 0xd9, 0x85,
-	0x82, 0xc0, 0x6d, 0xef, 0x0e, 0x70, 0xdb, 0xcb, 0xc6, 0x6d, 0xef, 0x6e, 0x70, 0x1b, 0xbe, 0x8e,
-	0x21, 0x30, 0xd7, 0xec, 0xb1, 0x6a, 0x9f, 0xc8, 0x8a, 0x15, 0x50, 0x3b, 0xbd, 0xe1, 0x6f, 0x85,
-	0x9c, 0xc2, 0x54, 0x64, 0xec, 0x1c, 0xfe, 0x8a, 0x9e, 0xaa, 0x63, 0x33, 0xb7, 0x4d, 0xe4, 0x00,
-	0xee, 0x53, 0x94, 0xd2, 0x7e, 0xdf, 0x94, 0x95, 0x1f, 0x25, 0x59, 0x79, 0x9c, 0xc0, 0x29, 0xa5,
-	0x98, 0x53, 0x73, 0xc9, 0x2f, 0x43, 0x86, 0xa2, 0xb8, 0x31, 0x3f, 0xeb, 0x12, 0x3f, 0x4f, 0x32,
-	0xed, 0x4a, 0x24, 0x7d, 0x31, 0x20, 0x29, 0x91, 0x82, 0x59, 0xe0, 0x21, 0xc8, 0x0b, 0xcc, 0xd4,
-	0x95, 0x2a, 0x66, 0xcf, 0x1a, 0x1e, 0x40, 0x7f, 0x5d, 0xf2, 0x0b, 0xda, 0x73, 0xd7, 0xd2, 0x2e,
-	0xe1, 0xbf, 0x77, 0x57, 0xf8, 0xef, 0x5d, 0x81, 0xff, 0xde, 0x1d, 0xe2, 0x3f, 0xbc, 0x49, 0xfe,
-	0xa4, 0xb0, 0x12, 0x6c, 0xe8, 0x4e, 0x14, 0xe9, 0x0d, 0x8e, 0xfe, 0xec, 0x52, 0x9a, 0x9e, 0x50,
-	0x5a, 0xae, 0x63, 0xf3, 0x96, 0xad, 0x84, 0xcb, 0x6c, 0xe6, 0xd0, 0xb1, 0xfb, 0xe8, 0x11, 0x94,
-	0x3a, 0xa4, 0xcf, 0x17, 0xf3, 0x6c, 0x71, 0xa6, 0x43, 0xfa, 0x6c, 0xe9, 0x09, 0x94, 0xcf, 0xf5,
-	0x4b, 0x7e, 0xe6, 0x61, 0x97, 0xc7, 0x2a, 0x2e, 0x9d, 0xeb, 0x97, 0xec, 0xbc, 0xa3, 0xfd, 0x0e,
-	0xea, 0x91, 0x4f, 0x57, 0x17, 0xb4, 0xf8, 0xa0, 0xac, 0x8a, 0x83, 0x72, 0xc4, 0xb4, 0x7a, 0xfd,
-	0x06, 0x7f, 0x04, 0x25, 0x5b, 0x0f, 0x42, 0xd6, 0x6d, 0xe6, 0x59, 0x54, 0x33, 0x74, 0x4c, 0x1b,
-	0xcd, 0x8e, 0x28, 0xf1, 0x09, 0x58, 0xa6, 0xd4, 0xe0, 0x49, 0x4a, 0x13, 0x0d, 0x5e, 0x2a, 0xde,
-	0xa9, 0x35, 0x78, 0xb2, 0x5e, 0x41, 0x7a, 0x13, 0x66, 0x9b, 0xbd, 0xeb, 0x3a, 0xbc, 0x71, 0xeb,
-	0x5f, 0x13, 0x1a, 0xb1, 0xb2, 0xdb, 0x56, 0x3f, 0x71, 0xbf, 0x7d, 0xbb, 0xde, 0x7a, 0xe4, 0xfd,
-	0x76, 0x46, 0x77, 0x2d, 0xee, 0xb7, 0x6f, 0xdb, 0x5f, 0x8f, 0xbe, 0xdf, 0xce, 0xec, 0xb0, 0x31,
-	0xcc, 0x8b, 0x1d, 0x29, 0x47, 0x1a, 0x15, 0x39, 0x25, 0x2e, 0x72, 0x63, 0xf3, 0x70, 0x04, 0x0f,
-	0x52, 0x3a, 0x6f, 0x4b, 0x46, 0x9f, 0xdf, 0x7f, 0x66, 0xf8, 0x79, 0x53, 0x46, 0xd6, 0x93, 0x8c,
-	0x3c, 0x4d, 0x57, 0xa5, 0x0c, 0x5a, 0x7e, 0x0b, 0x0f, 0x87, 0x4c, 0x4f, 0xca, 0xcd, 0x86, 0xc4,
-	0xcd, 0xd2, 0x28, 0xeb, 0x12, 0x41, 0x7f, 0x50, 0xf8, 0xad, 0xa9, 0xd3, 0x26, 0x72, 0xe4, 0x37,
-	0xa9, 0x8e, 0x52, 0x8d, 0x53, 0xe5, 0x1a, 0x37, 0x76, 0x0b, 0xde, 0xa1, 0xb4, 0x4a, 0x8e, 0xdc,
-	0xf6, 0xa8, 0x9d, 0xac, 0x7b, 0xaa, 0x5c, 0xf7, 0xfa, 0xd1, 0x8d, 0xf7, 0x50, 0xdc, 0x53, 0x63,
-	0x7c, 0x58, 0xb7, 0xc4, 0x78, 0x56, 0xa4, 0x53, 0x64, 0x3c, 0x43, 0xbd, 0x60, 0xfc, 0x2f, 0x0a,
-	0x94, 0x0f, 0x5c, 0xa3, 0xc3, 0xcf, 0x67, 0xd9, 0x47, 0xab, 0x3a, 0xe4, 0xc4, 0x4f, 0xa5, 0x65,
-	0x9c, 0xb3, 0x4c, 0xf4, 0x03, 0xa8, 0x98, 0x4c, 0x57, 0x8b, 0x9e, 0x38, 0x19, 0x95, 0x2a, 0x06,
-	0x3e, 0x45, 0x8f, 0xa3, 0x54, 0xa0, 0xeb, 0x99, 0x7a, 0x24, 0xc0, 0xbf, 0x73, 0xc0, 0xa7, 0x22,
-	0x01, 0xa1, 0xe1, 0xd4, 0xd6, 0xdb, 0xec, 0x3a, 0xb2, 0x14, 0x69, 0xd8, 0xb5, 0xf5, 0xb6, 0xf6,
-	0x47, 0x05, 0x2a, 0xd4, 0xad, 0xd1, 0x75, 0x7a, 0x25, 0xe9, 0x6a, 0x25, 0x91, 0x49, 0x83, 0x68,
-	0x22, 0xf7, 0x27, 0x38, 0x40, 0xd3, 0x90, 0x4f, 0xfa, 0x8b, 0x15, 0x1e, 0xf2, 0x49, 0x5f, 0x6b,
-	0x43, 0x6d, 0x3b, 0x48, 0x3a, 0x74, 0xd3, 0xc4, 0x58, 0x4e, 0x26, 0xc6, 0xbc, 0xe4, 0xac, 0x94,
-	0x0f, 0x2e, 0x54, 0xf9, 0x5c, 0x46, 0xba, 0xab, 0x71, 0x07, 0xc0, 0x7f, 0x7d, 0xe6, 0xbf, 0x1b,
-	0xf0, 0x41, 0xcc, 0x9d, 0x9a, 0xe4, 0x2e, 0x45, 0x45, 0x3e, 0x4d, 0x85, 0x66, 0xd3, 0x93, 0x90,
-	0x64, 0xf2, 0xc6, 0x79, 0xf7, 0x89, 0x94, 0x77, 0x0f, 0x52, 0xc1, 0x49, 0xe9, 0xf6, 0x77, 0x05,
-	0xe6, 0xe8, 0xf4, 0x37, 0xcc, 0x81, 0xd1, 0xec, 0x66, 0xa4, 0xdc, 0xd5, 0x19, 0xf5, 0x43, 0xa8,
-	0x0a, 0x01, 0x0e, 0x42, 0x91, 0xe9, 0x12, 0x2f, 0xbd, 0x9d, 0x34, 0x0f, 0x78, 0x5b, 0x3c, 0xec,
-	0xf0, 0x94, 0xda, 0xe2, 0x21, 0xc5, 0x3c, 0x07, 0x7c, 0xda, 0x16, 0x27, 0xd7, 0xbe, 0x07, 0x62,
-	0xba, 0x50, 0xfb, 0xc6, 0xb1, 0xaf, 0xdc, 0x71, 0x69, 0x4e, 0xa6, 0xb1, 0xaf, 0x58, 0xc7, 0x29,
-	0x1b, 0x9e, 0x52, 0xc7, 0x29, 0x29, 0x8d, 0x4e, 0xe2, 0x8d, 0xd8, 0xd8, 0xf7, 0x80, 0xe9, 0x2f,
-	0x01, 0x71, 0x6b, 0xbb, 0xae, 0x6f, 0x5c, 0x91, 0xec, 0xd3, 0x00, 0x92, 0xfd, 0xae, 0x9f, 0x61,
-	0x6d, 0x4a, 0xbf, 0xeb, 0x0f, 0x6b, 0xe6, 0x90, 0x06, 0xf0, 0x20, 0x65, 0xf6, 0xee, 0x71, 0x5d,
-	0xfd, 0x02, 0x2a, 0x89, 0x9f, 0xd5, 0xd1, 0x2c, 0x1f, 0xee, 0x3b, 0x3d, 0xdd, 0xb6, 0xcc, 0xc6,
-	0x3d, 0x54, 0x81, 0x19, 0x3a, 0x71, 0xd4, 0x0d, 0x1b, 0x0a, 0xaa, 0x03, 0xd0, 0x01, 0xff, 0xf8,
-	0x35, 0x72, 0xab, 0x1d, 0x28, 0x0f, 0x7e, 0xa0, 0xa4, 0x92, 0xf1, 0x6b, 0x65, 0x28, 0xec, 0xbc,
-	0xeb, 0xea, 0x76, 0x43, 0x41, 0x55, 0x28, 0x7d, 0xed, 0x86, 0x7c, 0x94, 0x43, 0x25, 0xc8, 0x1f,
-	0x90, 0x20, 0x68, 0xa8, 0xd4, 0x14, 0x7d, 0x3a, 0xf4, 0xf9, 0x52, 0x1e, 0x01, 0x14, 0x0f, 0x74,
-	0xbf, 0x4d, 0xfc, 0x46, 0x01, 0xcd, 0x41, 0x8d, 0x3f, 0x47, 0xcb, 0xc5, 0xd5, 0x5f, 0x40, 0x79,
-	0xd0, 0xd8, 0x30, 0x4f, 0xb6, 0x5a, 0xb1, 0xbd, 0x06, 0x54, 0x77, 0xb6, 0x5a, 0xd4, 0x0e, 0x15,
-	0x09, 0x1a, 0x0a, 0xaa, 0x41, 0x79, 0x67, 0xab, 0x25, 0x86, 0x39, 0xf1, 0xc2, 0x2b, 0xa7, 0x4f,
-	0x5f, 0x6f, 0xa8, 0xd4, 0xab, 0x9d, 0xad, 0x16, 0xc3, 0xb9, 0x91, 0x5f, 0x7d, 0x0d, 0xe5, 0xc1,
-	0xed, 0x26, 0x15, 0x3d, 0x3c, 0x4a, 0xe8, 0x06, 0x28, 0x1e, 0x1e, 0xb5, 0x8e, 0x49, 0xc8, 0xb5,
-	0x1e, 0x1e, 0xb5, 0x22, 0x00, 0xc4, 0xd2, 0x1e, 0x09, 0x1b, 0xea, 0xeb, 0xc6, 0x77, 0xef, 0x97,
-	0x94, 0x7f, 0xbf, 0x5f, 0x52, 0xfe, 0xf3, 0x7e, 0x49, 0xf9, 0xeb, 0x7f, 0x97, 0xee, 0x9d, 0x14,
-	0xd9, 0xff, 0xa2, 0x6d, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xcd, 0xdb, 0x4d, 0xe9, 0x26,
-	0x00, 0x00,
+	// 2568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x5f, 0x8a, 0x92, 0x2c, 0x3e, 0xfd, 0x31, 0x3d, 0x6b, 0x3b, 0x4e, 0xb2, 0x9b, 0x66, 0xb9,
+	0x59, 0xaf, 0xd7, 0x69, 0xec, 0x8d, 0x83, 0xa2, 0xd8, 0x6e, 0x0f, 0x9b, 0xf8, 0xdf, 0x0a, 0x4a,
+	0x6c, 0x83, 0xf6, 0x06, 0x45, 0x0f, 0x2b, 0xd0, 0xe4, 0xd8, 0x66, 0x45, 0x91, 0x0a, 0x49, 0xc9,
+	0x52, 0xd1, 0x7f, 0x97, 0xa2, 0xa7, 0x5e, 0xda, 0x1e, 0xfa, 0x11, 0x7a, 0x29, 0xd0, 0x63, 0x3f,
+	0xc2, 0x1e, 0x5a, 0xa0, 0x1f, 0xa1, 0x48, 0x81, 0x7e, 0x8e, 0x62, 0xfe, 0x50, 0xe4, 0x50, 0x94,
+	0x2d, 0xdb, 0x72, 0x7a, 0x12, 0x67, 0xe6, 0xf1, 0xcd, 0x7b, 0xbf, 0xdf, 0x9b, 0x37, 0x6f, 0x86,
+	0x82, 0x6a, 0xab, 0xe7, 0x77, 0xcc, 0xce, 0xf1, 0x5a, 0xc7, 0xf7, 0x42, 0x0f, 0xcd, 0xf0, 0xe6,
+	0xbd, 0x4a, 0x1b, 0x87, 0x46, 0xd4, 0x7d, 0xaf, 0x8a, 0x7d, 0xdf, 0xf3, 0x87, 0xcd, 0xd9, 0xd0,
+	0x6e, 0xe3, 0x20, 0x34, 0xda, 0x1d, 0xde, 0x31, 0x7f, 0xea, 0x9d, 0x7a, 0xf4, 0x71, 0x9d, 0x3c,
+	0xb1, 0x5e, 0xed, 0x73, 0x28, 0x36, 0x7a, 0x07, 0x86, 0xed, 0x23, 0x15, 0xe4, 0x16, 0x1e, 0x2c,
+	0x49, 0x0f, 0xa5, 0x95, 0x8a, 0x4e, 0x1e, 0xd1, 0x3c, 0x14, 0x7a, 0x86, 0xd3, 0xc5, 0x4b, 0x39,
+	0xda, 0xc7, 0x1a, 0xda, 0x3f, 0x25, 0xa8, 0xea, 0xf8, 0x4d, 0x17, 0x07, 0xe1, 0xd7, 0xd8, 0xb0,
+	0xb0, 0x8f, 0x3e, 0x04, 0x30, 0x9d, 0x6e, 0x10, 0x62, 0xbf, 0x69, 0x5b, 0x54, 0x41, 0x5e, 0x57,
+	0x78, 0x4f, 0xdd, 0x42, 0x1b, 0xa0, 0x0c, 0x6d, 0xa1, 0xaa, 0xca, 0x1b, 0xf3, 0x6b, 0xb1, 0x75,
+	0x47, 0xd1, 0x93, 0x1e, 0x8b, 0xa1, 0xbb, 0x50, 0x0a, 0x7d, 0xc3, 0xc4, 0x44, 0xa1, 0x4c, 0x15,
+	0xce, 0xd0, 0x76, 0xdd, 0x22, 0x43, 0xbe, 0xe1, 0x9e, 0xd2, 0xa1, 0x3c, 0x1b, 0xa2, 0xed, 0xba,
+	0x85, 0x9e, 0x41, 0x99, 0x0d, 0xe1, 0x8e, 0x67, 0x9e, 0x2d, 0x15, 0xe8, 0x5c, 0x68, 0x8d, 0xc3,
+	0xa4, 0x93, 0xa1, 0x6d, 0x32, 0xa2, 0x83, 0x3f, 0x7c, 0xd6, 0xfe, 0x21, 0x41, 0x4d, 0xc7, 0x41,
+	0xc7, 0x73, 0x03, 0xfc, 0x7f, 0x71, 0x68, 0x19, 0x64, 0xd7, 0x3b, 0xa7, 0xbe, 0x8c, 0x53, 0x44,
+	0x04, 0xd0, 0x23, 0x28, 0x50, 0x8a, 0xb9, 0x5f, 0xb5, 0xb5, 0x88, 0xf0, 0x6d, 0xf2, 0xab, 0xb3,
+	0x41, 0xcd, 0x83, 0xb9, 0xad, 0xa0, 0xd1, 0xd3, 0x8d, 0xf3, 0x5d, 0x1c, 0x72, 0x9e, 0xd0, 0x1a,
+	0x14, 0xcf, 0xa8, 0x6b, 0xd4, 0x99, 0xf2, 0xc6, 0xe2, 0x5a, 0x14, 0x52, 0x02, 0x93, 0x3a, 0x97,
+	0x42, 0xab, 0x20, 0xfb, 0xf8, 0x0d, 0xf7, 0x6d, 0x69, 0x28, 0x9c, 0x52, 0xab, 0x13, 0x21, 0x2d,
+	0x04, 0x94, 0x9c, 0x90, 0x01, 0x89, 0xd6, 0x53, 0x33, 0xde, 0x49, 0xcc, 0x98, 0xc4, 0x7a, 0x38,
+	0xe5, 0x13, 0xc8, 0xfb, 0x38, 0x88, 0xf0, 0xbc, 0x9b, 0x31, 0x27, 0x7b, 0x4d, 0xa7, 0x62, 0xda,
+	0xc7, 0x30, 0x9b, 0x76, 0x72, 0x24, 0x80, 0xb5, 0x1f, 0x83, 0x3a, 0x62, 0x18, 0x82, 0xbc, 0xe9,
+	0x59, 0x98, 0x8a, 0x15, 0x74, 0xfa, 0x3c, 0x26, 0xd0, 0x63, 0x24, 0x0f, 0xba, 0xb7, 0x82, 0x64,
+	0xac, 0x36, 0x8d, 0x24, 0x1d, 0xb9, 0x15, 0x24, 0x13, 0x9a, 0x39, 0x92, 0x5f, 0x70, 0x24, 0x13,
+	0x4e, 0x4e, 0x9a, 0x0a, 0x96, 0x39, 0xbe, 0x49, 0x73, 0x33, 0xf0, 0xd5, 0xba, 0x30, 0xcf, 0x1d,
+	0xdb, 0xc2, 0x0e, 0x0e, 0xf1, 0x75, 0xc1, 0x7c, 0x92, 0x04, 0xf3, 0xbe, 0xe8, 0x98, 0xa0, 0x99,
+	0xe1, 0xf9, 0x73, 0x58, 0x48, 0x4d, 0x7b, 0x5d, 0x48, 0x3f, 0x17, 0x20, 0xfd, 0x20, 0x7b, 0x66,
+	0x01, 0xd5, 0x65, 0x40, 0x19, 0x0e, 0x8f, 0x86, 0xe8, 0x67, 0xf0, 0x7e, 0x96, 0x85, 0x59, 0x28,
+	0x1e, 0x13, 0xb4, 0x49, 0xaa, 0xd6, 0x8d, 0xf3, 0xed, 0x3e, 0x36, 0xbb, 0x21, 0x46, 0x8f, 0x20,
+	0x67, 0x79, 0x54, 0xaa, 0xb6, 0x31, 0x3f, 0x34, 0x8b, 0x8f, 0x1e, 0x0d, 0x3a, 0x58, 0xcf, 0x59,
+	0x1e, 0x5a, 0x81, 0x99, 0x56, 0xaf, 0xd9, 0x31, 0x6c, 0x9f, 0x7b, 0x30, 0x9b, 0xf0, 0x80, 0x6a,
+	0x2c, 0xb6, 0xe8, 0xaf, 0x76, 0x3e, 0x84, 0x8c, 0xeb, 0xb8, 0x2e, 0x55, 0x6b, 0x49, 0xaa, 0x52,
+	0x80, 0x89, 0xaa, 0x19, 0x57, 0xbf, 0x80, 0xc5, 0xf4, 0xc4, 0xd7, 0x25, 0xeb, 0xa9, 0x40, 0xd6,
+	0x87, 0x63, 0xe6, 0x16, 0xd8, 0xda, 0xe1, 0x2c, 0xa4, 0x9c, 0x5e, 0x87, 0x02, 0xee, 0x63, 0x33,
+	0x58, 0x92, 0x1e, 0xca, 0xa9, 0xa5, 0x24, 0xf2, 0xa0, 0x33, 0x39, 0x6d, 0x15, 0xe6, 0x33, 0x7d,
+	0xc8, 0xa2, 0xf3, 0x19, 0x14, 0x0e, 0x4d, 0xaf, 0x43, 0xb3, 0x4f, 0x10, 0x1a, 0x7e, 0xc8, 0xc3,
+	0x82, 0x35, 0x48, 0xaf, 0x63, 0xb7, 0xed, 0x30, 0x5a, 0x71, 0xb4, 0xa1, 0xfd, 0x45, 0x82, 0xf2,
+	0x21, 0x76, 0xb0, 0x19, 0xee, 0xd8, 0xd8, 0xb1, 0xd0, 0x63, 0x90, 0xc3, 0x41, 0x87, 0x07, 0x40,
+	0x6c, 0x5f, 0x42, 0x64, 0x8d, 0x46, 0x01, 0x91, 0x22, 0xdb, 0x9a, 0x71, 0x7a, 0xea, 0xe3, 0xe6,
+	0x49, 0xd7, 0x35, 0xa9, 0x5e, 0x45, 0x57, 0x68, 0xcf, 0x4e, 0xd7, 0x35, 0xd1, 0x32, 0x14, 0x4d,
+	0xcf, 0xe9, 0xb6, 0x5d, 0xba, 0x41, 0x91, 0x0d, 0x86, 0x6f, 0x9c, 0x9b, 0xb4, 0x57, 0xe7, 0xa3,
+	0xda, 0x27, 0x90, 0x27, 0x3a, 0x11, 0x40, 0x91, 0x8d, 0xa8, 0xef, 0xa1, 0x39, 0xa8, 0x3e, 0x8f,
+	0x14, 0x85, 0xb6, 0xe7, 0xaa, 0x92, 0xf6, 0x1b, 0x09, 0x0a, 0xaf, 0x8c, 0xd0, 0x3c, 0x4b, 0x28,
+	0x96, 0x2e, 0x52, 0x8c, 0x3e, 0x00, 0x25, 0x3c, 0xf3, 0x71, 0x70, 0xe6, 0x39, 0x16, 0x77, 0x3b,
+	0xee, 0x40, 0x4f, 0x01, 0xda, 0x44, 0x5d, 0x33, 0x1c, 0x74, 0x30, 0x35, 0xb1, 0xb6, 0x81, 0x86,
+	0x1e, 0xd3, 0x99, 0xa8, 0xab, 0x4a, 0x3b, 0x7a, 0xd4, 0x7e, 0x00, 0x85, 0x97, 0x04, 0x36, 0xb4,
+	0x08, 0x45, 0xef, 0xe4, 0x24, 0xc0, 0x21, 0xdf, 0xcc, 0x79, 0x8b, 0x80, 0x6c, 0x7a, 0x5d, 0x97,
+	0x81, 0x9c, 0xd7, 0x59, 0x43, 0x6b, 0xc1, 0xec, 0x56, 0xc0, 0x20, 0xbc, 0x6e, 0xf8, 0xaf, 0x24,
+	0xc3, 0x7f, 0x31, 0xc5, 0x8b, 0x10, 0xf8, 0x7f, 0xcf, 0x41, 0x55, 0x9c, 0x6b, 0x34, 0xfb, 0x3e,
+	0x82, 0x42, 0x40, 0x42, 0x85, 0xeb, 0xab, 0xc5, 0xfa, 0x48, 0xaf, 0xce, 0x06, 0xd1, 0x33, 0x80,
+	0x13, 0xc2, 0x78, 0xd3, 0xb1, 0x83, 0x70, 0x49, 0xa6, 0x21, 0x3b, 0x9f, 0x15, 0x12, 0xba, 0x42,
+	0xe5, 0x5e, 0xda, 0x41, 0x88, 0x9e, 0x41, 0xf5, 0xfc, 0x0c, 0x93, 0x98, 0xb0, 0x9d, 0x10, 0xfb,
+	0xc1, 0x52, 0x9e, 0xbe, 0x57, 0x13, 0x81, 0xd5, 0x2b, 0x54, 0x68, 0x87, 0xc9, 0xa0, 0xc7, 0xa0,
+	0x9c, 0xfa, 0x5e, 0xb7, 0xd3, 0x3c, 0x1e, 0x04, 0x4b, 0x05, 0xfe, 0x82, 0xc8, 0x69, 0x89, 0x0a,
+	0xbc, 0x18, 0x04, 0xc4, 0x78, 0x16, 0xc8, 0xc5, 0x94, 0xf1, 0x94, 0x1a, 0x1e, 0xd8, 0x62, 0x4d,
+	0x35, 0x33, 0x51, 0x4d, 0xa5, 0x1d, 0x81, 0xac, 0x7b, 0xe7, 0x19, 0x78, 0x2d, 0x42, 0x91, 0x7a,
+	0x18, 0xf0, 0x28, 0xe2, 0x2d, 0xf4, 0x31, 0x54, 0x69, 0xb8, 0x5b, 0x4d, 0x4a, 0x74, 0x40, 0x41,
+	0x92, 0xf5, 0x0a, 0xeb, 0xdc, 0xa4, 0x7d, 0x5a, 0x07, 0xd4, 0x98, 0xfd, 0xeb, 0xe6, 0xa0, 0xc7,
+	0x42, 0x0e, 0xba, 0x33, 0x12, 0x00, 0x42, 0xf6, 0xf9, 0x16, 0x6a, 0xa9, 0xf9, 0xb2, 0x8a, 0x94,
+	0x87, 0x90, 0xf7, 0xbd, 0x73, 0xe2, 0x12, 0xc1, 0xbb, 0x12, 0x5b, 0xe0, 0x9d, 0xeb, 0x74, 0x24,
+	0x11, 0xe5, 0x72, 0x32, 0xca, 0xb5, 0x3d, 0x28, 0x35, 0xf0, 0xe0, 0x35, 0xd9, 0xb2, 0x09, 0x58,
+	0x8d, 0x18, 0xac, 0x06, 0xdb, 0xda, 0x5f, 0x27, 0xb7, 0x76, 0x26, 0x77, 0x0f, 0x4a, 0xdb, 0xfd,
+	0x8e, 0xed, 0xe3, 0xe7, 0x4c, 0x9b, 0xac, 0x0f, 0xdb, 0x6c, 0x7d, 0xd4, 0xdd, 0x00, 0xfb, 0xd3,
+	0x5e, 0x1f, 0x82, 0x52, 0xb6, 0x3e, 0x28, 0x1d, 0x51, 0xff, 0xb4, 0xe9, 0x10, 0xf5, 0x72, 0x3a,
+	0xfe, 0x28, 0x41, 0x55, 0xf4, 0xee, 0x13, 0x0e, 0x3d, 0xdb, 0x06, 0xe6, 0xe2, 0x6d, 0x80, 0xa3,
+	0xca, 0xf1, 0xff, 0x14, 0x66, 0xcd, 0x33, 0x6c, 0xb6, 0x9a, 0x56, 0xb7, 0xe3, 0xd8, 0xa6, 0x11,
+	0x32, 0x4c, 0x4b, 0x7a, 0x8d, 0x76, 0x6f, 0x45, 0xbd, 0x62, 0xb0, 0xcb, 0x93, 0x05, 0xbb, 0x0b,
+	0xb5, 0x14, 0x0a, 0x59, 0x41, 0x42, 0x22, 0xfc, 0xe4, 0x04, 0x9b, 0x21, 0xb6, 0x9a, 0x2d, 0x3c,
+	0x08, 0x78, 0x62, 0xab, 0x44, 0x9d, 0x0d, 0x3c, 0xa0, 0xcb, 0x60, 0x68, 0x21, 0x91, 0xa2, 0x26,
+	0x54, 0xf4, 0xca, 0xb0, 0xb3, 0x81, 0x07, 0xda, 0x57, 0x80, 0x5e, 0x90, 0xa5, 0x2f, 0x22, 0xb1,
+	0x4a, 0x80, 0x7c, 0x13, 0x21, 0x31, 0x8e, 0x38, 0x2a, 0xa3, 0x6d, 0xc1, 0xfb, 0x82, 0x06, 0x6e,
+	0xf6, 0x13, 0x28, 0x10, 0x98, 0xa3, 0x40, 0x1e, 0x4b, 0x06, 0x93, 0x62, 0xc1, 0x76, 0xb3, 0xb2,
+	0x71, 0x4c, 0xb0, 0x65, 0x54, 0x8c, 0x34, 0xd8, 0x6e, 0x5a, 0x2c, 0x8e, 0x0b, 0xb6, 0xcc, 0x3a,
+	0xf1, 0x3b, 0x09, 0xaa, 0x97, 0xd4, 0x88, 0x13, 0xa7, 0xff, 0x54, 0x26, 0x97, 0x27, 0xc8, 0xe4,
+	0x8b, 0x50, 0xb4, 0x5d, 0x0b, 0xf7, 0x59, 0xde, 0xcf, 0xeb, 0xbc, 0x25, 0x46, 0x28, 0x4c, 0x16,
+	0xa1, 0x75, 0xa8, 0x5d, 0x5e, 0xc5, 0x4e, 0x14, 0xa1, 0xda, 0x8f, 0xa0, 0xc0, 0xea, 0x9b, 0xfb,
+	0xa0, 0xb0, 0xe2, 0x20, 0x3e, 0x88, 0x97, 0x58, 0x47, 0xdd, 0x1a, 0x73, 0x28, 0xf9, 0x21, 0x54,
+	0x75, 0x6c, 0xd9, 0x41, 0x32, 0xe5, 0x4d, 0x74, 0x9a, 0xf9, 0x25, 0xcc, 0xd0, 0x17, 0xb7, 0xbc,
+	0x49, 0x5f, 0x41, 0x1a, 0xe4, 0xbc, 0xce, 0x48, 0x2d, 0xb2, 0xdf, 0xc1, 0xbe, 0x41, 0xaa, 0x20,
+	0x3d, 0xe7, 0x75, 0xd0, 0x32, 0xe4, 0x4d, 0x23, 0xc0, 0xf4, 0x7c, 0x9f, 0x94, 0xda, 0xee, 0xdb,
+	0x41, 0xb8, 0x69, 0x90, 0x48, 0x20, 0xe3, 0xda, 0xb7, 0x50, 0x69, 0xf4, 0x0e, 0xe3, 0xe3, 0xec,
+	0x32, 0xe4, 0x5a, 0xbd, 0x8c, 0x08, 0x4f, 0xb8, 0xa6, 0xe7, 0x5a, 0xbd, 0xa1, 0xfe, 0xdc, 0x25,
+	0xfa, 0xbf, 0x86, 0x2a, 0xd7, 0x7f, 0x53, 0x76, 0x6c, 0xa8, 0x91, 0x5a, 0xfd, 0xf0, 0xfa, 0xf7,
+	0x0b, 0x9f, 0x26, 0x57, 0xe4, 0x42, 0xa2, 0xac, 0x3e, 0x4c, 0x5d, 0x2e, 0xb8, 0x64, 0xf5, 0x8b,
+	0x66, 0x5f, 0x79, 0x3d, 0xae, 0x0a, 0xeb, 0x71, 0x31, 0x3d, 0x9b, 0xb0, 0x1c, 0x1f, 0x12, 0x12,
+	0x2e, 0xbc, 0x53, 0xf8, 0x82, 0xc0, 0x78, 0xbd, 0x0b, 0x05, 0x8e, 0xdb, 0xee, 0x2d, 0xe0, 0xb6,
+	0x9b, 0x8d, 0xdb, 0xee, 0xed, 0xe0, 0x36, 0x7a, 0x1d, 0x83, 0x61, 0xae, 0xd1, 0xa3, 0xd9, 0x3e,
+	0x11, 0x15, 0x2b, 0x20, 0xb7, 0x7a, 0xa3, 0x7b, 0x85, 0x18, 0xc2, 0x44, 0x64, 0xe2, 0x18, 0x7e,
+	0x45, 0x4e, 0xd5, 0xf1, 0x34, 0x37, 0x0d, 0xe4, 0x00, 0xde, 0x27, 0x28, 0xa5, 0xed, 0xbe, 0x2a,
+	0x2b, 0xdf, 0x4f, 0xb2, 0x72, 0x2f, 0x81, 0x53, 0x4a, 0x31, 0xa3, 0xa6, 0xcf, 0x2e, 0x43, 0x46,
+	0xbc, 0xb8, 0x32, 0x3f, 0xeb, 0x02, 0x3f, 0xf7, 0x33, 0xe7, 0x15, 0x48, 0xfa, 0x72, 0x48, 0x52,
+	0x22, 0x04, 0xb3, 0xc0, 0x43, 0x90, 0xe7, 0x98, 0xc9, 0x2b, 0x15, 0x9d, 0x3e, 0x6b, 0xfa, 0x10,
+	0xfa, 0xcb, 0x82, 0x9f, 0xd3, 0x9e, 0xbb, 0x94, 0x76, 0x01, 0xff, 0xdd, 0xdb, 0xc2, 0x7f, 0xf7,
+	0x02, 0xfc, 0x77, 0x6f, 0x11, 0xff, 0xd1, 0x45, 0xf2, 0x07, 0x89, 0xa6, 0x60, 0xd3, 0x70, 0x23,
+	0x4f, 0xaf, 0x70, 0xf4, 0xa7, 0x97, 0xd2, 0xe4, 0x84, 0xd2, 0xf4, 0x5c, 0x87, 0x95, 0x6c, 0x25,
+	0x5d, 0xa1, 0x3d, 0xfb, 0xae, 0x33, 0x40, 0x77, 0xa1, 0xd4, 0xc2, 0x03, 0x36, 0x98, 0xa7, 0x83,
+	0x33, 0x2d, 0x3c, 0xa0, 0x43, 0xf7, 0x41, 0x69, 0x1b, 0x7d, 0x76, 0xe6, 0xa1, 0x97, 0xc7, 0xb2,
+	0x5e, 0x6a, 0x1b, 0x7d, 0x7a, 0xde, 0xd1, 0x7e, 0x0d, 0xb5, 0xc8, 0xa6, 0x8b, 0x13, 0x5a, 0x7c,
+	0x50, 0x96, 0xf9, 0x41, 0x39, 0x62, 0x5a, 0xbe, 0x7c, 0x81, 0xdf, 0x85, 0x92, 0x63, 0x04, 0x21,
+	0xad, 0x36, 0xf3, 0xd4, 0xab, 0x19, 0xd2, 0x26, 0x85, 0x66, 0x8b, 0xa7, 0xf8, 0x04, 0x2c, 0x53,
+	0x2a, 0xf0, 0x04, 0xa5, 0x89, 0x02, 0x2f, 0xe5, 0xef, 0xd4, 0x0a, 0x3c, 0x51, 0x2f, 0x27, 0xbd,
+	0x01, 0xb3, 0x8d, 0xde, 0x65, 0x15, 0xde, 0xa4, 0xf9, 0xaf, 0x01, 0x6a, 0xac, 0xec, 0xa6, 0xd9,
+	0x8f, 0xdf, 0x6f, 0xdf, 0xac, 0xb6, 0x1e, 0x7b, 0xbf, 0x9d, 0x51, 0x5d, 0xf3, 0xfb, 0xed, 0x9b,
+	0xd6, 0xd7, 0xe3, 0xef, 0xb7, 0x33, 0x2b, 0x6c, 0x1d, 0xe6, 0xf9, 0x8a, 0x14, 0x3d, 0x8d, 0x92,
+	0x9c, 0x14, 0x27, 0xb9, 0x89, 0x79, 0x38, 0x80, 0x85, 0x94, 0xce, 0x9b, 0x92, 0x31, 0x60, 0xf7,
+	0x9f, 0x19, 0x76, 0x5e, 0x95, 0x91, 0xf5, 0x24, 0x23, 0x1f, 0xa6, 0xb3, 0x52, 0x06, 0x2d, 0xbf,
+	0x82, 0x3b, 0x23, 0x53, 0x5f, 0x97, 0x9b, 0x0d, 0x81, 0x9b, 0x07, 0xe3, 0x66, 0x17, 0x08, 0xfa,
+	0x9d, 0xc4, 0x6e, 0x4d, 0xdd, 0x53, 0x2c, 0x7a, 0x7e, 0x95, 0xec, 0x28, 0xe4, 0x38, 0x59, 0xcc,
+	0x71, 0x13, 0x97, 0xe0, 0x2d, 0x42, 0xab, 0x60, 0xc8, 0x4d, 0x8f, 0xda, 0xc9, 0xbc, 0x27, 0x8b,
+	0x79, 0x6f, 0x10, 0xdd, 0x78, 0x8f, 0xf8, 0x3d, 0x35, 0xc6, 0x47, 0x75, 0x0b, 0x8c, 0x67, 0x79,
+	0x3a, 0x45, 0xc6, 0x33, 0xd4, 0x73, 0xc6, 0xff, 0x24, 0x81, 0xf2, 0xd2, 0x33, 0x5b, 0xec, 0x7c,
+	0x96, 0x7d, 0xb4, 0xaa, 0x41, 0x8e, 0x7f, 0x2a, 0x55, 0xf4, 0x9c, 0x6d, 0xa1, 0xef, 0x41, 0xd9,
+	0xa2, 0xba, 0x9a, 0xe4, 0xc4, 0x49, 0xa9, 0x94, 0x75, 0x60, 0x5d, 0xe4, 0x38, 0x4a, 0x04, 0xba,
+	0x1d, 0xcb, 0x88, 0x04, 0xd8, 0x3e, 0x07, 0xac, 0x2b, 0x12, 0xe0, 0x1a, 0x4e, 0x1c, 0xe3, 0x94,
+	0x5e, 0x47, 0x96, 0x22, 0x0d, 0x3b, 0x8e, 0x71, 0xaa, 0xfd, 0x5e, 0x82, 0x32, 0x31, 0x6b, 0x7c,
+	0x9e, 0x5e, 0x49, 0x9a, 0x5a, 0x4e, 0x44, 0xd2, 0xd0, 0x9b, 0xc8, 0xfc, 0x6b, 0x1c, 0xa0, 0x89,
+	0xcb, 0xc7, 0x83, 0xa5, 0x32, 0x73, 0xf9, 0x78, 0xa0, 0x9d, 0x42, 0x75, 0x2b, 0x48, 0x1a, 0x74,
+	0xd5, 0xc0, 0x58, 0x4e, 0x06, 0xc6, 0xbc, 0x60, 0xac, 0x10, 0x0f, 0x1e, 0x54, 0x58, 0x5f, 0x46,
+	0xb8, 0xcb, 0x71, 0x05, 0xc0, 0xbe, 0x3e, 0xb3, 0xef, 0x06, 0xac, 0x11, 0x73, 0x27, 0x27, 0xb9,
+	0x4b, 0x51, 0x91, 0x4f, 0x53, 0xa1, 0x39, 0xe4, 0x24, 0x24, 0x4c, 0x79, 0xe5, 0xb8, 0xfb, 0x4c,
+	0x88, 0xbb, 0x85, 0x94, 0x73, 0x42, 0xb8, 0xfd, 0x55, 0x82, 0x39, 0xd2, 0xfd, 0x0d, 0x35, 0x60,
+	0x3c, 0xbb, 0x19, 0x21, 0x77, 0x71, 0x44, 0x7d, 0x04, 0x15, 0x2e, 0xc0, 0x40, 0x28, 0x52, 0x5d,
+	0xfc, 0xa5, 0xd7, 0xd7, 0x8d, 0x03, 0x56, 0x16, 0x8f, 0x1a, 0x3c, 0xa5, 0xb2, 0x78, 0x44, 0x31,
+	0x8b, 0x01, 0x9f, 0x94, 0xc5, 0xc9, 0xb1, 0x77, 0x40, 0x4c, 0x17, 0xaa, 0xdf, 0xb8, 0xce, 0x85,
+	0x2b, 0x2e, 0xcd, 0xc9, 0x34, 0xd6, 0x15, 0xad, 0x38, 0xc5, 0x89, 0xa7, 0x54, 0x71, 0x0a, 0x4a,
+	0xa3, 0x93, 0xb8, 0x1a, 0x4f, 0xf6, 0x0e, 0x30, 0xfd, 0x19, 0x20, 0x36, 0xdb, 0x8e, 0xe7, 0x9b,
+	0x17, 0x04, 0xfb, 0x34, 0x80, 0xa4, 0xdf, 0xf5, 0x33, 0x66, 0x9b, 0xd2, 0x77, 0xfd, 0x51, 0xcd,
+	0x0c, 0xd2, 0x00, 0x16, 0x52, 0xd3, 0xbe, 0x03, 0x5c, 0x7f, 0x2b, 0x41, 0xf9, 0xa8, 0xef, 0xbe,
+	0xea, 0x86, 0xf4, 0x6a, 0x0f, 0xdd, 0x81, 0x19, 0xeb, 0xb8, 0xe9, 0x1a, 0x6d, 0x96, 0x26, 0x15,
+	0xbd, 0x68, 0x1d, 0xef, 0x19, 0x6d, 0x4c, 0xce, 0x69, 0xa1, 0x71, 0xec, 0x60, 0x36, 0xc6, 0xbf,
+	0xb2, 0xd2, 0x1e, 0x3a, 0x7c, 0x1f, 0x14, 0x3b, 0x68, 0xb2, 0x5d, 0x87, 0x9f, 0xe2, 0x4a, 0x36,
+	0xbf, 0x73, 0x46, 0x1f, 0xd1, 0x3b, 0x3f, 0xf6, 0x4f, 0xa0, 0x8c, 0xcf, 0x0c, 0xb9, 0x56, 0x4f,
+	0xfb, 0xaf, 0x04, 0xca, 0x51, 0xdf, 0xd5, 0xb1, 0xe9, 0xf9, 0x16, 0x5a, 0x80, 0x62, 0xd8, 0x1f,
+	0x5e, 0x8e, 0x2a, 0x7a, 0x21, 0xec, 0xbb, 0x75, 0x0b, 0xad, 0x42, 0x31, 0x08, 0x8d, 0xb0, 0x1b,
+	0x8c, 0x54, 0xb2, 0x47, 0x7d, 0xf7, 0x90, 0x8e, 0xe8, 0x5c, 0x02, 0xad, 0x43, 0x89, 0x16, 0x56,
+	0x4d, 0xfa, 0x3d, 0x6c, 0x7c, 0x1c, 0xcc, 0x50, 0xa9, 0xa3, 0x00, 0x3d, 0x05, 0xc5, 0xf4, 0xda,
+	0x6d, 0x9b, 0xbe, 0x71, 0xd1, 0xbf, 0x96, 0x4a, 0x4c, 0xec, 0x88, 0x5e, 0x27, 0xb7, 0x39, 0x70,
+	0xd1, 0x07, 0xc3, 0xf9, 0xa4, 0x49, 0x11, 0xaa, 0x7a, 0x2c, 0xb6, 0xfa, 0x25, 0x94, 0x13, 0xff,
+	0x63, 0x40, 0xb3, 0xac, 0x59, 0x77, 0x7b, 0x86, 0x63, 0x5b, 0xea, 0x7b, 0xa8, 0x0c, 0x33, 0xa4,
+	0xe3, 0xa0, 0x1b, 0xaa, 0x12, 0xaa, 0x01, 0x90, 0x06, 0x83, 0x51, 0xcd, 0xad, 0xfe, 0x4d, 0x02,
+	0x65, 0xf8, 0x49, 0x98, 0x88, 0xc6, 0xef, 0x29, 0x50, 0xd8, 0x7e, 0xd3, 0x35, 0x1c, 0x55, 0x42,
+	0x15, 0x28, 0xed, 0x79, 0x21, 0x6b, 0xe5, 0x50, 0x09, 0xf2, 0x2f, 0x71, 0x10, 0xa8, 0x32, 0x99,
+	0x8b, 0x3c, 0xed, 0xfb, 0x6c, 0x28, 0x8f, 0x00, 0x8a, 0x2f, 0x0d, 0xff, 0x14, 0xfb, 0x6a, 0x01,
+	0xcd, 0x41, 0x95, 0x3d, 0x47, 0xc3, 0x45, 0x54, 0x84, 0x5c, 0xdd, 0x55, 0x67, 0x88, 0xea, 0x3d,
+	0x2f, 0xac, 0xbb, 0x6a, 0x89, 0x2a, 0xb3, 0x5b, 0x58, 0x55, 0xc8, 0xe4, 0x7b, 0x5e, 0x48, 0x1b,
+	0x40, 0x14, 0xd5, 0x83, 0xbd, 0xae, 0xe3, 0xa8, 0x65, 0x54, 0x05, 0xa5, 0x1e, 0xec, 0x79, 0x21,
+	0x6d, 0x56, 0x56, 0x7f, 0x0a, 0xca, 0xb0, 0x1e, 0xa5, 0xfe, 0x6c, 0x36, 0x63, 0xa3, 0x55, 0xa8,
+	0x6c, 0x6f, 0x36, 0x89, 0xb1, 0x44, 0x24, 0x50, 0x25, 0xf2, 0xf6, 0xf6, 0x66, 0x93, 0x37, 0x73,
+	0xfc, 0x85, 0xe7, 0xee, 0x80, 0xbc, 0xae, 0xca, 0xc4, 0xb5, 0xed, 0xcd, 0x26, 0x5d, 0x1e, 0x6a,
+	0x7e, 0xf5, 0x05, 0x28, 0xc3, 0x4b, 0x69, 0x22, 0xba, 0x7f, 0x90, 0xd0, 0x0d, 0x50, 0xdc, 0x3f,
+	0x68, 0x1e, 0xe2, 0x90, 0x69, 0xdd, 0x3f, 0x68, 0x46, 0x30, 0xf2, 0xa1, 0x5d, 0x1c, 0xaa, 0xf2,
+	0xea, 0x57, 0x34, 0xee, 0x58, 0xf0, 0x10, 0x84, 0x8e, 0x7e, 0xb2, 0x97, 0x50, 0x32, 0x07, 0x55,
+	0xd2, 0xb1, 0x49, 0x19, 0x0f, 0xb1, 0xa5, 0x4a, 0x91, 0xcc, 0xf3, 0x63, 0xcf, 0x27, 0x1d, 0xb9,
+	0x17, 0xea, 0x77, 0x6f, 0x1f, 0x48, 0xff, 0x7a, 0xfb, 0x40, 0xfa, 0xf7, 0xdb, 0x07, 0xd2, 0x9f,
+	0xff, 0xf3, 0xe0, 0xbd, 0xe3, 0x22, 0xfd, 0x13, 0xe2, 0xb3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
+	0x5b, 0x03, 0x5c, 0xaf, 0xe2, 0x28, 0x00, 0x00,
 }
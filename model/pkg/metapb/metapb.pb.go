@@ -22,6 +22,8 @@
 		Primary
 		TableEpoch
 		Table
+		TableTTL
+		Index
 */
 package metapb
 
@@ -255,6 +257,32 @@ func (x TableStatus) String() string {
 }
 func (TableStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{4} }
 
+type IndexState int32
+
+const (
+	IndexState_IndexInvalid IndexState = 0
+	// 写入时维护索引数据, 查询不使用
+	IndexState_IndexWriteOnly IndexState = 1
+	// 存量数据回填完成, 查询可以使用
+	IndexState_IndexPublic IndexState = 2
+)
+
+var IndexState_name = map[int32]string{
+	0: "IndexInvalid",
+	1: "IndexWriteOnly",
+	2: "IndexPublic",
+}
+var IndexState_value = map[string]int32{
+	"IndexInvalid":   0,
+	"IndexWriteOnly": 1,
+	"IndexPublic":    2,
+}
+
+func (x IndexState) String() string {
+	return proto.EnumName(IndexState_name, int32(x))
+}
+func (IndexState) EnumDescriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{5} }
+
 type Cluster struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// max peer count for a Range.
@@ -823,6 +851,12 @@ type Table struct {
 	// table expand area
 	// now when status is TableDelete, expand is the delete flag time
 	Expand []byte `protobuf:"bytes,12,opt,name=expand,proto3" json:"expand,omitempty"`
+	// 二级索引
+	Indexes []*Index `protobuf:"bytes,13,rep,name=indexes" json:"indexes,omitempty"`
+	// 已分配的最大列ID, 删除的列ID不再复用
+	MaxColumnId uint64 `protobuf:"varint,14,opt,name=max_column_id,json=maxColumnId,proto3" json:"max_column_id,omitempty"`
+	// 表级别的过期设置, 为空表示不过期
+	Ttl *TableTTL `protobuf:"bytes,15,opt,name=ttl" json:"ttl,omitempty"`
 }
 
 func (m *Table) Reset()                    { *m = Table{} }
@@ -914,6 +948,96 @@ func (m *Table) GetExpand() []byte {
 	return nil
 }
 
+func (m *Table) GetIndexes() []*Index {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *Table) GetMaxColumnId() uint64 {
+	if m != nil {
+		return m.MaxColumnId
+	}
+	return 0
+}
+
+func (m *Table) GetTtl() *TableTTL {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type TableTTL struct {
+	// 记录过期时间(毫秒时间戳)的列, 必须是BigInt类型
+	Column string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// 插入时没有指定过期列, 过期时间为插入时间加上duration(毫秒), 0表示不自动填充
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *TableTTL) Reset()                    { *m = TableTTL{} }
+func (m *TableTTL) String() string            { return proto.CompactTextString(m) }
+func (*TableTTL) ProtoMessage()               {}
+func (*TableTTL) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{14} }
+
+func (m *TableTTL) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
+func (m *TableTTL) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// 二级索引, 索引数据存放在单独的表中, 该表的主键依次为索引列和原表的主键列
+type Index struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 索引列ID, 按索引列的顺序
+	ColumnIds []uint64 `protobuf:"varint,2,rep,packed,name=column_ids,json=columnIds" json:"column_ids,omitempty"`
+	// 存放索引数据的表
+	TableId uint64     `protobuf:"varint,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	State   IndexState `protobuf:"varint,4,opt,name=state,proto3,enum=metapb.IndexState" json:"state,omitempty"`
+}
+
+func (m *Index) Reset()                    { *m = Index{} }
+func (m *Index) String() string            { return proto.CompactTextString(m) }
+func (*Index) ProtoMessage()               {}
+func (*Index) Descriptor() ([]byte, []int) { return fileDescriptorMetapb, []int{15} }
+
+func (m *Index) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Index) GetColumnIds() []uint64 {
+	if m != nil {
+		return m.ColumnIds
+	}
+	return nil
+}
+
+func (m *Index) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *Index) GetState() IndexState {
+	if m != nil {
+		return m.State
+	}
+	return IndexState_IndexInvalid
+}
+
 func init() {
 	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
 	proto.RegisterType((*NodeLabel)(nil), "metapb.NodeLabel")
@@ -929,11 +1053,14 @@ func init() {
 	proto.RegisterType((*Primary)(nil), "metapb.Primary")
 	proto.RegisterType((*TableEpoch)(nil), "metapb.TableEpoch")
 	proto.RegisterType((*Table)(nil), "metapb.Table")
+	proto.RegisterType((*TableTTL)(nil), "metapb.TableTTL")
+	proto.RegisterType((*Index)(nil), "metapb.Index")
 	proto.RegisterEnum("metapb.NodeState", NodeState_name, NodeState_value)
 	proto.RegisterEnum("metapb.RangeState", RangeState_name, RangeState_value)
 	proto.RegisterEnum("metapb.ReplicaRole", ReplicaRole_name, ReplicaRole_value)
 	proto.RegisterEnum("metapb.DataType", DataType_name, DataType_value)
 	proto.RegisterEnum("metapb.TableStatus", TableStatus_name, TableStatus_value)
+	proto.RegisterEnum("metapb.IndexState", IndexState_name, IndexState_value)
 }
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1615,6 +1742,113 @@ func (m *Table) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expand)))
 		i += copy(dAtA[i:], m.Expand)
 	}
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxColumnId != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.MaxColumnId))
+	}
+	if m.Ttl != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Ttl.Size()))
+		n7, err := m.Ttl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *TableTTL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableTTL) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Column) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Column)))
+		i += copy(dAtA[i:], m.Column)
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Duration))
+	}
+	return i, nil
+}
+
+func (m *Index) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Index) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.ColumnIds) > 0 {
+		dAtA9 := make([]byte, len(m.ColumnIds)*10)
+		var j8 int
+		for _, num := range m.ColumnIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
+	}
+	if m.TableId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.TableId))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.State))
+	}
 	return i, nil
 }
 
@@ -1945,6 +2179,55 @@ func (m *Table) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetapb(uint64(l))
 	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.MaxColumnId != 0 {
+		n += 1 + sovMetapb(uint64(m.MaxColumnId))
+	}
+	if m.Ttl != nil {
+		l = m.Ttl.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	return n
+}
+
+func (m *TableTTL) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Column)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovMetapb(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *Index) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.ColumnIds) > 0 {
+		l = 0
+		for _, e := range m.ColumnIds {
+			l += sovMetapb(uint64(e))
+		}
+		n += 1 + sovMetapb(uint64(l)) + l
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMetapb(uint64(m.TableId))
+	}
+	if m.State != 0 {
+		n += 1 + sovMetapb(uint64(m.State))
+	}
 	return n
 }
 
@@ -4245,6 +4528,366 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				m.Expand = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, &Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxColumnId", wireType)
+			}
+			m.MaxColumnId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxColumnId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &TableTTL{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Column = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Index) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Index: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Index: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ColumnIds = append(m.ColumnIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ColumnIds = append(m.ColumnIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIds", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (IndexState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4374,86 +5017,95 @@ var (
 func init() { proto.RegisterFile("metapb.proto", fileDescriptorMetapb) }
 
 var fileDescriptorMetapb = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xaf, 0xed, 0xfd, 0xb0, 0x8f, 0x9d, 0x74, 0x3b, 0xed, 0xbf, 0xf5, 0xbf, 0x45, 0x21, 0x72,
-	0x2b, 0x35, 0x04, 0x51, 0x44, 0xfa, 0x00, 0x28, 0x4d, 0x40, 0x8a, 0x1a, 0xdc, 0x6a, 0x1c, 0x2a,
-	0xb8, 0x5a, 0x8d, 0x3d, 0x27, 0xce, 0x2a, 0xeb, 0xd9, 0xd5, 0xec, 0xac, 0x15, 0x4b, 0xbc, 0x00,
-	0x12, 0x17, 0x5c, 0xf2, 0x48, 0x5c, 0xf2, 0x02, 0x08, 0x54, 0x5e, 0x81, 0x3b, 0x6e, 0xd0, 0x99,
-	0xd9, 0x75, 0xec, 0x7c, 0x20, 0x24, 0xae, 0xb2, 0xe7, 0xf7, 0x3b, 0x3e, 0xe7, 0x77, 0x3e, 0x66,
-	0x26, 0xd0, 0x9b, 0xa1, 0x11, 0xf9, 0xf8, 0x45, 0xae, 0x33, 0x93, 0xb1, 0xc0, 0x59, 0x8f, 0x1f,
-	0x4c, 0xb3, 0x69, 0x66, 0xa1, 0x4f, 0xe9, 0xcb, 0xb1, 0x83, 0xcf, 0x21, 0x3c, 0x48, 0xcb, 0xc2,
-	0xa0, 0x66, 0x9b, 0xd0, 0x4c, 0x64, 0xbf, 0xb1, 0xdd, 0xd8, 0xf1, 0x78, 0x33, 0x91, 0xec, 0x19,
-	0x6c, 0xce, 0xc4, 0x45, 0x9c, 0x23, 0xea, 0x78, 0x92, 0x95, 0xca, 0xf4, 0x9b, 0xdb, 0x8d, 0x9d,
-	0x0d, 0xde, 0x9b, 0x89, 0x8b, 0xb7, 0x88, 0xfa, 0x80, 0xb0, 0xc1, 0x4b, 0xe8, 0x0c, 0x33, 0x89,
-	0xc7, 0x62, 0x8c, 0x29, 0x8b, 0xa0, 0x75, 0x8e, 0x0b, 0x1b, 0xa3, 0xc3, 0xe9, 0x93, 0x3d, 0x00,
-	0x7f, 0x2e, 0xd2, 0x12, 0xed, 0x6f, 0x3b, 0xdc, 0x19, 0x83, 0xdf, 0x1a, 0xe0, 0xd1, 0xaf, 0xae,
-	0xe5, 0xfc, 0x10, 0xba, 0x05, 0xea, 0x39, 0xea, 0x58, 0x48, 0xa9, 0xab, 0x1f, 0x81, 0x83, 0xf6,
-	0xa5, 0xd4, 0xec, 0x09, 0x74, 0xb4, 0x38, 0x35, 0x8e, 0x6e, 0x59, 0xba, 0x4d, 0x40, 0x4d, 0x9e,
-	0x19, 0x93, 0x3b, 0xd2, 0x73, 0x24, 0x01, 0x96, 0x7c, 0x0e, 0x7e, 0x61, 0x84, 0xc1, 0xbe, 0xbf,
-	0xdd, 0xd8, 0xd9, 0xdc, 0xbb, 0xf7, 0xa2, 0xea, 0x12, 0xe9, 0x18, 0x11, 0xc1, 0x1d, 0xcf, 0xfa,
-	0x10, 0xce, 0x51, 0x17, 0x49, 0xa6, 0xfa, 0x81, 0x8d, 0x51, 0x9b, 0xec, 0x23, 0x08, 0x52, 0xaa,
-	0xb3, 0xe8, 0x87, 0xdb, 0xad, 0x9d, 0xee, 0x7a, 0x0c, 0xdb, 0x01, 0x5e, 0x39, 0x0c, 0xbe, 0x01,
-	0x8f, 0x7a, 0x74, 0xad, 0xc0, 0x47, 0x10, 0xaa, 0x4c, 0x62, 0x9c, 0x48, 0x5b, 0x9c, 0xc7, 0x03,
-	0x32, 0x8f, 0x24, 0x7b, 0x0e, 0x9e, 0xce, 0x52, 0xb4, 0x35, 0x6d, 0xee, 0xdd, 0xaf, 0x23, 0x73,
-	0xcc, 0xd3, 0x64, 0x22, 0x78, 0x96, 0x22, 0xb7, 0x0e, 0x83, 0xef, 0x20, 0xac, 0x40, 0xf6, 0x7f,
-	0x68, 0x6b, 0xa1, 0xa6, 0x36, 0x9a, 0x4b, 0x11, 0x5a, 0xfb, 0x48, 0xb2, 0x6d, 0xf0, 0x68, 0x70,
-	0x36, 0x49, 0x77, 0xaf, 0x57, 0x87, 0x23, 0x4d, 0xdc, 0x32, 0xd4, 0xac, 0xc2, 0x08, 0x6d, 0x62,
-	0x9a, 0x18, 0x65, 0xed, 0xf1, 0xb6, 0x05, 0x5e, 0xe3, 0x82, 0x64, 0xa2, 0x92, 0x96, 0xf2, 0x2c,
-	0x15, 0xa0, 0x92, 0xaf, 0x71, 0x31, 0xd8, 0x07, 0xe0, 0x94, 0xe2, 0x8b, 0x3c, 0x9b, 0x9c, 0x91,
-	0x80, 0x49, 0xa6, 0x4e, 0xe3, 0x39, 0xea, 0x5a, 0x00, 0xd9, 0xef, 0x50, 0xaf, 0x76, 0xd1, 0x15,
-	0x5a, 0x9b, 0x83, 0x3f, 0x1b, 0xe0, 0xdb, 0x18, 0xd7, 0x9a, 0xb3, 0x26, 0xa9, 0x79, 0xbb, 0xa4,
-	0xd6, 0xaa, 0x24, 0xf6, 0x12, 0xba, 0xae, 0x0b, 0x48, 0x9a, 0xac, 0xde, 0xee, 0x1e, 0x5b, 0x36,
-	0x70, 0xa9, 0x96, 0x83, 0xbe, 0x54, 0x3e, 0x00, 0x9f, 0xba, 0x50, 0xf4, 0x7d, 0x3b, 0xc9, 0xf5,
-	0x06, 0x39, 0x8a, 0xaa, 0x33, 0x62, 0x9c, 0xda, 0xf6, 0x06, 0xae, 0x06, 0x6b, 0x1f, 0x49, 0xf6,
-	0x19, 0xf4, 0x72, 0x9d, 0xcc, 0x84, 0x5e, 0x90, 0xa0, 0x7a, 0x1f, 0x36, 0xeb, 0x28, 0x07, 0x59,
-	0x5a, 0xce, 0x14, 0xef, 0x56, 0x3e, 0xaf, 0x71, 0x51, 0x0c, 0xbe, 0x85, 0xe0, 0x18, 0x85, 0x44,
-	0xfd, 0x4f, 0x63, 0xbb, 0x75, 0x3d, 0x9e, 0x40, 0xc7, 0x12, 0xab, 0x7b, 0x4f, 0x00, 0xad, 0xf6,
-	0x80, 0x83, 0xcf, 0xb3, 0xd2, 0x20, 0x7b, 0x0a, 0xbe, 0x8d, 0x64, 0xc3, 0x76, 0xf7, 0x36, 0xd6,
-	0x9a, 0xc0, 0x1d, 0xc7, 0x9e, 0x41, 0x90, 0x5a, 0x21, 0x37, 0x2e, 0x47, 0xc5, 0x0d, 0xbe, 0x6f,
-	0x40, 0xfb, 0x50, 0x18, 0xf1, 0x4a, 0x14, 0xc8, 0x18, 0x78, 0x4a, 0xcc, 0xb0, 0x3a, 0xd8, 0xf6,
-	0xbb, 0x1a, 0x5e, 0x73, 0x39, 0xbc, 0x2d, 0x80, 0x5c, 0x67, 0x39, 0x6a, 0x93, 0x60, 0x51, 0x49,
-	0x5c, 0x41, 0x56, 0x17, 0xc2, 0x5b, 0x5b, 0x08, 0x3a, 0xf4, 0x13, 0x8d, 0xc2, 0x60, 0x6c, 0x92,
-	0x99, 0x3b, 0x9f, 0x2d, 0x0e, 0x0e, 0x3a, 0x49, 0x66, 0x38, 0xf8, 0xb5, 0x09, 0x81, 0x6b, 0xe9,
-	0xbf, 0x52, 0xf2, 0x09, 0x74, 0xa4, 0x30, 0x22, 0x36, 0x8b, 0xbc, 0x3e, 0x4f, 0x51, 0x5d, 0x23,
-	0x95, 0x74, 0xb2, 0xc8, 0x91, 0xb7, 0x65, 0xf5, 0xc5, 0x1e, 0x43, 0xbb, 0x54, 0x45, 0x32, 0x55,
-	0x28, 0xad, 0xb2, 0x36, 0x5f, 0xda, 0x74, 0x7d, 0x15, 0x13, 0x91, 0x3a, 0x51, 0x3e, 0x77, 0x06,
-	0xfb, 0x00, 0x3a, 0xb9, 0xc6, 0x49, 0xb2, 0xbc, 0x23, 0x7c, 0x7e, 0x09, 0x50, 0x3c, 0x55, 0xa6,
-	0x29, 0x6d, 0x4a, 0x3f, 0x74, 0xf1, 0x6a, 0x9b, 0x4a, 0x5d, 0xd9, 0x9b, 0x7e, 0xdb, 0x6a, 0x86,
-	0xcb, 0x35, 0xa1, 0x2e, 0x65, 0x5a, 0x26, 0x4a, 0xa4, 0xfd, 0x8e, 0x0d, 0x5c, 0x9b, 0x24, 0x25,
-	0x51, 0x12, 0x2f, 0xfa, 0x60, 0x63, 0x3a, 0x83, 0x3d, 0x85, 0x0d, 0x89, 0xa7, 0xa2, 0x4c, 0x4d,
-	0xec, 0xee, 0xd9, 0xae, 0x3d, 0x1b, 0xbd, 0x0a, 0x7c, 0x47, 0xd8, 0x95, 0xd1, 0xf4, 0xae, 0x8e,
-	0x66, 0xf0, 0x06, 0xc2, 0xb7, 0x4e, 0x82, 0x9d, 0x85, 0xed, 0x74, 0xbc, 0xd2, 0x66, 0x70, 0xd0,
-	0x90, 0x9a, 0xfd, 0x14, 0x3c, 0x85, 0x17, 0xa6, 0xda, 0x9d, 0xbb, 0xcb, 0xdd, 0x71, 0xbf, 0xe7,
-	0x96, 0xa4, 0x5b, 0xe2, 0x84, 0xea, 0xfd, 0x0f, 0xb7, 0xc4, 0x5f, 0x4d, 0xf0, 0x6d, 0x8c, 0x1b,
-	0x47, 0xfe, 0x08, 0x42, 0x39, 0x76, 0x12, 0xdd, 0x1b, 0x11, 0xc8, 0xb1, 0x95, 0x77, 0x1f, 0x7c,
-	0x39, 0xa6, 0xe3, 0xd3, 0xb2, 0xe1, 0x3c, 0x39, 0x3e, 0x92, 0xd5, 0x82, 0x78, 0xb7, 0xac, 0xaa,
-	0x7f, 0x6d, 0x55, 0x77, 0x20, 0x74, 0x15, 0x17, 0xfd, 0xe0, 0xc6, 0x83, 0x5d, 0xd3, 0x6c, 0x07,
-	0x7c, 0x77, 0xeb, 0x84, 0xeb, 0xb7, 0xce, 0x65, 0xf5, 0xdc, 0x39, 0xb0, 0x67, 0xe0, 0x6b, 0x9c,
-	0x5e, 0x14, 0xfd, 0xf6, 0x8d, 0x11, 0x1d, 0x79, 0xf5, 0x28, 0x74, 0xae, 0x1e, 0x05, 0xb6, 0x0d,
-	0xbd, 0xfc, 0x3c, 0x96, 0x65, 0x1e, 0x4f, 0xce, 0x70, 0x72, 0x5e, 0x2d, 0x03, 0xe4, 0xe7, 0x87,
-	0x65, 0x7e, 0x40, 0x08, 0xfb, 0x18, 0x02, 0x7a, 0xc7, 0xca, 0xc2, 0xae, 0xc2, 0xca, 0x53, 0x62,
-	0x35, 0x8d, 0x2c, 0xc5, 0x2b, 0x17, 0xf6, 0x10, 0x02, 0xbc, 0xc8, 0x85, 0x92, 0x76, 0x2b, 0xe8,
-	0x4e, 0xb5, 0xd6, 0x6e, 0xe1, 0x5e, 0x75, 0xfb, 0x2e, 0xb2, 0x0d, 0xe8, 0x0c, 0xe3, 0x23, 0x35,
-	0x17, 0x69, 0x22, 0xa3, 0x3b, 0xac, 0x0b, 0xe1, 0x30, 0x3e, 0xce, 0xa6, 0x89, 0x8a, 0x1a, 0xac,
-	0x07, 0x6d, 0x6b, 0x64, 0xa5, 0x89, 0x9a, 0xce, 0xf3, 0xcd, 0xe9, 0x69, 0x9a, 0x28, 0x8c, 0x5a,
-	0xec, 0x2e, 0x74, 0x87, 0xf1, 0x49, 0x36, 0x1b, 0x17, 0x26, 0x53, 0x18, 0x79, 0x8e, 0xff, 0x3a,
-	0x9f, 0x6a, 0x21, 0x31, 0xf2, 0xeb, 0xc0, 0x89, 0x49, 0x44, 0x1a, 0x05, 0xbb, 0x3f, 0x34, 0xaa,
-	0xc7, 0x65, 0x99, 0x96, 0xaf, 0xa4, 0x05, 0x08, 0xb8, 0x75, 0x76, 0x59, 0x79, 0x3c, 0xcc, 0xf4,
-	0x4c, 0xa4, 0x51, 0x93, 0x04, 0xf1, 0x78, 0x94, 0xa7, 0x89, 0x89, 0x5a, 0xce, 0xf8, 0x0a, 0xf5,
-	0x94, 0xf2, 0x59, 0x3f, 0x8e, 0xb3, 0x6c, 0x4e, 0xe9, 0x36, 0x01, 0x78, 0x7c, 0x9c, 0x09, 0x39,
-	0x52, 0x22, 0x8f, 0x02, 0x67, 0xef, 0x8f, 0x95, 0x8b, 0x13, 0xba, 0x84, 0xb5, 0xfa, 0xf6, 0xee,
-	0x10, 0xba, 0x2b, 0xaf, 0x2f, 0x8b, 0xa0, 0x47, 0x7f, 0x57, 0x14, 0xfd, 0x0f, 0xee, 0x59, 0x64,
-	0xb4, 0x50, 0x93, 0xb8, 0x72, 0x8d, 0x1a, 0xec, 0x21, 0x30, 0x0b, 0xef, 0x17, 0xab, 0x78, 0x73,
-	0xf7, 0xc7, 0xea, 0x46, 0xb5, 0x97, 0x4e, 0x17, 0xc2, 0xb5, 0x8e, 0x9e, 0x24, 0x6a, 0x91, 0xa8,
-	0xaa, 0xb6, 0xd1, 0x4c, 0xa4, 0x29, 0x59, 0x4d, 0x16, 0x42, 0xeb, 0x48, 0x51, 0x5d, 0x00, 0xc1,
-	0xab, 0x64, 0x4a, 0xdf, 0x1e, 0xeb, 0x80, 0xff, 0x65, 0x9a, 0x09, 0x13, 0xf9, 0x04, 0x1f, 0x66,
-	0xe5, 0x38, 0xc5, 0x28, 0xa0, 0x30, 0xef, 0x84, 0x9e, 0x9c, 0x09, 0x1d, 0x85, 0xce, 0x5f, 0x09,
-	0xbd, 0x88, 0xda, 0xac, 0x0d, 0xde, 0xa1, 0x30, 0x18, 0x75, 0xa8, 0x44, 0x5a, 0xa3, 0x91, 0x11,
-	0xb3, 0x3c, 0x82, 0xdd, 0x39, 0x74, 0x57, 0xb6, 0x82, 0x4a, 0xb4, 0xe6, 0xa5, 0x32, 0xf2, 0x77,
-	0x88, 0xed, 0x7b, 0xed, 0xf0, 0x56, 0x63, 0x2e, 0x34, 0x46, 0xcd, 0x25, 0xc2, 0x4b, 0xa5, 0x12,
-	0x35, 0x75, 0x43, 0xb7, 0xc8, 0x21, 0xa6, 0x68, 0x68, 0x08, 0xf7, 0x60, 0xe3, 0x12, 0x20, 0x1f,
-	0xff, 0x55, 0xf4, 0xf3, 0xfb, 0xad, 0xc6, 0x2f, 0xef, 0xb7, 0x1a, 0xbf, 0xbf, 0xdf, 0x6a, 0xfc,
-	0xf4, 0xc7, 0xd6, 0x9d, 0x71, 0x60, 0xff, 0x1d, 0x7d, 0xf9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xb0, 0x37, 0x92, 0x48, 0xbc, 0x0a, 0x00, 0x00,
+	// 1440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0xd7,
+	0x11, 0x37, 0xc9, 0xfd, 0x43, 0x0e, 0x29, 0x7a, 0xfd, 0xec, 0xda, 0x5b, 0xbb, 0x55, 0x85, 0xb5,
+	0x01, 0xb3, 0x2a, 0xea, 0xa2, 0xf2, 0xbd, 0x85, 0x2c, 0xb5, 0x00, 0x61, 0x55, 0x16, 0x9e, 0x54,
+	0xb7, 0x39, 0x2d, 0x1e, 0xf9, 0x9e, 0xa8, 0x85, 0x96, 0xbb, 0x8b, 0xb7, 0x6f, 0x05, 0x11, 0x08,
+	0x90, 0x73, 0x80, 0x1c, 0x72, 0xcc, 0x47, 0xca, 0x31, 0x5f, 0x20, 0x48, 0xe0, 0x5c, 0x73, 0xcc,
+	0x07, 0x08, 0x66, 0xde, 0x2e, 0xff, 0x48, 0x72, 0x10, 0x20, 0x27, 0xed, 0xfc, 0x66, 0x38, 0xf3,
+	0x9b, 0xbf, 0xbb, 0x82, 0xc1, 0x5c, 0x19, 0x51, 0x4c, 0x5e, 0x15, 0x3a, 0x37, 0x39, 0xf3, 0xac,
+	0xf4, 0xf4, 0xd1, 0x2c, 0x9f, 0xe5, 0x04, 0xfd, 0x0d, 0x9f, 0xac, 0x36, 0xfa, 0x27, 0xf8, 0x07,
+	0x69, 0x55, 0x1a, 0xa5, 0xd9, 0x10, 0xda, 0x89, 0x0c, 0x5b, 0x3b, 0xad, 0x91, 0xc3, 0xdb, 0x89,
+	0x64, 0x2f, 0x60, 0x38, 0x17, 0xd7, 0x71, 0xa1, 0x94, 0x8e, 0xa7, 0x79, 0x95, 0x99, 0xb0, 0xbd,
+	0xd3, 0x1a, 0x6d, 0xf1, 0xc1, 0x5c, 0x5c, 0x9f, 0x28, 0xa5, 0x0f, 0x10, 0x8b, 0x5e, 0x43, 0xef,
+	0x38, 0x97, 0xea, 0x48, 0x4c, 0x54, 0xca, 0x02, 0xe8, 0x5c, 0xaa, 0x05, 0xf9, 0xe8, 0x71, 0x7c,
+	0x64, 0x8f, 0xc0, 0xbd, 0x12, 0x69, 0xa5, 0xe8, 0xb7, 0x3d, 0x6e, 0x85, 0xe8, 0xbb, 0x16, 0x38,
+	0xf8, 0xab, 0x5b, 0x31, 0xff, 0x04, 0xfd, 0x52, 0xe9, 0x2b, 0xa5, 0x63, 0x21, 0xa5, 0xae, 0x7f,
+	0x04, 0x16, 0xda, 0x97, 0x52, 0xb3, 0x67, 0xd0, 0xd3, 0xe2, 0xdc, 0x58, 0x75, 0x87, 0xd4, 0x5d,
+	0x04, 0x1a, 0xe5, 0x85, 0x31, 0x85, 0x55, 0x3a, 0x56, 0x89, 0x00, 0x29, 0x5f, 0x82, 0x5b, 0x1a,
+	0x61, 0x54, 0xe8, 0xee, 0xb4, 0x46, 0xc3, 0xbd, 0x07, 0xaf, 0xea, 0x2a, 0x21, 0x8f, 0x53, 0x54,
+	0x70, 0xab, 0x67, 0x21, 0xf8, 0x57, 0x4a, 0x97, 0x49, 0x9e, 0x85, 0x1e, 0xf9, 0x68, 0x44, 0xf6,
+	0x67, 0xf0, 0x52, 0xcc, 0xb3, 0x0c, 0xfd, 0x9d, 0xce, 0xa8, 0xbf, 0xe9, 0x83, 0x2a, 0xc0, 0x6b,
+	0x83, 0xe8, 0xff, 0xe0, 0x60, 0x8d, 0x6e, 0x25, 0xf8, 0x04, 0xfc, 0x2c, 0x97, 0x2a, 0x4e, 0x24,
+	0x25, 0xe7, 0x70, 0x0f, 0xc5, 0xb1, 0x64, 0x2f, 0xc1, 0xd1, 0x79, 0xaa, 0x28, 0xa7, 0xe1, 0xde,
+	0xc3, 0xc6, 0x33, 0x57, 0x45, 0x9a, 0x4c, 0x05, 0xcf, 0x53, 0xc5, 0xc9, 0x20, 0xfa, 0x14, 0xfc,
+	0x1a, 0x64, 0xbf, 0x87, 0xae, 0x16, 0xd9, 0x8c, 0xbc, 0xd9, 0x10, 0x3e, 0xc9, 0x63, 0xc9, 0x76,
+	0xc0, 0xc1, 0xc6, 0x51, 0x90, 0xfe, 0xde, 0xa0, 0x71, 0x87, 0x9c, 0x38, 0x69, 0xb0, 0x58, 0xa5,
+	0x11, 0xda, 0xc4, 0xd8, 0x31, 0x8c, 0x3a, 0xe0, 0x5d, 0x02, 0xde, 0xaa, 0x05, 0xd2, 0x54, 0x99,
+	0x24, 0x95, 0x43, 0x2a, 0x4f, 0x65, 0xf2, 0xad, 0x5a, 0x44, 0xfb, 0x00, 0x1c, 0x43, 0xfc, 0xab,
+	0xc8, 0xa7, 0x17, 0x48, 0x60, 0x9a, 0x67, 0xe7, 0xf1, 0x95, 0xd2, 0x0d, 0x01, 0x94, 0xdf, 0x2b,
+	0xbd, 0x5e, 0x45, 0x9b, 0x68, 0x23, 0x46, 0x3f, 0xb5, 0xc0, 0x25, 0x1f, 0xb7, 0x8a, 0xb3, 0x41,
+	0xa9, 0xfd, 0x71, 0x4a, 0x9d, 0x75, 0x4a, 0xec, 0x35, 0xf4, 0x6d, 0x15, 0x14, 0x72, 0x22, 0xbe,
+	0xfd, 0x3d, 0xb6, 0x2c, 0xe0, 0x92, 0x2d, 0x07, 0xbd, 0x62, 0x1e, 0x81, 0x8b, 0x55, 0x28, 0x43,
+	0x97, 0x3a, 0xb9, 0x59, 0x20, 0xab, 0xc2, 0xec, 0x8c, 0x98, 0xa4, 0x54, 0x5e, 0xcf, 0xe6, 0x40,
+	0xf2, 0x58, 0xb2, 0xbf, 0xc3, 0xa0, 0xd0, 0xc9, 0x5c, 0xe8, 0x05, 0x12, 0x6a, 0xe6, 0x61, 0xd8,
+	0x78, 0x39, 0xc8, 0xd3, 0x6a, 0x9e, 0xf1, 0x7e, 0x6d, 0xf3, 0x56, 0x2d, 0xca, 0xe8, 0x13, 0xf0,
+	0x8e, 0x94, 0x90, 0x4a, 0xff, 0x52, 0xdb, 0x3e, 0x3a, 0x1e, 0xcf, 0xa0, 0x47, 0x8a, 0xf5, 0xb9,
+	0x47, 0x00, 0x47, 0x3b, 0xe2, 0xe0, 0xf2, 0xbc, 0x32, 0x8a, 0x3d, 0x07, 0x97, 0x3c, 0x91, 0xdb,
+	0xfe, 0xde, 0xd6, 0x46, 0x11, 0xb8, 0xd5, 0xb1, 0x17, 0xe0, 0xa5, 0x44, 0xe4, 0xce, 0xe1, 0xa8,
+	0x75, 0xd1, 0xe7, 0x2d, 0xe8, 0x1e, 0x0a, 0x23, 0xde, 0x88, 0x52, 0x31, 0x06, 0x4e, 0x26, 0xe6,
+	0xaa, 0x5e, 0x6c, 0x7a, 0xae, 0x9b, 0xd7, 0x5e, 0x36, 0x6f, 0x1b, 0xa0, 0xd0, 0x79, 0xa1, 0xb4,
+	0x49, 0x54, 0x59, 0x53, 0x5c, 0x43, 0xd6, 0x07, 0xc2, 0xd9, 0x18, 0x08, 0x5c, 0xfa, 0xa9, 0x56,
+	0xc2, 0xa8, 0xd8, 0x24, 0x73, 0xbb, 0x9f, 0x1d, 0x0e, 0x16, 0x3a, 0x4b, 0xe6, 0x2a, 0xfa, 0xb6,
+	0x0d, 0x9e, 0x2d, 0xe9, 0xaf, 0x62, 0xf2, 0x57, 0xe8, 0x49, 0x61, 0x44, 0x6c, 0x16, 0x45, 0xb3,
+	0x4f, 0x41, 0x93, 0x23, 0xa6, 0x74, 0xb6, 0x28, 0x14, 0xef, 0xca, 0xfa, 0x89, 0x3d, 0x85, 0x6e,
+	0x95, 0x95, 0xc9, 0x2c, 0x53, 0x92, 0x98, 0x75, 0xf9, 0x52, 0xc6, 0xf3, 0x55, 0x4e, 0x45, 0x6a,
+	0x49, 0xb9, 0xdc, 0x0a, 0xec, 0x0f, 0xd0, 0x2b, 0xb4, 0x9a, 0x26, 0xcb, 0x1b, 0xe1, 0xf2, 0x15,
+	0x80, 0xfe, 0xb2, 0x2a, 0x4d, 0x71, 0x52, 0x42, 0xdf, 0xfa, 0x6b, 0x64, 0x4c, 0x75, 0x6d, 0x6e,
+	0xc2, 0x2e, 0x71, 0x86, 0xd5, 0x98, 0x60, 0x95, 0x72, 0x2d, 0x93, 0x4c, 0xa4, 0x61, 0x8f, 0x1c,
+	0x37, 0x22, 0x52, 0x49, 0x32, 0xa9, 0xae, 0x43, 0x20, 0x9f, 0x56, 0x60, 0xcf, 0x61, 0x4b, 0xaa,
+	0x73, 0x51, 0xa5, 0x26, 0xb6, 0x77, 0xb6, 0x4f, 0xbb, 0x31, 0xa8, 0xc1, 0xf7, 0x88, 0xdd, 0x68,
+	0xcd, 0xe0, 0x66, 0x6b, 0xa2, 0x77, 0xe0, 0x9f, 0x58, 0x0a, 0xd4, 0x0b, 0xaa, 0x74, 0xbc, 0x56,
+	0x66, 0xb0, 0xd0, 0x31, 0x16, 0xfb, 0x39, 0x38, 0x99, 0xba, 0x36, 0xf5, 0xec, 0xdc, 0x5f, 0xce,
+	0x8e, 0xfd, 0x3d, 0x27, 0x25, 0x5e, 0x89, 0x33, 0xcc, 0xf7, 0x37, 0x5c, 0x89, 0x1f, 0x3b, 0xe0,
+	0x92, 0x8f, 0x3b, 0x5b, 0xfe, 0x04, 0x7c, 0x39, 0xb1, 0x14, 0xed, 0x3b, 0xc2, 0x93, 0x13, 0xa2,
+	0xf7, 0x10, 0x5c, 0x39, 0xc1, 0xf5, 0xe9, 0x90, 0x3b, 0x47, 0x4e, 0xc6, 0xb2, 0x1e, 0x10, 0xe7,
+	0x23, 0xa3, 0xea, 0xde, 0x1a, 0xd5, 0x11, 0xf8, 0x36, 0xe3, 0x32, 0xf4, 0xee, 0x5c, 0xec, 0x46,
+	0xcd, 0x46, 0xe0, 0xda, 0xab, 0xe3, 0x6f, 0x5e, 0x9d, 0x55, 0xf6, 0xdc, 0x1a, 0xb0, 0x17, 0xe0,
+	0x6a, 0x35, 0xbb, 0x2e, 0xc3, 0xee, 0x9d, 0x1e, 0xad, 0xf2, 0xe6, 0x2a, 0xf4, 0x6e, 0xae, 0x02,
+	0xdb, 0x81, 0x41, 0x71, 0x19, 0xcb, 0xaa, 0x88, 0xa7, 0x17, 0x6a, 0x7a, 0x59, 0x0f, 0x03, 0x14,
+	0x97, 0x87, 0x55, 0x71, 0x80, 0x08, 0xfb, 0x0b, 0x78, 0xf8, 0x1e, 0xab, 0x4a, 0x1a, 0x85, 0xb5,
+	0x57, 0x09, 0x71, 0x3a, 0x25, 0x15, 0xaf, 0x4d, 0xd8, 0x63, 0xf0, 0xd4, 0x75, 0x21, 0x32, 0x49,
+	0x53, 0x81, 0x37, 0x95, 0x24, 0xf6, 0x12, 0x7c, 0x9a, 0x2f, 0x55, 0x86, 0x5b, 0xc4, 0x77, 0x79,
+	0x4a, 0xc6, 0x08, 0xf3, 0x46, 0xcb, 0x22, 0xd8, 0xc2, 0x8f, 0x84, 0x7a, 0x66, 0x12, 0x19, 0x0e,
+	0xa9, 0xca, 0xfd, 0xb9, 0xb8, 0xb6, 0x99, 0x8d, 0x25, 0x8b, 0xa0, 0x63, 0x4c, 0x1a, 0xde, 0xa7,
+	0x12, 0x05, 0x1b, 0x74, 0xce, 0xce, 0x8e, 0x38, 0x2a, 0xa3, 0x7f, 0x40, 0xb7, 0x01, 0x90, 0x94,
+	0xf5, 0x57, 0xb7, 0xbc, 0x96, 0x70, 0xb1, 0x64, 0xa5, 0x85, 0x69, 0xa6, 0xa5, 0xc3, 0x97, 0x72,
+	0xf4, 0x19, 0xb8, 0xc4, 0xec, 0xce, 0x69, 0xf9, 0x23, 0xc0, 0x92, 0x60, 0x19, 0xb6, 0x77, 0x3a,
+	0x23, 0x87, 0xf7, 0xa6, 0x35, 0xbd, 0xcd, 0x3b, 0xdf, 0xd9, 0xbc, 0xf3, 0xa3, 0xe6, 0xa3, 0xc1,
+	0xa1, 0x5a, 0xb2, 0x8d, 0x2a, 0xac, 0x7f, 0x35, 0xec, 0x96, 0xf6, 0x3b, 0x88, 0x30, 0xb6, 0x05,
+	0xbd, 0xe3, 0x78, 0x9c, 0x5d, 0x89, 0x34, 0x91, 0xc1, 0x3d, 0xd6, 0x07, 0xff, 0x38, 0x3e, 0xca,
+	0x67, 0x49, 0x16, 0xb4, 0xd8, 0x00, 0xba, 0x24, 0xe4, 0x95, 0x09, 0xda, 0xd6, 0xf2, 0xdd, 0xf9,
+	0x79, 0x9a, 0x64, 0x2a, 0xe8, 0xb0, 0xfb, 0xd0, 0x3f, 0x8e, 0xcf, 0xf2, 0xf9, 0xa4, 0x34, 0x79,
+	0xa6, 0x02, 0xc7, 0xea, 0xff, 0x5b, 0xcc, 0xb4, 0x90, 0x2a, 0x70, 0x1b, 0xc7, 0x89, 0x49, 0x44,
+	0x1a, 0x78, 0xbb, 0x5f, 0xb4, 0xea, 0xd7, 0xf1, 0x32, 0x2c, 0x5f, 0x0b, 0x0b, 0xe0, 0x71, 0x32,
+	0xb6, 0x51, 0x79, 0x7c, 0x9c, 0xeb, 0xb9, 0x48, 0x83, 0x36, 0x12, 0xe2, 0xf1, 0x69, 0x91, 0x26,
+	0x26, 0xe8, 0x58, 0xe1, 0x3f, 0x4a, 0xcf, 0x30, 0x1e, 0xd9, 0x71, 0x35, 0xcf, 0xaf, 0x30, 0xdc,
+	0x10, 0x80, 0xc7, 0x47, 0xb9, 0x90, 0xa7, 0x99, 0x28, 0x02, 0xcf, 0xca, 0xfb, 0x93, 0xcc, 0xfa,
+	0xf1, 0x6d, 0xc0, 0x86, 0x7d, 0x77, 0xf7, 0x18, 0xfa, 0x6b, 0xdf, 0x2b, 0x2c, 0x80, 0x01, 0xfe,
+	0x5d, 0x63, 0xf4, 0x3b, 0x78, 0x40, 0xc8, 0xe9, 0x22, 0x9b, 0xc6, 0xb5, 0x69, 0xd0, 0x62, 0x8f,
+	0x81, 0x11, 0xbc, 0x5f, 0xae, 0xe3, 0xed, 0xdd, 0x2f, 0xeb, 0x77, 0x10, 0x9d, 0xe9, 0x3e, 0xf8,
+	0x1b, 0x15, 0x3d, 0x4b, 0xb2, 0x45, 0x92, 0xd5, 0xb9, 0x9d, 0xce, 0x45, 0x9a, 0xa2, 0xd4, 0x66,
+	0x3e, 0x74, 0xc6, 0x19, 0xe6, 0x05, 0xe0, 0xbd, 0x49, 0x66, 0xf8, 0xec, 0xb0, 0x1e, 0xb8, 0xff,
+	0x4e, 0x73, 0x61, 0x02, 0x17, 0xe1, 0xc3, 0xbc, 0x9a, 0xa4, 0x2a, 0xf0, 0xd0, 0xcd, 0x7b, 0xa1,
+	0xa7, 0x17, 0x42, 0x07, 0xbe, 0xb5, 0xcf, 0x84, 0x5e, 0x04, 0x5d, 0xd6, 0x05, 0xe7, 0x50, 0x18,
+	0x15, 0xf4, 0x30, 0x45, 0x5c, 0xbc, 0x53, 0x23, 0xe6, 0x45, 0x00, 0xbb, 0x57, 0xd0, 0x5f, 0xdb,
+	0x23, 0x4c, 0x91, 0xc4, 0x15, 0x33, 0xb4, 0xb7, 0x08, 0xd5, 0xbd, 0x31, 0x38, 0xd1, 0xaa, 0x10,
+	0x5a, 0x05, 0xed, 0x25, 0xc2, 0xab, 0x2c, 0x4b, 0xb2, 0x99, 0x6d, 0x3a, 0x21, 0x87, 0x2a, 0x55,
+	0x06, 0x9b, 0xf0, 0x00, 0xb6, 0x56, 0x00, 0xda, 0xb8, 0xbb, 0x07, 0x00, 0xab, 0x99, 0x43, 0x1f,
+	0x24, 0xad, 0xc2, 0x32, 0x18, 0x12, 0xf2, 0x3f, 0x9d, 0x18, 0xf5, 0x2e, 0x4b, 0x17, 0x41, 0x0b,
+	0xfd, 0x12, 0x76, 0x52, 0x4d, 0xd2, 0x64, 0x1a, 0xb4, 0xdf, 0x04, 0x5f, 0x7f, 0xd8, 0x6e, 0x7d,
+	0xf3, 0x61, 0xbb, 0xf5, 0xfd, 0x87, 0xed, 0xd6, 0x57, 0x3f, 0x6c, 0xdf, 0x9b, 0x78, 0xf4, 0x5f,
+	0xc0, 0xeb, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xca, 0x84, 0x57, 0xce, 0x33, 0x0c, 0x00, 0x00,
 }
@@ -22,6 +22,9 @@
 		AskSplitResponse
 		ReportSplitRequest
 		ReportSplitResponse
+		RangeKeySamples
+		ReportKeySamplesRequest
+		ReportKeySamplesResponse
 		NodeLoginRequest
 		NodeLoginResponse
 		GetNodeIdRequest
@@ -44,16 +47,32 @@
 		GetColumnByIdResponse
 		AddColumnRequest
 		AddColumnResponse
+		ColumnAlteration
+		AlterColumnRequest
+		AlterColumnResponse
 		TruncateTableRequest
 		TruncateTableResponse
 		CreateDatabaseRequest
 		CreateDatabaseResponse
 		CreateTableRequest
 		CreateTableResponse
+		DeleteDatabaseRequest
+		DeleteDatabaseResponse
+		DeleteTableRequest
+		DeleteTableResponse
+		CreateIndexRequest
+		CreateIndexResponse
+		SetIndexStateRequest
+		SetIndexStateResponse
+		GetDatabasesRequest
+		GetDatabasesResponse
+		GetTablesRequest
+		GetTablesResponse
 		RequestHeader
 		ResponseHeader
 		MsLeader
 		NoLeader
+		SchemaError
 		Error
 */
 package mspb
@@ -84,6 +103,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type AlterColumnType int32
+
+const (
+	AlterColumnType_AlterColumnInvalid AlterColumnType = 0
+	// 删除列, 已有数据中的列值在读取时忽略
+	AlterColumnType_AlterColumnDrop AlterColumnType = 1
+	// 重命名列, 列ID不变
+	AlterColumnType_AlterColumnRename AlterColumnType = 2
+	// 修改默认值, default_value为空表示删除默认值
+	AlterColumnType_AlterColumnDefault AlterColumnType = 3
+	// 修改是否可以为空
+	AlterColumnType_AlterColumnNullable AlterColumnType = 4
+)
+
+var AlterColumnType_name = map[int32]string{
+	0: "AlterColumnInvalid",
+	1: "AlterColumnDrop",
+	2: "AlterColumnRename",
+	3: "AlterColumnDefault",
+	4: "AlterColumnNullable",
+}
+var AlterColumnType_value = map[string]int32{
+	"AlterColumnInvalid":  0,
+	"AlterColumnDrop":     1,
+	"AlterColumnRename":   2,
+	"AlterColumnDefault":  3,
+	"AlterColumnNullable": 4,
+}
+
+func (x AlterColumnType) String() string {
+	return proto.EnumName(AlterColumnType_name, int32(x))
+}
+func (AlterColumnType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMspb, []int{0} }
+
+// 元数据操作的错误码, 调用方按错误码区分需要处理的错误
+type SchemaErrorCode int32
+
+const (
+	SchemaErrorCode_SchemaErrUnknown               SchemaErrorCode = 0
+	SchemaErrorCode_SchemaErrDupDatabase           SchemaErrorCode = 1
+	SchemaErrorCode_SchemaErrNotExistDatabase      SchemaErrorCode = 2
+	SchemaErrorCode_SchemaErrDupTable              SchemaErrorCode = 3
+	SchemaErrorCode_SchemaErrNotExistTable         SchemaErrorCode = 4
+	SchemaErrorCode_SchemaErrTableSchemaStale      SchemaErrorCode = 5
+	SchemaErrorCode_SchemaErrColumnInIndex         SchemaErrorCode = 6
+	SchemaErrorCode_SchemaErrColumnNotAllowNotNull SchemaErrorCode = 7
+	SchemaErrorCode_SchemaErrDupIndex              SchemaErrorCode = 8
+	SchemaErrorCode_SchemaErrInvalidIndexColumn    SchemaErrorCode = 9
+)
+
+var SchemaErrorCode_name = map[int32]string{
+	0: "SchemaErrUnknown",
+	1: "SchemaErrDupDatabase",
+	2: "SchemaErrNotExistDatabase",
+	3: "SchemaErrDupTable",
+	4: "SchemaErrNotExistTable",
+	5: "SchemaErrTableSchemaStale",
+	6: "SchemaErrColumnInIndex",
+	7: "SchemaErrColumnNotAllowNotNull",
+	8: "SchemaErrDupIndex",
+	9: "SchemaErrInvalidIndexColumn",
+}
+var SchemaErrorCode_value = map[string]int32{
+	"SchemaErrUnknown":               0,
+	"SchemaErrDupDatabase":           1,
+	"SchemaErrNotExistDatabase":      2,
+	"SchemaErrDupTable":              3,
+	"SchemaErrNotExistTable":         4,
+	"SchemaErrTableSchemaStale":      5,
+	"SchemaErrColumnInIndex":         6,
+	"SchemaErrColumnNotAllowNotNull": 7,
+	"SchemaErrDupIndex":              8,
+	"SchemaErrInvalidIndexColumn":    9,
+}
+
+func (x SchemaErrorCode) String() string {
+	return proto.EnumName(SchemaErrorCode_name, int32(x))
+}
+func (SchemaErrorCode) EnumDescriptor() ([]byte, []int) { return fileDescriptorMspb, []int{1} }
+
 type MSLeader struct {
 	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -689,6 +788,79 @@ func (m *ReportSplitResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+// gateway对每个range的请求key抽样, 用于选择按负载分裂的分裂点
+type RangeKeySamples struct {
+	RangeId    uint64             `protobuf:"varint,1,opt,name=range_id,json=rangeId,proto3" json:"range_id,omitempty"`
+	RangeEpoch *metapb.RangeEpoch `protobuf:"bytes,2,opt,name=range_epoch,json=rangeEpoch" json:"range_epoch,omitempty"`
+	Keys       [][]byte           `protobuf:"bytes,3,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *RangeKeySamples) Reset()                    { *m = RangeKeySamples{} }
+func (m *RangeKeySamples) String() string            { return proto.CompactTextString(m) }
+func (*RangeKeySamples) ProtoMessage()               {}
+func (*RangeKeySamples) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{14} }
+
+func (m *RangeKeySamples) GetRangeId() uint64 {
+	if m != nil {
+		return m.RangeId
+	}
+	return 0
+}
+
+func (m *RangeKeySamples) GetRangeEpoch() *metapb.RangeEpoch {
+	if m != nil {
+		return m.RangeEpoch
+	}
+	return nil
+}
+
+func (m *RangeKeySamples) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ReportKeySamplesRequest struct {
+	Header  *RequestHeader     `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Samples []*RangeKeySamples `protobuf:"bytes,2,rep,name=samples" json:"samples,omitempty"`
+}
+
+func (m *ReportKeySamplesRequest) Reset()                    { *m = ReportKeySamplesRequest{} }
+func (m *ReportKeySamplesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReportKeySamplesRequest) ProtoMessage()               {}
+func (*ReportKeySamplesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{15} }
+
+func (m *ReportKeySamplesRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ReportKeySamplesRequest) GetSamples() []*RangeKeySamples {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type ReportKeySamplesResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *ReportKeySamplesResponse) Reset()                    { *m = ReportKeySamplesResponse{} }
+func (m *ReportKeySamplesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReportKeySamplesResponse) ProtoMessage()               {}
+func (*ReportKeySamplesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{16} }
+
+func (m *ReportKeySamplesResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 type NodeLoginRequest struct {
 	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	NodeId uint64         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -697,7 +869,7 @@ type NodeLoginRequest struct {
 func (m *NodeLoginRequest) Reset()                    { *m = NodeLoginRequest{} }
 func (m *NodeLoginRequest) String() string            { return proto.CompactTextString(m) }
 func (*NodeLoginRequest) ProtoMessage()               {}
-func (*NodeLoginRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{14} }
+func (*NodeLoginRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{17} }
 
 func (m *NodeLoginRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -720,7 +892,7 @@ type NodeLoginResponse struct {
 func (m *NodeLoginResponse) Reset()                    { *m = NodeLoginResponse{} }
 func (m *NodeLoginResponse) String() string            { return proto.CompactTextString(m) }
 func (*NodeLoginResponse) ProtoMessage()               {}
-func (*NodeLoginResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{15} }
+func (*NodeLoginResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{18} }
 
 func (m *NodeLoginResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -740,7 +912,7 @@ type GetNodeIdRequest struct {
 func (m *GetNodeIdRequest) Reset()                    { *m = GetNodeIdRequest{} }
 func (m *GetNodeIdRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeIdRequest) ProtoMessage()               {}
-func (*GetNodeIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{16} }
+func (*GetNodeIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{19} }
 
 func (m *GetNodeIdRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -787,7 +959,7 @@ type GetNodeIdResponse struct {
 func (m *GetNodeIdResponse) Reset()                    { *m = GetNodeIdResponse{} }
 func (m *GetNodeIdResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeIdResponse) ProtoMessage()               {}
-func (*GetNodeIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{17} }
+func (*GetNodeIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{20} }
 
 func (m *GetNodeIdResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -820,7 +992,7 @@ type GetRouteRequest struct {
 func (m *GetRouteRequest) Reset()                    { *m = GetRouteRequest{} }
 func (m *GetRouteRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRouteRequest) ProtoMessage()               {}
-func (*GetRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{18} }
+func (*GetRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{21} }
 
 func (m *GetRouteRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -858,7 +1030,7 @@ type GetRouteResponse struct {
 func (m *GetRouteResponse) Reset()                    { *m = GetRouteResponse{} }
 func (m *GetRouteResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetRouteResponse) ProtoMessage()               {}
-func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{19} }
+func (*GetRouteResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{22} }
 
 func (m *GetRouteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -882,7 +1054,7 @@ type GetNodeRequest struct {
 func (m *GetNodeRequest) Reset()                    { *m = GetNodeRequest{} }
 func (m *GetNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeRequest) ProtoMessage()               {}
-func (*GetNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{20} }
+func (*GetNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{23} }
 
 func (m *GetNodeRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -906,7 +1078,7 @@ type GetNodeResponse struct {
 func (m *GetNodeResponse) Reset()                    { *m = GetNodeResponse{} }
 func (m *GetNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNodeResponse) ProtoMessage()               {}
-func (*GetNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{21} }
+func (*GetNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{24} }
 
 func (m *GetNodeResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -930,7 +1102,7 @@ type GetDBRequest struct {
 func (m *GetDBRequest) Reset()                    { *m = GetDBRequest{} }
 func (m *GetDBRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDBRequest) ProtoMessage()               {}
-func (*GetDBRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{22} }
+func (*GetDBRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{25} }
 
 func (m *GetDBRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -954,7 +1126,7 @@ type GetDBResponse struct {
 func (m *GetDBResponse) Reset()                    { *m = GetDBResponse{} }
 func (m *GetDBResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDBResponse) ProtoMessage()               {}
-func (*GetDBResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{23} }
+func (*GetDBResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{26} }
 
 func (m *GetDBResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -979,7 +1151,7 @@ type GetTableRequest struct {
 func (m *GetTableRequest) Reset()                    { *m = GetTableRequest{} }
 func (m *GetTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTableRequest) ProtoMessage()               {}
-func (*GetTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{24} }
+func (*GetTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{27} }
 
 func (m *GetTableRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1011,7 +1183,7 @@ type GetTableByIdRequest struct {
 func (m *GetTableByIdRequest) Reset()                    { *m = GetTableByIdRequest{} }
 func (m *GetTableByIdRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTableByIdRequest) ProtoMessage()               {}
-func (*GetTableByIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{25} }
+func (*GetTableByIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{28} }
 
 func (m *GetTableByIdRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1042,7 +1214,7 @@ type GetTableResponse struct {
 func (m *GetTableResponse) Reset()                    { *m = GetTableResponse{} }
 func (m *GetTableResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTableResponse) ProtoMessage()               {}
-func (*GetTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{26} }
+func (*GetTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{29} }
 
 func (m *GetTableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1066,7 +1238,7 @@ type GetTableByIdResponse struct {
 func (m *GetTableByIdResponse) Reset()                    { *m = GetTableByIdResponse{} }
 func (m *GetTableByIdResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTableByIdResponse) ProtoMessage()               {}
-func (*GetTableByIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{27} }
+func (*GetTableByIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{30} }
 
 func (m *GetTableByIdResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1091,7 +1263,7 @@ type GetColumnsRequest struct {
 func (m *GetColumnsRequest) Reset()                    { *m = GetColumnsRequest{} }
 func (m *GetColumnsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnsRequest) ProtoMessage()               {}
-func (*GetColumnsRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{28} }
+func (*GetColumnsRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{31} }
 
 func (m *GetColumnsRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1122,7 +1294,7 @@ type GetColumnsResponse struct {
 func (m *GetColumnsResponse) Reset()                    { *m = GetColumnsResponse{} }
 func (m *GetColumnsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnsResponse) ProtoMessage()               {}
-func (*GetColumnsResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{29} }
+func (*GetColumnsResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{32} }
 
 func (m *GetColumnsResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1148,7 +1320,7 @@ type GetColumnByNameRequest struct {
 func (m *GetColumnByNameRequest) Reset()                    { *m = GetColumnByNameRequest{} }
 func (m *GetColumnByNameRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnByNameRequest) ProtoMessage()               {}
-func (*GetColumnByNameRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{30} }
+func (*GetColumnByNameRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{33} }
 
 func (m *GetColumnByNameRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1186,7 +1358,7 @@ type GetColumnByNameResponse struct {
 func (m *GetColumnByNameResponse) Reset()                    { *m = GetColumnByNameResponse{} }
 func (m *GetColumnByNameResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnByNameResponse) ProtoMessage()               {}
-func (*GetColumnByNameResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{31} }
+func (*GetColumnByNameResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{34} }
 
 func (m *GetColumnByNameResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1212,7 +1384,7 @@ type GetColumnByIdRequest struct {
 func (m *GetColumnByIdRequest) Reset()                    { *m = GetColumnByIdRequest{} }
 func (m *GetColumnByIdRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnByIdRequest) ProtoMessage()               {}
-func (*GetColumnByIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{32} }
+func (*GetColumnByIdRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{35} }
 
 func (m *GetColumnByIdRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1250,7 +1422,7 @@ type GetColumnByIdResponse struct {
 func (m *GetColumnByIdResponse) Reset()                    { *m = GetColumnByIdResponse{} }
 func (m *GetColumnByIdResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetColumnByIdResponse) ProtoMessage()               {}
-func (*GetColumnByIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{33} }
+func (*GetColumnByIdResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{36} }
 
 func (m *GetColumnByIdResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1276,7 +1448,7 @@ type AddColumnRequest struct {
 func (m *AddColumnRequest) Reset()                    { *m = AddColumnRequest{} }
 func (m *AddColumnRequest) String() string            { return proto.CompactTextString(m) }
 func (*AddColumnRequest) ProtoMessage()               {}
-func (*AddColumnRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{34} }
+func (*AddColumnRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{37} }
 
 func (m *AddColumnRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1314,7 +1486,7 @@ type AddColumnResponse struct {
 func (m *AddColumnResponse) Reset()                    { *m = AddColumnResponse{} }
 func (m *AddColumnResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddColumnResponse) ProtoMessage()               {}
-func (*AddColumnResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{35} }
+func (*AddColumnResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{38} }
 
 func (m *AddColumnResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1330,6 +1502,128 @@ func (m *AddColumnResponse) GetColumns() []*metapb.Column {
 	return nil
 }
 
+type ColumnAlteration struct {
+	Type         AlterColumnType `protobuf:"varint,1,opt,name=type,proto3,enum=mspb.AlterColumnType" json:"type,omitempty"`
+	Name         string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName      string          `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	DefaultValue []byte          `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Nullable     bool            `protobuf:"varint,5,opt,name=nullable,proto3" json:"nullable,omitempty"`
+}
+
+func (m *ColumnAlteration) Reset()                    { *m = ColumnAlteration{} }
+func (m *ColumnAlteration) String() string            { return proto.CompactTextString(m) }
+func (*ColumnAlteration) ProtoMessage()               {}
+func (*ColumnAlteration) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{39} }
+
+func (m *ColumnAlteration) GetType() AlterColumnType {
+	if m != nil {
+		return m.Type
+	}
+	return AlterColumnType_AlterColumnInvalid
+}
+
+func (m *ColumnAlteration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ColumnAlteration) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *ColumnAlteration) GetDefaultValue() []byte {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *ColumnAlteration) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+type AlterColumnRequest struct {
+	Header  *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbId    uint64         `protobuf:"varint,2,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	TableId uint64         `protobuf:"varint,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 发起修改时的表结构版本(table epoch conf_ver), 与master不一致时拒绝修改, 0表示不检查
+	ConfVer     uint64              `protobuf:"varint,4,opt,name=conf_ver,json=confVer,proto3" json:"conf_ver,omitempty"`
+	Alterations []*ColumnAlteration `protobuf:"bytes,5,rep,name=alterations" json:"alterations,omitempty"`
+}
+
+func (m *AlterColumnRequest) Reset()                    { *m = AlterColumnRequest{} }
+func (m *AlterColumnRequest) String() string            { return proto.CompactTextString(m) }
+func (*AlterColumnRequest) ProtoMessage()               {}
+func (*AlterColumnRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{40} }
+
+func (m *AlterColumnRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AlterColumnRequest) GetDbId() uint64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *AlterColumnRequest) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *AlterColumnRequest) GetConfVer() uint64 {
+	if m != nil {
+		return m.ConfVer
+	}
+	return 0
+}
+
+func (m *AlterColumnRequest) GetAlterations() []*ColumnAlteration {
+	if m != nil {
+		return m.Alterations
+	}
+	return nil
+}
+
+type AlterColumnResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// 修改后的表结构
+	Table *metapb.Table `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
+}
+
+func (m *AlterColumnResponse) Reset()                    { *m = AlterColumnResponse{} }
+func (m *AlterColumnResponse) String() string            { return proto.CompactTextString(m) }
+func (*AlterColumnResponse) ProtoMessage()               {}
+func (*AlterColumnResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{41} }
+
+func (m *AlterColumnResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AlterColumnResponse) GetTable() *metapb.Table {
+	if m != nil {
+		return m.Table
+	}
+	return nil
+}
+
 type TruncateTableRequest struct {
 	Header  *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	DbId    uint64         `protobuf:"varint,2,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
@@ -1339,7 +1633,7 @@ type TruncateTableRequest struct {
 func (m *TruncateTableRequest) Reset()                    { *m = TruncateTableRequest{} }
 func (m *TruncateTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*TruncateTableRequest) ProtoMessage()               {}
-func (*TruncateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{36} }
+func (*TruncateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{42} }
 
 func (m *TruncateTableRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1369,7 +1663,7 @@ type TruncateTableResponse struct {
 func (m *TruncateTableResponse) Reset()                    { *m = TruncateTableResponse{} }
 func (m *TruncateTableResponse) String() string            { return proto.CompactTextString(m) }
 func (*TruncateTableResponse) ProtoMessage()               {}
-func (*TruncateTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{37} }
+func (*TruncateTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{43} }
 
 func (m *TruncateTableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1386,7 +1680,7 @@ type CreateDatabaseRequest struct {
 func (m *CreateDatabaseRequest) Reset()                    { *m = CreateDatabaseRequest{} }
 func (m *CreateDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseRequest) ProtoMessage()               {}
-func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{38} }
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{44} }
 
 func (m *CreateDatabaseRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1409,7 +1703,7 @@ type CreateDatabaseResponse struct {
 func (m *CreateDatabaseResponse) Reset()                    { *m = CreateDatabaseResponse{} }
 func (m *CreateDatabaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseResponse) ProtoMessage()               {}
-func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{39} }
+func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{45} }
 
 func (m *CreateDatabaseResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1423,12 +1717,14 @@ type CreateTableRequest struct {
 	DbName     string         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	TableName  string         `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
 	Properties string         `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	// 预分裂的第一主键列的值
+	SplitKeys [][]byte `protobuf:"bytes,5,rep,name=split_keys,json=splitKeys" json:"split_keys,omitempty"`
 }
 
 func (m *CreateTableRequest) Reset()                    { *m = CreateTableRequest{} }
 func (m *CreateTableRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateTableRequest) ProtoMessage()               {}
-func (*CreateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{40} }
+func (*CreateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{46} }
 
 func (m *CreateTableRequest) GetHeader() *RequestHeader {
 	if m != nil {
@@ -1458,6 +1754,13 @@ func (m *CreateTableRequest) GetProperties() string {
 	return ""
 }
 
+func (m *CreateTableRequest) GetSplitKeys() [][]byte {
+	if m != nil {
+		return m.SplitKeys
+	}
+	return nil
+}
+
 type CreateTableResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 }
@@ -1465,7 +1768,7 @@ type CreateTableResponse struct {
 func (m *CreateTableResponse) Reset()                    { *m = CreateTableResponse{} }
 func (m *CreateTableResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateTableResponse) ProtoMessage()               {}
-func (*CreateTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{41} }
+func (*CreateTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{47} }
 
 func (m *CreateTableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1474,113 +1777,461 @@ func (m *CreateTableResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
-type RequestHeader struct {
-	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+type DeleteDatabaseRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbName string         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 }
 
-func (m *RequestHeader) Reset()                    { *m = RequestHeader{} }
-func (m *RequestHeader) String() string            { return proto.CompactTextString(m) }
-func (*RequestHeader) ProtoMessage()               {}
-func (*RequestHeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{42} }
+func (m *DeleteDatabaseRequest) Reset()                    { *m = DeleteDatabaseRequest{} }
+func (m *DeleteDatabaseRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDatabaseRequest) ProtoMessage()               {}
+func (*DeleteDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{48} }
 
-func (m *RequestHeader) GetClusterId() uint64 {
+func (m *DeleteDatabaseRequest) GetHeader() *RequestHeader {
 	if m != nil {
-		return m.ClusterId
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-type ResponseHeader struct {
-	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	Error     *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+func (m *DeleteDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *ResponseHeader) Reset()                    { *m = ResponseHeader{} }
-func (m *ResponseHeader) String() string            { return proto.CompactTextString(m) }
-func (*ResponseHeader) ProtoMessage()               {}
-func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{43} }
+type DeleteDatabaseResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
 
-func (m *ResponseHeader) GetClusterId() uint64 {
+func (m *DeleteDatabaseResponse) Reset()                    { *m = DeleteDatabaseResponse{} }
+func (m *DeleteDatabaseResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDatabaseResponse) ProtoMessage()               {}
+func (*DeleteDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{49} }
+
+func (m *DeleteDatabaseResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.ClusterId
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *ResponseHeader) GetError() *Error {
+type DeleteTableRequest struct {
+	Header    *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbName    string         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName string         `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+}
+
+func (m *DeleteTableRequest) Reset()                    { *m = DeleteTableRequest{} }
+func (m *DeleteTableRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTableRequest) ProtoMessage()               {}
+func (*DeleteTableRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{50} }
+
+func (m *DeleteTableRequest) GetHeader() *RequestHeader {
 	if m != nil {
-		return m.Error
+		return m.Header
 	}
 	return nil
 }
 
-type MsLeader struct {
-	MsLeader string `protobuf:"bytes,1,opt,name=ms_leader,json=msLeader,proto3" json:"ms_leader,omitempty"`
+func (m *DeleteTableRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *MsLeader) Reset()                    { *m = MsLeader{} }
-func (m *MsLeader) String() string            { return proto.CompactTextString(m) }
-func (*MsLeader) ProtoMessage()               {}
-func (*MsLeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{44} }
+func (m *DeleteTableRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
 
-func (m *MsLeader) GetMsLeader() string {
+type DeleteTableResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *DeleteTableResponse) Reset()                    { *m = DeleteTableResponse{} }
+func (m *DeleteTableResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTableResponse) ProtoMessage()               {}
+func (*DeleteTableResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{51} }
+
+func (m *DeleteTableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.MsLeader
+		return m.Header
+	}
+	return nil
+}
+
+type CreateIndexRequest struct {
+	Header    *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbName    string         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName string         `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IndexName string         `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Columns   []string       `protobuf:"bytes,5,rep,name=columns" json:"columns,omitempty"`
+}
+
+func (m *CreateIndexRequest) Reset()                    { *m = CreateIndexRequest{} }
+func (m *CreateIndexRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateIndexRequest) ProtoMessage()               {}
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{52} }
+
+func (m *CreateIndexRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CreateIndexRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
 	return ""
 }
 
-type NoLeader struct {
+func (m *CreateIndexRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
 }
 
-func (m *NoLeader) Reset()                    { *m = NoLeader{} }
-func (m *NoLeader) String() string            { return proto.CompactTextString(m) }
-func (*NoLeader) ProtoMessage()               {}
-func (*NoLeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{45} }
+func (m *CreateIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
 
-type Error struct {
-	MsLeader *MsLeader `protobuf:"bytes,2,opt,name=ms_leader,json=msLeader" json:"ms_leader,omitempty"`
-	NoLeader *NoLeader `protobuf:"bytes,3,opt,name=no_leader,json=noLeader" json:"no_leader,omitempty"`
+func (m *CreateIndexRequest) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
 }
 
-func (m *Error) Reset()                    { *m = Error{} }
-func (m *Error) String() string            { return proto.CompactTextString(m) }
-func (*Error) ProtoMessage()               {}
-func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{46} }
+type CreateIndexResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Index  *metapb.Index   `protobuf:"bytes,2,opt,name=index" json:"index,omitempty"`
+}
 
-func (m *Error) GetMsLeader() *MsLeader {
+func (m *CreateIndexResponse) Reset()                    { *m = CreateIndexResponse{} }
+func (m *CreateIndexResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateIndexResponse) ProtoMessage()               {}
+func (*CreateIndexResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{53} }
+
+func (m *CreateIndexResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.MsLeader
+		return m.Header
 	}
 	return nil
 }
 
-func (m *Error) GetNoLeader() *NoLeader {
+func (m *CreateIndexResponse) GetIndex() *metapb.Index {
 	if m != nil {
-		return m.NoLeader
+		return m.Index
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MSLeader)(nil), "mspb.MSLeader")
-	proto.RegisterType((*GetMSLeaderRequest)(nil), "mspb.GetMSLeaderRequest")
-	proto.RegisterType((*GetMSLeaderResponse)(nil), "mspb.GetMSLeaderResponse")
-	proto.RegisterType((*PeerStats)(nil), "mspb.PeerStats")
-	proto.RegisterType((*RangeStats)(nil), "mspb.RangeStats")
-	proto.RegisterType((*RangeHeartbeatRequest)(nil), "mspb.RangeHeartbeatRequest")
-	proto.RegisterType((*RangeHeartbeatResponse)(nil), "mspb.RangeHeartbeatResponse")
-	proto.RegisterType((*NodeStats)(nil), "mspb.NodeStats")
-	proto.RegisterType((*NodeHeartbeatRequest)(nil), "mspb.NodeHeartbeatRequest")
-	proto.RegisterType((*NodeHeartbeatResponse)(nil), "mspb.NodeHeartbeatResponse")
-	proto.RegisterType((*AskSplitRequest)(nil), "mspb.AskSplitRequest")
-	proto.RegisterType((*AskSplitResponse)(nil), "mspb.AskSplitResponse")
-	proto.RegisterType((*ReportSplitRequest)(nil), "mspb.ReportSplitRequest")
-	proto.RegisterType((*ReportSplitResponse)(nil), "mspb.ReportSplitResponse")
-	proto.RegisterType((*NodeLoginRequest)(nil), "mspb.NodeLoginRequest")
-	proto.RegisterType((*NodeLoginResponse)(nil), "mspb.NodeLoginResponse")
-	proto.RegisterType((*GetNodeIdRequest)(nil), "mspb.GetNodeIdRequest")
-	proto.RegisterType((*GetNodeIdResponse)(nil), "mspb.GetNodeIdResponse")
+type SetIndexStateRequest struct {
+	Header    *RequestHeader    `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbName    string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName string            `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IndexName string            `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	State     metapb.IndexState `protobuf:"varint,5,opt,name=state,proto3,enum=metapb.IndexState" json:"state,omitempty"`
+}
+
+func (m *SetIndexStateRequest) Reset()                    { *m = SetIndexStateRequest{} }
+func (m *SetIndexStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetIndexStateRequest) ProtoMessage()               {}
+func (*SetIndexStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{54} }
+
+func (m *SetIndexStateRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetIndexStateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *SetIndexStateRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *SetIndexStateRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *SetIndexStateRequest) GetState() metapb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return metapb.IndexState_IndexInvalid
+}
+
+type SetIndexStateResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *SetIndexStateResponse) Reset()                    { *m = SetIndexStateResponse{} }
+func (m *SetIndexStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetIndexStateResponse) ProtoMessage()               {}
+func (*SetIndexStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{55} }
+
+func (m *SetIndexStateResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type GetDatabasesRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *GetDatabasesRequest) Reset()                    { *m = GetDatabasesRequest{} }
+func (m *GetDatabasesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDatabasesRequest) ProtoMessage()               {}
+func (*GetDatabasesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{56} }
+
+func (m *GetDatabasesRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type GetDatabasesResponse struct {
+	Header *ResponseHeader    `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Dbs    []*metapb.DataBase `protobuf:"bytes,2,rep,name=dbs" json:"dbs,omitempty"`
+}
+
+func (m *GetDatabasesResponse) Reset()                    { *m = GetDatabasesResponse{} }
+func (m *GetDatabasesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDatabasesResponse) ProtoMessage()               {}
+func (*GetDatabasesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{57} }
+
+func (m *GetDatabasesResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetDatabasesResponse) GetDbs() []*metapb.DataBase {
+	if m != nil {
+		return m.Dbs
+	}
+	return nil
+}
+
+// 只返回正常工作的表
+type GetTablesRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	DbName string         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+}
+
+func (m *GetTablesRequest) Reset()                    { *m = GetTablesRequest{} }
+func (m *GetTablesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTablesRequest) ProtoMessage()               {}
+func (*GetTablesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{58} }
+
+func (m *GetTablesRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetTablesRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type GetTablesResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Tables []*metapb.Table `protobuf:"bytes,2,rep,name=tables" json:"tables,omitempty"`
+}
+
+func (m *GetTablesResponse) Reset()                    { *m = GetTablesResponse{} }
+func (m *GetTablesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTablesResponse) ProtoMessage()               {}
+func (*GetTablesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{59} }
+
+func (m *GetTablesResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetTablesResponse) GetTables() []*metapb.Table {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type RequestHeader struct {
+	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (m *RequestHeader) Reset()                    { *m = RequestHeader{} }
+func (m *RequestHeader) String() string            { return proto.CompactTextString(m) }
+func (*RequestHeader) ProtoMessage()               {}
+func (*RequestHeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{60} }
+
+func (m *RequestHeader) GetClusterId() uint64 {
+	if m != nil {
+		return m.ClusterId
+	}
+	return 0
+}
+
+type ResponseHeader struct {
+	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Error     *Error `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ResponseHeader) Reset()                    { *m = ResponseHeader{} }
+func (m *ResponseHeader) String() string            { return proto.CompactTextString(m) }
+func (*ResponseHeader) ProtoMessage()               {}
+func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{61} }
+
+func (m *ResponseHeader) GetClusterId() uint64 {
+	if m != nil {
+		return m.ClusterId
+	}
+	return 0
+}
+
+func (m *ResponseHeader) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type MsLeader struct {
+	MsLeader string `protobuf:"bytes,1,opt,name=ms_leader,json=msLeader,proto3" json:"ms_leader,omitempty"`
+}
+
+func (m *MsLeader) Reset()                    { *m = MsLeader{} }
+func (m *MsLeader) String() string            { return proto.CompactTextString(m) }
+func (*MsLeader) ProtoMessage()               {}
+func (*MsLeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{62} }
+
+func (m *MsLeader) GetMsLeader() string {
+	if m != nil {
+		return m.MsLeader
+	}
+	return ""
+}
+
+type NoLeader struct {
+}
+
+func (m *NoLeader) Reset()                    { *m = NoLeader{} }
+func (m *NoLeader) String() string            { return proto.CompactTextString(m) }
+func (*NoLeader) ProtoMessage()               {}
+func (*NoLeader) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{63} }
+
+type SchemaError struct {
+	Code    SchemaErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=mspb.SchemaErrorCode" json:"code,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *SchemaError) Reset()                    { *m = SchemaError{} }
+func (m *SchemaError) String() string            { return proto.CompactTextString(m) }
+func (*SchemaError) ProtoMessage()               {}
+func (*SchemaError) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{64} }
+
+func (m *SchemaError) GetCode() SchemaErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return SchemaErrorCode_SchemaErrUnknown
+}
+
+func (m *SchemaError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type Error struct {
+	MsLeader    *MsLeader    `protobuf:"bytes,2,opt,name=ms_leader,json=msLeader" json:"ms_leader,omitempty"`
+	NoLeader    *NoLeader    `protobuf:"bytes,3,opt,name=no_leader,json=noLeader" json:"no_leader,omitempty"`
+	SchemaError *SchemaError `protobuf:"bytes,4,opt,name=schema_error,json=schemaError" json:"schema_error,omitempty"`
+}
+
+func (m *Error) Reset()                    { *m = Error{} }
+func (m *Error) String() string            { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()               {}
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorMspb, []int{65} }
+
+func (m *Error) GetMsLeader() *MsLeader {
+	if m != nil {
+		return m.MsLeader
+	}
+	return nil
+}
+
+func (m *Error) GetNoLeader() *NoLeader {
+	if m != nil {
+		return m.NoLeader
+	}
+	return nil
+}
+
+func (m *Error) GetSchemaError() *SchemaError {
+	if m != nil {
+		return m.SchemaError
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MSLeader)(nil), "mspb.MSLeader")
+	proto.RegisterType((*GetMSLeaderRequest)(nil), "mspb.GetMSLeaderRequest")
+	proto.RegisterType((*GetMSLeaderResponse)(nil), "mspb.GetMSLeaderResponse")
+	proto.RegisterType((*PeerStats)(nil), "mspb.PeerStats")
+	proto.RegisterType((*RangeStats)(nil), "mspb.RangeStats")
+	proto.RegisterType((*RangeHeartbeatRequest)(nil), "mspb.RangeHeartbeatRequest")
+	proto.RegisterType((*RangeHeartbeatResponse)(nil), "mspb.RangeHeartbeatResponse")
+	proto.RegisterType((*NodeStats)(nil), "mspb.NodeStats")
+	proto.RegisterType((*NodeHeartbeatRequest)(nil), "mspb.NodeHeartbeatRequest")
+	proto.RegisterType((*NodeHeartbeatResponse)(nil), "mspb.NodeHeartbeatResponse")
+	proto.RegisterType((*AskSplitRequest)(nil), "mspb.AskSplitRequest")
+	proto.RegisterType((*AskSplitResponse)(nil), "mspb.AskSplitResponse")
+	proto.RegisterType((*ReportSplitRequest)(nil), "mspb.ReportSplitRequest")
+	proto.RegisterType((*ReportSplitResponse)(nil), "mspb.ReportSplitResponse")
+	proto.RegisterType((*RangeKeySamples)(nil), "mspb.RangeKeySamples")
+	proto.RegisterType((*ReportKeySamplesRequest)(nil), "mspb.ReportKeySamplesRequest")
+	proto.RegisterType((*ReportKeySamplesResponse)(nil), "mspb.ReportKeySamplesResponse")
+	proto.RegisterType((*NodeLoginRequest)(nil), "mspb.NodeLoginRequest")
+	proto.RegisterType((*NodeLoginResponse)(nil), "mspb.NodeLoginResponse")
+	proto.RegisterType((*GetNodeIdRequest)(nil), "mspb.GetNodeIdRequest")
+	proto.RegisterType((*GetNodeIdResponse)(nil), "mspb.GetNodeIdResponse")
 	proto.RegisterType((*GetRouteRequest)(nil), "mspb.GetRouteRequest")
 	proto.RegisterType((*GetRouteResponse)(nil), "mspb.GetRouteResponse")
 	proto.RegisterType((*GetNodeRequest)(nil), "mspb.GetNodeRequest")
@@ -1599,17 +2250,35 @@ func init() {
 	proto.RegisterType((*GetColumnByIdResponse)(nil), "mspb.GetColumnByIdResponse")
 	proto.RegisterType((*AddColumnRequest)(nil), "mspb.AddColumnRequest")
 	proto.RegisterType((*AddColumnResponse)(nil), "mspb.AddColumnResponse")
+	proto.RegisterType((*ColumnAlteration)(nil), "mspb.ColumnAlteration")
+	proto.RegisterType((*AlterColumnRequest)(nil), "mspb.AlterColumnRequest")
+	proto.RegisterType((*AlterColumnResponse)(nil), "mspb.AlterColumnResponse")
 	proto.RegisterType((*TruncateTableRequest)(nil), "mspb.TruncateTableRequest")
 	proto.RegisterType((*TruncateTableResponse)(nil), "mspb.TruncateTableResponse")
 	proto.RegisterType((*CreateDatabaseRequest)(nil), "mspb.CreateDatabaseRequest")
 	proto.RegisterType((*CreateDatabaseResponse)(nil), "mspb.CreateDatabaseResponse")
 	proto.RegisterType((*CreateTableRequest)(nil), "mspb.CreateTableRequest")
 	proto.RegisterType((*CreateTableResponse)(nil), "mspb.CreateTableResponse")
+	proto.RegisterType((*DeleteDatabaseRequest)(nil), "mspb.DeleteDatabaseRequest")
+	proto.RegisterType((*DeleteDatabaseResponse)(nil), "mspb.DeleteDatabaseResponse")
+	proto.RegisterType((*DeleteTableRequest)(nil), "mspb.DeleteTableRequest")
+	proto.RegisterType((*DeleteTableResponse)(nil), "mspb.DeleteTableResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "mspb.CreateIndexRequest")
+	proto.RegisterType((*CreateIndexResponse)(nil), "mspb.CreateIndexResponse")
+	proto.RegisterType((*SetIndexStateRequest)(nil), "mspb.SetIndexStateRequest")
+	proto.RegisterType((*SetIndexStateResponse)(nil), "mspb.SetIndexStateResponse")
+	proto.RegisterType((*GetDatabasesRequest)(nil), "mspb.GetDatabasesRequest")
+	proto.RegisterType((*GetDatabasesResponse)(nil), "mspb.GetDatabasesResponse")
+	proto.RegisterType((*GetTablesRequest)(nil), "mspb.GetTablesRequest")
+	proto.RegisterType((*GetTablesResponse)(nil), "mspb.GetTablesResponse")
 	proto.RegisterType((*RequestHeader)(nil), "mspb.RequestHeader")
 	proto.RegisterType((*ResponseHeader)(nil), "mspb.ResponseHeader")
 	proto.RegisterType((*MsLeader)(nil), "mspb.MsLeader")
 	proto.RegisterType((*NoLeader)(nil), "mspb.NoLeader")
+	proto.RegisterType((*SchemaError)(nil), "mspb.SchemaError")
 	proto.RegisterType((*Error)(nil), "mspb.Error")
+	proto.RegisterEnum("mspb.AlterColumnType", AlterColumnType_name, AlterColumnType_value)
+	proto.RegisterEnum("mspb.SchemaErrorCode", SchemaErrorCode_name, SchemaErrorCode_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1627,6 +2296,7 @@ type MsServerClient interface {
 	RangeHeartbeat(ctx context.Context, in *RangeHeartbeatRequest, opts ...grpc.CallOption) (*RangeHeartbeatResponse, error)
 	AskSplit(ctx context.Context, in *AskSplitRequest, opts ...grpc.CallOption) (*AskSplitResponse, error)
 	ReportSplit(ctx context.Context, in *ReportSplitRequest, opts ...grpc.CallOption) (*ReportSplitResponse, error)
+	ReportKeySamples(ctx context.Context, in *ReportKeySamplesRequest, opts ...grpc.CallOption) (*ReportKeySamplesResponse, error)
 	NodeLogin(ctx context.Context, in *NodeLoginRequest, opts ...grpc.CallOption) (*NodeLoginResponse, error)
 	GetNodeId(ctx context.Context, in *GetNodeIdRequest, opts ...grpc.CallOption) (*GetNodeIdResponse, error)
 	GetMSLeader(ctx context.Context, in *GetMSLeaderRequest, opts ...grpc.CallOption) (*GetMSLeaderResponse, error)
@@ -1640,8 +2310,15 @@ type MsServerClient interface {
 	GetColumnById(ctx context.Context, in *GetColumnByIdRequest, opts ...grpc.CallOption) (*GetColumnByIdResponse, error)
 	TruncateTable(ctx context.Context, in *TruncateTableRequest, opts ...grpc.CallOption) (*TruncateTableResponse, error)
 	AddColumn(ctx context.Context, in *AddColumnRequest, opts ...grpc.CallOption) (*AddColumnResponse, error)
+	AlterColumn(ctx context.Context, in *AlterColumnRequest, opts ...grpc.CallOption) (*AlterColumnResponse, error)
 	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*CreateDatabaseResponse, error)
 	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error)
+	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
+	SetIndexState(ctx context.Context, in *SetIndexStateRequest, opts ...grpc.CallOption) (*SetIndexStateResponse, error)
+	GetDatabases(ctx context.Context, in *GetDatabasesRequest, opts ...grpc.CallOption) (*GetDatabasesResponse, error)
+	GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error)
 }
 
 type msServerClient struct {
@@ -1688,6 +2365,15 @@ func (c *msServerClient) ReportSplit(ctx context.Context, in *ReportSplitRequest
 	return out, nil
 }
 
+func (c *msServerClient) ReportKeySamples(ctx context.Context, in *ReportKeySamplesRequest, opts ...grpc.CallOption) (*ReportKeySamplesResponse, error) {
+	out := new(ReportKeySamplesResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/ReportKeySamples", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msServerClient) NodeLogin(ctx context.Context, in *NodeLoginRequest, opts ...grpc.CallOption) (*NodeLoginResponse, error) {
 	out := new(NodeLoginResponse)
 	err := grpc.Invoke(ctx, "/mspb.MsServer/NodeLogin", in, out, c.cc, opts...)
@@ -1805,6 +2491,15 @@ func (c *msServerClient) AddColumn(ctx context.Context, in *AddColumnRequest, op
 	return out, nil
 }
 
+func (c *msServerClient) AlterColumn(ctx context.Context, in *AlterColumnRequest, opts ...grpc.CallOption) (*AlterColumnResponse, error) {
+	out := new(AlterColumnResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/AlterColumn", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msServerClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*CreateDatabaseResponse, error) {
 	out := new(CreateDatabaseResponse)
 	err := grpc.Invoke(ctx, "/mspb.MsServer/CreateDatabase", in, out, c.cc, opts...)
@@ -1823,31 +2518,93 @@ func (c *msServerClient) CreateTable(ctx context.Context, in *CreateTableRequest
 	return out, nil
 }
 
-// Server API for MsServer service
+func (c *msServerClient) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error) {
+	out := new(DeleteDatabaseResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/DeleteDatabase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type MsServerServer interface {
-	NodeHeartbeat(context.Context, *NodeHeartbeatRequest) (*NodeHeartbeatResponse, error)
-	RangeHeartbeat(context.Context, *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error)
-	AskSplit(context.Context, *AskSplitRequest) (*AskSplitResponse, error)
-	ReportSplit(context.Context, *ReportSplitRequest) (*ReportSplitResponse, error)
-	NodeLogin(context.Context, *NodeLoginRequest) (*NodeLoginResponse, error)
-	GetNodeId(context.Context, *GetNodeIdRequest) (*GetNodeIdResponse, error)
-	GetMSLeader(context.Context, *GetMSLeaderRequest) (*GetMSLeaderResponse, error)
-	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
-	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
-	GetDB(context.Context, *GetDBRequest) (*GetDBResponse, error)
-	GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error)
-	GetTableById(context.Context, *GetTableByIdRequest) (*GetTableByIdResponse, error)
-	GetColumns(context.Context, *GetColumnsRequest) (*GetColumnsResponse, error)
-	GetColumnByName(context.Context, *GetColumnByNameRequest) (*GetColumnByNameResponse, error)
-	GetColumnById(context.Context, *GetColumnByIdRequest) (*GetColumnByIdResponse, error)
-	TruncateTable(context.Context, *TruncateTableRequest) (*TruncateTableResponse, error)
-	AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error)
-	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
-	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
+func (c *msServerClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error) {
+	out := new(DeleteTableResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/DeleteTable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterMsServerServer(s *grpc.Server, srv MsServerServer) {
+func (c *msServerClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
+	out := new(CreateIndexResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/CreateIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msServerClient) SetIndexState(ctx context.Context, in *SetIndexStateRequest, opts ...grpc.CallOption) (*SetIndexStateResponse, error) {
+	out := new(SetIndexStateResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/SetIndexState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msServerClient) GetDatabases(ctx context.Context, in *GetDatabasesRequest, opts ...grpc.CallOption) (*GetDatabasesResponse, error) {
+	out := new(GetDatabasesResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/GetDatabases", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msServerClient) GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error) {
+	out := new(GetTablesResponse)
+	err := grpc.Invoke(ctx, "/mspb.MsServer/GetTables", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MsServer service
+
+type MsServerServer interface {
+	NodeHeartbeat(context.Context, *NodeHeartbeatRequest) (*NodeHeartbeatResponse, error)
+	RangeHeartbeat(context.Context, *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error)
+	AskSplit(context.Context, *AskSplitRequest) (*AskSplitResponse, error)
+	ReportSplit(context.Context, *ReportSplitRequest) (*ReportSplitResponse, error)
+	ReportKeySamples(context.Context, *ReportKeySamplesRequest) (*ReportKeySamplesResponse, error)
+	NodeLogin(context.Context, *NodeLoginRequest) (*NodeLoginResponse, error)
+	GetNodeId(context.Context, *GetNodeIdRequest) (*GetNodeIdResponse, error)
+	GetMSLeader(context.Context, *GetMSLeaderRequest) (*GetMSLeaderResponse, error)
+	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
+	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
+	GetDB(context.Context, *GetDBRequest) (*GetDBResponse, error)
+	GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error)
+	GetTableById(context.Context, *GetTableByIdRequest) (*GetTableByIdResponse, error)
+	GetColumns(context.Context, *GetColumnsRequest) (*GetColumnsResponse, error)
+	GetColumnByName(context.Context, *GetColumnByNameRequest) (*GetColumnByNameResponse, error)
+	GetColumnById(context.Context, *GetColumnByIdRequest) (*GetColumnByIdResponse, error)
+	TruncateTable(context.Context, *TruncateTableRequest) (*TruncateTableResponse, error)
+	AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error)
+	AlterColumn(context.Context, *AlterColumnRequest) (*AlterColumnResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
+	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
+	DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error)
+	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
+	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
+	SetIndexState(context.Context, *SetIndexStateRequest) (*SetIndexStateResponse, error)
+	GetDatabases(context.Context, *GetDatabasesRequest) (*GetDatabasesResponse, error)
+	GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error)
+}
+
+func RegisterMsServerServer(s *grpc.Server, srv MsServerServer) {
 	s.RegisterService(&_MsServer_serviceDesc, srv)
 }
 
@@ -1923,6 +2680,24 @@ func _MsServer_ReportSplit_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsServer_ReportKeySamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportKeySamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).ReportKeySamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/ReportKeySamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).ReportKeySamples(ctx, req.(*ReportKeySamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsServer_NodeLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeLoginRequest)
 	if err := dec(in); err != nil {
@@ -2157,6 +2932,24 @@ func _MsServer_AddColumn_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsServer_AlterColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).AlterColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/AlterColumn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).AlterColumn(ctx, req.(*AlterColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsServer_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -2193,6 +2986,114 @@ func _MsServer_CreateTable_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsServer_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/DeleteDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).DeleteDatabase(ctx, req.(*DeleteDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsServer_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/DeleteTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsServer_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsServer_SetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndexStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).SetIndexState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/SetIndexState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).SetIndexState(ctx, req.(*SetIndexStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsServer_GetDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).GetDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/GetDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).GetDatabases(ctx, req.(*GetDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsServer_GetTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsServerServer).GetTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mspb.MsServer/GetTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsServerServer).GetTables(ctx, req.(*GetTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mspb.MsServer",
 	HandlerType: (*MsServerServer)(nil),
@@ -2213,6 +3114,10 @@ var _MsServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportSplit",
 			Handler:    _MsServer_ReportSplit_Handler,
 		},
+		{
+			MethodName: "ReportKeySamples",
+			Handler:    _MsServer_ReportKeySamples_Handler,
+		},
 		{
 			MethodName: "NodeLogin",
 			Handler:    _MsServer_NodeLogin_Handler,
@@ -2265,6 +3170,10 @@ var _MsServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddColumn",
 			Handler:    _MsServer_AddColumn_Handler,
 		},
+		{
+			MethodName: "AlterColumn",
+			Handler:    _MsServer_AlterColumn_Handler,
+		},
 		{
 			MethodName: "CreateDatabase",
 			Handler:    _MsServer_CreateDatabase_Handler,
@@ -2273,6 +3182,30 @@ var _MsServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTable",
 			Handler:    _MsServer_CreateTable_Handler,
 		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _MsServer_DeleteDatabase_Handler,
+		},
+		{
+			MethodName: "DeleteTable",
+			Handler:    _MsServer_DeleteTable_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _MsServer_CreateIndex_Handler,
+		},
+		{
+			MethodName: "SetIndexState",
+			Handler:    _MsServer_SetIndexState_Handler,
+		},
+		{
+			MethodName: "GetDatabases",
+			Handler:    _MsServer_GetDatabases_Handler,
+		},
+		{
+			MethodName: "GetTables",
+			Handler:    _MsServer_GetTables_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mspb.proto",
@@ -2993,7 +3926,7 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *NodeLoginRequest) Marshal() (dAtA []byte, err error) {
+func (m *RangeKeySamples) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3003,30 +3936,38 @@ func (m *NodeLoginRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeLoginRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RangeKeySamples) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.RangeId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n30, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintMspb(dAtA, i, uint64(m.RangeId))
+	}
+	if m.RangeEpoch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.RangeEpoch.Size()))
+		n30, err := m.RangeEpoch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n30
 	}
-	if m.NodeId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.NodeId))
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	return i, nil
 }
 
-func (m *NodeLoginResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReportKeySamplesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3036,7 +3977,7 @@ func (m *NodeLoginResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeLoginResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportKeySamplesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3051,10 +3992,22 @@ func (m *NodeLoginResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n31
 	}
+	if len(m.Samples) > 0 {
+		for _, msg := range m.Samples {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *GetNodeIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReportKeySamplesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3064,7 +4017,7 @@ func (m *GetNodeIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetNodeIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportKeySamplesResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3079,31 +4032,10 @@ func (m *GetNodeIdRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n32
 	}
-	if m.ServerPort != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.ServerPort))
-	}
-	if m.RaftPort != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.RaftPort))
-	}
-	if m.HttpPort != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.HttpPort))
-	}
-	if len(m.Version) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
-	}
 	return i, nil
 }
 
-func (m *GetNodeIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *NodeLoginRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3113,7 +4045,7 @@ func (m *GetNodeIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetNodeIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeLoginRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3133,20 +4065,10 @@ func (m *GetNodeIdResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.NodeId))
 	}
-	if m.Clearup {
-		dAtA[i] = 0x18
-		i++
-		if m.Clearup {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
 	return i, nil
 }
 
-func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
+func (m *NodeLoginResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3156,7 +4078,7 @@ func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeLoginResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3171,26 +4093,10 @@ func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n34
 	}
-	if m.DbId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.DbId))
-	}
-	if m.TableId != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.TableId))
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
 	return i, nil
 }
 
-func (m *GetRouteResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetNodeIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3200,7 +4106,7 @@ func (m *GetRouteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetNodeIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3215,6 +4121,142 @@ func (m *GetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n35
 	}
+	if m.ServerPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.ServerPort))
+	}
+	if m.RaftPort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.RaftPort))
+	}
+	if m.HttpPort != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.HttpPort))
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	return i, nil
+}
+
+func (m *GetNodeIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n36, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.NodeId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.NodeId))
+	}
+	if m.Clearup {
+		dAtA[i] = 0x18
+		i++
+		if m.Clearup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n37, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.DbId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.TableId))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n38, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
 	if len(m.Routes) > 0 {
 		for _, msg := range m.Routes {
 			dAtA[i] = 0x12
@@ -3249,11 +4291,11 @@ func (m *GetNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n36, err := m.Header.MarshalTo(dAtA[i:])
+		n39, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
 	if m.Id != 0 {
 		dAtA[i] = 0x10
@@ -3282,21 +4324,21 @@ func (m *GetNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n37, err := m.Header.MarshalTo(dAtA[i:])
+		n40, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n40
 	}
 	if m.Node != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Node.Size()))
-		n38, err := m.Node.MarshalTo(dAtA[i:])
+		n41, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n41
 	}
 	return i, nil
 }
@@ -3320,11 +4362,11 @@ func (m *GetDBRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n39, err := m.Header.MarshalTo(dAtA[i:])
+		n42, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n42
 	}
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
@@ -3354,21 +4396,21 @@ func (m *GetDBResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n40, err := m.Header.MarshalTo(dAtA[i:])
+		n43, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n43
 	}
 	if m.Db != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Db.Size()))
-		n41, err := m.Db.MarshalTo(dAtA[i:])
+		n44, err := m.Db.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n44
 	}
 	return i, nil
 }
@@ -3392,11 +4434,11 @@ func (m *GetTableRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n42, err := m.Header.MarshalTo(dAtA[i:])
+		n45, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n45
 	}
 	if len(m.DbName) > 0 {
 		dAtA[i] = 0x12
@@ -3432,11 +4474,11 @@ func (m *GetTableByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n43, err := m.Header.MarshalTo(dAtA[i:])
+		n46, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n46
 	}
 	if m.DbId != 0 {
 		dAtA[i] = 0x10
@@ -3470,21 +4512,21 @@ func (m *GetTableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n44, err := m.Header.MarshalTo(dAtA[i:])
+		n47, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n47
 	}
 	if m.Table != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Table.Size()))
-		n45, err := m.Table.MarshalTo(dAtA[i:])
+		n48, err := m.Table.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n48
 	}
 	return i, nil
 }
@@ -3508,21 +4550,21 @@ func (m *GetTableByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n46, err := m.Header.MarshalTo(dAtA[i:])
+		n49, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n49
 	}
 	if m.Table != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Table.Size()))
-		n47, err := m.Table.MarshalTo(dAtA[i:])
+		n50, err := m.Table.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n50
 	}
 	return i, nil
 }
@@ -3546,11 +4588,11 @@ func (m *GetColumnsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n48, err := m.Header.MarshalTo(dAtA[i:])
+		n51, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n51
 	}
 	if m.DbId != 0 {
 		dAtA[i] = 0x10
@@ -3584,11 +4626,11 @@ func (m *GetColumnsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n49, err := m.Header.MarshalTo(dAtA[i:])
+		n52, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n52
 	}
 	if len(m.Columns) > 0 {
 		for _, msg := range m.Columns {
@@ -3624,11 +4666,11 @@ func (m *GetColumnByNameRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n50, err := m.Header.MarshalTo(dAtA[i:])
+		n53, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n53
 	}
 	if m.DbId != 0 {
 		dAtA[i] = 0x10
@@ -3668,21 +4710,21 @@ func (m *GetColumnByNameResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n51, err := m.Header.MarshalTo(dAtA[i:])
+		n54, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n54
 	}
 	if m.Column != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Column.Size()))
-		n52, err := m.Column.MarshalTo(dAtA[i:])
+		n55, err := m.Column.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n55
 	}
 	return i, nil
 }
@@ -3706,11 +4748,11 @@ func (m *GetColumnByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n53, err := m.Header.MarshalTo(dAtA[i:])
+		n56, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n56
 	}
 	if m.DbId != 0 {
 		dAtA[i] = 0x10
@@ -3749,21 +4791,21 @@ func (m *GetColumnByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n54, err := m.Header.MarshalTo(dAtA[i:])
+		n57, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n57
 	}
 	if m.Column != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Column.Size()))
-		n55, err := m.Column.MarshalTo(dAtA[i:])
+		n58, err := m.Column.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n58
 	}
 	return i, nil
 }
@@ -3787,11 +4829,11 @@ func (m *AddColumnRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n56, err := m.Header.MarshalTo(dAtA[i:])
+		n59, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n59
 	}
 	if m.DbId != 0 {
 		dAtA[i] = 0x10
@@ -3837,11 +4879,11 @@ func (m *AddColumnResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n57, err := m.Header.MarshalTo(dAtA[i:])
+		n60, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n60
 	}
 	if len(m.Columns) > 0 {
 		for _, msg := range m.Columns {
@@ -3858,7 +4900,7 @@ func (m *AddColumnResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TruncateTableRequest) Marshal() (dAtA []byte, err error) {
+func (m *ColumnAlteration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3868,35 +4910,48 @@ func (m *TruncateTableRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TruncateTableRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ColumnAlteration) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.Type != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n58, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n58
+		i = encodeVarintMspb(dAtA, i, uint64(m.Type))
 	}
-	if m.DbId != 0 {
-		dAtA[i] = 0x10
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.DbId))
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.TableId != 0 {
-		dAtA[i] = 0x18
+	if len(m.NewName) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.NewName)))
+		i += copy(dAtA[i:], m.NewName)
+	}
+	if len(m.DefaultValue) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DefaultValue)))
+		i += copy(dAtA[i:], m.DefaultValue)
+	}
+	if m.Nullable {
+		dAtA[i] = 0x28
+		i++
+		if m.Nullable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.TableId))
 	}
 	return i, nil
 }
 
-func (m *TruncateTableResponse) Marshal() (dAtA []byte, err error) {
+func (m *AlterColumnRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3906,7 +4961,7 @@ func (m *TruncateTableResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TruncateTableResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterColumnRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3915,20 +4970,151 @@ func (m *TruncateTableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n59, err := m.Header.MarshalTo(dAtA[i:])
+		n61, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n61
 	}
-	return i, nil
-}
-
-func (m *CreateDatabaseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+	if m.DbId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.TableId))
+	}
+	if m.ConfVer != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.ConfVer))
+	}
+	if len(m.Alterations) > 0 {
+		for _, msg := range m.Alterations {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AlterColumnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterColumnResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n62, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if m.Table != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Table.Size()))
+		n63, err := m.Table.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	return i, nil
+}
+
+func (m *TruncateTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n64, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	if m.DbId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.TableId))
+	}
+	return i, nil
+}
+
+func (m *TruncateTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n65, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	return i, nil
+}
+
+func (m *CreateDatabaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -3943,11 +5129,11 @@ func (m *CreateDatabaseRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n60, err := m.Header.MarshalTo(dAtA[i:])
+		n66, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n66
 	}
 	if len(m.DbName) > 0 {
 		dAtA[i] = 0x12
@@ -3977,11 +5163,11 @@ func (m *CreateDatabaseResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n61, err := m.Header.MarshalTo(dAtA[i:])
+		n67, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n67
 	}
 	return i, nil
 }
@@ -4005,11 +5191,11 @@ func (m *CreateTableRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n62, err := m.Header.MarshalTo(dAtA[i:])
+		n68, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n68
 	}
 	if len(m.DbName) > 0 {
 		dAtA[i] = 0x12
@@ -4029,6 +5215,14 @@ func (m *CreateTableRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMspb(dAtA, i, uint64(len(m.Properties)))
 		i += copy(dAtA[i:], m.Properties)
 	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	return i, nil
 }
 
@@ -4051,16 +5245,16 @@ func (m *CreateTableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
-		n63, err := m.Header.MarshalTo(dAtA[i:])
+		n69, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n69
 	}
 	return i, nil
 }
 
-func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
+func (m *DeleteDatabaseRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4070,20 +5264,31 @@ func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteDatabaseRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.ClusterId))
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n70, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
 	}
 	return i, nil
 }
 
-func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
+func (m *DeleteDatabaseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4093,30 +5298,25 @@ func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteDatabaseResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		dAtA[i] = 0x12
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.Error.Size()))
-		n64, err := m.Error.MarshalTo(dAtA[i:])
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n71, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n71
 	}
 	return i, nil
 }
 
-func (m *MsLeader) Marshal() (dAtA []byte, err error) {
+func (m *DeleteTableRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4126,21 +5326,37 @@ func (m *MsLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteTableRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.MsLeader) > 0 {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(len(m.MsLeader)))
-		i += copy(dAtA[i:], m.MsLeader)
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n72, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
+	}
+	if len(m.TableName) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.TableName)))
+		i += copy(dAtA[i:], m.TableName)
 	}
 	return i, nil
 }
 
-func (m *NoLeader) Marshal() (dAtA []byte, err error) {
+func (m *DeleteTableResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4150,15 +5366,25 @@ func (m *NoLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NoLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteTableResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n73, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
 	return i, nil
 }
 
-func (m *Error) Marshal() (dAtA []byte, err error) {
+func (m *CreateIndexRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4168,336 +5394,514 @@ func (m *Error) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.MsLeader != nil {
-		dAtA[i] = 0x12
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.MsLeader.Size()))
-		n65, err := m.MsLeader.MarshalTo(dAtA[i:])
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n74, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n74
 	}
-	if m.NoLeader != nil {
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
+	}
+	if len(m.TableName) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintMspb(dAtA, i, uint64(m.NoLeader.Size()))
-		n66, err := m.NoLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.TableName)))
+		i += copy(dAtA[i:], m.TableName)
+	}
+	if len(m.IndexName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.IndexName)))
+		i += copy(dAtA[i:], m.IndexName)
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n66
 	}
 	return i, nil
 }
 
-func encodeVarintMspb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreateIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *MSLeader) Size() (n int) {
+
+func (m *CreateIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMspb(uint64(m.Id))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n75, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	if m.Index != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Index.Size()))
+		n76, err := m.Index.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetMSLeaderRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+func (m *SetIndexStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetMSLeaderResponse) Size() (n int) {
+func (m *SetIndexStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n77, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
 	}
-	return n
+	if len(m.TableName) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.TableName)))
+		i += copy(dAtA[i:], m.TableName)
+	}
+	if len(m.IndexName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.IndexName)))
+		i += copy(dAtA[i:], m.IndexName)
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.State))
+	}
+	return i, nil
 }
 
-func (m *PeerStats) Size() (n int) {
+func (m *SetIndexStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIndexStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n78, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
 	}
-	if m.DownSeconds != 0 {
-		n += 1 + sovMspb(uint64(m.DownSeconds))
+	return i, nil
+}
+
+func (m *GetDatabasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RangeStats) Size() (n int) {
+func (m *GetDatabasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.BytesWritten != 0 {
-		n += 1 + sovMspb(uint64(m.BytesWritten))
-	}
-	if m.BytesRead != 0 {
-		n += 1 + sovMspb(uint64(m.BytesRead))
-	}
-	if m.KeysWritten != 0 {
-		n += 1 + sovMspb(uint64(m.KeysWritten))
-	}
-	if m.KeysRead != 0 {
-		n += 1 + sovMspb(uint64(m.KeysRead))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n79, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
-	if m.ApproximateSize != 0 {
-		n += 1 + sovMspb(uint64(m.ApproximateSize))
+	return i, nil
+}
+
+func (m *GetDatabasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RangeHeartbeatRequest) Size() (n int) {
+func (m *GetDatabasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if len(m.DownPeers) > 0 {
-		for _, e := range m.DownPeers {
-			l = e.Size()
-			n += 1 + l + sovMspb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n80, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n80
 	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovMspb(uint64(l))
+	if len(m.Dbs) > 0 {
+		for _, msg := range m.Dbs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	return i, nil
+}
+
+func (m *GetTablesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RangeHeartbeatResponse) Size() (n int) {
+func (m *GetTablesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.RangeId != 0 {
-		n += 1 + sovMspb(uint64(m.RangeId))
-	}
-	if m.Epoch != nil {
-		l = m.Epoch.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovMspb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n81, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
 	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if len(m.DbName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.DbName)))
+		i += copy(dAtA[i:], m.DbName)
 	}
-	if m.CommitId != 0 {
-		n += 1 + sovMspb(uint64(m.CommitId))
+	return i, nil
+}
+
+func (m *GetTablesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NodeStats) Size() (n int) {
+func (m *GetTablesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.RangeCount != 0 {
-		n += 1 + sovMspb(uint64(m.RangeCount))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Header.Size()))
+		n82, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
-	if m.RangeSplitCount != 0 {
-		n += 1 + sovMspb(uint64(m.RangeSplitCount))
+	if len(m.Tables) > 0 {
+		for _, msg := range m.Tables {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMspb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.SendingSnapCount != 0 {
-		n += 1 + sovMspb(uint64(m.SendingSnapCount))
+	return i, nil
+}
+
+func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ReceivingSnapCount != 0 {
-		n += 1 + sovMspb(uint64(m.ReceivingSnapCount))
-	}
-	if m.ApplyingSnapCount != 0 {
-		n += 1 + sovMspb(uint64(m.ApplyingSnapCount))
-	}
-	if m.RangeLeaderCount != 0 {
-		n += 1 + sovMspb(uint64(m.RangeLeaderCount))
-	}
-	if m.Capacity != 0 {
-		n += 1 + sovMspb(uint64(m.Capacity))
-	}
-	if m.UsedSize != 0 {
-		n += 1 + sovMspb(uint64(m.UsedSize))
-	}
-	if m.Available != 0 {
-		n += 1 + sovMspb(uint64(m.Available))
-	}
-	if m.BytesWritten != 0 {
-		n += 1 + sovMspb(uint64(m.BytesWritten))
-	}
-	if m.KeysWritten != 0 {
-		n += 1 + sovMspb(uint64(m.KeysWritten))
-	}
-	if m.BytesRead != 0 {
-		n += 1 + sovMspb(uint64(m.BytesRead))
-	}
-	if m.KeysRead != 0 {
-		n += 1 + sovMspb(uint64(m.KeysRead))
-	}
-	if m.IsBusy {
-		n += 2
-	}
-	if m.Start != 0 {
-		n += 1 + sovMspb(uint64(m.Start))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NodeHeartbeatRequest) Size() (n int) {
+func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.ClusterId))
 	}
-	if m.NodeId != 0 {
-		n += 1 + sovMspb(uint64(m.NodeId))
+	return i, nil
+}
+
+func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.ClusterId))
 	}
-	if len(m.IsolatedReplicas) > 0 {
-		l = 0
-		for _, e := range m.IsolatedReplicas {
-			l += sovMspb(uint64(e))
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Error.Size()))
+		n83, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovMspb(uint64(l)) + l
+		i += n83
 	}
-	return n
+	return i, nil
 }
 
-func (m *NodeHeartbeatResponse) Size() (n int) {
+func (m *MsLeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsLeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if len(m.MsLeader) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.MsLeader)))
+		i += copy(dAtA[i:], m.MsLeader)
 	}
-	if m.NodeId != 0 {
-		n += 1 + sovMspb(uint64(m.NodeId))
+	return i, nil
+}
+
+func (m *NoLeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DeleteReplicas) > 0 {
-		l = 0
-		for _, e := range m.DeleteReplicas {
-			l += sovMspb(uint64(e))
-		}
-		n += 1 + sovMspb(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *NoLeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchemaError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AskSplitRequest) Size() (n int) {
+func (m *SchemaError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.Code))
 	}
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
-	l = len(m.SplitKey)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	return i, nil
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AskSplitResponse) Size() (n int) {
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.NewRangeId != 0 {
-		n += 1 + sovMspb(uint64(m.NewRangeId))
+	if m.MsLeader != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.MsLeader.Size()))
+		n84, err := m.MsLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
 	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovMspb(uint64(e))
+	if m.NoLeader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.NoLeader.Size()))
+		n85, err := m.NoLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovMspb(uint64(l)) + l
+		i += n85
 	}
-	l = len(m.SplitKey)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	if m.SchemaError != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMspb(dAtA, i, uint64(m.SchemaError.Size()))
+		n86, err := m.SchemaError.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReportSplitRequest) Size() (n int) {
+func encodeVarintMspb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *MSLeader) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
-	if m.Left != nil {
-		l = m.Left.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovMspb(uint64(m.Id))
 	}
-	if m.Right != nil {
-		l = m.Right.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *ReportSplitResponse) Size() (n int) {
+func (m *GetMSLeaderRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -4507,321 +5911,393 @@ func (m *ReportSplitResponse) Size() (n int) {
 	return n
 }
 
-func (m *NodeLoginRequest) Size() (n int) {
+func (m *GetMSLeaderResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.NodeId != 0 {
-		n += 1 + sovMspb(uint64(m.NodeId))
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *NodeLoginResponse) Size() (n int) {
+func (m *PeerStats) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Peer != nil {
+		l = m.Peer.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if m.DownSeconds != 0 {
+		n += 1 + sovMspb(uint64(m.DownSeconds))
+	}
 	return n
 }
 
-func (m *GetNodeIdRequest) Size() (n int) {
+func (m *RangeStats) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.BytesWritten != 0 {
+		n += 1 + sovMspb(uint64(m.BytesWritten))
 	}
-	if m.ServerPort != 0 {
-		n += 1 + sovMspb(uint64(m.ServerPort))
+	if m.BytesRead != 0 {
+		n += 1 + sovMspb(uint64(m.BytesRead))
 	}
-	if m.RaftPort != 0 {
-		n += 1 + sovMspb(uint64(m.RaftPort))
+	if m.KeysWritten != 0 {
+		n += 1 + sovMspb(uint64(m.KeysWritten))
 	}
-	if m.HttpPort != 0 {
-		n += 1 + sovMspb(uint64(m.HttpPort))
+	if m.KeysRead != 0 {
+		n += 1 + sovMspb(uint64(m.KeysRead))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	if m.ApproximateSize != 0 {
+		n += 1 + sovMspb(uint64(m.ApproximateSize))
 	}
 	return n
 }
 
-func (m *GetNodeIdResponse) Size() (n int) {
+func (m *RangeHeartbeatRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.NodeId != 0 {
-		n += 1 + sovMspb(uint64(m.NodeId))
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Clearup {
-		n += 2
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.DownPeers) > 0 {
+		for _, e := range m.DownPeers {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *GetRouteRequest) Size() (n int) {
+func (m *RangeHeartbeatResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
+	if m.RangeId != 0 {
+		n += 1 + sovMspb(uint64(m.RangeId))
 	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
+	if m.Epoch != nil {
+		l = m.Epoch.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.Key)
-	if l > 0 {
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	return n
-}
-
-func (m *GetRouteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Task != nil {
+		l = m.Task.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovMspb(uint64(l))
-		}
+	if m.CommitId != 0 {
+		n += 1 + sovMspb(uint64(m.CommitId))
 	}
 	return n
 }
 
-func (m *GetNodeRequest) Size() (n int) {
+func (m *NodeStats) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.RangeCount != 0 {
+		n += 1 + sovMspb(uint64(m.RangeCount))
 	}
-	if m.Id != 0 {
-		n += 1 + sovMspb(uint64(m.Id))
+	if m.RangeSplitCount != 0 {
+		n += 1 + sovMspb(uint64(m.RangeSplitCount))
+	}
+	if m.SendingSnapCount != 0 {
+		n += 1 + sovMspb(uint64(m.SendingSnapCount))
+	}
+	if m.ReceivingSnapCount != 0 {
+		n += 1 + sovMspb(uint64(m.ReceivingSnapCount))
+	}
+	if m.ApplyingSnapCount != 0 {
+		n += 1 + sovMspb(uint64(m.ApplyingSnapCount))
+	}
+	if m.RangeLeaderCount != 0 {
+		n += 1 + sovMspb(uint64(m.RangeLeaderCount))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovMspb(uint64(m.Capacity))
+	}
+	if m.UsedSize != 0 {
+		n += 1 + sovMspb(uint64(m.UsedSize))
+	}
+	if m.Available != 0 {
+		n += 1 + sovMspb(uint64(m.Available))
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovMspb(uint64(m.BytesWritten))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovMspb(uint64(m.KeysWritten))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovMspb(uint64(m.BytesRead))
+	}
+	if m.KeysRead != 0 {
+		n += 1 + sovMspb(uint64(m.KeysRead))
+	}
+	if m.IsBusy {
+		n += 2
+	}
+	if m.Start != 0 {
+		n += 1 + sovMspb(uint64(m.Start))
 	}
 	return n
 }
 
-func (m *GetNodeResponse) Size() (n int) {
+func (m *NodeHeartbeatRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Node != nil {
-		l = m.Node.Size()
+	if m.NodeId != 0 {
+		n += 1 + sovMspb(uint64(m.NodeId))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if len(m.IsolatedReplicas) > 0 {
+		l = 0
+		for _, e := range m.IsolatedReplicas {
+			l += sovMspb(uint64(e))
+		}
+		n += 1 + sovMspb(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *GetDBRequest) Size() (n int) {
+func (m *NodeHeartbeatResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	if m.NodeId != 0 {
+		n += 1 + sovMspb(uint64(m.NodeId))
+	}
+	if len(m.DeleteReplicas) > 0 {
+		l = 0
+		for _, e := range m.DeleteReplicas {
+			l += sovMspb(uint64(e))
+		}
+		n += 1 + sovMspb(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *GetDBResponse) Size() (n int) {
+func (m *AskSplitRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Db != nil {
-		l = m.Db.Size()
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.SplitKey)
+	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *GetTableRequest) Size() (n int) {
+func (m *AskSplitResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.DbName)
-	if l > 0 {
+	if m.Range != nil {
+		l = m.Range.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.TableName)
+	if m.NewRangeId != 0 {
+		n += 1 + sovMspb(uint64(m.NewRangeId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovMspb(uint64(e))
+		}
+		n += 1 + sovMspb(uint64(l)) + l
+	}
+	l = len(m.SplitKey)
 	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *GetTableByIdRequest) Size() (n int) {
+func (m *ReportSplitRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *GetTableResponse) Size() (n int) {
+func (m *ReportSplitResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Table != nil {
-		l = m.Table.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
 	return n
 }
 
-func (m *GetTableByIdResponse) Size() (n int) {
+func (m *RangeKeySamples) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.RangeId != 0 {
+		n += 1 + sovMspb(uint64(m.RangeId))
 	}
-	if m.Table != nil {
-		l = m.Table.Size()
+	if m.RangeEpoch != nil {
+		l = m.RangeEpoch.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetColumnsRequest) Size() (n int) {
+func (m *ReportKeySamplesRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
-	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *GetColumnsResponse) Size() (n int) {
+func (m *ReportKeySamplesResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if len(m.Columns) > 0 {
-		for _, e := range m.Columns {
-			l = e.Size()
-			n += 1 + l + sovMspb(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *GetColumnByNameRequest) Size() (n int) {
+func (m *NodeLoginRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
-	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
-	}
-	l = len(m.ColName)
-	if l > 0 {
-		n += 1 + l + sovMspb(uint64(l))
+	if m.NodeId != 0 {
+		n += 1 + sovMspb(uint64(m.NodeId))
 	}
 	return n
 }
 
-func (m *GetColumnByNameResponse) Size() (n int) {
+func (m *NodeLoginResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Column != nil {
-		l = m.Column.Size()
-		n += 1 + l + sovMspb(uint64(l))
-	}
 	return n
 }
 
-func (m *GetColumnByIdRequest) Size() (n int) {
+func (m *GetNodeIdRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
+	if m.ServerPort != 0 {
+		n += 1 + sovMspb(uint64(m.ServerPort))
 	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
+	if m.RaftPort != 0 {
+		n += 1 + sovMspb(uint64(m.RaftPort))
 	}
-	if m.ColId != 0 {
-		n += 1 + sovMspb(uint64(m.ColId))
+	if m.HttpPort != 0 {
+		n += 1 + sovMspb(uint64(m.HttpPort))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *GetColumnByIdResponse) Size() (n int) {
+func (m *GetNodeIdResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Column != nil {
-		l = m.Column.Size()
-		n += 1 + l + sovMspb(uint64(l))
+	if m.NodeId != 0 {
+		n += 1 + sovMspb(uint64(m.NodeId))
+	}
+	if m.Clearup {
+		n += 2
 	}
 	return n
 }
 
-func (m *AddColumnRequest) Size() (n int) {
+func (m *GetRouteRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -4834,24 +6310,22 @@ func (m *AddColumnRequest) Size() (n int) {
 	if m.TableId != 0 {
 		n += 1 + sovMspb(uint64(m.TableId))
 	}
-	if len(m.Columns) > 0 {
-		for _, e := range m.Columns {
-			l = e.Size()
-			n += 1 + l + sovMspb(uint64(l))
-		}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *AddColumnResponse) Size() (n int) {
+func (m *GetRouteResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if len(m.Columns) > 0 {
-		for _, e := range m.Columns {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovMspb(uint64(l))
 		}
@@ -4859,57 +6333,62 @@ func (m *AddColumnResponse) Size() (n int) {
 	return n
 }
 
-func (m *TruncateTableRequest) Size() (n int) {
+func (m *GetNodeRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.DbId != 0 {
-		n += 1 + sovMspb(uint64(m.DbId))
-	}
-	if m.TableId != 0 {
-		n += 1 + sovMspb(uint64(m.TableId))
+	if m.Id != 0 {
+		n += 1 + sovMspb(uint64(m.Id))
 	}
 	return n
 }
 
-func (m *TruncateTableResponse) Size() (n int) {
+func (m *GetNodeResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
 	return n
 }
 
-func (m *CreateDatabaseRequest) Size() (n int) {
+func (m *GetDBRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.DbName)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *CreateDatabaseResponse) Size() (n int) {
+func (m *GetDBResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if m.Db != nil {
+		l = m.Db.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
 	return n
 }
 
-func (m *CreateTableRequest) Size() (n int) {
+func (m *GetTableRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -4924,89 +6403,2921 @@ func (m *CreateTableRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	l = len(m.Properties)
-	if l > 0 {
+	return n
+}
+
+func (m *GetTableByIdRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
 	return n
 }
 
-func (m *CreateTableResponse) Size() (n int) {
+func (m *GetTableResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if m.Table != nil {
+		l = m.Table.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
 	return n
 }
 
-func (m *RequestHeader) Size() (n int) {
+func (m *GetTableByIdResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovMspb(uint64(m.ClusterId))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Table != nil {
+		l = m.Table.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *ResponseHeader) Size() (n int) {
+func (m *GetColumnsRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovMspb(uint64(m.ClusterId))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.Error != nil {
-		l = m.Error.Size()
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	return n
+}
+
+func (m *GetColumnsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsLeader) Size() (n int) {
+func (m *GetColumnByNameRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.MsLeader)
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	l = len(m.ColName)
 	if l > 0 {
 		n += 1 + l + sovMspb(uint64(l))
 	}
 	return n
 }
 
-func (m *NoLeader) Size() (n int) {
+func (m *GetColumnByNameResponse) Size() (n int) {
 	var l int
 	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Column != nil {
+		l = m.Column.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
 	return n
 }
 
-func (m *Error) Size() (n int) {
+func (m *GetColumnByIdRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.MsLeader != nil {
-		l = m.MsLeader.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	if m.NoLeader != nil {
-		l = m.NoLeader.Size()
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	if m.ColId != 0 {
+		n += 1 + sovMspb(uint64(m.ColId))
+	}
+	return n
+}
+
+func (m *GetColumnByIdResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Column != nil {
+		l = m.Column.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *AddColumnRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddColumnResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ColumnAlteration) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMspb(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Nullable {
+		n += 2
+	}
+	return n
+}
+
+func (m *AlterColumnRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovMspb(uint64(l))
 	}
-	return n
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	if m.ConfVer != 0 {
+		n += 1 + sovMspb(uint64(m.ConfVer))
+	}
+	if len(m.Alterations) > 0 {
+		for _, e := range m.Alterations {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AlterColumnResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Table != nil {
+		l = m.Table.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *TruncateTableRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.DbId != 0 {
+		n += 1 + sovMspb(uint64(m.DbId))
+	}
+	if m.TableId != 0 {
+		n += 1 + sovMspb(uint64(m.TableId))
+	}
+	return n
+}
+
+func (m *TruncateTableResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateDatabaseRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateDatabaseResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTableRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			l = len(b)
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateTableResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteDatabaseRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteDatabaseResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTableRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTableResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateIndexRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateIndexResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.Index != nil {
+		l = m.Index.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetIndexStateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovMspb(uint64(m.State))
+	}
+	return n
+}
+
+func (m *SetIndexStateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDatabasesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDatabasesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.Dbs) > 0 {
+		for _, e := range m.Dbs {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTablesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTablesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovMspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovMspb(uint64(m.ClusterId))
+	}
+	return n
+}
+
+func (m *ResponseHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovMspb(uint64(m.ClusterId))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *MsLeader) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.MsLeader)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *NoLeader) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchemaError) Size() (n int) {
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovMspb(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	var l int
+	_ = l
+	if m.MsLeader != nil {
+		l = m.MsLeader.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.NoLeader != nil {
+		l = m.NoLeader.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	if m.SchemaError != nil {
+		l = m.SchemaError.Size()
+		n += 1 + l + sovMspb(uint64(l))
+	}
+	return n
+}
+
+func sovMspb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMspb(x uint64) (n int) {
+	return sovMspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MSLeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MSLeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MSLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMSLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMSLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMSLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMSLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMSLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &MSLeader{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
+			}
+			m.DownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownSeconds |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			}
+			m.BytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			}
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			}
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			}
+			m.ApproximateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &metapb.Range{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownPeers = append(m.DownPeers, &PeerStats{})
+			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &RangeStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
+			}
+			m.RangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Epoch == nil {
+				m.Epoch = &metapb.RangeEpoch{}
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
+			}
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &taskpb.Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
+			}
+			m.CommitId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeCount", wireType)
+			}
+			m.RangeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSplitCount", wireType)
+			}
+			m.RangeSplitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeSplitCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
+			}
+			m.SendingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+			}
+			m.ReceivingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			}
+			m.ApplyingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeLeaderCount", wireType)
+			}
+			m.RangeLeaderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeLeaderCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+			}
+			m.UsedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			}
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			}
+			m.BytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			}
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBusy = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &NodeStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IsolatedReplicas = append(m.IsolatedReplicas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IsolatedReplicas = append(m.IsolatedReplicas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedReplicas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeleteReplicas = append(m.DeleteReplicas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeleteReplicas = append(m.DeleteReplicas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteReplicas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &metapb.Range{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &metapb.Range{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRangeId", wireType)
+			}
+			m.NewRangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRangeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Left == nil {
+				m.Left = &metapb.Range{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Right == nil {
+				m.Right = &metapb.Range{}
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-
-func sovMspb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozMspb(x uint64) (n int) {
-	return sovMspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MSLeader) Unmarshal(dAtA []byte) error {
+func (m *RangeKeySamples) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5029,17 +9340,17 @@ func (m *MSLeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MSLeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeKeySamples: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MSLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeKeySamples: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
 			}
-			m.Id = 0
+			m.RangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5049,16 +9360,16 @@ func (m *MSLeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				m.RangeId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEpoch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5068,20 +9379,53 @@ func (m *MSLeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.RangeEpoch == nil {
+				m.RangeEpoch = &metapb.RangeEpoch{}
+			}
+			if err := m.RangeEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5104,7 +9448,7 @@ func (m *MSLeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMSLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *ReportKeySamplesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5127,10 +9471,10 @@ func (m *GetMSLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMSLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportKeySamplesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMSLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportKeySamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5166,6 +9510,37 @@ func (m *GetMSLeaderRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, &RangeKeySamples{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -5187,7 +9562,7 @@ func (m *GetMSLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *ReportKeySamplesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5210,10 +9585,10 @@ func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMSLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportKeySamplesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMSLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportKeySamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5249,9 +9624,59 @@ func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeLoginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5275,13 +9700,32 @@ func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &MSLeader{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -5303,7 +9747,7 @@ func (m *GetMSLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerStats) Unmarshal(dAtA []byte) error {
+func (m *NodeLoginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5326,15 +9770,15 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLoginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5358,32 +9802,13 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
-			}
-			m.DownSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownSeconds |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -5405,7 +9830,7 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeStats) Unmarshal(dAtA []byte) error {
+func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5428,17 +9853,17 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.BytesWritten = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5448,16 +9873,30 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerPort", wireType)
 			}
-			m.BytesRead = 0
+			m.ServerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5467,16 +9906,16 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				m.ServerPort |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftPort", wireType)
 			}
-			m.KeysWritten = 0
+			m.RaftPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5486,16 +9925,16 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				m.RaftPort |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpPort", wireType)
 			}
-			m.KeysRead = 0
+			m.HttpPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5505,16 +9944,16 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				m.HttpPort |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ApproximateSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5524,11 +9963,21 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -5550,7 +9999,7 @@ func (m *RangeStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5573,10 +10022,10 @@ func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5606,17 +10055,17 @@ func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5626,153 +10075,31 @@ func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.NodeId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Range == nil {
-				m.Range = &metapb.Range{}
-			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DownPeers = append(m.DownPeers, &PeerStats{})
-			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clearup", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &RangeStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Clearup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -5794,7 +10121,7 @@ func (m *RangeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5817,10 +10144,10 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RangeHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5850,7 +10177,7 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5858,9 +10185,9 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			m.RangeId = 0
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5870,16 +10197,16 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RangeId |= (uint64(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			var msglen int
+			m.TableId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5889,63 +10216,16 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TableId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Epoch == nil {
-				m.Epoch = &metapb.RangeEpoch{}
-			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
-			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -5955,44 +10235,23 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &taskpb.Task{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitId", wireType)
-			}
-			m.CommitId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -6014,7 +10273,7 @@ func (m *RangeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStats) Unmarshal(dAtA []byte) error {
+func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6037,93 +10296,17 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeCount", wireType)
-			}
-			m.RangeCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RangeCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeSplitCount", wireType)
-			}
-			m.RangeSplitCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RangeSplitCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
-			}
-			m.SendingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
-			}
-			m.ReceivingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ApplyingSnapCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6133,54 +10316,30 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeLeaderCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.RangeLeaderCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RangeLeaderCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			m.Capacity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Capacity |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.UsedSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6190,16 +10349,78 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.Available = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &metapb.Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6209,16 +10430,30 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Available |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.BytesWritten = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6228,16 +10463,66 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.KeysWritten = 0
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6247,16 +10532,30 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.BytesRead = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6266,16 +10565,80 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.KeysRead = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &metapb.Node{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDBRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDBRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDBRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6285,36 +10648,30 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IsBusy = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			m.Start = 0
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6324,11 +10681,21 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -6350,7 +10717,7 @@ func (m *NodeStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6373,10 +10740,10 @@ func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDBResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDBResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6406,34 +10773,15 @@ func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			m.NodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Db", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6457,75 +10805,13 @@ func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &NodeStats{}
+			if m.Db == nil {
+				m.Db = &metapb.DataBase{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Db.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.IsolatedReplicas = append(m.IsolatedReplicas, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMspb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IsolatedReplicas = append(m.IsolatedReplicas, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedReplicas", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -6547,7 +10833,7 @@ func (m *NodeHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6570,10 +10856,10 @@ func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6603,17 +10889,17 @@ func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.NodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6623,73 +10909,50 @@ func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DeleteReplicas = append(m.DeleteReplicas, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMspb
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DeleteReplicas = append(m.DeleteReplicas, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteReplicas", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -6711,7 +10974,7 @@ func (m *NodeHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTableByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,10 +10997,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTableByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTableByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6774,10 +11037,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			var msglen int
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6787,30 +11050,16 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Range == nil {
-				m.Range = &metapb.Range{}
-			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			var byteLen int
+			m.TableId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6820,23 +11069,11 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.TableId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -6858,7 +11095,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6881,10 +11118,10 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6922,7 +11159,7 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6946,18 +11183,68 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &metapb.Range{}
+			if m.Table == nil {
+				m.Table = &metapb.Table{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Table.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRangeId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.NewRangeId = 0
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTableByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTableByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTableByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -6967,78 +11254,30 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewRangeId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMspb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
 			}
-		case 5:
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7048,21 +11287,23 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
+			if m.Table == nil {
+				m.Table = &metapb.Table{}
+			}
+			if err := m.Table.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7086,7 +11327,7 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7109,10 +11350,10 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7149,43 +11390,10 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Left == nil {
-				m.Left = &metapb.Range{}
-			}
-			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			var msglen int
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7195,25 +11403,30 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Right == nil {
-				m.Right = &metapb.Range{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7235,7 +11448,7 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *GetColumnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7258,10 +11471,10 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7297,6 +11510,37 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, &metapb.Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7318,7 +11562,7 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
+func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7341,10 +11585,10 @@ func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLoginRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7382,9 +11626,9 @@ func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			m.NodeId = 0
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7394,11 +11638,59 @@ func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeId |= (uint64(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7420,7 +11712,7 @@ func (m *NodeLoginRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLoginResponse) Unmarshal(dAtA []byte) error {
+func (m *GetColumnByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7443,10 +11735,10 @@ func (m *NodeLoginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLoginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7482,6 +11774,39 @@ func (m *NodeLoginResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Column == nil {
+				m.Column = &metapb.Column{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7503,7 +11828,7 @@ func (m *NodeLoginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
+func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7526,10 +11851,10 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7567,9 +11892,9 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			m.ServerPort = 0
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7579,16 +11904,16 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerPort |= (uint32(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			m.RaftPort = 0
+			m.TableId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7598,35 +11923,16 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftPort |= (uint32(b) & 0x7F) << shift
+				m.TableId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpPort", wireType)
-			}
-			m.HttpPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HttpPort |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColId", wireType)
 			}
-			var stringLen uint64
+			m.ColId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7636,21 +11942,11 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ColId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7672,7 +11968,7 @@ func (m *GetNodeIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
+func (m *GetColumnByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7695,10 +11991,10 @@ func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetColumnByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetColumnByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7727,37 +12023,18 @@ func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			m.NodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clearup", wireType)
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			var v int
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7767,12 +12044,25 @@ func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Clearup = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Column == nil {
+				m.Column = &metapb.Column{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -7794,7 +12084,7 @@ func (m *GetNodeIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
+func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7817,10 +12107,10 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRouteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddColumnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddColumnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7896,9 +12186,9 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -7908,21 +12198,21 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Columns = append(m.Columns, &metapb.Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7946,7 +12236,7 @@ func (m *GetRouteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7969,10 +12259,10 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddColumnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddColumnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8010,7 +12300,7 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8034,8 +12324,8 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &metapb.Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Columns = append(m.Columns, &metapb.Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8060,7 +12350,7 @@ func (m *GetRouteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ColumnAlteration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8083,17 +12373,36 @@ func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ColumnAlteration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ColumnAlteration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (AlterColumnType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8103,30 +12412,86 @@ func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultValue = append(m.DefaultValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.DefaultValue == nil {
+				m.DefaultValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullable", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8136,11 +12501,12 @@ func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Nullable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -8162,7 +12528,7 @@ func (m *GetNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *AlterColumnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8185,10 +12551,10 @@ func (m *GetNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterColumnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterColumnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8218,17 +12584,17 @@ func (m *GetNodeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			var msglen int
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8238,80 +12604,35 @@ func (m *GetNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Node == nil {
-				m.Node = &metapb.Node{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDBRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDBRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDBRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfVer", wireType)
 			}
-			var msglen int
+			m.ConfVer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8321,30 +12642,16 @@ func (m *GetDBRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ConfVer |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alterations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8354,20 +12661,22 @@ func (m *GetDBRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Alterations = append(m.Alterations, &ColumnAlteration{})
+			if err := m.Alterations[len(m.Alterations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8390,7 +12699,7 @@ func (m *GetDBRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
+func (m *AlterColumnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8413,10 +12722,10 @@ func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDBResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterColumnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDBResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterColumnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8454,7 +12763,7 @@ func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Db", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8478,10 +12787,10 @@ func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Db == nil {
-				m.Db = &metapb.DataBase{}
+			if m.Table == nil {
+				m.Table = &metapb.Table{}
 			}
-			if err := m.Db.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Table.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8506,7 +12815,7 @@ func (m *GetDBResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
+func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8529,10 +12838,10 @@ func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TruncateTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TruncateTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8569,10 +12878,10 @@ func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
 			}
-			var stringLen uint64
+			m.DbId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8582,26 +12891,16 @@ func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DbId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			var stringLen uint64
+			m.TableId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8611,21 +12910,11 @@ func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.TableId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -8647,7 +12936,7 @@ func (m *GetTableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTableByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *TruncateTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8670,10 +12959,10 @@ func (m *GetTableByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTableByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TruncateTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTableByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TruncateTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8703,50 +12992,12 @@ func (m *GetTableByIdRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
-			}
-			m.DbId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
-			}
-			m.TableId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -8768,7 +13019,7 @@ func (m *GetTableByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateDatabaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8791,10 +13042,10 @@ func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDatabaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDatabaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8824,7 +13075,7 @@ func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8832,9 +13083,9 @@ func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -8844,24 +13095,20 @@ func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Table == nil {
-				m.Table = &metapb.Table{}
-			}
-			if err := m.Table.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8884,7 +13131,7 @@ func (m *GetTableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTableByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateDatabaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8907,10 +13154,10 @@ func (m *GetTableByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTableByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDatabaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTableByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8946,39 +13193,6 @@ func (m *GetTableByIdResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Table == nil {
-				m.Table = &metapb.Table{}
-			}
-			if err := m.Table.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -9000,7 +13214,7 @@ func (m *GetTableByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9023,10 +13237,10 @@ func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9062,11 +13276,69 @@ func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
-			m.DbId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9076,16 +13348,26 @@ func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.TableId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKeys", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9095,11 +13377,21 @@ func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKeys = append(m.SplitKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SplitKeys[len(m.SplitKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -9121,7 +13413,7 @@ func (m *GetColumnsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnsResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9144,10 +13436,10 @@ func (m *GetColumnsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9183,37 +13475,6 @@ func (m *GetColumnsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Columns = append(m.Columns, &metapb.Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -9235,7 +13496,7 @@ func (m *GetColumnsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteDatabaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9258,10 +13519,10 @@ func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDatabaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDatabaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9298,46 +13559,8 @@ func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
-			}
-			m.DbId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
-			}
-			m.TableId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9362,7 +13585,7 @@ func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ColName = string(dAtA[iNdEx:postIndex])
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9385,7 +13608,7 @@ func (m *GetColumnByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteDatabaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9408,10 +13631,10 @@ func (m *GetColumnByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDatabaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9447,39 +13670,6 @@ func (m *GetColumnByNameResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Column == nil {
-				m.Column = &metapb.Column{}
-			}
-			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -9501,7 +13691,7 @@ func (m *GetColumnByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9524,10 +13714,10 @@ func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9564,10 +13754,10 @@ func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.DbId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9577,16 +13767,26 @@ func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
-			m.TableId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9596,30 +13796,21 @@ func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
 			}
-			m.ColId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ColId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -9641,7 +13832,7 @@ func (m *GetColumnByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetColumnByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9660,52 +13851,19 @@ func (m *GetColumnByIdResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetColumnByIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetColumnByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9729,10 +13887,10 @@ func (m *GetColumnByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Column == nil {
-				m.Column = &metapb.Column{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9757,7 +13915,7 @@ func (m *GetColumnByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9780,10 +13938,10 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddColumnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddColumnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9820,10 +13978,10 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.DbId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9833,16 +13991,26 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
-			m.TableId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9852,16 +14020,26 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -9871,22 +14049,49 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, &metapb.Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9909,7 +14114,7 @@ func (m *AddColumnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9932,10 +14137,10 @@ func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddColumnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddColumnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9973,7 +14178,7 @@ func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9997,8 +14202,10 @@ func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, &metapb.Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Index == nil {
+				m.Index = &metapb.Index{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10023,7 +14230,7 @@ func (m *AddColumnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
+func (m *SetIndexStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10046,10 +14253,10 @@ func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TruncateTableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetIndexStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TruncateTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetIndexStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10086,10 +14293,10 @@ func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.DbId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -10099,16 +14306,26 @@ func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DbId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
-			m.TableId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -10118,66 +14335,26 @@ func (m *TruncateTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TruncateTableResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMspb
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TruncateTableResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TruncateTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -10187,25 +14364,40 @@ func (m *TruncateTableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (metapb.IndexState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -10227,7 +14419,7 @@ func (m *TruncateTableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabaseRequest) Unmarshal(dAtA []byte) error {
+func (m *SetIndexStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10250,10 +14442,10 @@ func (m *CreateDatabaseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabaseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetIndexStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetIndexStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10275,48 +14467,19 @@ func (m *CreateDatabaseRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10339,7 +14502,7 @@ func (m *CreateDatabaseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabaseResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDatabasesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10362,10 +14525,10 @@ func (m *CreateDatabaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDatabasesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDatabasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10395,7 +14558,7 @@ func (m *CreateDatabaseResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10422,7 +14585,7 @@ func (m *CreateDatabaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDatabasesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10445,10 +14608,10 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDatabasesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDatabasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10478,7 +14641,7 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10486,9 +14649,9 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dbs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -10498,26 +14661,78 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.Dbs = append(m.Dbs, &metapb.DataBase{})
+			if err := m.Dbs[len(m.Dbs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMspb
@@ -10527,24 +14742,28 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMspb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10569,7 +14788,7 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Properties = string(dAtA[iNdEx:postIndex])
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10592,7 +14811,7 @@ func (m *CreateTableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTableResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTablesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10615,10 +14834,10 @@ func (m *CreateTableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTablesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10654,6 +14873,37 @@ func (m *CreateTableResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &metapb.Table{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -10975,6 +15225,104 @@ func (m *NoLeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SchemaError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (SchemaErrorCode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Error) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11070,6 +15418,39 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchemaError == nil {
+				m.SchemaError = &SchemaError{}
+			}
+			if err := m.SchemaError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMspb(dAtA[iNdEx:])
@@ -11199,126 +15580,172 @@ var (
 func init() { proto.RegisterFile("mspb.proto", fileDescriptorMspb) }
 
 var fileDescriptorMspb = []byte{
-	// 1930 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xcf, 0xc8, 0xfa, 0xfb, 0x24, 0xcb, 0x72, 0xfb, 0x4f, 0x14, 0x39, 0xeb, 0x75, 0x7a, 0xd9,
-	0x5d, 0x43, 0x82, 0x81, 0xc0, 0x81, 0x2a, 0xaa, 0xa8, 0x8a, 0x93, 0xe0, 0xf5, 0xb2, 0x09, 0xa9,
-	0x71, 0xaa, 0xe0, 0x42, 0xa9, 0x5a, 0xea, 0x5e, 0x65, 0xca, 0xd2, 0xcc, 0x30, 0xdd, 0x72, 0xd0,
-	0x9e, 0xb8, 0x51, 0x70, 0xe2, 0xc8, 0x72, 0x82, 0x6f, 0xc0, 0x81, 0x1b, 0x77, 0x8a, 0x23, 0x1f,
-	0x81, 0x0a, 0x9f, 0x82, 0x1b, 0xd5, 0xaf, 0xbb, 0x47, 0x33, 0x23, 0x2d, 0xb0, 0xb3, 0xb1, 0x6f,
-	0xd3, 0xef, 0xf7, 0xfa, 0xf5, 0x7b, 0xaf, 0xdf, 0x7b, 0xdd, 0xaf, 0x07, 0x60, 0x26, 0xe3, 0xd1,
-	0x49, 0x9c, 0x44, 0x2a, 0x22, 0x55, 0xfd, 0x3d, 0xe8, 0xcc, 0x84, 0x62, 0x8e, 0x36, 0xe8, 0x28,
-	0x26, 0x2f, 0xd3, 0xd1, 0xee, 0x24, 0x9a, 0x44, 0xf8, 0xf9, 0x2d, 0xfd, 0x65, 0xa8, 0xf4, 0x7b,
-	0xd0, 0x7c, 0x76, 0xf1, 0x89, 0x60, 0x5c, 0x24, 0xa4, 0x0b, 0x95, 0x80, 0xf7, 0xbd, 0x23, 0xef,
-	0xb8, 0xea, 0x57, 0x02, 0x4e, 0xfa, 0xd0, 0x60, 0x9c, 0x27, 0x42, 0xca, 0x7e, 0xe5, 0xc8, 0x3b,
-	0x6e, 0xf9, 0x6e, 0x48, 0x1f, 0x01, 0x39, 0x13, 0xca, 0x4d, 0xf4, 0xc5, 0x2f, 0xe6, 0x42, 0x2a,
-	0x72, 0x1f, 0xea, 0xaf, 0x90, 0x80, 0x32, 0xda, 0x0f, 0x77, 0x4e, 0x50, 0x41, 0x0b, 0x7f, 0x64,
-	0x78, 0x2d, 0x0b, 0xbd, 0x84, 0x9d, 0x9c, 0x08, 0x19, 0x47, 0xa1, 0x14, 0xe4, 0x41, 0x41, 0xc6,
-	0xae, 0x93, 0x61, 0xf0, 0xbc, 0x10, 0xf2, 0x01, 0xd4, 0xa7, 0x86, 0xbb, 0x82, 0xdc, 0x5d, 0xc3,
-	0x9d, 0x4a, 0xb5, 0x28, 0x7d, 0x01, 0xad, 0x17, 0x42, 0x24, 0x17, 0x8a, 0x29, 0x49, 0x8e, 0xa0,
-	0x1a, 0x8b, 0x74, 0x81, 0xce, 0x89, 0xf5, 0x99, 0x66, 0xf0, 0x11, 0x21, 0xf7, 0xa0, 0xc3, 0xa3,
-	0xd7, 0xe1, 0x50, 0x8a, 0x71, 0x14, 0x72, 0x63, 0x7d, 0xd5, 0x6f, 0x6b, 0xda, 0x85, 0x21, 0xd1,
-	0xbf, 0x7a, 0x00, 0x3e, 0x0b, 0x27, 0xc2, 0xc8, 0x7c, 0x0f, 0x36, 0x47, 0x0b, 0x25, 0xe4, 0xf0,
-	0x75, 0x12, 0x28, 0x25, 0x42, 0xeb, 0xc5, 0x0e, 0x12, 0x7f, 0x6a, 0x68, 0xe4, 0x1d, 0x00, 0xc3,
-	0x94, 0x08, 0xc6, 0xad, 0xd0, 0x16, 0x52, 0x7c, 0xc1, 0xb8, 0x5e, 0xf5, 0x52, 0x2c, 0x96, 0x22,
-	0x36, 0xcc, 0xaa, 0x9a, 0xe6, 0x24, 0x1c, 0x40, 0x0b, 0x59, 0x50, 0x40, 0x15, 0xf1, 0xa6, 0x26,
-	0xe0, 0xfc, 0xaf, 0x43, 0x8f, 0xc5, 0x71, 0x12, 0xfd, 0x32, 0x98, 0x31, 0x25, 0x86, 0x32, 0xf8,
-	0x4c, 0xf4, 0x6b, 0xc8, 0xb3, 0x95, 0xa1, 0x5f, 0x04, 0x9f, 0x09, 0xfa, 0x87, 0x0a, 0xec, 0xa1,
-	0xf6, 0x1f, 0x09, 0x96, 0xa8, 0x91, 0x60, 0xaa, 0xcc, 0x1e, 0x92, 0xf7, 0xa0, 0x96, 0x68, 0x29,
-	0xd6, 0xfb, 0x9b, 0xce, 0x95, 0x28, 0xda, 0x37, 0x18, 0xf9, 0x5a, 0xba, 0x47, 0x1b, 0x6b, 0x1c,
-	0x6e, 0x31, 0x72, 0x02, 0x80, 0x2e, 0xd7, 0xfe, 0x97, 0xfd, 0xea, 0xd1, 0xc6, 0x71, 0xfb, 0xe1,
-	0x96, 0x59, 0x3b, 0xdd, 0x39, 0xbf, 0xa5, 0x59, 0xf4, 0x50, 0x92, 0xef, 0xc0, 0x66, 0x2c, 0x42,
-	0x1e, 0x84, 0x13, 0x3b, 0xa5, 0x86, 0x53, 0xf2, 0xc2, 0x3b, 0x96, 0xc5, 0x4c, 0xf9, 0x00, 0x6a,
-	0x52, 0x8b, 0xe9, 0xd7, 0x51, 0x8f, 0x9e, 0xb5, 0x2c, 0xdd, 0x44, 0xdf, 0xc0, 0xf4, 0xdf, 0x1e,
-	0xec, 0x17, 0x9d, 0x53, 0x2a, 0x3a, 0xef, 0x40, 0x13, 0x5d, 0x30, 0x0c, 0xdc, 0x6e, 0x37, 0x70,
-	0x7c, 0xce, 0xc9, 0x31, 0xd4, 0x44, 0x1c, 0x8d, 0x5f, 0x59, 0x9f, 0x90, 0x9c, 0xe7, 0x9e, 0x6a,
-	0xc4, 0x37, 0x0c, 0xe4, 0x9b, 0xd0, 0x56, 0x2c, 0x99, 0x08, 0x85, 0x76, 0xe2, 0xa6, 0x17, 0xcd,
-	0x04, 0xc3, 0xa0, 0xbf, 0x75, 0x70, 0xeb, 0xac, 0xc7, 0x8d, 0xd7, 0x7c, 0xb6, 0x04, 0xbc, 0x64,
-	0xf2, 0xd2, 0x47, 0x44, 0xc7, 0xd0, 0x38, 0x9a, 0xcd, 0x02, 0xa5, 0xd5, 0xaa, 0x9b, 0x18, 0x32,
-	0x84, 0x73, 0x4e, 0xff, 0x58, 0x85, 0xd6, 0xf3, 0x88, 0xdb, 0xa8, 0x7e, 0x17, 0xda, 0xc6, 0x80,
-	0x71, 0x34, 0x0f, 0x15, 0xda, 0xbc, 0xe9, 0x03, 0x92, 0x1e, 0x6b, 0x0a, 0xf9, 0x06, 0x6c, 0x1b,
-	0x06, 0x19, 0x4f, 0x03, 0x65, 0xd9, 0x2a, 0xc8, 0xb6, 0x85, 0xc0, 0x85, 0xa6, 0x1b, 0xde, 0x07,
-	0x40, 0xa4, 0xdd, 0x31, 0x19, 0xb2, 0xd8, 0x32, 0x6f, 0x20, 0x73, 0xcf, 0x22, 0x17, 0x21, 0x8b,
-	0x0d, 0xf7, 0xb7, 0x61, 0x37, 0x11, 0x63, 0x11, 0x5c, 0x15, 0xf8, 0xab, 0xc8, 0x4f, 0x52, 0x6c,
-	0x39, 0xe3, 0x04, 0x76, 0x58, 0x1c, 0x4f, 0x17, 0x85, 0x09, 0x35, 0x9c, 0xb0, 0xed, 0xa0, 0x25,
-	0xff, 0x03, 0x20, 0x46, 0x77, 0x13, 0x81, 0x96, 0xbd, 0x6e, 0xf4, 0x41, 0xc4, 0x14, 0x11, 0xc3,
-	0x3d, 0x80, 0xe6, 0x98, 0xc5, 0x6c, 0x1c, 0xa8, 0x45, 0xbf, 0x61, 0x9d, 0x66, 0xc7, 0xda, 0xa3,
-	0x73, 0x29, 0xb8, 0xc9, 0xb8, 0xa6, 0x01, 0x35, 0x41, 0xa7, 0x1a, 0xb9, 0x0b, 0x2d, 0x76, 0xc5,
-	0x82, 0x29, 0x1b, 0x4d, 0x45, 0xbf, 0x65, 0x72, 0x3e, 0x25, 0xac, 0xd6, 0x0d, 0x58, 0x53, 0x37,
-	0x8a, 0x85, 0xa1, 0xbd, 0x5a, 0x18, 0xf2, 0xa5, 0xa5, 0x53, 0x2c, 0x2d, 0xb9, 0xba, 0xb1, 0x59,
-	0xa8, 0x1b, 0xb7, 0xa1, 0x11, 0xc8, 0xe1, 0x68, 0x2e, 0x17, 0xfd, 0xee, 0x91, 0x77, 0xdc, 0xf4,
-	0xeb, 0x81, 0x3c, 0x9d, 0xcb, 0x05, 0xd9, 0xc5, 0x84, 0x49, 0x54, 0x7f, 0x0b, 0x9d, 0x62, 0x06,
-	0xf4, 0xcf, 0x1e, 0xec, 0xea, 0x10, 0xf9, 0x6a, 0xa5, 0xe3, 0x36, 0x34, 0xc2, 0x88, 0x67, 0x52,
-	0xa3, 0xae, 0x87, 0xe7, 0x9c, 0xbc, 0xef, 0xb2, 0xd4, 0x64, 0x86, 0xad, 0x01, 0x69, 0x4c, 0xda,
-	0x24, 0x25, 0xf7, 0x61, 0x3b, 0x90, 0xd1, 0x94, 0x29, 0xc1, 0x87, 0x89, 0x88, 0xa7, 0xc1, 0x98,
-	0x99, 0xb2, 0x51, 0xf5, 0x7b, 0x0e, 0xf0, 0x2d, 0x9d, 0xfe, 0xda, 0x83, 0xbd, 0x82, 0xca, 0xa5,
-	0x12, 0xfa, 0x0b, 0x95, 0xfe, 0x10, 0xb6, 0xb8, 0x98, 0x0a, 0x25, 0x96, 0xba, 0x6c, 0xa0, 0x2e,
-	0x5d, 0x43, 0x4e, 0x35, 0xf9, 0x95, 0x07, 0x5b, 0x8f, 0xe4, 0x25, 0xa6, 0xc5, 0xf5, 0x95, 0xdc,
-	0x03, 0x68, 0x99, 0x84, 0xbc, 0x14, 0x0b, 0xf4, 0x63, 0xc7, 0x6f, 0x22, 0xe1, 0xc7, 0x62, 0x41,
-	0xff, 0xe6, 0x41, 0x6f, 0xa9, 0x42, 0x29, 0x3f, 0xfc, 0x5f, 0x4a, 0x1c, 0x41, 0x27, 0x14, 0xaf,
-	0x87, 0x69, 0x05, 0x34, 0xc7, 0x19, 0x84, 0xe2, 0xb5, 0x6f, 0x8b, 0xa0, 0xe5, 0xd0, 0x75, 0x6d,
-	0x18, 0x70, 0xb7, 0x7d, 0x9a, 0x43, 0x97, 0xb2, 0x73, 0x2e, 0xf3, 0x86, 0xd4, 0x0a, 0x86, 0xfc,
-	0xc6, 0x03, 0xe2, 0x8b, 0x38, 0x4a, 0x54, 0x79, 0x77, 0xde, 0x83, 0xea, 0x54, 0x7c, 0xaa, 0xd6,
-	0x1b, 0x82, 0x10, 0x1a, 0x1b, 0x4c, 0x5e, 0x29, 0x1b, 0x90, 0x2b, 0xc6, 0x6a, 0x8c, 0x3e, 0x86,
-	0x9d, 0x9c, 0x2a, 0x65, 0xdc, 0x4a, 0x7f, 0x06, 0x3d, 0x1d, 0xa5, 0x9f, 0x44, 0x93, 0x20, 0x7c,
-	0xab, 0x49, 0x45, 0x1f, 0xc1, 0x76, 0x46, 0x72, 0x29, 0xe5, 0xfe, 0xe2, 0x41, 0xef, 0x4c, 0xa8,
-	0xe7, 0x28, 0xb0, 0x94, 0x76, 0xef, 0x42, 0x5b, 0x8a, 0xe4, 0x4a, 0x24, 0x43, 0xed, 0x28, 0x7b,
-	0x4c, 0x80, 0x21, 0xbd, 0x88, 0x12, 0xa5, 0x77, 0x3b, 0x61, 0x9f, 0x2a, 0x03, 0x9b, 0x83, 0xa1,
-	0xa9, 0x09, 0x0e, 0x7c, 0xa5, 0x54, 0x6c, 0x40, 0x73, 0x0a, 0x34, 0x35, 0x01, 0xc1, 0x3e, 0x34,
-	0xae, 0x44, 0x22, 0x83, 0x28, 0xc4, 0x28, 0x69, 0xf9, 0x6e, 0x48, 0x15, 0x6c, 0x67, 0xb4, 0x7e,
-	0xbb, 0x59, 0xdf, 0x87, 0xc6, 0x78, 0x2a, 0x58, 0x32, 0x8f, 0x51, 0xdb, 0xa6, 0xef, 0x86, 0x98,
-	0xe6, 0x67, 0x42, 0xf9, 0xd1, 0x5c, 0xe7, 0x7e, 0x09, 0x5f, 0xed, 0x40, 0x8d, 0x8f, 0x96, 0x2b,
-	0x56, 0xf9, 0xe8, 0x9c, 0xeb, 0xfb, 0x84, 0xd2, 0xa7, 0xc6, 0x32, 0x9b, 0x1a, 0x38, 0x3e, 0xe7,
-	0xa4, 0x07, 0x1b, 0x3a, 0x45, 0xaa, 0x98, 0x22, 0xfa, 0x93, 0x4e, 0x70, 0xbb, 0xac, 0x06, 0xa5,
-	0xec, 0x7e, 0x1f, 0xea, 0x89, 0x9e, 0xae, 0xef, 0xbf, 0x1b, 0xb9, 0xc8, 0x47, 0xa1, 0x16, 0xa4,
-	0xcf, 0xa0, 0x6b, 0x3d, 0x5c, 0xca, 0x52, 0xd3, 0x74, 0x54, 0x5c, 0xd3, 0x41, 0x19, 0x7a, 0xce,
-	0x88, 0x2b, 0xa5, 0xf6, 0x11, 0x54, 0xf5, 0xfe, 0xd8, 0x94, 0x4e, 0x6f, 0x4a, 0x28, 0x11, 0x11,
-	0xfa, 0x13, 0xe8, 0x9c, 0x09, 0xf5, 0xe4, 0xb4, 0x94, 0xbe, 0x04, 0xaa, 0x21, 0x9b, 0x09, 0xdb,
-	0x11, 0xe1, 0x37, 0x1d, 0xc2, 0xa6, 0x15, 0x58, 0x52, 0xe3, 0x0a, 0x1f, 0x59, 0x7d, 0x7b, 0x4e,
-	0xdf, 0x27, 0x4c, 0xb1, 0x53, 0x26, 0x85, 0x5f, 0xe1, 0x23, 0x7a, 0x85, 0x4e, 0x79, 0xa9, 0x37,
-	0xbb, 0x6c, 0x61, 0xe0, 0xa3, 0x61, 0x46, 0xef, 0x3a, 0x1f, 0x3d, 0x67, 0x33, 0xa1, 0xef, 0x0d,
-	0x26, 0xa4, 0x10, 0xdb, 0x40, 0xac, 0x85, 0x14, 0x0d, 0xd3, 0x04, 0x9b, 0x34, 0x5c, 0xf7, 0x74,
-	0x51, 0x32, 0xed, 0xbf, 0x64, 0x28, 0x53, 0x81, 0x81, 0x6b, 0x6d, 0x2d, 0x7b, 0x3c, 0xa1, 0xb0,
-	0x62, 0x55, 0x37, 0x32, 0x0d, 0x46, 0x03, 0xd8, 0xcd, 0x9b, 0x76, 0x7d, 0x4b, 0xc5, 0x58, 0x83,
-	0x1e, 0x47, 0xd3, 0xf9, 0x2c, 0x94, 0x37, 0xe2, 0xc3, 0x29, 0xf6, 0xe7, 0xe9, 0x8a, 0xa5, 0x4c,
-	0x3b, 0x86, 0xc6, 0xd8, 0x08, 0xb0, 0xf9, 0xdf, 0x75, 0xc6, 0x19, 0xb9, 0xbe, 0x83, 0xe9, 0xef,
-	0x3c, 0xd8, 0x4f, 0x97, 0x3b, 0x5d, 0xe8, 0xc8, 0xb9, 0x91, 0xa2, 0x77, 0x07, 0x9a, 0xe3, 0x68,
-	0x6a, 0x42, 0xb7, 0x6a, 0xca, 0xfe, 0x38, 0x9a, 0x62, 0xe0, 0x46, 0x70, 0x7b, 0x45, 0xa3, 0xb2,
-	0x2f, 0x0c, 0xc6, 0xcc, 0xe5, 0x0b, 0x43, 0xce, 0x09, 0x16, 0xa5, 0xbf, 0xf5, 0x30, 0x9e, 0xdc,
-	0x8a, 0x37, 0x93, 0x2b, 0x64, 0x0f, 0xb5, 0xd3, 0x80, 0x79, 0x0c, 0xa8, 0x8d, 0xa3, 0xe9, 0x39,
-	0xa7, 0x33, 0xd8, 0x2b, 0xe8, 0x72, 0xad, 0xb6, 0x7f, 0xae, 0x6f, 0x94, 0x9c, 0x5b, 0xea, 0x4d,
-	0xd8, 0x9d, 0x89, 0xcd, 0xea, 0x7f, 0x8f, 0xcd, 0x4b, 0xd8, 0xce, 0xa8, 0x76, 0xcd, 0x89, 0x20,
-	0x61, 0xf7, 0x65, 0x32, 0x0f, 0xc7, 0x4c, 0x89, 0xf2, 0xb5, 0xfa, 0xcb, 0xe6, 0xfa, 0x53, 0xd8,
-	0x2b, 0x2c, 0x5a, 0xea, 0x7e, 0xf7, 0x73, 0xd8, 0x7b, 0x9c, 0x08, 0xa6, 0x84, 0x3e, 0x78, 0x46,
-	0xfa, 0xe0, 0x79, 0x9b, 0x07, 0x0d, 0xfd, 0x11, 0xec, 0x17, 0xc5, 0x97, 0x52, 0xf3, 0x73, 0x0f,
-	0x88, 0x11, 0x74, 0xe3, 0xa7, 0x21, 0x39, 0x04, 0x88, 0x93, 0x28, 0x16, 0x89, 0x0a, 0x84, 0xb4,
-	0x15, 0x27, 0x43, 0xd1, 0x4d, 0x40, 0x4e, 0xb5, 0x52, 0x06, 0x9e, 0xc0, 0x66, 0x4e, 0x6b, 0xad,
-	0xd4, 0x78, 0x3a, 0x97, 0x0a, 0x9b, 0x24, 0xfb, 0xae, 0xd8, 0xb2, 0x94, 0x73, 0x4e, 0x7d, 0xe8,
-	0xe6, 0x25, 0xfd, 0x8f, 0x09, 0xe4, 0x1e, 0xd4, 0x44, 0x92, 0x44, 0xee, 0xc9, 0xb4, 0x6d, 0xb4,
-	0x79, 0xaa, 0x49, 0xbe, 0x41, 0xe8, 0x87, 0xd0, 0x7c, 0x26, 0xed, 0xa3, 0xf0, 0x01, 0xb4, 0x66,
-	0xd2, 0xbe, 0x91, 0xa0, 0xb0, 0x96, 0xdf, 0x9c, 0x59, 0x90, 0x02, 0x34, 0x9f, 0x47, 0xf6, 0x9b,
-	0x41, 0x0d, 0x85, 0x90, 0xfb, 0xd9, 0x19, 0xf9, 0x77, 0x59, 0x3b, 0x6f, 0x29, 0x41, 0x33, 0x87,
-	0xd1, 0x30, 0xf7, 0x40, 0xd8, 0x75, 0x2d, 0xbf, 0x63, 0x0e, 0xed, 0xd7, 0xc3, 0x3f, 0x81, 0x56,
-	0xec, 0x02, 0x3b, 0x06, 0xf2, 0x31, 0x6c, 0xe6, 0x7a, 0x7a, 0x32, 0x58, 0x3e, 0x15, 0x14, 0xdf,
-	0x26, 0x06, 0x07, 0x6b, 0x31, 0xe3, 0x36, 0x7a, 0x8b, 0x3c, 0x83, 0x6e, 0xfe, 0xc5, 0x8f, 0x1c,
-	0x64, 0x5e, 0x07, 0x57, 0xa4, 0xdd, 0x5d, 0x0f, 0xa6, 0xe2, 0x7e, 0x00, 0x4d, 0xd7, 0x61, 0x93,
-	0x3d, 0xc3, 0x5b, 0x68, 0xfa, 0x07, 0xfb, 0x45, 0x72, 0x3a, 0xf9, 0x09, 0xb4, 0x33, 0xad, 0x24,
-	0xe9, 0xbb, 0x68, 0x29, 0x36, 0xba, 0x83, 0x3b, 0x6b, 0x90, 0x54, 0xca, 0x0f, 0xcd, 0x3b, 0x1e,
-	0x76, 0x7c, 0x64, 0x7f, 0x69, 0x7d, 0xb6, 0xb9, 0x1c, 0xdc, 0x5e, 0xa1, 0x67, 0xe7, 0xa7, 0x7d,
-	0x93, 0x9b, 0x5f, 0x6c, 0xff, 0xdc, 0xfc, 0x95, 0x06, 0xcb, 0x58, 0x91, 0x79, 0xde, 0x77, 0x56,
-	0xac, 0xfe, 0x34, 0x70, 0x56, 0xac, 0xf9, 0x17, 0x60, 0x1c, 0xe9, 0x9a, 0x18, 0xe7, 0xc8, 0x42,
-	0x5b, 0x35, 0xd8, 0x2f, 0x92, 0xd3, 0xc9, 0xdf, 0x87, 0x86, 0xd5, 0x8c, 0xec, 0xe6, 0x14, 0x75,
-	0x53, 0xf7, 0x0a, 0xd4, 0x74, 0xe6, 0x43, 0xa8, 0xe1, 0x7d, 0x9e, 0x90, 0x94, 0x23, 0xed, 0x16,
-	0x06, 0x3b, 0x39, 0x5a, 0x41, 0x55, 0xcc, 0xfc, 0x8c, 0xaa, 0xd9, 0x22, 0x95, 0x51, 0x35, 0x57,
-	0x20, 0xe8, 0x2d, 0x72, 0x86, 0x1d, 0x49, 0x7a, 0x19, 0x25, 0x77, 0xf2, 0x9c, 0x99, 0xfb, 0xc4,
-	0x60, 0xb0, 0x0e, 0x4a, 0x05, 0x3d, 0x02, 0x58, 0x5e, 0xfc, 0xc8, 0x72, 0x7f, 0xf2, 0x97, 0xcf,
-	0x41, 0x7f, 0x15, 0x48, 0x45, 0xbc, 0xc0, 0x5e, 0x23, 0x7b, 0x75, 0x22, 0x77, 0x0b, 0xec, 0xb9,
-	0x3b, 0xde, 0xe0, 0x9d, 0x2f, 0x40, 0x53, 0x89, 0x1f, 0x63, 0x7b, 0xb4, 0xbc, 0x8e, 0x90, 0xc1,
-	0xca, 0x8c, 0xa5, 0x7d, 0x07, 0x6b, 0xb1, 0xac, 0xac, 0xdc, 0x69, 0xe7, 0x64, 0xad, 0x3b, 0x77,
-	0x9d, 0xac, 0xb5, 0xc7, 0xa3, 0x89, 0xf1, 0xf4, 0x6e, 0xe0, 0x62, 0xbc, 0x78, 0x8f, 0x71, 0x31,
-	0xbe, 0x72, 0x89, 0x30, 0x55, 0x23, 0x7f, 0xa6, 0xb9, 0xaa, 0xb1, 0xf6, 0x20, 0x75, 0x55, 0x63,
-	0xfd, 0x31, 0x68, 0x52, 0x26, 0x73, 0x7c, 0xb8, 0x94, 0x59, 0x3d, 0xec, 0x5c, 0xca, 0xac, 0x39,
-	0x6b, 0xe8, 0xad, 0xd3, 0xde, 0xdf, 0xdf, 0x1c, 0x7a, 0xff, 0x78, 0x73, 0xe8, 0xfd, 0xf3, 0xcd,
-	0xa1, 0xf7, 0xfb, 0x7f, 0x1d, 0xde, 0x1a, 0xd5, 0xf1, 0x4f, 0xdf, 0x77, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0x50, 0x4a, 0xa6, 0x2f, 0x1c, 0x00, 0x00,
+	// 2663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
+	0x15, 0xdf, 0xf6, 0xd8, 0x63, 0xfb, 0xd9, 0xe3, 0xe9, 0xa9, 0xf9, 0xf2, 0x7a, 0xb2, 0x93, 0x49,
+	0x87, 0x24, 0x93, 0x0f, 0x26, 0xb0, 0xc9, 0x21, 0x12, 0x12, 0xd2, 0x7c, 0x2c, 0x9b, 0x49, 0xb2,
+	0xc3, 0xaa, 0xbd, 0x84, 0x5c, 0x90, 0xd5, 0x76, 0xd7, 0xce, 0xb4, 0xa6, 0xdd, 0xdd, 0x74, 0x97,
+	0x67, 0xe2, 0x9c, 0x38, 0x81, 0xe0, 0xc4, 0x8d, 0x8f, 0x13, 0x67, 0x24, 0x24, 0x84, 0x38, 0x20,
+	0x71, 0x45, 0x88, 0x23, 0x7f, 0x02, 0x5a, 0xae, 0xfc, 0x03, 0xdc, 0x50, 0xbd, 0x57, 0xd5, 0xee,
+	0x6e, 0x7b, 0x81, 0x34, 0x3b, 0x23, 0x6e, 0xee, 0xf7, 0x5e, 0xbd, 0xfa, 0xd5, 0xab, 0xf7, 0x5e,
+	0xd5, 0x7b, 0x65, 0x80, 0x71, 0x12, 0x0d, 0x0f, 0xa2, 0x38, 0x14, 0x21, 0xab, 0xca, 0xdf, 0xbd,
+	0xf6, 0x98, 0x0b, 0x47, 0xd3, 0x7a, 0x6d, 0xe1, 0x24, 0x97, 0xe9, 0xd7, 0xc6, 0x79, 0x78, 0x1e,
+	0xe2, 0xcf, 0x77, 0xe5, 0x2f, 0xa2, 0x5a, 0xef, 0x43, 0xe3, 0x51, 0xff, 0x13, 0xee, 0xb8, 0x3c,
+	0x66, 0x1d, 0xa8, 0x78, 0x6e, 0xd7, 0xd8, 0x33, 0xf6, 0xab, 0x76, 0xc5, 0x73, 0x59, 0x17, 0xea,
+	0x8e, 0xeb, 0xc6, 0x3c, 0x49, 0xba, 0x95, 0x3d, 0x63, 0xbf, 0x69, 0xeb, 0x4f, 0xeb, 0x10, 0xd8,
+	0x43, 0x2e, 0xf4, 0x40, 0x9b, 0x7f, 0x7f, 0xc2, 0x13, 0xc1, 0xde, 0x86, 0xe5, 0x0b, 0x24, 0xa0,
+	0x8e, 0xd6, 0xfd, 0xf5, 0x03, 0x04, 0xa8, 0xd8, 0x1f, 0x92, 0xac, 0x12, 0xb1, 0x2e, 0x61, 0x3d,
+	0xa7, 0x22, 0x89, 0xc2, 0x20, 0xe1, 0xec, 0x9d, 0x82, 0x8e, 0x0d, 0xad, 0x83, 0xf8, 0x79, 0x25,
+	0xec, 0x75, 0x58, 0xf6, 0x49, 0xba, 0x82, 0xd2, 0x1d, 0x92, 0x4e, 0xb5, 0x2a, 0xae, 0xf5, 0x18,
+	0x9a, 0x8f, 0x39, 0x8f, 0xfb, 0xc2, 0x11, 0x09, 0xdb, 0x83, 0x6a, 0xc4, 0xd3, 0x09, 0xda, 0x07,
+	0xca, 0x66, 0x52, 0xc0, 0x46, 0x0e, 0x7b, 0x05, 0xda, 0x6e, 0x78, 0x1d, 0x0c, 0x12, 0x3e, 0x0a,
+	0x03, 0x97, 0x56, 0x5f, 0xb5, 0x5b, 0x92, 0xd6, 0x27, 0x92, 0xf5, 0x47, 0x03, 0xc0, 0x76, 0x82,
+	0x73, 0x4e, 0x3a, 0x5f, 0x85, 0x95, 0xe1, 0x54, 0xf0, 0x64, 0x70, 0x1d, 0x7b, 0x42, 0xf0, 0x40,
+	0x59, 0xb1, 0x8d, 0xc4, 0xef, 0x12, 0x8d, 0xdd, 0x03, 0x20, 0xa1, 0x98, 0x3b, 0xae, 0x52, 0xda,
+	0x44, 0x8a, 0xcd, 0x1d, 0x57, 0xce, 0x7a, 0xc9, 0xa7, 0x33, 0x15, 0x4b, 0x34, 0xab, 0xa4, 0x69,
+	0x0d, 0x3b, 0xd0, 0x44, 0x11, 0x54, 0x50, 0x45, 0x7e, 0x43, 0x12, 0x70, 0xfc, 0x9b, 0x60, 0x3a,
+	0x51, 0x14, 0x87, 0x9f, 0x7b, 0x63, 0x47, 0xf0, 0x41, 0xe2, 0x7d, 0xc1, 0xbb, 0x35, 0x94, 0x59,
+	0xcd, 0xd0, 0xfb, 0xde, 0x17, 0xdc, 0xfa, 0x65, 0x05, 0x36, 0x11, 0xfd, 0x87, 0xdc, 0x89, 0xc5,
+	0x90, 0x3b, 0xa2, 0xcc, 0x1e, 0xb2, 0x57, 0xa1, 0x16, 0x4b, 0x2d, 0xca, 0xfa, 0x2b, 0xda, 0x94,
+	0xa8, 0xda, 0x26, 0x1e, 0xfb, 0x4a, 0xba, 0x47, 0x4b, 0x0b, 0x0c, 0xae, 0x78, 0xec, 0x00, 0x00,
+	0x4d, 0x2e, 0xed, 0x9f, 0x74, 0xab, 0x7b, 0x4b, 0xfb, 0xad, 0xfb, 0xab, 0x34, 0x77, 0xba, 0x73,
+	0x76, 0x53, 0x8a, 0xc8, 0xcf, 0x84, 0x7d, 0x1d, 0x56, 0x22, 0x1e, 0xb8, 0x5e, 0x70, 0xae, 0x86,
+	0xd4, 0x70, 0x48, 0x5e, 0x79, 0x5b, 0x89, 0xd0, 0x90, 0xd7, 0xa1, 0x96, 0x48, 0x35, 0xdd, 0x65,
+	0xc4, 0x61, 0xaa, 0x95, 0xa5, 0x9b, 0x68, 0x13, 0xdb, 0xfa, 0xa7, 0x01, 0x5b, 0x45, 0xe3, 0x94,
+	0xf2, 0xce, 0xbb, 0xd0, 0x40, 0x13, 0x0c, 0x3c, 0xbd, 0xdb, 0x75, 0xfc, 0x3e, 0x75, 0xd9, 0x3e,
+	0xd4, 0x78, 0x14, 0x8e, 0x2e, 0x94, 0x4d, 0x58, 0xce, 0x72, 0x0f, 0x24, 0xc7, 0x26, 0x01, 0xf6,
+	0x55, 0x68, 0x09, 0x27, 0x3e, 0xe7, 0x02, 0xd7, 0x89, 0x9b, 0x5e, 0x5c, 0x26, 0x90, 0x80, 0xfc,
+	0x2d, 0x9d, 0x5b, 0x46, 0x3d, 0x6e, 0xbc, 0x94, 0x53, 0x29, 0xe0, 0x89, 0x93, 0x5c, 0xda, 0xc8,
+	0x91, 0x3e, 0x34, 0x0a, 0xc7, 0x63, 0x4f, 0x48, 0x58, 0xcb, 0xe4, 0x43, 0x44, 0x38, 0x75, 0xad,
+	0x5f, 0x55, 0xa1, 0x79, 0x16, 0xba, 0xca, 0xab, 0x5f, 0x86, 0x16, 0x2d, 0x60, 0x14, 0x4e, 0x02,
+	0x81, 0x6b, 0x5e, 0xb1, 0x01, 0x49, 0xc7, 0x92, 0xc2, 0xde, 0x82, 0x35, 0x12, 0x48, 0x22, 0xdf,
+	0x13, 0x4a, 0xac, 0x82, 0x62, 0xab, 0xc8, 0xe8, 0x4b, 0x3a, 0xc9, 0xbe, 0x03, 0x2c, 0x51, 0x3b,
+	0x96, 0x04, 0x4e, 0xa4, 0x84, 0x97, 0x50, 0xd8, 0x54, 0x9c, 0x7e, 0xe0, 0x44, 0x24, 0xfd, 0x35,
+	0xd8, 0x88, 0xf9, 0x88, 0x7b, 0x57, 0x05, 0xf9, 0x2a, 0xca, 0xb3, 0x94, 0x37, 0x1b, 0x71, 0x00,
+	0xeb, 0x4e, 0x14, 0xf9, 0xd3, 0xc2, 0x80, 0x1a, 0x0e, 0x58, 0xd3, 0xac, 0x99, 0xfc, 0x3b, 0xc0,
+	0x08, 0x3b, 0x79, 0xa0, 0x12, 0x5f, 0x26, 0x3c, 0xc8, 0xa1, 0x24, 0x42, 0xd2, 0x3d, 0x68, 0x8c,
+	0x9c, 0xc8, 0x19, 0x79, 0x62, 0xda, 0xad, 0x2b, 0xa3, 0xa9, 0x6f, 0x69, 0xd1, 0x49, 0xc2, 0x5d,
+	0x8a, 0xb8, 0x06, 0x31, 0x25, 0x41, 0x86, 0x1a, 0x7b, 0x09, 0x9a, 0xce, 0x95, 0xe3, 0xf9, 0xce,
+	0xd0, 0xe7, 0xdd, 0x26, 0xc5, 0x7c, 0x4a, 0x98, 0xcf, 0x1b, 0xb0, 0x20, 0x6f, 0x14, 0x13, 0x43,
+	0x6b, 0x3e, 0x31, 0xe4, 0x53, 0x4b, 0xbb, 0x98, 0x5a, 0x72, 0x79, 0x63, 0xa5, 0x90, 0x37, 0xb6,
+	0xa1, 0xee, 0x25, 0x83, 0xe1, 0x24, 0x99, 0x76, 0x3b, 0x7b, 0xc6, 0x7e, 0xc3, 0x5e, 0xf6, 0x92,
+	0xa3, 0x49, 0x32, 0x65, 0x1b, 0x18, 0x30, 0xb1, 0xe8, 0xae, 0xa2, 0x51, 0xe8, 0xc3, 0xfa, 0xad,
+	0x01, 0x1b, 0xd2, 0x45, 0xfe, 0xb7, 0xd4, 0xb1, 0x0d, 0xf5, 0x20, 0x74, 0x33, 0xa1, 0xb1, 0x2c,
+	0x3f, 0x4f, 0x5d, 0xf6, 0x9a, 0x8e, 0x52, 0x8a, 0x0c, 0x95, 0x03, 0x52, 0x9f, 0x54, 0x41, 0xca,
+	0xde, 0x86, 0x35, 0x2f, 0x09, 0x7d, 0x47, 0x70, 0x77, 0x10, 0xf3, 0xc8, 0xf7, 0x46, 0x0e, 0xa5,
+	0x8d, 0xaa, 0x6d, 0x6a, 0x86, 0xad, 0xe8, 0xd6, 0x8f, 0x0c, 0xd8, 0x2c, 0x40, 0x2e, 0x15, 0xd0,
+	0xcf, 0x05, 0xfd, 0x06, 0xac, 0xba, 0xdc, 0xe7, 0x82, 0xcf, 0xb0, 0x2c, 0x21, 0x96, 0x0e, 0x91,
+	0x53, 0x24, 0x3f, 0x30, 0x60, 0xf5, 0x30, 0xb9, 0xc4, 0xb0, 0xb8, 0xb9, 0x94, 0xbb, 0x03, 0x4d,
+	0x0a, 0xc8, 0x4b, 0x3e, 0x45, 0x3b, 0xb6, 0xed, 0x06, 0x12, 0x3e, 0xe6, 0x53, 0xeb, 0xcf, 0x06,
+	0x98, 0x33, 0x08, 0xa5, 0xec, 0xf0, 0x5f, 0x81, 0xd8, 0x83, 0x76, 0xc0, 0xaf, 0x07, 0x69, 0x06,
+	0xa4, 0xe3, 0x0c, 0x02, 0x7e, 0x6d, 0xab, 0x24, 0xa8, 0x24, 0x64, 0x5e, 0x1b, 0x78, 0xae, 0xde,
+	0x3e, 0x29, 0x21, 0x53, 0xd9, 0xa9, 0x9b, 0xe4, 0x17, 0x52, 0x2b, 0x2c, 0xe4, 0xc7, 0x06, 0x30,
+	0x9b, 0x47, 0x61, 0x2c, 0xca, 0x9b, 0xf3, 0x15, 0xa8, 0xfa, 0xfc, 0xa9, 0x58, 0xbc, 0x10, 0x64,
+	0xe1, 0x62, 0xbd, 0xf3, 0x0b, 0xa1, 0x1c, 0x72, 0x6e, 0xb1, 0x92, 0x67, 0x1d, 0xc3, 0x7a, 0x0e,
+	0x4a, 0x19, 0xb3, 0x5a, 0x13, 0x58, 0x45, 0xa5, 0x1f, 0xf3, 0x69, 0xdf, 0x19, 0x47, 0x3e, 0x4f,
+	0x72, 0x47, 0x88, 0x91, 0x3f, 0x42, 0xde, 0xd3, 0xc9, 0x99, 0x0e, 0x92, 0xca, 0x73, 0x0f, 0x12,
+	0x4a, 0xd8, 0xf8, 0x9b, 0x31, 0xa8, 0xca, 0xb8, 0x47, 0xef, 0x6c, 0xdb, 0xf8, 0xdb, 0xba, 0x86,
+	0x6d, 0xc2, 0x3e, 0x9b, 0xb7, 0x94, 0x2d, 0xdf, 0x85, 0x7a, 0x42, 0xc3, 0xbb, 0x15, 0x3c, 0x8c,
+	0x37, 0x33, 0x27, 0x6c, 0x46, 0xb7, 0x96, 0xb2, 0x3e, 0x84, 0xee, 0xfc, 0xc4, 0xa5, 0x2c, 0xf7,
+	0x19, 0x98, 0x32, 0xbe, 0x3f, 0x09, 0xcf, 0xbd, 0xe0, 0x85, 0xa6, 0x23, 0xeb, 0x10, 0xd6, 0x32,
+	0x9a, 0x4b, 0x81, 0xfb, 0xbd, 0x01, 0xe6, 0x43, 0x2e, 0xce, 0x50, 0x61, 0x29, 0x74, 0x2f, 0x43,
+	0x2b, 0xe1, 0xf1, 0x15, 0x8f, 0x07, 0xd2, 0x5a, 0xea, 0x80, 0x05, 0x22, 0x3d, 0x0e, 0x63, 0x21,
+	0xe3, 0x24, 0x76, 0x9e, 0x0a, 0x62, 0xd3, 0x91, 0xda, 0x90, 0x04, 0xcd, 0xbc, 0x10, 0x22, 0x22,
+	0x26, 0x9d, 0x9f, 0x0d, 0x49, 0x40, 0x66, 0x17, 0xea, 0x57, 0x3c, 0x4e, 0xbc, 0x30, 0xc0, 0xf8,
+	0x6a, 0xda, 0xfa, 0xd3, 0x12, 0xb0, 0x96, 0x41, 0xfd, 0x62, 0xf3, 0x65, 0x17, 0xea, 0x23, 0x9f,
+	0x3b, 0xf1, 0x24, 0x42, 0xb4, 0x0d, 0x5b, 0x7f, 0x62, 0x82, 0x7c, 0xc8, 0x85, 0x1d, 0x4e, 0x64,
+	0xd6, 0x2c, 0x61, 0xab, 0x75, 0xa8, 0xb9, 0xc3, 0xd9, 0x8c, 0x55, 0x77, 0x78, 0xea, 0xca, 0x30,
+	0x12, 0xf2, 0xbc, 0x9d, 0xe5, 0xa1, 0x3a, 0x7e, 0x9f, 0xba, 0xcc, 0x84, 0x25, 0x99, 0x5c, 0xaa,
+	0x98, 0x5c, 0xe4, 0x4f, 0xeb, 0x1c, 0xb7, 0x4b, 0x21, 0x28, 0xb5, 0xee, 0xd7, 0x60, 0x39, 0x96,
+	0xc3, 0x75, 0x20, 0xcc, 0x72, 0x06, 0x2a, 0x55, 0x4c, 0xeb, 0x11, 0x74, 0x94, 0x85, 0x4b, 0xad,
+	0x94, 0xca, 0xb5, 0x8a, 0x2e, 0xd7, 0x2c, 0x07, 0x2d, 0x47, 0xea, 0x4a, 0xc1, 0xde, 0x83, 0xaa,
+	0xdc, 0x1f, 0x95, 0x4a, 0xd2, 0x3b, 0x26, 0x6a, 0x44, 0x8e, 0xf5, 0x6d, 0x68, 0x3f, 0xe4, 0xe2,
+	0xe4, 0xa8, 0x14, 0x5e, 0x06, 0xd5, 0xc0, 0x19, 0x73, 0x55, 0x4b, 0xe2, 0x6f, 0x6b, 0x00, 0x2b,
+	0x4a, 0x61, 0x49, 0xc4, 0x15, 0x77, 0xa8, 0xf0, 0x9a, 0x1a, 0xef, 0x89, 0x23, 0x9c, 0x23, 0x27,
+	0xe1, 0x76, 0xc5, 0x1d, 0x5a, 0x57, 0x68, 0x94, 0x27, 0x72, 0xb3, 0xcb, 0x26, 0x06, 0x77, 0x38,
+	0xc8, 0xe0, 0x5e, 0x76, 0x87, 0x67, 0xce, 0x98, 0xcb, 0x1b, 0x17, 0xb9, 0x14, 0xf2, 0x96, 0x90,
+	0xd7, 0x44, 0x8a, 0x64, 0x5b, 0x31, 0x96, 0xb7, 0x38, 0xef, 0xd1, 0xb4, 0x64, 0xd8, 0x7f, 0x49,
+	0x57, 0xb6, 0x38, 0x3a, 0xae, 0x5a, 0x6b, 0xd9, 0x83, 0x1d, 0x95, 0x15, 0xcf, 0x43, 0xd2, 0x49,
+	0x3c, 0xcb, 0x83, 0x8d, 0xfc, 0xd2, 0x6e, 0x6e, 0xaa, 0x08, 0x73, 0xd0, 0x71, 0xe8, 0x4f, 0xc6,
+	0x41, 0x72, 0x2b, 0x36, 0xf4, 0xb1, 0xb3, 0x91, 0xce, 0x58, 0x6a, 0x69, 0xfb, 0x50, 0x1f, 0x91,
+	0x02, 0x15, 0xff, 0x1d, 0xbd, 0x38, 0xd2, 0x6b, 0x6b, 0xb6, 0xf5, 0x53, 0x03, 0xb6, 0xd2, 0xe9,
+	0x8e, 0xa6, 0xd2, 0x73, 0x6e, 0x25, 0xe9, 0xdd, 0x85, 0xc6, 0x28, 0xf4, 0xc9, 0x75, 0xab, 0x94,
+	0xf6, 0x47, 0xa1, 0x8f, 0x8e, 0x1b, 0xc2, 0xf6, 0x1c, 0xa2, 0xb2, 0xbd, 0x19, 0x5a, 0xe6, 0xac,
+	0x37, 0x93, 0x33, 0x82, 0xe2, 0x5a, 0x3f, 0x31, 0xd0, 0x9f, 0xf4, 0x8c, 0xb7, 0x13, 0x2b, 0x6c,
+	0x13, 0xd1, 0x49, 0x06, 0xb5, 0x51, 0x6a, 0xa3, 0xd0, 0x3f, 0x75, 0xad, 0x31, 0x6c, 0x16, 0xb0,
+	0xdc, 0xe8, 0xda, 0x7f, 0x21, 0xef, 0xe2, 0xae, 0xab, 0xa8, 0xb7, 0xb1, 0xee, 0x8c, 0x6f, 0x56,
+	0xff, 0xbd, 0x6f, 0x5e, 0xc2, 0x5a, 0x06, 0xda, 0x0d, 0x07, 0xc2, 0x6f, 0x0c, 0x30, 0x89, 0x76,
+	0xe8, 0x0b, 0x1e, 0x3b, 0xc2, 0x0b, 0x03, 0xf6, 0x26, 0x54, 0xc5, 0x34, 0xe2, 0x38, 0x55, 0x47,
+	0xdf, 0x26, 0x91, 0x4f, 0xa2, 0x4f, 0xa6, 0x11, 0xb7, 0x51, 0x64, 0xd1, 0xd9, 0x22, 0xad, 0x20,
+	0xcb, 0x8b, 0x4c, 0x7e, 0xae, 0x07, 0xfc, 0x1a, 0x93, 0xf7, 0xab, 0xb0, 0xe2, 0xf2, 0xa7, 0xce,
+	0xc4, 0x17, 0x83, 0x2b, 0xc7, 0x9f, 0x70, 0x75, 0xfc, 0xb7, 0x15, 0xf1, 0x53, 0x49, 0x93, 0x25,
+	0x7f, 0x30, 0xf1, 0xa9, 0x70, 0xaf, 0xe1, 0x2d, 0x25, 0xfd, 0x96, 0x45, 0x14, 0xcb, 0x20, 0xb9,
+	0xbd, 0xa0, 0x0d, 0x9e, 0x0e, 0xae, 0x54, 0x1b, 0xa8, 0x2a, 0xcd, 0x17, 0x3c, 0xfd, 0x94, 0xc7,
+	0xec, 0x03, 0x68, 0x39, 0xa9, 0xdd, 0x74, 0x2f, 0x6c, 0x8b, 0x26, 0x2f, 0x9a, 0xd5, 0xce, 0x8a,
+	0x5a, 0x17, 0xb0, 0x9e, 0x5b, 0xc7, 0xcd, 0xe5, 0xf2, 0x04, 0x36, 0x9e, 0xc4, 0x93, 0x60, 0xe4,
+	0x08, 0x5e, 0xfe, 0x38, 0xfe, 0xb2, 0xe9, 0xfc, 0x01, 0x6c, 0x16, 0x26, 0x2d, 0x75, 0x85, 0xff,
+	0x1e, 0x6c, 0x1e, 0xc7, 0xdc, 0x11, 0x5c, 0xde, 0x2d, 0x86, 0xf2, 0x6e, 0xf1, 0x22, 0xef, 0x12,
+	0xd6, 0xb7, 0x60, 0xab, 0xa8, 0xbe, 0x14, 0xcc, 0x3f, 0x18, 0xc0, 0x48, 0xd1, 0xad, 0x5f, 0x78,
+	0xd8, 0x2e, 0x40, 0x14, 0x87, 0x11, 0x8f, 0x85, 0xc7, 0x13, 0x75, 0xa8, 0x64, 0x28, 0x72, 0x78,
+	0x5a, 0xca, 0x93, 0x87, 0xb6, 0xed, 0xa6, 0xae, 0xe5, 0x13, 0x59, 0x40, 0xe7, 0x90, 0x97, 0xdd,
+	0xa6, 0x13, 0xec, 0xb7, 0xdc, 0xd8, 0x36, 0x15, 0xd5, 0x97, 0x82, 0x39, 0x05, 0x46, 0x7a, 0x6e,
+	0xff, 0x5a, 0x7a, 0x0c, 0xeb, 0xb9, 0xa9, 0x4b, 0xe1, 0xff, 0x5d, 0xea, 0x66, 0xa7, 0x81, 0xcb,
+	0x3f, 0xbf, 0x55, 0x37, 0xbb, 0x07, 0xe0, 0xc9, 0x49, 0xb3, 0x77, 0x97, 0x26, 0x52, 0x90, 0xdd,
+	0x9d, 0x9d, 0x38, 0xd2, 0xc5, 0x9a, 0xb3, 0x13, 0xe6, 0x42, 0x3b, 0x98, 0xc2, 0x5c, 0x36, 0xd1,
+	0xe1, 0x5c, 0xc5, 0x44, 0x47, 0x3a, 0x89, 0x67, 0xfd, 0xc9, 0x80, 0x8d, 0x3e, 0x17, 0x48, 0xeb,
+	0x0b, 0x47, 0xf0, 0xff, 0x27, 0x03, 0xed, 0x53, 0x7b, 0x95, 0x4e, 0xb4, 0xce, 0xac, 0x5f, 0x94,
+	0x41, 0x4b, 0x02, 0x32, 0x75, 0x16, 0x56, 0x51, 0xca, 0x59, 0x8e, 0xb0, 0x10, 0xd2, 0x11, 0x53,
+	0xea, 0x12, 0x6f, 0x5d, 0xe0, 0x0d, 0x31, 0xa3, 0xa3, 0xd4, 0xe6, 0x59, 0xb0, 0xe4, 0x0e, 0xf5,
+	0x4d, 0x64, 0xbe, 0x5a, 0x94, 0x4c, 0xeb, 0xb3, 0x59, 0x09, 0x95, 0xbc, 0xd8, 0xe4, 0x71, 0x81,
+	0xa5, 0x8c, 0xd6, 0x5c, 0xb6, 0xad, 0x80, 0xfb, 0x3c, 0xd7, 0x56, 0xa0, 0x60, 0x56, 0x4c, 0xeb,
+	0x00, 0x56, 0x72, 0xd8, 0xa4, 0x4b, 0x8c, 0xfc, 0x49, 0x22, 0xb0, 0xcd, 0xaa, 0xda, 0x88, 0x4d,
+	0x45, 0x39, 0x75, 0x2d, 0x1b, 0x3a, 0xf9, 0x09, 0xff, 0xc3, 0x00, 0xf6, 0x0a, 0xd4, 0x78, 0x1c,
+	0x87, 0xfa, 0xd1, 0xb5, 0x45, 0xa0, 0x1f, 0x48, 0x92, 0x4d, 0x1c, 0xeb, 0x0d, 0x68, 0x3c, 0x4a,
+	0xd4, 0xb3, 0xf2, 0x0e, 0x34, 0xc7, 0x89, 0x7a, 0x65, 0x41, 0x65, 0x4d, 0xbb, 0x31, 0x56, 0x4c,
+	0x0b, 0xa0, 0x71, 0x16, 0xaa, 0xdf, 0x36, 0xb4, 0xfa, 0xa3, 0x0b, 0x3e, 0x76, 0x50, 0x95, 0xbc,
+	0xfe, 0x8d, 0x42, 0xb7, 0x70, 0xfd, 0xcb, 0x08, 0x1c, 0x63, 0x5f, 0x42, 0x8a, 0xc8, 0xb0, 0x1f,
+	0xf3, 0x24, 0x71, 0xce, 0xb5, 0xd5, 0xf5, 0xa7, 0xf5, 0x33, 0x03, 0x6a, 0xa4, 0xee, 0xed, 0x2c,
+	0x8c, 0xfc, 0x73, 0xb1, 0x02, 0x33, 0x83, 0x25, 0x85, 0x83, 0x70, 0x90, 0x7b, 0xb7, 0xec, 0xe8,
+	0x97, 0x08, 0x2d, 0x1c, 0xa8, 0x5f, 0xec, 0x7d, 0x68, 0x27, 0x08, 0x6b, 0x40, 0x66, 0xa1, 0x37,
+	0xba, 0xb5, 0x39, 0xc0, 0x76, 0x2b, 0x99, 0x7d, 0xbc, 0xf5, 0x43, 0x03, 0x56, 0x0b, 0x97, 0x59,
+	0xb6, 0x95, 0xbb, 0x55, 0x9e, 0x06, 0x57, 0x8e, 0xef, 0xb9, 0xe6, 0x1d, 0xb6, 0x9e, 0x13, 0x3d,
+	0x89, 0xc3, 0xc8, 0x34, 0xd8, 0x26, 0xac, 0xe5, 0xae, 0x6e, 0xd2, 0xe9, 0xcc, 0x4a, 0x41, 0xc7,
+	0x09, 0xdd, 0x68, 0xcd, 0x25, 0xb6, 0x9d, 0xbb, 0xe9, 0x9d, 0xa9, 0x9b, 0xac, 0x59, 0x7d, 0xeb,
+	0xd7, 0x15, 0x58, 0x2d, 0x98, 0x95, 0x6d, 0x80, 0x99, 0x92, 0xbe, 0x13, 0x5c, 0x06, 0xe1, 0x75,
+	0x60, 0xde, 0x61, 0x5d, 0xd8, 0x48, 0xa9, 0x27, 0x93, 0x48, 0x07, 0xa4, 0x69, 0xb0, 0x7b, 0x70,
+	0x37, 0xe5, 0x9c, 0x85, 0xe2, 0xc1, 0xe7, 0x5e, 0x92, 0xc6, 0xab, 0x59, 0x91, 0x50, 0xb3, 0x03,
+	0xd1, 0x5f, 0xcd, 0x25, 0xd6, 0x83, 0xad, 0xb9, 0x51, 0xc4, 0xab, 0xe6, 0x34, 0x22, 0x8d, 0xbe,
+	0xfa, 0xc2, 0xf1, 0xb9, 0x59, 0xcb, 0x0d, 0xd5, 0xd6, 0xc2, 0x5c, 0x65, 0x2e, 0x33, 0x0b, 0x76,
+	0x0b, 0xbc, 0xb3, 0x50, 0x1c, 0xfa, 0x7e, 0x78, 0x7d, 0x16, 0x0a, 0xb9, 0x70, 0xb3, 0x5e, 0x44,
+	0x44, 0x43, 0x1b, 0xec, 0x65, 0xd8, 0x49, 0xc9, 0xca, 0xfc, 0xc8, 0x22, 0x35, 0x66, 0xf3, 0xfe,
+	0x3f, 0x3a, 0xd2, 0xb3, 0xfb, 0xd8, 0x7a, 0x65, 0x1f, 0xc1, 0x4a, 0xee, 0x59, 0x89, 0xf5, 0x66,
+	0xaf, 0x55, 0xc5, 0xe7, 0xb1, 0xde, 0xce, 0x42, 0x1e, 0xc5, 0x9d, 0x75, 0x87, 0x3d, 0x82, 0x4e,
+	0xfe, 0xd1, 0x99, 0xed, 0x64, 0xda, 0xe7, 0x73, 0xda, 0x5e, 0x5a, 0xcc, 0x4c, 0xd5, 0x7d, 0x03,
+	0x1a, 0xfa, 0x91, 0x87, 0xe9, 0xca, 0x29, 0xff, 0xee, 0xd4, 0xdb, 0x2a, 0x92, 0xd3, 0xc1, 0x27,
+	0xd0, 0xca, 0xbc, 0x66, 0xb0, 0xae, 0xce, 0x4a, 0xc5, 0xb7, 0x96, 0xde, 0xdd, 0x05, 0x9c, 0x54,
+	0x4b, 0x1f, 0xcc, 0x62, 0x7b, 0x9f, 0xdd, 0xcb, 0x0e, 0x98, 0x7b, 0x6f, 0xe8, 0xed, 0x3e, 0x8f,
+	0x9d, 0x2a, 0xfd, 0x26, 0xbd, 0x4f, 0x63, 0x3f, 0x9e, 0x6d, 0xcd, 0x4c, 0x9a, 0x6d, 0xfd, 0xf7,
+	0xb6, 0xe7, 0xe8, 0xd9, 0xf1, 0x69, 0x57, 0x5b, 0x8f, 0x2f, 0x36, 0xe7, 0xf5, 0xf8, 0xb9, 0xf6,
+	0x37, 0x99, 0x26, 0xf3, 0xb7, 0x15, 0x6d, 0x9a, 0xf9, 0x3f, 0xc3, 0x68, 0xd3, 0x2c, 0xf8, 0x8f,
+	0x0b, 0xed, 0x8e, 0x6e, 0x31, 0xeb, 0xdd, 0x29, 0x34, 0xbd, 0x7b, 0x5b, 0x45, 0x72, 0x3a, 0xf8,
+	0x03, 0xa8, 0x2b, 0x64, 0x6c, 0x23, 0x07, 0x54, 0x0f, 0xdd, 0x2c, 0x50, 0xd3, 0x91, 0xf7, 0xa1,
+	0x86, 0xdd, 0x56, 0xc6, 0x52, 0x89, 0xb4, 0x97, 0xdb, 0x5b, 0xcf, 0xd1, 0x0a, 0x50, 0x31, 0x02,
+	0x33, 0x50, 0xb3, 0x37, 0xd7, 0x0c, 0xd4, 0xdc, 0xad, 0xd2, 0xba, 0xc3, 0x1e, 0x62, 0xbf, 0x38,
+	0x6d, 0x15, 0xb2, 0xbb, 0x79, 0xc9, 0x4c, 0xb7, 0xa7, 0xd7, 0x5b, 0xc4, 0x4a, 0x15, 0x1d, 0x02,
+	0xcc, 0xda, 0x72, 0x6c, 0xb6, 0x3f, 0xf9, 0xd6, 0x60, 0xaf, 0x3b, 0xcf, 0x48, 0x55, 0x3c, 0xc6,
+	0x4e, 0x70, 0xb6, 0xb1, 0xc5, 0x5e, 0x2a, 0x88, 0xe7, 0x3a, 0x70, 0xbd, 0x7b, 0xcf, 0xe1, 0xa6,
+	0x1a, 0x3f, 0xc2, 0xe6, 0xf5, 0xac, 0x59, 0xc4, 0x7a, 0x73, 0x23, 0x66, 0xeb, 0xdb, 0x59, 0xc8,
+	0xcb, 0xea, 0xca, 0x15, 0xaa, 0x5a, 0xd7, 0xa2, 0x92, 0x59, 0xeb, 0x5a, 0x58, 0xd9, 0x92, 0x8f,
+	0xa7, 0x9d, 0x1b, 0xed, 0xe3, 0xc5, 0x2e, 0x93, 0xf6, 0xf1, 0xb9, 0x16, 0x0f, 0xf9, 0x78, 0xe6,
+	0xa4, 0xd0, 0x3e, 0x3e, 0xdf, 0xee, 0xd0, 0x3e, 0xbe, 0xa0, 0x81, 0x40, 0x09, 0x2d, 0x5f, 0xd4,
+	0xea, 0x84, 0xb6, 0xb0, 0x92, 0xd6, 0x09, 0x6d, 0x71, 0x1d, 0x4c, 0xa0, 0x32, 0x05, 0xa2, 0x06,
+	0x35, 0x5f, 0xed, 0x6a, 0x50, 0x0b, 0xaa, 0x49, 0x02, 0x95, 0x2f, 0xe1, 0x34, 0xa8, 0x85, 0x75,
+	0xa3, 0x06, 0xb5, 0xb8, 0xea, 0x23, 0x50, 0x99, 0x72, 0x4a, 0x83, 0x9a, 0x2f, 0xee, 0x34, 0xa8,
+	0x05, 0xb5, 0x57, 0x76, 0x69, 0x78, 0xd4, 0xe4, 0x97, 0x96, 0xad, 0xb0, 0xf2, 0x4b, 0xcb, 0xd5,
+	0x31, 0xe4, 0x41, 0xb9, 0xfb, 0xba, 0xf6, 0xa0, 0x45, 0xa5, 0x88, 0xf6, 0xa0, 0x85, 0x17, 0xfc,
+	0x34, 0x6e, 0xd3, 0x0b, 0x77, 0x26, 0x6e, 0x8b, 0x17, 0xf9, 0x4c, 0xdc, 0xce, 0xdd, 0xcf, 0xd3,
+	0x74, 0x4b, 0xb7, 0x5e, 0x56, 0xc8, 0x13, 0xc9, 0x7c, 0xba, 0xcd, 0x5f, 0x8f, 0xad, 0x3b, 0x47,
+	0xe6, 0x5f, 0x9e, 0xed, 0x1a, 0x7f, 0x7d, 0xb6, 0x6b, 0xfc, 0xed, 0xd9, 0xae, 0xf1, 0xf3, 0xbf,
+	0xef, 0xde, 0x19, 0x2e, 0xe3, 0xff, 0x16, 0xdf, 0xfb, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11,
+	0xe2, 0xca, 0x8d, 0xfd, 0x28, 0x00, 0x00,
 }
@@ -13,6 +13,7 @@
 		TaskRangeLeaderTransfer
 		TaskRangeAddPeer
 		TaskRangeDelPeer
+		TaskRangeSplit
 		Task
 */
 package taskpb
@@ -48,6 +49,7 @@ const (
 	TaskType_RangeLeaderTransfer TaskType = 3
 	TaskType_RangeAddPeer        TaskType = 4
 	TaskType_RangeDelPeer        TaskType = 5
+	TaskType_RangeSplit          TaskType = 6
 )
 
 var TaskType_name = map[int32]string{
@@ -57,6 +59,7 @@ var TaskType_name = map[int32]string{
 	3: "RangeLeaderTransfer",
 	4: "RangeAddPeer",
 	5: "RangeDelPeer",
+	6: "RangeSplit",
 }
 var TaskType_value = map[string]int32{
 	"EmptyTask":           0,
@@ -65,6 +68,7 @@ var TaskType_value = map[string]int32{
 	"RangeLeaderTransfer": 3,
 	"RangeAddPeer":        4,
 	"RangeDelPeer":        5,
+	"RangeSplit":          6,
 }
 
 func (x TaskType) String() string {
@@ -145,6 +149,32 @@ func (m *TaskRangeDelPeer) GetPeer() *metapb.Peer {
 	return nil
 }
 
+// 下发给range的leader, 按指定的key分裂; range的epoch和split_key一起由DS校验,
+// 校验通过后DS走AskSplit/ReportSplit的分裂流程
+type TaskRangeSplit struct {
+	SplitKey   []byte             `protobuf:"bytes,1,opt,name=split_key,json=splitKey,proto3" json:"split_key,omitempty"`
+	RangeEpoch *metapb.RangeEpoch `protobuf:"bytes,2,opt,name=range_epoch,json=rangeEpoch" json:"range_epoch,omitempty"`
+}
+
+func (m *TaskRangeSplit) Reset()                    { *m = TaskRangeSplit{} }
+func (m *TaskRangeSplit) String() string            { return proto.CompactTextString(m) }
+func (*TaskRangeSplit) ProtoMessage()               {}
+func (*TaskRangeSplit) Descriptor() ([]byte, []int) { return fileDescriptorTaskpb, []int{5} }
+
+func (m *TaskRangeSplit) GetSplitKey() []byte {
+	if m != nil {
+		return m.SplitKey
+	}
+	return nil
+}
+
+func (m *TaskRangeSplit) GetRangeEpoch() *metapb.RangeEpoch {
+	if m != nil {
+		return m.RangeEpoch
+	}
+	return nil
+}
+
 type Task struct {
 	Type                TaskType                 `protobuf:"varint,1,opt,name=type,proto3,enum=taskpb.TaskType" json:"type,omitempty"`
 	RangeMerge          *TaskRangeMerge          `protobuf:"bytes,2,opt,name=range_merge,json=rangeMerge" json:"range_merge,omitempty"`
@@ -152,12 +182,13 @@ type Task struct {
 	RangeLeaderTransfer *TaskRangeLeaderTransfer `protobuf:"bytes,4,opt,name=range_leader_transfer,json=rangeLeaderTransfer" json:"range_leader_transfer,omitempty"`
 	RangeAddPeer        *TaskRangeAddPeer        `protobuf:"bytes,5,opt,name=range_add_peer,json=rangeAddPeer" json:"range_add_peer,omitempty"`
 	RangeDelPeer        *TaskRangeDelPeer        `protobuf:"bytes,6,opt,name=range_del_peer,json=rangeDelPeer" json:"range_del_peer,omitempty"`
+	RangeSplit          *TaskRangeSplit          `protobuf:"bytes,7,opt,name=range_split,json=rangeSplit" json:"range_split,omitempty"`
 }
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (m *Task) String() string            { return proto.CompactTextString(m) }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorTaskpb, []int{5} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorTaskpb, []int{6} }
 
 func (m *Task) GetType() TaskType {
 	if m != nil {
@@ -201,12 +232,20 @@ func (m *Task) GetRangeDelPeer() *TaskRangeDelPeer {
 	return nil
 }
 
+func (m *Task) GetRangeSplit() *TaskRangeSplit {
+	if m != nil {
+		return m.RangeSplit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskRangeMerge)(nil), "taskpb.TaskRangeMerge")
 	proto.RegisterType((*TaskRangeDelete)(nil), "taskpb.TaskRangeDelete")
 	proto.RegisterType((*TaskRangeLeaderTransfer)(nil), "taskpb.TaskRangeLeaderTransfer")
 	proto.RegisterType((*TaskRangeAddPeer)(nil), "taskpb.TaskRangeAddPeer")
 	proto.RegisterType((*TaskRangeDelPeer)(nil), "taskpb.TaskRangeDelPeer")
+	proto.RegisterType((*TaskRangeSplit)(nil), "taskpb.TaskRangeSplit")
 	proto.RegisterType((*Task)(nil), "taskpb.Task")
 	proto.RegisterEnum("taskpb.TaskType", TaskType_name, TaskType_value)
 }
@@ -335,6 +374,40 @@ func (m *TaskRangeDelPeer) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TaskRangeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRangeSplit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SplitKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTaskpb(dAtA, i, uint64(len(m.SplitKey)))
+		i += copy(dAtA[i:], m.SplitKey)
+	}
+	if m.RangeEpoch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeEpoch.Size()))
+		n4, err := m.RangeEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
 func (m *Task) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -359,51 +432,61 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeMerge.Size()))
-		n4, err := m.RangeMerge.MarshalTo(dAtA[i:])
+		n5, err := m.RangeMerge.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if m.RangeDelete != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeDelete.Size()))
-		n5, err := m.RangeDelete.MarshalTo(dAtA[i:])
+		n6, err := m.RangeDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.RangeLeaderTransfer != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeLeaderTransfer.Size()))
-		n6, err := m.RangeLeaderTransfer.MarshalTo(dAtA[i:])
+		n7, err := m.RangeLeaderTransfer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.RangeAddPeer != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeAddPeer.Size()))
-		n7, err := m.RangeAddPeer.MarshalTo(dAtA[i:])
+		n8, err := m.RangeAddPeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.RangeDelPeer != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeDelPeer.Size()))
-		n8, err := m.RangeDelPeer.MarshalTo(dAtA[i:])
+		n9, err := m.RangeDelPeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
+	}
+	if m.RangeSplit != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTaskpb(dAtA, i, uint64(m.RangeSplit.Size()))
+		n10, err := m.RangeSplit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
 	return i, nil
 }
@@ -462,6 +545,20 @@ func (m *TaskRangeDelPeer) Size() (n int) {
 	return n
 }
 
+func (m *TaskRangeSplit) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovTaskpb(uint64(l))
+	}
+	if m.RangeEpoch != nil {
+		l = m.RangeEpoch.Size()
+		n += 1 + l + sovTaskpb(uint64(l))
+	}
+	return n
+}
+
 func (m *Task) Size() (n int) {
 	var l int
 	_ = l
@@ -488,6 +585,10 @@ func (m *Task) Size() (n int) {
 		l = m.RangeDelPeer.Size()
 		n += 1 + l + sovTaskpb(uint64(l))
 	}
+	if m.RangeSplit != nil {
+		l = m.RangeSplit.Size()
+		n += 1 + l + sovTaskpb(uint64(l))
+	}
 	return n
 }
 
@@ -872,6 +973,120 @@ func (m *TaskRangeDelPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskRangeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRangeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRangeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTaskpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeEpoch == nil {
+				m.RangeEpoch = &metapb.RangeEpoch{}
+			}
+			if err := m.RangeEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1085,6 +1300,39 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeSplit == nil {
+				m.RangeSplit = &TaskRangeSplit{}
+			}
+			if err := m.RangeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskpb(dAtA[iNdEx:])
@@ -1214,31 +1462,35 @@ var (
 func init() { proto.RegisterFile("taskpb.proto", fileDescriptorTaskpb) }
 
 var fileDescriptorTaskpb = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x1a, 0x41,
-	0x14, 0xc7, 0x5d, 0x5d, 0xad, 0x3e, 0xb7, 0xeb, 0x30, 0xb6, 0x75, 0xeb, 0x61, 0x2b, 0x4b, 0x0f,
-	0xa5, 0x2d, 0x16, 0x6c, 0xa1, 0xd0, 0x43, 0xa1, 0xc5, 0x04, 0x02, 0x09, 0x84, 0x89, 0xf7, 0x65,
-	0x65, 0x5e, 0x96, 0xe0, 0xea, 0x0e, 0xe3, 0x42, 0xf4, 0x9b, 0xe4, 0x23, 0xe5, 0x98, 0x8f, 0x10,
-	0xcc, 0x77, 0xc8, 0x39, 0xec, 0xcc, 0xb8, 0x2a, 0x2a, 0xe4, 0x36, 0xf3, 0xde, 0xfc, 0xfe, 0x3b,
-	0xf3, 0x7b, 0x0b, 0x4e, 0x16, 0xcd, 0x27, 0x62, 0xdc, 0x17, 0x32, 0xcd, 0x52, 0x5a, 0xd3, 0xbb,
-	0xae, 0x33, 0xc5, 0x2c, 0x5a, 0x57, 0xbb, 0xef, 0xe2, 0x34, 0x4e, 0xd5, 0xf2, 0x47, 0xbe, 0xd2,
-	0xd5, 0x80, 0x80, 0x3b, 0x8a, 0xe6, 0x13, 0x16, 0xcd, 0x62, 0xbc, 0x40, 0x19, 0x63, 0xf0, 0x1d,
-	0x5a, 0x45, 0x65, 0x88, 0x09, 0x66, 0x48, 0x3f, 0x42, 0x5d, 0xe6, 0xdb, 0xf0, 0x86, 0x7b, 0x56,
-	0xcf, 0xfa, 0x62, 0xb3, 0x37, 0x6a, 0x7f, 0xc6, 0x83, 0x53, 0xe8, 0x14, 0xa7, 0xcf, 0x31, 0xe2,
-	0x28, 0x47, 0x32, 0x9a, 0xcd, 0xaf, 0x51, 0xd2, 0x6f, 0x00, 0xb8, 0x10, 0x61, 0xa2, 0xaa, 0x8a,
-	0x6b, 0x0e, 0x9c, 0xbe, 0xb9, 0xd3, 0x25, 0xa2, 0x64, 0x0d, 0x5c, 0x08, 0x0d, 0x05, 0xbf, 0x80,
-	0x14, 0x39, 0xff, 0x38, 0xcf, 0xdb, 0xb4, 0x07, 0xb6, 0xc0, 0x23, 0xa8, 0xea, 0xec, 0x50, 0x43,
-	0x4c, 0x5e, 0x49, 0x3d, 0x97, 0xc1, 0xce, 0x31, 0xfa, 0x19, 0xec, 0x6c, 0x29, 0x50, 0x1d, 0x75,
-	0x07, 0xa4, 0x6f, 0x2c, 0xe6, 0xbd, 0xd1, 0x52, 0x20, 0x53, 0x5d, 0xfa, 0x1b, 0x9a, 0xfa, 0xf5,
-	0xd3, 0xdc, 0x8f, 0x57, 0x56, 0xb9, 0x1f, 0xb6, 0x0f, 0x6f, 0xec, 0x31, 0x90, 0xc5, 0x9a, 0xfe,
-	0x01, 0x47, 0x83, 0x5c, 0x69, 0xf4, 0x2a, 0x8a, 0xec, 0xec, 0x91, 0xda, 0x32, 0xd3, 0x5f, 0x31,
-	0xca, 0xaf, 0xe0, 0xbd, 0x66, 0xb5, 0xbe, 0x30, 0x33, 0x56, 0x3d, 0x5b, 0x85, 0x7c, 0xda, 0x0b,
-	0xd9, 0x95, 0xcf, 0xda, 0xf2, 0xc0, 0x44, 0xfe, 0x82, 0xab, 0x43, 0x23, 0xce, 0x43, 0x25, 0xa9,
-	0xaa, 0xd2, 0xbc, 0xbd, 0x34, 0x33, 0x02, 0xa6, 0x1f, 0xb0, 0x1e, 0x48, 0xc1, 0x73, 0x4c, 0x34,
-	0x5f, 0x3b, 0xc2, 0x9b, 0x61, 0x18, 0xde, 0xec, 0xbe, 0xde, 0x42, 0x7d, 0xed, 0x96, 0xbe, 0x85,
-	0xc6, 0xc9, 0x54, 0x64, 0xcb, 0xbc, 0x40, 0x4a, 0xd4, 0x05, 0xd8, 0x58, 0x24, 0x16, 0x6d, 0x41,
-	0x73, 0xcb, 0x0d, 0x29, 0xd3, 0x0e, 0xb4, 0x0f, 0xbc, 0x93, 0x54, 0x28, 0x01, 0x67, 0xfb, 0xca,
-	0xc4, 0x2e, 0x2a, 0xe6, 0xb3, 0xa4, 0xfa, 0x9f, 0xdc, 0xaf, 0x7c, 0xeb, 0x61, 0xe5, 0x5b, 0x8f,
-	0x2b, 0xdf, 0xba, 0x7b, 0xf2, 0x4b, 0xe3, 0x9a, 0xfa, 0xfd, 0x7f, 0xbe, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xcc, 0xc9, 0x16, 0xe0, 0x3a, 0x03, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6a, 0x13, 0x41,
+	0x14, 0x86, 0xbb, 0xcd, 0x36, 0x4d, 0x4e, 0xd6, 0x74, 0x98, 0xaa, 0x59, 0x2b, 0xc4, 0xb2, 0x78,
+	0x21, 0x2a, 0x11, 0x5a, 0x41, 0xf0, 0x42, 0x50, 0x5a, 0x41, 0x54, 0x90, 0x69, 0xee, 0x97, 0x8d,
+	0x73, 0x8c, 0x25, 0x9b, 0xee, 0x30, 0x99, 0x8b, 0xee, 0x13, 0xf8, 0x0a, 0xbe, 0x85, 0xaf, 0xe1,
+	0xa5, 0x8f, 0x20, 0xf1, 0x45, 0x64, 0xce, 0xcc, 0x4e, 0x53, 0xd2, 0x40, 0xef, 0xce, 0xfc, 0x67,
+	0xff, 0xff, 0xcc, 0x9c, 0x8f, 0x85, 0xc4, 0x14, 0x8b, 0x99, 0x9a, 0x8c, 0x94, 0xae, 0x4c, 0xc5,
+	0xdb, 0xee, 0x74, 0x90, 0xcc, 0xd1, 0x14, 0x8d, 0x7a, 0x70, 0x77, 0x5a, 0x4d, 0x2b, 0x2a, 0x5f,
+	0xd8, 0xca, 0xa9, 0x19, 0x83, 0xfe, 0xb8, 0x58, 0xcc, 0x44, 0x71, 0x31, 0xc5, 0xcf, 0xa8, 0xa7,
+	0x98, 0x3d, 0x87, 0xbd, 0xa0, 0x9c, 0x60, 0x89, 0x06, 0xf9, 0x03, 0xe8, 0x68, 0x7b, 0xcc, 0xcf,
+	0x65, 0x1a, 0x1d, 0x46, 0x4f, 0x62, 0xb1, 0x4b, 0xe7, 0x0f, 0x32, 0x7b, 0x0f, 0x83, 0xf0, 0xf5,
+	0x27, 0x2c, 0x24, 0xea, 0xb1, 0x2e, 0x2e, 0x16, 0xdf, 0x50, 0xf3, 0x67, 0x00, 0x78, 0xa9, 0xf2,
+	0x92, 0x54, 0xf2, 0xf5, 0x8e, 0x92, 0x91, 0xbf, 0xd3, 0x17, 0x44, 0x2d, 0xba, 0x78, 0xa9, 0x9c,
+	0x29, 0x7b, 0x09, 0x2c, 0xe4, 0xbc, 0x95, 0xd2, 0xb6, 0xf9, 0x21, 0xc4, 0x0a, 0x37, 0x58, 0xa9,
+	0x73, 0xcd, 0x75, 0x82, 0xe5, 0x2d, 0x5d, 0x93, 0x95, 0x37, 0x9f, 0xa9, 0xf2, 0xdc, 0xf0, 0x87,
+	0xd0, 0x5d, 0xd8, 0x22, 0x9f, 0x61, 0x4d, 0xc6, 0x44, 0x74, 0x48, 0xf8, 0x88, 0x35, 0x3f, 0x86,
+	0x9e, 0x7b, 0x3d, 0xaa, 0xea, 0xeb, 0xf7, 0x74, 0x9b, 0x72, 0x79, 0x93, 0x4b, 0x29, 0xa7, 0xb6,
+	0x23, 0x40, 0x87, 0x3a, 0xfb, 0xd5, 0x82, 0xd8, 0x0e, 0xe1, 0x8f, 0x21, 0x36, 0xb5, 0x42, 0x4a,
+	0xed, 0x1f, 0xb1, 0x91, 0x27, 0x65, 0x7b, 0xe3, 0x5a, 0xa1, 0xa0, 0x2e, 0x7f, 0xd5, 0xcc, 0x98,
+	0x5b, 0x06, 0x7e, 0xc6, 0xfd, 0xd5, 0x8f, 0xaf, 0x08, 0xf9, 0x39, 0x54, 0xf3, 0xd7, 0x90, 0x38,
+	0xa3, 0x24, 0x54, 0x69, 0x8b, 0x9c, 0x83, 0x35, 0xa7, 0x23, 0x29, 0xdc, 0x14, 0x8f, 0xf5, 0x0c,
+	0xee, 0x39, 0xaf, 0x43, 0x94, 0x1b, 0x4f, 0x2e, 0x8d, 0x29, 0xe4, 0xd1, 0x5a, 0xc8, 0x75, 0xc0,
+	0x62, 0x5f, 0xdf, 0x40, 0xfd, 0x0d, 0xf4, 0x5d, 0x68, 0x21, 0x65, 0x4e, 0x20, 0x76, 0x28, 0x2d,
+	0x5d, 0x4b, 0xf3, 0x98, 0x85, 0x7b, 0x40, 0x03, 0x3d, 0xf8, 0x25, 0x96, 0xce, 0xdf, 0xde, 0xe0,
+	0xf7, 0xc0, 0xbd, 0xbf, 0xc1, 0x1f, 0x36, 0x49, 0xfc, 0xd2, 0xdd, 0x0d, 0x9b, 0x24, 0xee, 0x7e,
+	0x93, 0x54, 0x3f, 0xfd, 0x11, 0x41, 0xa7, 0xa1, 0xc2, 0xef, 0x40, 0xf7, 0x74, 0xae, 0x4c, 0x6d,
+	0x05, 0xb6, 0xc5, 0xfb, 0x00, 0x57, 0xfb, 0x67, 0x11, 0xdf, 0x83, 0xde, 0xca, 0x56, 0xd9, 0x36,
+	0x1f, 0xc0, 0xfe, 0x0d, 0x1b, 0x62, 0x2d, 0xce, 0x20, 0x59, 0x7d, 0x2c, 0x8b, 0x83, 0xe2, 0x2f,
+	0xcc, 0x76, 0x42, 0x3a, 0xdd, 0x83, 0xb5, 0xdf, 0xb1, 0xdf, 0xcb, 0x61, 0xf4, 0x67, 0x39, 0x8c,
+	0xfe, 0x2e, 0x87, 0xd1, 0xcf, 0x7f, 0xc3, 0xad, 0x49, 0x9b, 0x7e, 0xd6, 0xe3, 0xff, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x84, 0x0e, 0x8a, 0x0d, 0xe8, 0x03, 0x00, 0x00,
 }
@@ -36,9 +36,23 @@ type Client interface {
 	// columns输入参数只需要填写name和data type即可
 	// 返回master server处理后的columns列表(本次新增部分)
 	AddColumns(dbId, tableId uint64, columns []*metapb.Column) ([]*metapb.Column, error)
+	// confVer为发起修改时看到的表结构版本, 不一致时master拒绝修改; 返回修改后的表结构
+	AlterColumns(dbId, tableId, confVer uint64, alterations []*mspb.ColumnAlteration) (*metapb.Table, error)
 	TruncateTable(dbId, tableId uint64) error
 	CreateDatabase(dbName string) error
-	CreateTable(dbName, tableName, properties string) error
+	// splitKeys为预分裂的第一主键列的值
+	CreateTable(dbName, tableName, properties string, splitKeys [][]byte) error
+	// 删除库中所有的表
+	DeleteDatabase(dbName string) error
+	DeleteTable(dbName, tableName string) error
+	// 创建的索引处于只写状态
+	CreateIndex(dbName, tableName, indexName string, columns []string) (*metapb.Index, error)
+	SetIndexState(dbName, tableName, indexName string, state metapb.IndexState) error
+	GetDatabases() ([]*metapb.DataBase, error)
+	// 返回库中所有正常工作的表
+	GetTables(dbName string) ([]*metapb.Table, error)
+	// 上报请求key的抽样, master据此选择按负载分裂的分裂点
+	ReportKeySamples(samples []*mspb.RangeKeySamples) error
 
 	NodeHeartbeat(*mspb.NodeHeartbeatRequest) (*mspb.NodeHeartbeatResponse, error)
 	RangeHeartbeat(*mspb.RangeHeartbeatRequest) (*mspb.RangeHeartbeatResponse, error)
@@ -57,6 +71,24 @@ var (
 	errInvalidResponseHeader = errors.New("response header not set")
 )
 
+// SchemaError 元数据操作被master拒绝的原因
+type SchemaError struct {
+	Code    mspb.SchemaErrorCode
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	return e.Message
+}
+
+// IsSchemaError 判断是否为指定错误码的元数据错误
+func IsSchemaError(err error, code mspb.SchemaErrorCode) bool {
+	if e, ok := err.(*SchemaError); ok {
+		return e.Code == code
+	}
+	return false
+}
+
 type RPCClient struct {
 	msAddrs   []string
 	lock      sync.RWMutex
@@ -253,6 +285,48 @@ func (c *RPCClient) AddColumns(dbId, tableId uint64, columns []*metapb.Column) (
 	return nil, errInvalidResponse
 }
 
+func (c *RPCClient) AlterColumns(dbId, tableId, confVer uint64, alterations []*mspb.ColumnAlteration) (*metapb.Table, error) {
+	req := &mspb.AlterColumnRequest{
+		Header: &mspb.RequestHeader{},
+		DbId: dbId,
+		TableId: tableId,
+		ConfVer: confVer,
+		Alterations: alterations,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errInvalidResponse
+	}
+	if _resp, ok := resp.(*mspb.AlterColumnResponse); ok {
+		if _resp.GetTable() == nil {
+			return nil, errInvalidResponse
+		}
+		return _resp.GetTable(), nil
+	}
+	return nil, errInvalidResponse
+}
+
+func (c *RPCClient) ReportKeySamples(samples []*mspb.RangeKeySamples) error {
+	req := &mspb.ReportKeySamplesRequest{
+		Header:  &mspb.RequestHeader{},
+		Samples: samples,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errInvalidResponse
+	}
+	if _, ok := resp.(*mspb.ReportKeySamplesResponse); ok {
+		return nil
+	}
+	return errInvalidResponse
+}
+
 func (c *RPCClient) NodeHeartbeat(req *mspb.NodeHeartbeatRequest) (*mspb.NodeHeartbeatResponse, error) {
 	resp, err := c.callRPC(req, RequestMSTimeout)
 	if err != nil {
@@ -318,12 +392,13 @@ func (c *RPCClient) CreateDatabase(dbName string) error {
 	return errInvalidResponse
 }
 
-func (c *RPCClient) CreateTable(dbName, tableName, properties string) error {
+func (c *RPCClient) CreateTable(dbName, tableName, properties string, splitKeys [][]byte) error {
 	req := &mspb.CreateTableRequest{
 		Header: &mspb.RequestHeader{},
 		DbName: dbName,
 		TableName: tableName,
 		Properties: properties,
+		SplitKeys: splitKeys,
 	}
 	resp, err := c.callRPC(req, RequestMSTimeout)
 	if err != nil {
@@ -338,6 +413,123 @@ func (c *RPCClient) CreateTable(dbName, tableName, properties string) error {
 	return errInvalidResponse
 }
 
+func (c *RPCClient) DeleteDatabase(dbName string) error {
+	req := &mspb.DeleteDatabaseRequest{
+		Header: &mspb.RequestHeader{},
+		DbName: dbName,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errInvalidResponse
+	}
+	if _, ok := resp.(*mspb.DeleteDatabaseResponse); ok {
+		return nil
+	}
+	return errInvalidResponse
+}
+
+func (c *RPCClient) DeleteTable(dbName, tableName string) error {
+	req := &mspb.DeleteTableRequest{
+		Header: &mspb.RequestHeader{},
+		DbName: dbName,
+		TableName: tableName,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errInvalidResponse
+	}
+	if _, ok := resp.(*mspb.DeleteTableResponse); ok {
+		return nil
+	}
+	return errInvalidResponse
+}
+
+func (c *RPCClient) CreateIndex(dbName, tableName, indexName string, columns []string) (*metapb.Index, error) {
+	req := &mspb.CreateIndexRequest{
+		Header: &mspb.RequestHeader{},
+		DbName: dbName,
+		TableName: tableName,
+		IndexName: indexName,
+		Columns: columns,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errInvalidResponse
+	}
+	if _resp, ok := resp.(*mspb.CreateIndexResponse); ok {
+		if _resp.GetIndex() == nil {
+			return nil, errInvalidResponse
+		}
+		return _resp.GetIndex(), nil
+	}
+	return nil, errInvalidResponse
+}
+
+func (c *RPCClient) SetIndexState(dbName, tableName, indexName string, state metapb.IndexState) error {
+	req := &mspb.SetIndexStateRequest{
+		Header: &mspb.RequestHeader{},
+		DbName: dbName,
+		TableName: tableName,
+		IndexName: indexName,
+		State: state,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errInvalidResponse
+	}
+	if _, ok := resp.(*mspb.SetIndexStateResponse); ok {
+		return nil
+	}
+	return errInvalidResponse
+}
+
+func (c *RPCClient) GetDatabases() ([]*metapb.DataBase, error) {
+	req := &mspb.GetDatabasesRequest{
+		Header: &mspb.RequestHeader{},
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errInvalidResponse
+	}
+	if _resp, ok := resp.(*mspb.GetDatabasesResponse); ok {
+		return _resp.GetDbs(), nil
+	}
+	return nil, errInvalidResponse
+}
+
+func (c *RPCClient) GetTables(dbName string) ([]*metapb.Table, error) {
+	req := &mspb.GetTablesRequest{
+		Header: &mspb.RequestHeader{},
+		DbName: dbName,
+	}
+	resp, err := c.callRPC(req, RequestMSTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errInvalidResponse
+	}
+	if _resp, ok := resp.(*mspb.GetTablesResponse); ok {
+		return _resp.GetTables(), nil
+	}
+	return nil, errInvalidResponse
+}
+
 func (c *RPCClient) NodeLogin(req *mspb.NodeLoginRequest) (*mspb.NodeLoginResponse, error) {
 	resp, err := c.callRPC(req, RequestMSTimeout)
 	if err != nil {
@@ -614,6 +806,36 @@ func (c *RPCClient) callRPC(req interface{}, timeout time.Duration) (resp interf
 			if pbErr == nil {
 				return out, nil
 			}
+		case *mspb.AlterColumnRequest:
+			out, _err := conn.Cli.AlterColumn(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.ReportKeySamplesRequest:
+			out, _err := conn.Cli.ReportKeySamples(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
 		case *mspb.CreateTableRequest:
 			out, _err := conn.Cli.CreateTable(ctx, in)
 			cancel()
@@ -629,6 +851,96 @@ func (c *RPCClient) callRPC(req interface{}, timeout time.Duration) (resp interf
 			if pbErr == nil {
 				return out, nil
 			}
+		case *mspb.DeleteDatabaseRequest:
+			out, _err := conn.Cli.DeleteDatabase(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.DeleteTableRequest:
+			out, _err := conn.Cli.DeleteTable(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.CreateIndexRequest:
+			out, _err := conn.Cli.CreateIndex(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.SetIndexStateRequest:
+			out, _err := conn.Cli.SetIndexState(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.GetDatabasesRequest:
+			out, _err := conn.Cli.GetDatabases(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
+		case *mspb.GetTablesRequest:
+			out, _err := conn.Cli.GetTables(ctx, in)
+			cancel()
+			if _err != nil {
+				return nil, errors.New(grpc.ErrorDesc(_err))
+			}
+			header = out.GetHeader()
+			if header == nil {
+				err = errInvalidResponseHeader
+				return
+			}
+			pbErr = header.GetError()
+			if pbErr == nil {
+				return out, nil
+			}
 		case *mspb.CreateDatabaseRequest:
 			out, _err := conn.Cli.CreateDatabase(ctx, in)
 			cancel()
@@ -676,6 +988,8 @@ func (c *RPCClient) callRPC(req interface{}, timeout time.Duration) (resp interf
 			}
 			c.leader = leader
 			continue
+		} else if pbErr.GetSchemaError() != nil {
+			return nil, &SchemaError{Code: pbErr.GetSchemaError().GetCode(), Message: pbErr.GetSchemaError().GetMessage()}
 		}
 		return nil, errInvalidResponse
 	}
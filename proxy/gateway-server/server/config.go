@@ -9,10 +9,16 @@ import (
 var DefaultMaxRawCount uint64 = 10000
 var DefaultMaxWorkNum  uint64 = 100
 var DefaultMaxTaskQueueLen  uint64 = 10000
+var DefaultScanConcurrency int = 16
+var DefaultSortBufferRows int = 100000
 var DefaultHttpPort int = 8080
 var DefaultLockRpcPort int = 8090
 var DefaultInsertSlowLog int = 50
 var DefaultSelectSlowlog int = 200
+var DefaultTxnTimeoutSec int = 60
+var DefaultTxnMaxMutations int = 10000
+var DefaultKeySampleRate int = 100
+var DefaultKeySampleReportSec int = 10
 
 type Config struct {
 	SqlPort            int
@@ -39,10 +45,19 @@ type Config struct {
 	MaxLimit	uint64
 	MaxWorkNum  uint64
 	MaxTaskQueueLen uint64
+	ScanConcurrency int
+	SortBufferRows  int
+	SortTempDir     string
 	InsertSlowLog int
 	SelectSlowLog int
 	OpenMetric bool
 
+	TxnTimeoutSec   int
+	TxnMaxMutations int
+
+	KeySampleRate      int
+	KeySampleReportSec int
+
 	GrpcPoolSize     int
 	GrpcInitWinSize  int
 	SlowlogSlowerThanUsec int
@@ -155,6 +170,12 @@ func (c *Config)LoadConfig() {
 	}else{
 		c.MaxTaskQueueLen = uint64(maxLen)
 	}
+	if c.ScanConcurrency, found = config.Config.Int("select.scan.concurrency"); !found {
+		c.ScanConcurrency = DefaultScanConcurrency
+	}
+	c.SortBufferRows = config.Config.IntDefault("select.sort.buffer.rows", DefaultSortBufferRows)
+	// 为空时使用系统临时目录
+	c.SortTempDir, _ = config.Config.String("select.sort.tmpdir")
 
 
 	if c.InsertSlowLog, found = config.Config.Int("insert.slowlog"); !found {
@@ -167,6 +188,11 @@ func (c *Config)LoadConfig() {
 	}
 
 	c.OpenMetric = config.Config.BoolDefault("metrics.flag", false)
+
+	c.TxnTimeoutSec = config.Config.IntDefault("txn.timeout", DefaultTxnTimeoutSec)
+	c.TxnMaxMutations = config.Config.IntDefault("txn.max.mutations", DefaultTxnMaxMutations)
+	c.KeySampleRate = config.Config.IntDefault("keysample.rate", DefaultKeySampleRate)
+	c.KeySampleReportSec = config.Config.IntDefault("keysample.report.interval", DefaultKeySampleReportSec)
 	c.GrpcPoolSize = config.Config.IntDefault("grpc.pool.size", 3)
 	c.GrpcInitWinSize = config.Config.IntDefault("grpc.win.size", 64 * 1024)
 
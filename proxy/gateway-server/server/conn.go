@@ -58,6 +58,8 @@ type ClientConn struct {
 	stmtId uint32
 
 	stmts map[uint32]*Stmt //prepare相关,client端到proxy的stmt
+
+	txn *Txn //当前事务, 不在事务中时为nil
 }
 
 var DEFAULT_CAPABILITY uint32 = mysql.CLIENT_LONG_PASSWORD | mysql.CLIENT_LONG_FLAG |
@@ -99,6 +101,8 @@ func (c *ClientConn) Close() error {
 	}
 
 	c.c.Close()
+	// 连接断开时回滚未提交的事务
+	c.rollback()
 
 	c.closed = true
 
@@ -97,13 +97,17 @@ func (c *ClientConn) handleQuery(sql string) (err error) {
 		slowLogThreshold = c.server.cfg.InsertSlowLog
 		err = c.handleInsert(v, nil)
 	case *sqlparser.Update:
-		err = c.handleExec(stmt, nil, "Update")
+		method = "update"
+		slowLogThreshold = c.server.cfg.InsertSlowLog
+		err = c.handleUpdate(v, nil)
 	case *sqlparser.Delete:
 		method = "delete"
 		slowLogThreshold = c.server.cfg.SelectSlowLog
 		err = c.handleDelete(v, nil)
 	case *sqlparser.Replace:
-		err = c.handleExec(stmt, nil, "Replace")
+		method = "replace"
+		slowLogThreshold = c.server.cfg.InsertSlowLog
+		err = c.handleReplace(v, nil)
 	case *sqlparser.Set:
 		err = c.handleSet(v, sql)
 	case *sqlparser.Begin:
@@ -122,6 +126,12 @@ func (c *ClientConn) handleQuery(sql string) (err error) {
 		err = c.handleExec(stmt, nil,"Truncate")
 	case *sqlparser.Describe:
 		err = c.handleDescribe(v)
+	case *sqlparser.CreateIndex:
+		err = c.handleCreateIndex(v)
+	case *sqlparser.Show:
+		err = c.handleShow(v)
+	case *sqlparser.CreateDatabase, *sqlparser.DropDatabase, *sqlparser.CreateTable, *sqlparser.AlterTable, *sqlparser.DDL:
+		err = c.handleDDL(v)
 	default:
 		err = fmt.Errorf("statement %T not support now", v)
 	}
@@ -162,7 +172,7 @@ func (c *ClientConn) handleInsert(stmt *sqlparser.Insert, args []interface{}) er
 	if golog.GetFileLogger().IsEnableDebug() {
 		golog.Debug("table:%v,cols:%v,rows:%v, args:%v", stmt.Table, stmt.Columns, stmt.Rows, args)
 	}
-	ret, err := c.server.proxy.HandleInsert(c.db, stmt, args)
+	ret, err := c.server.proxy.HandleInsert(c.db, stmt, args, c.currentTxn())
 	if err != nil {
 		golog.Error("insert failed, err[%v]", err)
 		return c.writeError(err)
@@ -179,7 +189,7 @@ func (c *ClientConn) handleDelete(stmt *sqlparser.Delete, args []interface{}) er
 	if golog.GetFileLogger().IsEnableDebug() {
 		golog.Debug("table:%v,where:%v, args:%v", stmt.Table, stmt.Where, args)
 	}
-	ret, err := c.server.proxy.HandleDelete(c.db, stmt, args)
+	ret, err := c.server.proxy.HandleDelete(c.db, stmt, args, c.currentTxn())
 	if err != nil {
 		return err
 	}
@@ -187,6 +197,36 @@ func (c *ClientConn) handleDelete(stmt *sqlparser.Delete, args []interface{}) er
 	return c.writeOK(ret)
 }
 
+func (c *ClientConn) handleReplace(stmt *sqlparser.Replace, args []interface{}) error {
+	if len(c.db) == 0 {
+		return errors.ErrNoDatabase
+	}
+	if golog.GetFileLogger().IsEnableDebug() {
+		golog.Debug("table:%v,cols:%v,rows:%v, args:%v", stmt.Table, stmt.Columns, stmt.Rows, args)
+	}
+	ret, err := c.server.proxy.HandleReplace(c.db, stmt, args, c.currentTxn())
+	if err != nil {
+		golog.Error("replace failed, err[%v]", err)
+		return err
+	}
+	return c.writeOK(ret)
+}
+
+func (c *ClientConn) handleUpdate(stmt *sqlparser.Update, args []interface{}) error {
+	if len(c.db) == 0 {
+		return errors.ErrNoDatabase
+	}
+	if golog.GetFileLogger().IsEnableDebug() {
+		golog.Debug("table:%v,exprs:%v,where:%v, args:%v", stmt.Table, stmt.Exprs, stmt.Where, args)
+	}
+	ret, err := c.server.proxy.HandleUpdate(c.db, stmt, args, c.currentTxn())
+	if err != nil {
+		golog.Error("update failed, err[%v]", err)
+		return err
+	}
+	return c.writeOK(ret)
+}
+
 func (c *ClientConn) handleExec(stmt sqlparser.Statement, args []interface{}, statement string) error {
 	return  fmt.Errorf("statement %s not support now", statement)
 }
@@ -205,6 +245,20 @@ func (c *ClientConn) handleDescribe(stmt *sqlparser.Describe) error {
 	return c.writeResultset(res.Status, res.Resultset)
 }
 
+func (c *ClientConn) handleCreateIndex(stmt *sqlparser.CreateIndex) error {
+	if len(c.db) == 0 {
+		return errors.ErrNoDatabase
+	}
+
+	res, err := c.server.proxy.HandleCreateIndex(c.db, stmt)
+	if err != nil {
+		golog.Error("handle create index failed(%v), table: %s", err, string(stmt.Table))
+		return c.writeError(err)
+	}
+
+	return c.writeOK(res)
+}
+
 func (c *ClientConn) handleTruncate(stmt *sqlparser.Truncate) error {
 	if len(c.db) == 0 {
 		return errors.ErrNoDatabase
@@ -222,3 +276,42 @@ func (c *ClientConn) handleTruncate(stmt *sqlparser.Truncate) error {
 
 	return c.writeResultset(res.Status, res.Resultset)
 }
+
+func (c *ClientConn) handleDDL(stmt sqlparser.Statement) error {
+	// 同MySQL, DDL语句隐式提交当前事务
+	if err := c.commit(); err != nil {
+		return err
+	}
+
+	var res *mysql.Result
+	var err error
+	switch v := stmt.(type) {
+	case *sqlparser.CreateDatabase:
+		res, err = c.server.proxy.HandleCreateDatabase(v)
+	case *sqlparser.DropDatabase:
+		res, err = c.server.proxy.HandleDropDatabase(v)
+		if err == nil && c.db == string(v.Name) {
+			c.db = ""
+		}
+	default:
+		if len(c.db) == 0 {
+			return errors.ErrNoDatabase
+		}
+		switch v := stmt.(type) {
+		case *sqlparser.CreateTable:
+			res, err = c.server.proxy.HandleCreateTable(c.db, v)
+		case *sqlparser.AlterTable:
+			res, err = c.server.proxy.HandleAlterTable(c.db, v)
+		case *sqlparser.DDL:
+			if v.Action != sqlparser.AST_DROP {
+				return fmt.Errorf("statement %s %T not support now", v.Action, v)
+			}
+			res, err = c.server.proxy.HandleDropTable(c.db, v)
+		}
+	}
+	if err != nil {
+		golog.Error("handle ddl %s failed(%v)", sqlparser.String(stmt), err)
+		return c.writeError(err)
+	}
+	return c.writeOK(res)
+}
@@ -187,3 +187,72 @@ func (c *ClientConn) writeResultset(status uint16, r *mysql.Resultset) error {
 
 	return nil
 }
+
+// 写回缓存超过该大小时发送给客户端
+const rowStreamFlushSize = 1 << 20
+
+// 逐行写回排序后的查询结果, 列类型按第一行的值确定
+// 开始写行数据后出错时发送错误包结束结果集
+func (c *ClientConn) writeRowStream(columns []string, it rowIterator) error {
+	first, err := it.next()
+	if err != nil {
+		return err
+	}
+	if first == nil {
+		return c.writeResultset(0, newEmptyResultSet(columns))
+	}
+
+	c.affectedRows = int64(-1)
+	total := make([]byte, 0, 4096)
+	data := make([]byte, 4, 512)
+
+	data = append(data, mysql.PutLengthEncodedInt(uint64(len(columns)))...)
+	if total, err = c.writePacketBatch(total, data, false); err != nil {
+		return err
+	}
+	for i, name := range columns {
+		field := &mysql.Field{Name: hack.Slice(name)}
+		if err = formatField(field, first.fields[i].value); err != nil {
+			return err
+		}
+		data = data[0:4]
+		data = append(data, field.Dump()...)
+		if total, err = c.writePacketBatch(total, data, false); err != nil {
+			return err
+		}
+	}
+	if total, err = c.writeEOFBatch(total, 0, false); err != nil {
+		return err
+	}
+
+	var b []byte
+	for r := first; r != nil; r, err = it.next() {
+		data = data[0:4]
+		for i := range columns {
+			if b, err = formatValue(r.fields[i].value); err != nil {
+				break
+			}
+			data = append(data, mysql.PutLengthEncodedString(b)...)
+		}
+		if err != nil {
+			break
+		}
+		if total, err = c.writePacketBatch(total, data, false); err != nil {
+			return err
+		}
+		if len(total) >= rowStreamFlushSize {
+			if _, err = c.writePacketBatch(total, nil, true); err != nil {
+				return err
+			}
+			total = total[:0]
+		}
+	}
+	if err != nil {
+		if _, e := c.writePacketBatch(total, nil, true); e != nil {
+			return e
+		}
+		return c.writeError(err)
+	}
+	_, err = c.writeEOFBatch(total, 0, true)
+	return err
+}
@@ -99,13 +99,33 @@ func (c *ClientConn) writeFieldList(status uint16, fs []*mysql.Field) error {
 
 //处理select语句
 func (c *ClientConn) handleSelect(stmt *sqlparser.Select, args []interface{}) error {
+	if table := informationSchemaTable(c.db, stmt); len(table) > 0 {
+		ret, err := c.server.proxy.HandleInformationSchema(table, stmt, args)
+		if err != nil {
+			golog.Error("select information_schema.%s failed(%v)", table, err)
+			return c.writeError(err)
+		}
+		return c.writeResultset(ret.Status, ret.Resultset)
+	}
 	if len(c.db) == 0 {
 		return errors.ErrNoDatabase
 	}
 	if golog.GetFileLogger().IsEnableDebug() {
 		golog.Debug("into handleSelect %v", stmt)
 	}
-	ret, err := c.server.proxy.HandleSelect(c.db, stmt, args)
+	if len(stmt.OrderBy) > 0 && stmt.Limit == nil {
+		// 没有limit的排序结果可能很大, 边归并边写回
+		columns, it, err := c.server.proxy.HandleSortedSelect(c.db, stmt, args, c.currentTxn())
+		if err != nil {
+			golog.Debug("select failed, err[%v]", err)
+			return err
+		}
+		if it != nil {
+			defer it.close()
+			return c.writeRowStream(columns, it)
+		}
+	}
+	ret, err := c.server.proxy.HandleSelect(c.db, stmt, args, c.currentTxn())
 	if err != nil {
 		golog.Debug("select failed, err[%v]", err)
 		return err
@@ -69,10 +69,11 @@ func (c *ClientConn) handleSetAutoCommit(val sqlparser.ValExpr) error {
 	}
 	switch strings.ToUpper(flag) {
 	case `1`, `ON`:
-		c.status |= mysql.SERVER_STATUS_AUTOCOMMIT
-		if c.status&mysql.SERVER_STATUS_IN_TRANS > 0 {
-			c.status &= ^mysql.SERVER_STATUS_IN_TRANS
+		// 同MySQL, 打开autocommit时提交当前事务
+		if err := c.commit(); err != nil {
+			return err
 		}
+		c.status |= mysql.SERVER_STATUS_AUTOCOMMIT
 //		for _, co := range c.txConns {
 //			if e := co.SetAutoCommit(1); e != nil {
 //				co.Close()
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	golog "util/log"
+)
+
+func (c *ClientConn) handleShow(stmt *sqlparser.Show) error {
+	var res *mysql.Result
+	var err error
+	switch stmt.Section {
+	case sqlparser.AST_SHOW_VARIABLES:
+		vt := newVirtualTable("", "variables", "Variable_name", "Value")
+		for _, v := range c.sessionVariables() {
+			vt.addRow(v[0], v[1])
+		}
+		res, err = vt.showResult(stmt.LikeOrWhere)
+	case sqlparser.AST_SHOW_STATUS:
+		vt := newVirtualTable("", "status", "Variable_name", "Value")
+		for _, v := range c.server.statusVariables() {
+			vt.addRow(v[0], v[1])
+		}
+		res, err = vt.showResult(stmt.LikeOrWhere)
+	default:
+		res, err = c.server.proxy.HandleShow(c.db, stmt)
+	}
+	if err != nil {
+		golog.Error("handle show failed(%v), sql: %s", err, sqlparser.String(stmt))
+		return c.writeError(err)
+	}
+	return c.writeResultset(res.Status, res.Resultset)
+}
+
+// SHOW VARIABLES返回的系统变量, 客户端连接时会查询其中的部分变量
+func (c *ClientConn) sessionVariables() [][2]string {
+	autocommit := "OFF"
+	if c.isAutoCommit() {
+		autocommit = "ON"
+	}
+	zone, _ := time.Now().Zone()
+	return [][2]string{
+		{"autocommit", autocommit},
+		{"character_set_client", c.charset},
+		{"character_set_connection", c.charset},
+		{"character_set_database", mysql.DEFAULT_CHARSET},
+		{"character_set_results", c.charset},
+		{"character_set_server", mysql.DEFAULT_CHARSET},
+		{"collation_connection", mysql.Collations[c.collation]},
+		{"collation_database", mysql.DEFAULT_COLLATION_NAME},
+		{"collation_server", mysql.DEFAULT_COLLATION_NAME},
+		{"init_connect", ""},
+		{"interactive_timeout", "28800"},
+		{"license", "Apache License 2.0"},
+		{"lower_case_table_names", "0"},
+		{"max_allowed_packet", "16777216"},
+		{"net_buffer_length", "16384"},
+		{"net_write_timeout", "60"},
+		{"performance_schema", "OFF"},
+		{"query_cache_size", "0"},
+		{"query_cache_type", "OFF"},
+		{"sql_mode", ""},
+		{"system_time_zone", zone},
+		{"time_zone", "SYSTEM"},
+		{"transaction_isolation", "READ-COMMITTED"},
+		{"tx_isolation", "READ-COMMITTED"},
+		{"version", mysql.ServerVersion},
+		{"version_comment", "sharkstore"},
+		{"wait_timeout", "28800"},
+	}
+}
+
+// SHOW STATUS返回的状态变量
+func (s *Server) statusVariables() [][2]string {
+	return [][2]string{
+		{"Threads_connected", strconv.FormatInt(atomic.LoadInt64(&s.counter.ClientConns), 10)},
+		{"Uptime", strconv.FormatInt(int64(time.Since(s.startTime).Seconds()), 10)},
+	}
+}
@@ -71,49 +71,38 @@ func (c *ClientConn) handleStmtPrepare(sql string) error {
 	}
 
 	s.sql = sql
+	s.params = paramCount(sql)
+	s.columns = 0
 
-	//	defaultRule := c.schema.rule.DefaultRule
-	//
-	//	n := c.proxy.GetNode(defaultRule.Nodes[0])
-	//
-	//	co, err := n.GetMasterConn()
-	//	defer c.closeConn(co, false)
-	//	if err != nil {
-	//		return fmt.Errorf("prepare error %s", err)
-	//	}
-	//
-	//	err = co.UseDB(c.db)
-	//	if err != nil {
-	//		//reset the database to null
-	//		c.db = ""
-	//		return fmt.Errorf("prepare error %s", err)
-	//	}
-	//
-	//	t, err := co.Prepare(sql)
-	//	if err != nil {
-	//		return fmt.Errorf("prepare error %s", err)
-	//	}
-	//	s.params = t.ParamNum()
-	//	s.columns = t.ColumnNum()
-	//
-	//	s.id = c.stmtId
-	//	c.stmtId++
-	//
-	//	if err = c.writePrepare(s); err != nil {
-	//		return err
-	//	}
-	//
-	//	s.ResetParams()
-	//	c.stmts[s.id] = s
-	//
-	//	err = co.ClosePrepare(t.GetId())
-	//	if err != nil {
-	//		return err
-	//	}
+	s.id = c.stmtId
+	c.stmtId++
+
+	if err = c.writePrepare(s); err != nil {
+		return err
+	}
+
+	s.ResetParams()
+	c.stmts[s.id] = s
 
 	return nil
 }
 
+// 统计sql中'?'占位参数的个数
+func paramCount(sql string) int {
+	tkn := sqlparser.NewStringTokenizer(sql)
+	count := 0
+	for {
+		typ, val := tkn.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			break
+		}
+		if typ == sqlparser.VALUE_ARG && strings.HasPrefix(string(val), ":v") {
+			count++
+		}
+	}
+	return count
+}
+
 func (c *ClientConn) writePrepare(s *Stmt) error {
 	var err error
 	data := make([]byte, 4, 128)
@@ -258,77 +247,23 @@ func (c *ClientConn) handleStmtExecute(data []byte) error {
 }
 
 func (c *ClientConn) handlePrepareSelect(stmt *sqlparser.Select, sql string, args []interface{}) error {
-	//	defaultRule := c.schema.rule.DefaultRule
-	//	if len(defaultRule.Nodes) == 0 {
-	//		return errors.ErrNoDefaultNode
-	//	}
-	//	defaultNode := c.proxy.GetNode(defaultRule.Nodes[0])
-	//
-	//	//choose connection in slave DB first
-	//	conn, err := c.getBackendConn(defaultNode, true)
-	//	defer c.closeConn(conn, false)
-	//	if err != nil {
-	//		return err
-	//	}
-	//
-	//	if conn == nil {
-	//		r := c.newEmptyResultset(stmt)
-	//		return c.writeResultset(c.status, r)
-	//	}
-	//
-	//	var rs []*mysql.Result
-	//	rs, err = c.executeInNode(conn, sql, args)
-	//	if err != nil {
-	//		golog.Error("ClientConn", "handlePrepareSelect", err.Error(), c.connectionId)
-	//		return err
-	//	}
-	//
-	//	status := c.status | rs[0].Status
-	//	if rs[0].Resultset != nil {
-	//		err = c.writeResultset(status, rs[0].Resultset)
-	//	} else {
-	//		r := c.newEmptyResultset(stmt)
-	//		err = c.writeResultset(status, r)
-	//	}
-
-	return nil
+	// TODO: 结果集需要使用binary protocol返回
+	return fmt.Errorf("prepared select statement not support now")
 }
 
 func (c *ClientConn) handlePrepareExec(stmt sqlparser.Statement, sql string, args []interface{}) error {
-	//	defaultRule := c.schema.rule.DefaultRule
-	//	if len(defaultRule.Nodes) == 0 {
-	//		return errors.ErrNoDefaultNode
-	//	}
-	//	defaultNode := c.proxy.GetNode(defaultRule.Nodes[0])
-	//
-	//	//execute in Master DB
-	//	conn, err := c.getBackendConn(defaultNode, false)
-	//	defer c.closeConn(conn, false)
-	//	if err != nil {
-	//		return err
-	//	}
-	//
-	//	if conn == nil {
-	//		return c.writeOK(nil)
-	//	}
-	//
-	//	var rs []*mysql.Result
-	//	rs, err = c.executeInNode(conn, sql, args)
-	//	c.closeConn(conn, false)
-	//
-	//	if err != nil {
-	//		golog.Error("ClientConn", "handlePrepareExec", err.Error(), c.connectionId)
-	//		return err
-	//	}
-	//
-	//	status := c.status | rs[0].Status
-	//	if rs[0].Resultset != nil {
-	//		err = c.writeResultset(status, rs[0].Resultset)
-	//	} else {
-	//		err = c.writeOK(rs[0])
-	//	}
-
-	return nil
+	switch v := stmt.(type) {
+	case *sqlparser.Insert:
+		return c.handleInsert(v, args)
+	case *sqlparser.Update:
+		return c.handleUpdate(v, args)
+	case *sqlparser.Delete:
+		return c.handleDelete(v, args)
+	case *sqlparser.Replace:
+		return c.handleReplace(v, args)
+	default:
+		return fmt.Errorf("prepared statement %T not support now", v)
+	}
 }
 
 func (c *ClientConn) bindStmtArgs(s *Stmt, nullBitmap, paramTypes, paramValues []byte) error {
@@ -28,12 +28,12 @@ func (c *ClientConn) isAutoCommit() bool {
 }
 
 func (c *ClientConn) handleBegin() error {
-//	for _, co := range c.txConns {
-//		if err := co.Begin(); err != nil {
-//			return err
-//		}
-//	}
-//	c.status |= mysql.SERVER_STATUS_IN_TRANS
+	// 同MySQL, 开始新事务前隐式提交当前事务
+	if err := c.commit(); err != nil {
+		return err
+	}
+	c.txn = c.server.proxy.BeginTxn()
+	c.status |= mysql.SERVER_STATUS_IN_TRANS
 	return c.writeOK(nil)
 }
 
@@ -53,30 +53,31 @@ func (c *ClientConn) handleRollback() (err error) {
 	}
 }
 
+// 当前语句所在的事务, autocommit关闭时隐式开始事务
+func (c *ClientConn) currentTxn() *Txn {
+	if c.txn == nil && !c.isAutoCommit() {
+		c.txn = c.server.proxy.BeginTxn()
+		c.status |= mysql.SERVER_STATUS_IN_TRANS
+	}
+	return c.txn
+}
+
+// 提交失败时事务同样结束, 客户端需要重新执行整个事务
 func (c *ClientConn) commit() (err error) {
-//	c.status &= ^mysql.SERVER_STATUS_IN_TRANS
-//
-//	for _, co := range c.txConns {
-//		if e := co.Commit(); e != nil {
-//			err = e
-//		}
-//		co.Close()
-//	}
-//
-//	c.txConns = make(map[*backend.Node]*backend.BackendConn)
-	return
+	c.status &= ^mysql.SERVER_STATUS_IN_TRANS
+	txn := c.txn
+	c.txn = nil
+	if txn == nil {
+		return nil
+	}
+	return c.server.proxy.CommitTxn(txn)
 }
 
 func (c *ClientConn) rollback() (err error) {
-//	c.status &= ^mysql.SERVER_STATUS_IN_TRANS
-//
-//	for _, co := range c.txConns {
-//		if e := co.Rollback(); e != nil {
-//			err = e
-//		}
-//		co.Close()
-//	}
-//
-//	c.txConns = make(map[*backend.Node]*backend.BackendConn)
+	c.status &= ^mysql.SERVER_STATUS_IN_TRANS
+	if c.txn != nil {
+		c.server.proxy.RollbackTxn(c.txn)
+		c.txn = nil
+	}
 	return
 }
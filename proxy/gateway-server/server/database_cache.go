@@ -1,6 +1,7 @@
 package server
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -10,6 +11,12 @@ import (
 	"util/ttlcache"
 )
 
+// 表结构的缓存时间
+const tableCacheTTL = 5 * time.Minute
+
+// 缓存中找不到列时刷新表结构的最小间隔, 避免不存在的列反复访问master-server
+const tableRefreshInterval = time.Second
+
 type DataBase struct {
 	*metapb.DataBase
 	// more ......
@@ -87,7 +94,7 @@ func (d *DataBase) FindTable(tableName string) *Table {
 			d.missTables.Put(tableName, tableName)
 			return nil
 		}
-		t = NewTable(_t, d.cli, 5 * time.Minute)
+		t = NewTable(_t, d.cli, tableCacheTTL)
 		d.tables[t.Name()] = t
 		d.missTables.Delete(t.Name())
 	}
@@ -110,17 +117,76 @@ func (d *DataBase) FindTable(tableName string) *Table {
 			delete(d.tables, t.GetName())
 			return nil
 		}
-		table := NewTable(_t, d.cli, 5 * time.Minute)
-		if t.GetId() == _t.GetId() {
-			table.ranges = t.ranges
-		}
-		d.tables[table.Name()] = table
-		d.missTables.Delete(table.Name())
-		return table
+		return d.updateTable(t, _t)
 	}
 	return t
 }
 
+// 用master-server的表结构更新缓存, 调用者需要持有d.lock
+// 表结构版本(epoch conf_ver)比缓存旧时保留缓存的表结构, 避免并发加载时回退到旧的表结构
+func (d *DataBase) updateTable(t *Table, _t *metapb.Table) *Table {
+	if t != nil && t.GetId() == _t.GetId() && _t.GetEpoch().GetConfVer() < t.GetEpoch().GetConfVer() {
+		log.Warn("table %s.%s schema version %d is older than cached %d", d.DbName(), t.Name(),
+			_t.GetEpoch().GetConfVer(), t.GetEpoch().GetConfVer())
+		t.deadline = time.Now().Add(tableCacheTTL)
+		return t
+	}
+	table := NewTable(_t, d.cli, tableCacheTTL)
+	if t != nil && t.GetId() == _t.GetId() {
+		table.ranges = t.ranges
+	}
+	d.tables[table.Name()] = table
+	d.missTables.Delete(table.Name())
+	return table
+}
+
+// UpdateTable 修改表结构后用master-server返回的表结构更新缓存
+func (d *DataBase) UpdateTable(_t *metapb.Table) *Table {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.updateTable(d.tables[_t.GetName()], _t)
+}
+
+// RefreshTable 其他gateway修改表结构后本地缓存可能还没有过期, 缓存中找不到列时从master-server
+// 重新加载表结构, 表结构版本比缓存新时更新缓存并返回新的表, 否则返回nil
+func (d *DataBase) RefreshTable(tableName string) *Table {
+	d.lock.Lock()
+	t, ok := d.tables[tableName]
+	if !ok || time.Since(t.refreshTime) < tableRefreshInterval {
+		d.lock.Unlock()
+		return nil
+	}
+	t.refreshTime = time.Now()
+	d.lock.Unlock()
+
+	_t, err := d.loadTableFromRemote(tableName)
+	if err != nil || _t == nil {
+		log.Warn("refresh table %s.%s failed, err[%v]", d.DbName(), tableName, err)
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	t, ok = d.tables[tableName]
+	if !ok || t.GetId() != _t.GetId() || _t.GetEpoch().GetConfVer() <= t.GetEpoch().GetConfVer() {
+		return nil
+	}
+	log.Info("table %s.%s schema is refreshed, version %d -> %d", d.DbName(), tableName,
+		t.GetEpoch().GetConfVer(), _t.GetEpoch().GetConfVer())
+	table := d.updateTable(t, _t)
+	table.refreshTime = t.refreshTime
+	return table
+}
+
+// 使表缓存过期, 下次访问时从master-server重新加载
+func (d *DataBase) ExpireTable(tableName string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if t, ok := d.tables[tableName]; ok {
+		t.deadline = time.Time{}
+	}
+	d.missTables.Delete(tableName)
+}
+
 func (d *DataBase) AddTable(t *Table) {
 	if t == nil {
 		return
@@ -130,4 +196,28 @@ func (d *DataBase) AddTable(t *Table) {
 	d.tables[t.Name()] = t
 	d.missTables.Delete(t.Name())
 	//go d.routesUpdateLoop(t)
-}
\ No newline at end of file
+}
+
+// 从master-server加载库中所有的表并更新缓存, 按表名排序
+func (d *DataBase) GetAllTables() ([]*Table, error) {
+	tables, err := d.cli.GetTables(d.DbName())
+	if err != nil {
+		log.Error("get tables of %s from master server failed, err[%v]", d.DbName(), err)
+		return nil, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	result := make([]*Table, 0, len(tables))
+	for _, _t := range tables {
+		t, ok := d.tables[_t.GetName()]
+		if !ok || t.GetId() != _t.GetId() || t.deadline.Before(time.Now()) ||
+			_t.GetEpoch().GetConfVer() > t.GetEpoch().GetConfVer() {
+			t = d.updateTable(t, _t)
+		}
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
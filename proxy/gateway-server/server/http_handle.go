@@ -474,7 +474,7 @@ func (query *Query) setCommand(proxy *Proxy, t *Table) (*Reply, error) {
 		return nil, err
 	}
 
-	affected, duplicateKey, err := proxy.insertRows(t, colMap, rows)
+	affected, duplicateKey, err := proxy.insertRows(nil, t, colMap, rows)
 	if err != nil {
 		log.Error("insert error %s- %s:%s", db, tableName, err.Error())
 		return nil, err
@@ -506,7 +506,7 @@ func (query *Query) delCommand(proxy *Proxy, t *Table) (*Reply, error) {
 	}
 
 	// 向dataserver查询
-	affectedRows, err := proxy.doDelete(t, matchs)
+	affectedRows, err := proxy.doDelete(nil, t, matchs)
 	if err != nil {
 		return nil, err
 	}
@@ -772,7 +772,7 @@ func (s *Server) handleCreateTable(w http.ResponseWriter, r *http.Request) {
 		reply = &Response{Code: errCreateTable, Message: err.Error()}
 		return
 	}
-	err = s.proxy.msCli.CreateTable(dbname, tablename, string(properties))
+	err = s.proxy.msCli.CreateTable(dbname, tablename, string(properties), nil)
 	if err != nil {
 		reply = &Response{Code: errCreateTable, Message: err.Error()}
 		return
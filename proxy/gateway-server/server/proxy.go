@@ -2,14 +2,19 @@ package server
 
 import (
 	"sync"
+	"time"
 	dsClient "pkg-go/ds_client"
 	msClient "pkg-go/ms_client"
+	"proxy/store/dskv"
 	"util/hlc"
 
 	"golang.org/x/net/context"
 )
 
 type Proxy struct {
+	// 事务ID的序号, 原子操作, 放在第一个字段保证64位对齐
+	txnSeq uint64
+
 	msCli  msClient.Client
 	dsCli  dsClient.KvClient
 	config *Config
@@ -60,6 +65,11 @@ func NewProxy(msAddrs []string, config *Config) *Proxy {
 	}
 	proxy.wg.Add(1)
 	go proxy.workMonitor()
+
+	dskv.StartKeySampler(ctx, msCli, config.KeySampleRate, time.Duration(config.KeySampleReportSec)*time.Second)
+
+	proxy.wg.Add(1)
+	go proxy.txnWorker()
 	return proxy
 }
 
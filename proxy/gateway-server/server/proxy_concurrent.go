@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"model/pkg/kvrpcpb"
@@ -110,23 +111,25 @@ type InsertTask struct {
 	p     *Proxy
 	table *Table
 	rows  []*kvrpcpb.KeyValue
+	checkDup bool
 	done  chan error
 	rest  *InsertResult
 }
 
-func (it *InsertTask) init(proxy *Proxy, table *Table, rows []*kvrpcpb.KeyValue) *InsertTask {
+func (it *InsertTask) init(proxy *Proxy, table *Table, rows []*kvrpcpb.KeyValue, checkDup bool) *InsertTask {
 	if it == nil {
 		return it
 	}
 	it.p = proxy
 	it.table = table
 	it.rows = rows
+	it.checkDup = checkDup
 	return it
 }
 
 func (it *InsertTask) Do() {
 	it.do = true
-	affected, duplicateKey, err := it.p.insert(it.table, it.rows)
+	affected, duplicateKey, err := it.p.insertKvs(it.table, it.rows, it.checkDup)
 	if err != nil {
 		it.done <- err
 		return
@@ -252,3 +255,106 @@ func (t *aggreTask) Wait() error {
 
 func (t *aggreTask) Reset() {
 }
+
+func newScanTask(p *Proxy, kvproxy *dskv.KvProxy, req *kvrpcpb.SelectRequest, scope *kvrpcpb.Scope, limit *kvrpcpb.Limit) *scanTask {
+	return &scanTask{
+		p:       p,
+		kvproxy: kvproxy,
+		req:     req,
+		scope:   scope,
+		limit:   limit,
+		done:    make(chan error, 1),
+	}
+}
+
+// 查询一个子范围
+type scanTask struct {
+	p       *Proxy
+	kvproxy *dskv.KvProxy
+	req     *kvrpcpb.SelectRequest
+	scope   *kvrpcpb.Scope
+	limit   *kvrpcpb.Limit
+	started int32
+	done    chan error
+	rows    [][]*kvrpcpb.Row
+}
+
+func (t *scanTask) Do() {
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return
+	}
+	t.run()
+}
+
+func (t *scanTask) run() {
+	rows, err := t.p.scanScope(t.kvproxy, t.req, t.scope, t.limit)
+	t.rows = rows
+	t.done <- err
+}
+
+func (t *scanTask) Wait() error {
+	// 还没有被工作协程执行时在当前协程执行, 避免在工作协程中等待同一队列中的任务
+	if atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		t.run()
+	}
+	select {
+	case <-t.p.ctx.Done():
+		return errors.New("proxy already closed")
+	case err := <-t.done:
+		return err
+	}
+}
+
+func (t *scanTask) Reset() {
+}
+
+func newSortScanTask(p *Proxy, kvproxy *dskv.KvProxy, t *Table, req *kvrpcpb.SelectRequest, scope *kvrpcpb.Scope, collector rowCollector) *sortScanTask {
+	return &sortScanTask{
+		p:         p,
+		kvproxy:   kvproxy,
+		table:     t,
+		req:       req,
+		scope:     scope,
+		collector: collector,
+		done:      make(chan error, 1),
+	}
+}
+
+// 分页查询一个子范围的所有行, 交给collector排序
+type sortScanTask struct {
+	p         *Proxy
+	kvproxy   *dskv.KvProxy
+	table     *Table
+	req       *kvrpcpb.SelectRequest
+	scope     *kvrpcpb.Scope
+	collector rowCollector
+	started   int32
+	done      chan error
+}
+
+func (t *sortScanTask) Do() {
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return
+	}
+	t.run()
+}
+
+func (t *sortScanTask) run() {
+	t.done <- t.p.pageScope(t.kvproxy, t.table, t.req, t.scope, t.collector)
+}
+
+func (t *sortScanTask) Wait() error {
+	// 还没有被工作协程执行时在当前协程执行
+	if atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		t.run()
+	}
+	select {
+	case <-t.p.ctx.Done():
+		return errors.New("proxy already closed")
+	case err := <-t.done:
+		return err
+	}
+}
+
+func (t *sortScanTask) Reset() {
+}
@@ -0,0 +1,478 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"master-server/server"
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	msClient "pkg-go/ms_client"
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	"util/log"
+)
+
+// 等待master-server建表完成的最长时间
+const createTableTimeout = time.Minute
+
+// 建表选项, 指定预分裂的主键值, 多个值以逗号分隔, 如 split_keys='100,200'
+const tableOptionSplitKeys = "split_keys"
+
+func (p *Proxy) HandleCreateDatabase(stmt *sqlparser.CreateDatabase) (*mysql.Result, error) {
+	dbName := string(stmt.Name)
+	err := p.msCli.CreateDatabase(dbName)
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrDupDatabase) {
+		if stmt.IfNotExists {
+			return &mysql.Result{}, nil
+		}
+		return nil, mysql.NewDefaultError(mysql.ER_DB_CREATE_EXISTS, dbName)
+	}
+	if err != nil {
+		log.Error("[ddl] create database %s failed(%v)", dbName, err)
+		return nil, err
+	}
+	p.router.ExpireDB(dbName)
+	log.Info("[ddl] database %s created", dbName)
+	return &mysql.Result{AffectedRows: 1}, nil
+}
+
+func (p *Proxy) HandleDropDatabase(stmt *sqlparser.DropDatabase) (*mysql.Result, error) {
+	dbName := string(stmt.Name)
+	err := p.msCli.DeleteDatabase(dbName)
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrNotExistDatabase) {
+		if stmt.IfExists {
+			return &mysql.Result{}, nil
+		}
+		return nil, mysql.NewDefaultError(mysql.ER_DB_DROP_EXISTS, dbName)
+	}
+	if err != nil {
+		log.Error("[ddl] drop database %s failed(%v)", dbName, err)
+		return nil, err
+	}
+	p.router.ExpireDB(dbName)
+	log.Info("[ddl] database %s dropped", dbName)
+	return &mysql.Result{}, nil
+}
+
+func (p *Proxy) HandleCreateTable(db string, stmt *sqlparser.CreateTable) (*mysql.Result, error) {
+	tableName := string(stmt.Name)
+	columns, indexes, err := buildTableColumns(stmt)
+	if err != nil {
+		return nil, err
+	}
+	var splitKeys [][]byte
+	for _, opt := range stmt.Options {
+		if strings.ToLower(string(opt.Name)) != tableOptionSplitKeys {
+			continue
+		}
+		for _, key := range strings.Split(string(opt.Value), ",") {
+			if key = strings.TrimSpace(key); len(key) > 0 {
+				splitKeys = append(splitKeys, []byte(key))
+			}
+		}
+	}
+	properties, err := json.Marshal(&server.TableProperty{Columns: columns, Indexes: indexes})
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.msCli.CreateTable(db, tableName, string(properties), splitKeys)
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrDupTable) {
+		if stmt.IfNotExists {
+			return &mysql.Result{}, nil
+		}
+		return nil, mysql.NewDefaultError(mysql.ER_TABLE_EXISTS_ERROR, tableName)
+	}
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrNotExistDatabase) {
+		return nil, mysql.NewDefaultError(mysql.ER_BAD_DB_ERROR, db)
+	}
+	if err != nil {
+		log.Error("[ddl] create table %s.%s failed(%v)", db, tableName, err)
+		return nil, err
+	}
+
+	// master-server异步创建分片, 表可用后才返回
+	deadline := time.Now().Add(createTableTimeout)
+	for {
+		t, err := p.msCli.GetTable(db, tableName)
+		if err == nil && t != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			log.Error("[ddl] wait table %s.%s running timeout", db, tableName)
+			return nil, fmt.Errorf("table %s.%s is created but not ready yet", db, tableName)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	p.router.ExpireTable(db, tableName)
+	log.Info("[ddl] table %s.%s created", db, tableName)
+	return &mysql.Result{}, nil
+}
+
+func (p *Proxy) HandleDropTable(db string, stmt *sqlparser.DDL) (*mysql.Result, error) {
+	tableName := string(stmt.Table)
+	err := p.msCli.DeleteTable(db, tableName)
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrNotExistTable) ||
+		msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrNotExistDatabase) {
+		if stmt.IfExists {
+			return &mysql.Result{}, nil
+		}
+		return nil, mysql.NewDefaultError(mysql.ER_BAD_TABLE_ERROR, db+"."+tableName)
+	}
+	if err != nil {
+		log.Error("[ddl] drop table %s.%s failed(%v)", db, tableName, err)
+		return nil, err
+	}
+	p.router.ExpireTable(db, tableName)
+	log.Info("[ddl] table %s.%s dropped", db, tableName)
+	return &mysql.Result{}, nil
+}
+
+func (p *Proxy) HandleAlterTable(db string, stmt *sqlparser.AlterTable) (*mysql.Result, error) {
+	tableName := string(stmt.Table)
+	t := p.router.FindTable(db, tableName)
+	if t == nil {
+		return nil, mysql.NewDefaultError(mysql.ER_NO_SUCH_TABLE, db, tableName)
+	}
+	if len(stmt.AlterColumns) > 0 {
+		// 添加列和其他修改在master-server是两次修改, 不能保证原子性
+		if len(stmt.AddColumns) > 0 {
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "add column with other column changes")
+		}
+		return p.alterColumns(t, stmt.AlterColumns)
+	}
+	var columns []*metapb.Column
+	for _, def := range stmt.AddColumns {
+		col, err := buildColumn(def)
+		if err != nil {
+			return nil, err
+		}
+		if def.PrimaryKey {
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "add primary key column")
+		}
+		if t.FindColumn(col.GetName()) != nil {
+			return nil, mysql.NewDefaultError(mysql.ER_DUP_FIELDNAME, col.GetName())
+		}
+		columns = append(columns, col)
+	}
+	if _, err := p.msCli.AddColumns(t.GetDbId(), t.GetId(), columns); err != nil {
+		log.Error("[ddl] add columns to %s.%s failed(%v)", db, tableName, err)
+		return nil, err
+	}
+	p.router.ExpireTable(db, tableName)
+	log.Info("[ddl] %d columns added to %s.%s", len(columns), db, tableName)
+	return &mysql.Result{}, nil
+}
+
+// 删除, 重命名列以及修改默认值和是否可以为空, 带上本地缓存的表结构版本提交到master-server,
+// 本地缓存过期时master-server拒绝修改, 刷新表结构后重新检查并提交一次
+func (p *Proxy) alterColumns(t *Table, alters []*sqlparser.AlterColumn) (*mysql.Result, error) {
+	db, tableName := t.DbName(), t.Name()
+	for retry := 0; ; retry++ {
+		alterations, err := buildColumnAlterations(t, alters)
+		if err != nil {
+			return nil, err
+		}
+		confVer := t.GetEpoch().GetConfVer()
+		table, err := p.msCli.AlterColumns(t.GetDbId(), t.GetId(), confVer, alterations)
+		if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrTableSchemaStale) && retry == 0 {
+			log.Warn("[ddl] schema of %s.%s is stale, version %d", db, tableName, confVer)
+			p.router.ExpireTable(db, tableName)
+			if t = p.router.FindTable(db, tableName); t == nil {
+				return nil, mysql.NewDefaultError(mysql.ER_NO_SUCH_TABLE, db, tableName)
+			}
+			continue
+		}
+		switch {
+		case msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrTableSchemaStale):
+			return nil, mysql.NewDefaultError(mysql.ER_TABLE_DEF_CHANGED)
+		case msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrColumnInIndex):
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "drop column used by index")
+		case msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrColumnNotAllowNotNull):
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "change nullable column to not null")
+		case err != nil:
+			log.Error("[ddl] alter columns of %s.%s failed(%v)", db, tableName, err)
+			return nil, err
+		}
+		p.router.UpdateTable(db, table)
+		log.Info("[ddl] columns of %s.%s altered, schema version %d -> %d", db, tableName,
+			confVer, table.GetEpoch().GetConfVer())
+		return &mysql.Result{}, nil
+	}
+}
+
+// 把ALTER TABLE的列修改转换为master-server的修改, 按语句中的顺序检查, 后面的修改可以使用前面重命名后的列名
+// CHANGE/MODIFY不支持修改列的类型, 未指定默认值时删除默认值, 未指定NOT NULL时改为可以为空
+func buildColumnAlterations(t *Table, alters []*sqlparser.AlterColumn) ([]*mspb.ColumnAlteration, error) {
+	columns := make(map[string]*metapb.Column)
+	for _, c := range t.GetAllColumns() {
+		columns[c.GetName()] = c
+	}
+	indexed := make(map[uint64]bool)
+	for _, index := range t.GetIndexes() {
+		for _, id := range index.GetColumnIds() {
+			indexed[id] = true
+		}
+	}
+
+	var alterations []*mspb.ColumnAlteration
+	for _, alter := range alters {
+		name := string(alter.Name)
+		col, ok := columns[name]
+		if !ok {
+			if alter.Action == sqlparser.AST_DROP_COLUMN {
+				return nil, mysql.NewDefaultError(mysql.ER_CANT_DROP_FIELD_OR_KEY, name)
+			}
+			return nil, mysql.NewDefaultError(mysql.ER_BAD_FIELD_ERROR, name, t.Name())
+		}
+		if col.GetPrimaryKey() > 0 {
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "alter primary key column")
+		}
+		rename := func(newName string) error {
+			if newName == name {
+				return nil
+			}
+			if _, ok := columns[newName]; ok {
+				return mysql.NewDefaultError(mysql.ER_DUP_FIELDNAME, newName)
+			}
+			delete(columns, name)
+			columns[newName] = col
+			alterations = append(alterations, &mspb.ColumnAlteration{
+				Type:    mspb.AlterColumnType_AlterColumnRename,
+				Name:    name,
+				NewName: newName,
+			})
+			name = newName
+			return nil
+		}
+
+		switch alter.Action {
+		case sqlparser.AST_DROP_COLUMN:
+			if indexed[col.GetId()] {
+				return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "drop column used by index")
+			}
+			delete(columns, name)
+			alterations = append(alterations, &mspb.ColumnAlteration{
+				Type: mspb.AlterColumnType_AlterColumnDrop,
+				Name: name,
+			})
+		case sqlparser.AST_RENAME_COLUMN:
+			if err := rename(string(alter.NewName)); err != nil {
+				return nil, err
+			}
+		case sqlparser.AST_CHANGE_COLUMN, sqlparser.AST_MODIFY_COLUMN:
+			newCol, err := buildColumn(alter.Definition)
+			if err != nil {
+				return nil, err
+			}
+			if alter.Definition.PrimaryKey {
+				return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "alter primary key column")
+			}
+			if newCol.GetDataType() != col.GetDataType() || newCol.GetUnsigned() != col.GetUnsigned() ||
+				newCol.GetScale() != col.GetScale() || newCol.GetPrecision() != col.GetPrecision() {
+				return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "change column type")
+			}
+			if newCol.GetNullable() != col.GetNullable() && !newCol.GetNullable() {
+				return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "change nullable column to not null")
+			}
+			if err := rename(newCol.GetName()); err != nil {
+				return nil, err
+			}
+			alterations = append(alterations, &mspb.ColumnAlteration{
+				Type:         mspb.AlterColumnType_AlterColumnDefault,
+				Name:         name,
+				DefaultValue: newCol.GetDefaultValue(),
+			}, &mspb.ColumnAlteration{
+				Type:     mspb.AlterColumnType_AlterColumnNullable,
+				Name:     name,
+				Nullable: newCol.GetNullable(),
+			})
+		case sqlparser.AST_SET_DEFAULT:
+			alterations = append(alterations, &mspb.ColumnAlteration{
+				Type:         mspb.AlterColumnType_AlterColumnDefault,
+				Name:         name,
+				DefaultValue: columnDefaultValue(name, alter.Default),
+			})
+		case sqlparser.AST_DROP_DEFAULT:
+			alterations = append(alterations, &mspb.ColumnAlteration{
+				Type: mspb.AlterColumnType_AlterColumnDefault,
+				Name: name,
+			})
+		default:
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, alter.Action)
+		}
+	}
+	return alterations, nil
+}
+
+// 把建表语句转换为master-server的列定义, KEY约束转换为随表一起创建的索引
+func buildTableColumns(stmt *sqlparser.CreateTable) ([]*metapb.Column, []*server.IndexProperty, error) {
+	var columns []*metapb.Column
+	var pks []string
+	for _, def := range stmt.Columns {
+		col, err := buildColumn(def)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, c := range columns {
+			if c.GetName() == col.GetName() {
+				return nil, nil, mysql.NewDefaultError(mysql.ER_DUP_FIELDNAME, col.GetName())
+			}
+		}
+		if def.PrimaryKey {
+			pks = append(pks, col.GetName())
+		}
+		columns = append(columns, col)
+	}
+
+	findColumn := func(name string) *metapb.Column {
+		for _, c := range columns {
+			if c.GetName() == name {
+				return c
+			}
+		}
+		return nil
+	}
+	var indexes []*server.IndexProperty
+	for _, cons := range stmt.Constraints {
+		var names []string
+		for _, expr := range cons.Columns {
+			colName, ok := expr.(*sqlparser.NonStarExpr).Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, nil, fmt.Errorf("invalid key column")
+			}
+			name := strings.ToLower(string(colName.Name))
+			if findColumn(name) == nil {
+				return nil, nil, mysql.NewDefaultError(mysql.ER_KEY_COLUMN_DOES_NOT_EXITS, name)
+			}
+			names = append(names, name)
+		}
+		switch cons.Type {
+		case sqlparser.AST_PRIMARY_KEY:
+			if len(pks) > 0 {
+				return nil, nil, mysql.NewDefaultError(mysql.ER_MULTIPLE_PRI_KEY)
+			}
+			pks = names
+		case sqlparser.AST_KEY:
+			name := string(cons.Name)
+			if len(name) == 0 {
+				name = names[0]
+			}
+			for _, index := range indexes {
+				if index.Name == name {
+					return nil, nil, mysql.NewDefaultError(mysql.ER_DUP_KEYNAME, name)
+				}
+			}
+			indexes = append(indexes, &server.IndexProperty{Name: name, Columns: names})
+		default:
+			return nil, nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, cons.Type)
+		}
+	}
+	if len(pks) == 0 {
+		return nil, nil, mysql.NewDefaultError(mysql.ER_REQUIRES_PRIMARY_KEY)
+	}
+
+	// 主键按列的定义顺序编码, 联合主键的顺序需要和列顺序一致
+	var pos int
+	for _, c := range columns {
+		if pos < len(pks) && c.GetName() == pks[pos] {
+			c.PrimaryKey = 1
+			c.Nullable = false
+			pos++
+		}
+	}
+	if pos != len(pks) {
+		return nil, nil, fmt.Errorf("primary key columns must be in the same order as they are defined")
+	}
+	return columns, indexes, nil
+}
+
+func buildColumn(def *sqlparser.ColumnDefinition) (*metapb.Column, error) {
+	name := string(def.Name)
+	col := &metapb.Column{
+		Name:     name,
+		DataType: columnDataType(string(def.Type)),
+		Nullable: !def.NotNull && !def.PrimaryKey,
+	}
+	if col.DataType == metapb.DataType_Invalid {
+		return nil, mysql.NewDefaultError(mysql.ER_WRONG_FIELD_SPEC, name)
+	}
+	if def.Unique {
+		return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "unique key")
+	}
+	// varchar的长度记录在Scale, float的(M,D)分别记录在Precision和Scale
+	switch col.DataType {
+	case metapb.DataType_Varchar:
+		if len(def.Length) > 0 {
+			n, _ := strconv.Atoi(string(def.Length[0]))
+			col.Scale = int32(n)
+		}
+	case metapb.DataType_Float, metapb.DataType_Double:
+		if len(def.Length) == 2 {
+			m, _ := strconv.Atoi(string(def.Length[0]))
+			d, _ := strconv.Atoi(string(def.Length[1]))
+			col.Precision, col.Scale = int32(m), int32(d)
+		}
+	}
+	for _, attr := range def.Attrs {
+		switch string(attr) {
+		case "unsigned":
+			col.Unsigned = true
+		case "signed", "zerofill", "comment":
+		case "auto_increment":
+			return nil, mysql.NewDefaultError(mysql.ER_NOT_SUPPORTED_YET, "auto_increment")
+		default:
+			log.Warn("[ddl] ignore attribute %s of column %s", string(attr), name)
+		}
+	}
+	col.DefaultValue = columnDefaultValue(name, def.Default)
+	if def.PrimaryKey && len(col.DefaultValue) > 0 {
+		return nil, mysql.NewDefaultError(mysql.ER_INVALID_DEFAULT, name)
+	}
+	return col, nil
+}
+
+// 列的默认值, NULL表示没有默认值
+func columnDefaultValue(name string, value sqlparser.ValExpr) []byte {
+	switch v := value.(type) {
+	case nil, *sqlparser.NullVal:
+	case sqlparser.StrVal:
+		return []byte(v)
+	case sqlparser.NumVal:
+		return []byte(v)
+	default:
+		// 如CURRENT_TIMESTAMP, 由应用层写入
+		log.Warn("[ddl] ignore default value %s of column %s", sqlparser.String(v), name)
+	}
+	return nil
+}
+
+func columnDataType(typ string) metapb.DataType {
+	switch strings.ToLower(typ) {
+	case "tinyint", "bool", "boolean":
+		return metapb.DataType_Tinyint
+	case "smallint":
+		return metapb.DataType_Smallint
+	case "mediumint", "int", "integer":
+		return metapb.DataType_Int
+	case "bigint":
+		return metapb.DataType_BigInt
+	case "float":
+		return metapb.DataType_Float
+	case "double", "real":
+		return metapb.DataType_Double
+	case "char", "varchar", "tinytext", "text", "mediumtext", "longtext":
+		return metapb.DataType_Varchar
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return metapb.DataType_Binary
+	case "date":
+		return metapb.DataType_Date
+	case "datetime", "timestamp":
+		return metapb.DataType_TimeStamp
+	}
+	return metapb.DataType_Invalid
+}
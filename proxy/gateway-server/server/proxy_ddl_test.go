@@ -0,0 +1,118 @@
+package server
+
+import (
+	"testing"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"proxy/gateway-server/sqlparser"
+)
+
+func parseTestCreateTable(t *testing.T, sql string) *sqlparser.CreateTable {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmt.(*sqlparser.CreateTable)
+}
+
+func TestBuildTableColumns(t *testing.T) {
+	stmt := parseTestCreateTable(t, "create table user (id bigint unsigned not null, name varchar(64) default 'x', "+
+		"age int, avatar blob, created datetime, primary key (id), key idx_name_age (name, age))")
+	columns, indexes, err := buildTableColumns(stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*metapb.Column{
+		{Name: "id", DataType: metapb.DataType_BigInt, Unsigned: true, PrimaryKey: 1},
+		{Name: "name", DataType: metapb.DataType_Varchar, Scale: 64, Nullable: true, DefaultValue: []byte("x")},
+		{Name: "age", DataType: metapb.DataType_Int, Nullable: true},
+		{Name: "avatar", DataType: metapb.DataType_Binary, Nullable: true},
+		{Name: "created", DataType: metapb.DataType_TimeStamp, Nullable: true},
+	}
+	if len(columns) != len(expected) {
+		t.Fatalf("unexpected columns %v", columns)
+	}
+	for i, col := range columns {
+		if col.String() != expected[i].String() {
+			t.Errorf("column %d: expected %v, actual %v", i, expected[i], col)
+		}
+	}
+	if len(indexes) != 1 || indexes[0].Name != "idx_name_age" || len(indexes[0].Columns) != 2 ||
+		indexes[0].Columns[0] != "name" || indexes[0].Columns[1] != "age" {
+		t.Fatalf("unexpected indexes %v", indexes)
+	}
+
+	invalid := []string{
+		"create table t (id int, name varchar(10))",
+		"create table t (id int primary key, name varchar(10), primary key (name))",
+		"create table t (id int auto_increment primary key)",
+		"create table t (id int primary key, name varchar(10) unique)",
+		"create table t (id int primary key, v json)",
+		"create table t (a int, b int, primary key (b, a))",
+		"create table t (id int primary key, key (name))",
+		"create table t (id int primary key, a int, b int, key k (a), key k (b))",
+	}
+	for _, sql := range invalid {
+		if _, _, err := buildTableColumns(parseTestCreateTable(t, sql)); err == nil {
+			t.Errorf("expect error for %s", sql)
+		}
+	}
+}
+
+func TestBuildColumnAlterations(t *testing.T) {
+	table := NewTable(&metapb.Table{
+		Name: "user",
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Scale: 64, Nullable: true},
+			{Name: "age", Id: 3, DataType: metapb.DataType_Int},
+			{Name: "email", Id: 4, DataType: metapb.DataType_Varchar, Scale: 128, Nullable: true},
+		},
+		Indexes: []*metapb.Index{{Name: "idx_email", ColumnIds: []uint64{4}}},
+	}, nil, tableCacheTTL)
+	parse := func(sql string) []*sqlparser.AlterColumn {
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stmt.(*sqlparser.AlterTable).AlterColumns
+	}
+
+	alterations, err := buildColumnAlterations(table, parse("alter table user rename column name to nick, "+
+		"change age years int null default 18, alter nick set default 'x', drop column years"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*mspb.ColumnAlteration{
+		{Type: mspb.AlterColumnType_AlterColumnRename, Name: "name", NewName: "nick"},
+		{Type: mspb.AlterColumnType_AlterColumnRename, Name: "age", NewName: "years"},
+		{Type: mspb.AlterColumnType_AlterColumnDefault, Name: "years", DefaultValue: []byte("18")},
+		{Type: mspb.AlterColumnType_AlterColumnNullable, Name: "years", Nullable: true},
+		{Type: mspb.AlterColumnType_AlterColumnDefault, Name: "nick", DefaultValue: []byte("x")},
+		{Type: mspb.AlterColumnType_AlterColumnDrop, Name: "years"},
+	}
+	if len(alterations) != len(expected) {
+		t.Fatalf("unexpected alterations %v", alterations)
+	}
+	for i, a := range alterations {
+		if a.String() != expected[i].String() {
+			t.Errorf("alteration %d: expected %v, actual %v", i, expected[i], a)
+		}
+	}
+
+	invalid := []string{
+		"alter table user drop column none",
+		"alter table user drop column id",
+		"alter table user drop column email",
+		"alter table user rename column name to age",
+		"alter table user modify name varchar(32)",
+		"alter table user modify name varchar(64) not null",
+		"alter table user drop column age, alter age drop default",
+	}
+	for _, sql := range invalid {
+		if _, err := buildColumnAlterations(table, parse(sql)); err == nil {
+			t.Errorf("expect error for %s", sql)
+		}
+	}
+}
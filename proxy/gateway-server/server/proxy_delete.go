@@ -13,7 +13,8 @@ import (
 )
 
 // HandleDelete handle delete
-func (p *Proxy) HandleDelete(db string, stmt *sqlparser.Delete, args []interface{}) (*mysql.Result, error) {
+// txn不为nil时删除缓存在事务中, 为nil时分批以隐式事务删除
+func (p *Proxy) HandleDelete(db string, stmt *sqlparser.Delete, args []interface{}, txn *Txn) (res *mysql.Result, err error) {
 	//var parseTime time.Time
 	//start := time.Now()
 	//defer func() {
@@ -27,7 +28,12 @@ func (p *Proxy) HandleDelete(db string, stmt *sqlparser.Delete, args []interface
 	//	}
 	//}()
 
-	parser := &StmtParser{}
+	if txn != nil {
+		txn.beginStatement()
+		defer func() { txn.endStatement(err) }()
+	}
+
+	parser := &StmtParser{args: args}
 
 	// 解析表明
 	tableName := parser.parseTable(stmt)
@@ -37,29 +43,32 @@ func (p *Proxy) HandleDelete(db string, stmt *sqlparser.Delete, args []interface
 		return nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
 	}
 
-	var matchs []Match
+	var where [][]Match
 	if stmt.Where != nil {
-		var err error
-		matchs, err = parser.parseWhere(stmt.Where)
+		where, err = parser.parseWhere(stmt.Where)
 		if err != nil {
 			log.Error("handle delete parse where error(%v)", err)
 			return nil, err
 		}
-		log.Debug("matchs %v", matchs)
+		log.Debug("where %v", where)
 	}
 
 	//parseTime = time.Now()
-	affectedRows, err := p.doDelete(t, matchs)
+	affectedRows, err := p.deleteWhere(txn, t, where)
 	if err != nil {
 		return nil, err
 	}
-	ret := new(mysql.Result)
-	ret.AffectedRows = affectedRows
-	ret.Status = 0
-	return ret, nil
+	res = new(mysql.Result)
+	res.AffectedRows = affectedRows
+	res.Status = 0
+	return res, nil
 }
 
-func (p *Proxy) doDelete(t *Table, matches []Match) (affected uint64, err error) {
+func (p *Proxy) doDelete(txn *Txn, t *Table, matches []Match) (affected uint64, err error) {
+	// 有索引时需要知道被删除的行, 先查询再按主键删除
+	if txn != nil || len(t.GetIndexes()) > 0 {
+		return p.deleteWhere(txn, t, [][]Match{matches})
+	}
 	pbMatches, err := makePBMatches(t, matches)
 	if err != nil {
 		log.Error("[delete]covert where matches failed(%v), Table: %s.%s", err, t.DbName(), t.Name())
@@ -0,0 +1,584 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"pkg-go/ds_client"
+	"proxy/store/dskv"
+	"util/log"
+)
+
+// 能直接下推到dataserver过滤的条件
+func isPushdownMatch(m Match) bool {
+	return m.matchType >= Equal && m.matchType <= LargerOrEqual
+}
+
+// 只有一个AND分支并且所有条件都能下推
+func isSimpleWhere(where [][]Match) bool {
+	if len(where) > 1 {
+		return false
+	}
+	for _, and := range where {
+		for _, m := range and {
+			if !isPushdownMatch(m) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func firstAndMatches(where [][]Match) []Match {
+	if len(where) == 0 {
+		return nil
+	}
+	return where[0]
+}
+
+// 主键列上的IN展开为多个等值条件的OR, 主键完全指定时变成单行查询
+// 展开后分支过多时保留IN条件, 在gateway中过滤
+func expandPKIn(t *Table, where [][]Match) ([][]Match, error) {
+	for i := 0; i < len(where); i++ {
+		and := where[i]
+		for j, m := range and {
+			if m.matchType != In {
+				continue
+			}
+			col := t.FindColumn(m.column)
+			if col == nil {
+				return nil, fmt.Errorf("Unknown column '%s' in 'where clause'", m.column)
+			}
+			if col.GetPrimaryKey() == 0 || len(where)-1+len(m.values) > maxWhereDisjuncts {
+				continue
+			}
+			expanded := make([][]Match, 0, len(where)-1+len(m.values))
+			expanded = append(expanded, where[:i]...)
+			for _, v := range m.values {
+				newAnd := make([]Match, 0, len(and))
+				newAnd = append(newAnd, and[:j]...)
+				newAnd = append(newAnd, Match{column: m.column, sqlValue: v, matchType: Equal})
+				newAnd = append(newAnd, and[j+1:]...)
+				expanded = append(expanded, newAnd)
+			}
+			expanded = append(expanded, where[i+1:]...)
+			where = expanded
+			// 重新处理展开后的当前分支
+			i--
+			break
+		}
+	}
+	return where, nil
+}
+
+// 一个AND分支中可以下推到dataserver的条件, LIKE的固定前缀转换为范围条件
+func pushdownMatches(t *Table, and []Match) ([]Match, error) {
+	var pushed []Match
+	for _, m := range and {
+		if isPushdownMatch(m) {
+			pushed = append(pushed, m)
+			continue
+		}
+		if m.matchType != Like {
+			continue
+		}
+		col := t.FindColumn(m.column)
+		if col == nil {
+			return nil, fmt.Errorf("Unknown column '%s' in 'where clause'", m.column)
+		}
+		if !isBytesColumn(col) {
+			continue
+		}
+		prefix := likePrefix(m.sqlValue)
+		if len(prefix) == 0 {
+			continue
+		}
+		pushed = append(pushed, Match{column: m.column, sqlValue: prefix, matchType: LargerOrEqual})
+		if next := nextComparableBytes(prefix); next != nil {
+			pushed = append(pushed, Match{column: m.column, sqlValue: next, matchType: Less})
+		}
+	}
+	return pushed, nil
+}
+
+func isBytesColumn(col *metapb.Column) bool {
+	switch col.DataType {
+	case metapb.DataType_Varchar, metapb.DataType_Binary, metapb.DataType_Date, metapb.DataType_TimeStamp:
+		return true
+	}
+	return false
+}
+
+// 按where条件查询, where为析取范式
+// 简单条件直接由dataserver过滤; 否则每个AND分支分别查询所有列(有可用索引时按索引回表), 在gateway中过滤、去重后按主键排序
+// 匹配的行超过MaxLimit时返回ErrExceedMaxLimit
+func (p *Proxy) selectWhere(txn *Txn, t *Table, fieldList []*kvrpcpb.SelectField, where [][]Match, limit *Limit) ([][]*Row, error) {
+	where, err := expandPKIn(t, where)
+	if err != nil {
+		return nil, err
+	}
+	if isSimpleWhere(where) && !p.hasIndexPlan(txn, t, where) {
+		return p.txnSelect(txn, t, fieldList, firstAndMatches(where), limit)
+	}
+
+	rows, truncated, err := p.filterRows(txn, t, where)
+	if err != nil {
+		return nil, err
+	}
+	if truncated {
+		log.Warn("[select] Table %s.%s matched rows exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+		return nil, ErrExceedMaxLimit
+	}
+
+	if hasAggreField(fieldList) {
+		return [][]*Row{partialAggreRows(fieldList, rows)}, nil
+	}
+
+	return [][]*Row{limitRows(fieldList, rows, limit)}, nil
+}
+
+func hasAggreField(fieldList []*kvrpcpb.SelectField) bool {
+	for _, f := range fieldList {
+		if f.Typ == kvrpcpb.SelectField_AggreFunction {
+			return true
+		}
+	}
+	return false
+}
+
+// 按limit截取gateway过滤后的行, 并投影到查询列
+func limitRows(fieldList []*kvrpcpb.SelectField, rows []*txnRow, limit *Limit) []*Row {
+	if limit != nil {
+		if limit.offset >= uint64(len(rows)) {
+			rows = nil
+		} else {
+			rows = rows[limit.offset:]
+			if uint64(len(rows)) > limit.rowCount {
+				rows = rows[:limit.rowCount]
+			}
+		}
+	}
+	result := make([]*Row, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, projectRow(fieldList, r.values))
+	}
+	return result
+}
+
+// 匹配的行已经达到MaxLimit
+var errFilterTruncated = errors.New("filtered rows exceeding the maximum limit")
+
+type rowCollectorFunc func(r *Row) error
+
+func (f rowCollectorFunc) add(r *Row) error {
+	return f(r)
+}
+
+// 查询满足where条件的所有行
+// 没有可用索引并且不需要合并事务写入的分支分页扫描, 不受MaxLimit的限制;
+// 匹配的行超过MaxLimit, 或者其他分支的查询结果达到MaxLimit时truncated为true, 返回的行不完整
+func (p *Proxy) filterRows(txn *Txn, t *Table, where [][]Match) (rows []*txnRow, truncated bool, err error) {
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(where) == 0 {
+		where = [][]Match{nil}
+	}
+	seen := make(map[string]struct{})
+	for _, and := range where {
+		pushed, err := pushdownMatches(t, and)
+		if err != nil {
+			return nil, false, err
+		}
+		and := and
+		collector := rowCollectorFunc(func(row *Row) error {
+			key, err := rowKey(t, fieldList, row)
+			if err != nil {
+				return err
+			}
+			if _, ok := seen[string(key)]; ok {
+				return nil
+			}
+			values := rowFieldMap(fieldList, row)
+			ok, err := matchRow(t, values, and)
+			if err != nil || !ok {
+				return err
+			}
+			if uint64(len(rows)) >= p.config.MaxLimit {
+				return errFilterTruncated
+			}
+			seen[string(key)] = struct{}{}
+			rows = append(rows, &txnRow{key: key, values: values})
+			return nil
+		})
+
+		var rowss [][]*Row
+		var count uint64
+		if index, it := p.usableIndex(txn, t, pushed); index != nil {
+			var rs []*Row
+			rs, count, err = p.indexSelect(t, it, pushed)
+			rowss = [][]*Row{rs}
+		} else if txn != nil && len(txn.tableMutations(t)) > 0 {
+			rowss, err = p.txnSelect(txn, t, fieldList, pushed, nil)
+			for _, rs := range rowss {
+				count += uint64(len(rs))
+			}
+		} else {
+			err = p.scanRows(t, fieldList, pushed, collector)
+		}
+		for _, rs := range rowss {
+			for _, row := range rs {
+				if err == nil {
+					err = collector.add(row)
+				}
+			}
+		}
+		if err == errFilterTruncated || count >= p.config.MaxLimit {
+			log.Warn("[select] Table %s.%s rows of where branch exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+			truncated, err = true, nil
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return bytes.Compare(rows[i].key, rows[j].key) < 0
+	})
+	return rows, truncated, nil
+}
+
+// 分页扫描满足下推条件的所有行, 逐行交给c
+func (p *Proxy) scanRows(t *Table, fieldList []*kvrpcpb.SelectField, matches []Match, c rowCollector) error {
+	pbMatches, err := makePBMatches(t, matches)
+	if err != nil {
+		return err
+	}
+	key, scope, err := findPKScope(t, pbMatches)
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		// 主键完全指定, 最多一行
+		rowss, err := p.doSelect(t, fieldList, matches, nil, nil)
+		if err != nil {
+			return err
+		}
+		for _, rows := range rowss {
+			for _, r := range rows {
+				if err = c.add(r); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	proxy := dskv.GetKvProxy()
+	defer dskv.PutKvProxy(proxy)
+	proxy.Init(p.dsCli, p.clock, t.ranges, client.WriteTimeout, client.ReadTimeoutShort)
+	sreq := &kvrpcpb.SelectRequest{FieldList: fieldList, WhereFilters: pbMatches}
+	return p.pageScope(proxy, t, sreq, scope, c)
+}
+
+func projectRow(fieldList []*kvrpcpb.SelectField, values map[string]interface{}) *Row {
+	row := &Row{fields: make([]Field, len(fieldList))}
+	for i, f := range fieldList {
+		row.fields[i].col = f.Column.Name
+		row.fields[i].value = values[f.Column.Name]
+	}
+	return row
+}
+
+// 每行转换为一个部分聚合结果, 跟多个dataserver返回的聚合结果一样在合并时计算最终值
+func partialAggreRows(fieldList []*kvrpcpb.SelectField, rows []*txnRow) []*Row {
+	if len(rows) == 0 {
+		row := &Row{fields: make([]Field, len(fieldList))}
+		for i, f := range fieldList {
+			row.fields[i].col, _ = makeFieldName(f)
+			if f.AggreFunc == "count" {
+				row.fields[i].value = uint64(0)
+			}
+		}
+		return []*Row{row}
+	}
+	result := make([]*Row, 0, len(rows))
+	for _, r := range rows {
+		row := &Row{fields: make([]Field, len(fieldList))}
+		for i, f := range fieldList {
+			row.fields[i].col, _ = makeFieldName(f)
+			var value interface{}
+			if f.Column != nil {
+				value = r.values[f.Column.Name]
+			}
+			if f.AggreFunc == "count" {
+				if f.Column == nil || value != nil {
+					row.fields[i].value = uint64(1)
+				} else {
+					row.fields[i].value = uint64(0)
+				}
+			} else {
+				row.fields[i].value = value
+			}
+			row.fields[i].aggreCount = 1
+		}
+		result = append(result, row)
+	}
+	return result
+}
+
+// 按where条件删除, 先查询出匹配的行, 再按主键删除
+// 事务中的删除缓存在事务中, 匹配的行数受限制; 非事务时每批最多MaxLimit行, 每批以一个隐式事务删除, 直到没有匹配的行
+func (p *Proxy) deleteWhere(txn *Txn, t *Table, where [][]Match) (affected uint64, err error) {
+	where, err = expandPKIn(t, where)
+	if err != nil {
+		return 0, err
+	}
+	if txn != nil {
+		rows, truncated, err := p.filterRows(txn, t, where)
+		if err != nil {
+			return 0, err
+		}
+		if truncated {
+			log.Warn("[delete] Table %s.%s matched rows exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+			return 0, ErrExceedMaxLimit
+		}
+		return p.deleteRows(txn, t, rows)
+	}
+
+	for {
+		var n uint64
+		var truncated bool
+		err = p.autoCommit(func(txn *Txn) error {
+			var rows []*txnRow
+			var err error
+			if rows, truncated, err = p.filterRows(txn, t, where); err != nil {
+				return err
+			}
+			if len(rows) > txn.maxMutations {
+				rows, truncated = rows[:txn.maxMutations], true
+			}
+			n, err = p.deleteRows(txn, t, rows)
+			return err
+		})
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+		if !truncated {
+			return affected, nil
+		}
+		// 扫描到的都是残留的索引项, 重新扫描也没有进展
+		if n == 0 {
+			log.Warn("[delete] Table %s.%s no row deleted in a batch of the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+			return affected, ErrExceedMaxLimit
+		}
+	}
+}
+
+// 事务内按主键逐行删除
+func (p *Proxy) deleteRows(txn *Txn, t *Table, rows []*txnRow) (affected uint64, err error) {
+	for _, r := range rows {
+		if err = txn.delete(t, r.key, &txnBase{exists: true, row: r.values}); err != nil {
+			log.Error("[delete] Table %s.%s delete row %v failed(%v)", t.DbName(), t.Name(), r.key, err)
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+// 在gateway中计算where条件, 除IS [NOT] NULL外NULL值不匹配任何条件
+func matchRow(t *Table, values map[string]interface{}, matches []Match) (bool, error) {
+	for _, m := range matches {
+		col := t.FindColumn(m.column)
+		if col == nil {
+			return false, fmt.Errorf("Unknown column '%s' in 'where clause'", m.column)
+		}
+		v := values[col.Name]
+		switch m.matchType {
+		case IsNull:
+			if v != nil {
+				return false, nil
+			}
+			continue
+		case IsNotNull:
+			if v == nil {
+				return false, nil
+			}
+			continue
+		}
+		if v == nil {
+			return false, nil
+		}
+		ok, err := matchValue(col, v, m)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func matchValue(col *metapb.Column, v interface{}, m Match) (bool, error) {
+	switch m.matchType {
+	case In, NotIn:
+		for _, value := range m.values {
+			c, err := compareColumnValue(col, v, value)
+			if err != nil {
+				return false, err
+			}
+			if c == 0 {
+				return m.matchType == In, nil
+			}
+		}
+		// 列表中有NULL时没有匹配的结果为NULL, IN和NOT IN都不成立
+		return m.matchType == NotIn && !m.hasNull, nil
+	case Like, NotLike:
+		b, err := formatValue(v)
+		if err != nil {
+			return false, err
+		}
+		return likeMatch(b, m.sqlValue) == (m.matchType == Like), nil
+	}
+
+	c, err := compareColumnValue(col, v, m.sqlValue)
+	if err != nil {
+		return false, err
+	}
+	switch m.matchType {
+	case Equal:
+		return c == 0, nil
+	case NotEqual:
+		return c != 0, nil
+	case Less:
+		return c < 0, nil
+	case LessOrEqual:
+		return c <= 0, nil
+	case Larger:
+		return c > 0, nil
+	case LargerOrEqual:
+		return c >= 0, nil
+	default:
+		return false, fmt.Errorf("unsupported match type(%v)", m.matchType)
+	}
+}
+
+// LIKE匹配, %匹配任意个字符, _匹配一个字符, 反斜杠转义, 区分大小写
+func likeMatch(value, pattern []byte) bool {
+	if len(pattern) == 0 {
+		return len(value) == 0
+	}
+	switch pattern[0] {
+	case '%':
+		// 合并连续的%
+		for len(pattern) > 0 && pattern[0] == '%' {
+			pattern = pattern[1:]
+		}
+		if len(pattern) == 0 {
+			return true
+		}
+		for i := 0; i <= len(value); i++ {
+			if likeMatch(value[i:], pattern) {
+				return true
+			}
+		}
+		return false
+	case '_':
+		if len(value) == 0 {
+			return false
+		}
+		_, size := utf8.DecodeRune(value)
+		return likeMatch(value[size:], pattern[1:])
+	case '\\':
+		if len(pattern) > 1 {
+			pattern = pattern[1:]
+		}
+	}
+	if len(value) == 0 || value[0] != pattern[0] {
+		return false
+	}
+	return likeMatch(value[1:], pattern[1:])
+}
+
+// LIKE模式中第一个通配符之前的固定前缀
+func likePrefix(pattern []byte) []byte {
+	var prefix []byte
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '%', '_':
+			return prefix
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+		}
+		prefix = append(prefix, pattern[i])
+	}
+	return prefix
+}
+
+// 按列类型比较列值v和条件值
+func compareColumnValue(col *metapb.Column, v interface{}, threshold []byte) (int, error) {
+	switch col.DataType {
+	case metapb.DataType_Tinyint, metapb.DataType_Smallint, metapb.DataType_Int, metapb.DataType_BigInt,
+		metapb.DataType_Float, metapb.DataType_Double:
+		l, err := toNumber(v)
+		if err != nil {
+			return 0, err
+		}
+		r, err := parseNumber(threshold)
+		if err != nil {
+			return 0, err
+		}
+		if uv, ok := v.(uint64); ok {
+			if ru, err := strconv.ParseUint(string(threshold), 10, 64); err == nil {
+				return compareUint(uv, ru), nil
+			}
+		}
+		li, lok := l.(int64)
+		ri, rok := r.(int64)
+		if lok && rok {
+			return compareInt(li, ri), nil
+		}
+		return compareFloat(toFloat(l), toFloat(r)), nil
+	default:
+		b, err := formatValue(v)
+		if err != nil {
+			return 0, err
+		}
+		return bytes.Compare(b, threshold), nil
+	}
+}
+
+func compareUint(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareInt(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"proxy/gateway-server/sqlparser"
+)
+
+func parseTestWhere(t *testing.T, where string) [][]Match {
+	stmt, err := sqlparser.Parse("select * from " + testTableName + " where " + where)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches, err := new(StmtParser).parseWhere(stmt.(*sqlparser.Select).Where)
+	if err != nil {
+		t.Fatalf("parse %s failed: %v", where, err)
+	}
+	return matches
+}
+
+func TestMatchRow(t *testing.T) {
+	table := newTxnTestTable()
+	row := map[string]interface{}{"id": uint64(10), "name": []byte("abc"), "balance": nil}
+	tests := []struct {
+		where    string
+		expected bool
+	}{
+		{"id = 10", true},
+		{"id = 11", false},
+		{"id > 9 and id <= 10", true},
+		{"id >= 11", false},
+		{"id != 10", false},
+		{"name = 'abc'", true},
+		{"name < 'abd'", true},
+		{"name > 'abc'", false},
+		{"balance > 0", false},
+		{"id = 10 and name = 'abd'", false},
+		{"id = 11 or name = 'abc'", true},
+		{"id in (1, 10)", true},
+		{"id in (1, 2, NULL)", false},
+		{"id not in (1, 2)", true},
+		{"id not in (1, 2, NULL)", false},
+		{"id not in (10, NULL)", false},
+		{"not id in (1, NULL)", false},
+		{"not id in (10, NULL)", false},
+		{"id in (10, NULL)", true},
+		{"id between 5 and 10", true},
+		{"id not between 5 and 10", false},
+		{"name like 'a%'", true},
+		{"name like '_b_'", true},
+		{"name like 'b%'", false},
+		{"name not like '%c'", false},
+		{"balance is null", true},
+		{"balance is not null", false},
+		{"not (id = 10 and name = 'abd')", true},
+		{"not balance > 0", false},
+	}
+	for _, tt := range tests {
+		where := parseTestWhere(t, tt.where)
+		var actual bool
+		for _, and := range where {
+			ok, err := matchRow(table, row, and)
+			if err != nil {
+				t.Fatalf("match %s failed: %v", tt.where, err)
+			}
+			actual = actual || ok
+		}
+		if actual != tt.expected {
+			t.Fatalf("match %s: expected: %v, actual: %v", tt.where, tt.expected, actual)
+		}
+	}
+}
+
+func TestLikeMatch(t *testing.T) {
+	tests := []struct {
+		value, pattern string
+		expected       bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "ab", false},
+		{"abc", "%", true},
+		{"", "%", true},
+		{"abc", "a%c", true},
+		{"abc", "a%%b%c", true},
+		{"abc", "%bd", false},
+		{"abc", "a_c", true},
+		{"ac", "a_c", false},
+		{"a中c", "a_c", true},
+		{"a%c", "a\\%c", true},
+		{"abc", "a\\%c", false},
+		{"ABC", "abc", false},
+	}
+	for _, tt := range tests {
+		if actual := likeMatch([]byte(tt.value), []byte(tt.pattern)); actual != tt.expected {
+			t.Fatalf("%s like %s: expected: %v, actual: %v", tt.value, tt.pattern, tt.expected, actual)
+		}
+	}
+
+	prefixes := map[string]string{"abc%": "abc", "ab_c": "ab", "%abc": "", "a\\%b%": "a%b", "abc": "abc"}
+	for pattern, expected := range prefixes {
+		if actual := likePrefix([]byte(pattern)); string(actual) != expected {
+			t.Fatalf("prefix of %s: expected: %s, actual: %s", pattern, expected, actual)
+		}
+	}
+}
+
+func TestExpandPKIn(t *testing.T) {
+	table := newTxnTestTable()
+	where, err := expandPKIn(table, parseTestWhere(t, "id in (1, 2) and name in ('a', 'b')"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := Match{column: "name", matchType: In, values: [][]byte{[]byte("a"), []byte("b")}}
+	expected := [][]Match{
+		{{column: "id", sqlValue: []byte("1"), matchType: Equal}, names},
+		{{column: "id", sqlValue: []byte("2"), matchType: Equal}, names},
+	}
+	if !reflect.DeepEqual(where, expected) {
+		t.Fatalf("expected: %v, actual: %v", expected, where)
+	}
+	if isSimpleWhere(where) {
+		t.Fatal("expected not simple where")
+	}
+
+	pushed, err := pushdownMatches(table, parseTestWhere(t, "name like 'ab%' and balance is null")[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = [][]Match{{
+		{column: "name", sqlValue: []byte("ab"), matchType: LargerOrEqual},
+		{column: "name", sqlValue: []byte("ac"), matchType: Less},
+	}}
+	if !reflect.DeepEqual([][]Match{pushed}, expected) {
+		t.Fatalf("expected: %v, actual: %v", expected, pushed)
+	}
+}
@@ -0,0 +1,482 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"model/pkg/timestamp"
+	"pkg-go/ds_client"
+	msClient "pkg-go/ms_client"
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	"proxy/store/dskv"
+	"util"
+	"util/log"
+)
+
+// 索引数据存放在同一个库的隐藏表中, 索引列和原表的主键列依次作为索引表的主键
+// 索引项先于数据行写入, 数据行删除、覆盖或索引列修改后才删除旧的索引项,
+// 残留的索引项在查询时回表按完整条件重新过滤
+// 有索引的表上的SQL写入和REST写入都以事务提交, 旧索引项在提交时持有行锁清理,
+// 同一行上并发的删除和重新插入不会删掉新行的索引项;
+// REST接口的删除直接下推到dataserver, 不清理索引项, 只会留下残留的索引项
+
+// 回填时每批扫描的行数
+const indexBackfillBatch = 1000
+
+// 索引表名, 与master-server一致
+func indexTableName(tableId uint64, indexName string) string {
+	return fmt.Sprintf("$index_%d_%s", tableId, indexName)
+}
+
+func (p *Proxy) findIndexTable(t *Table, index *metapb.Index) *Table {
+	it := p.router.FindTable(t.DbName(), indexTableName(t.GetId(), index.GetName()))
+	if it == nil || it.GetId() != index.GetTableId() {
+		return nil
+	}
+	return it
+}
+
+// 编码一行对应的索引项, 索引列中有NULL时不建索引, 返回nil
+func (p *Proxy) encodeIndexKv(it *Table, values map[string]interface{}) (*kvrpcpb.KeyValue, error) {
+	cols := it.GetColumns()
+	colMap := make(map[string]int, len(cols))
+	rowValue := make(InsertRowValue, 0, len(cols))
+	for _, col := range cols {
+		v := values[col.GetName()]
+		if v == nil {
+			return nil, nil
+		}
+		sv, err := formatValue(v)
+		if err != nil {
+			return nil, err
+		}
+		colMap[col.GetName()] = len(rowValue)
+		rowValue = append(rowValue, sv)
+	}
+	return p.EncodeRow(it, colMap, rowValue)
+}
+
+// 写入多行的索引项, 只写入指定的索引
+func (p *Proxy) insertIndexEntries(t *Table, rows []map[string]interface{}, indexes []*metapb.Index, ts timestamp.Timestamp) error {
+	for _, index := range indexes {
+		it := p.findIndexTable(t, index)
+		if it == nil {
+			log.Error("[index] index table of %s on %s.%s doesn't exist", index.GetName(), t.DbName(), t.Name())
+			return ErrNotExistTable
+		}
+		var kvs []*kvrpcpb.KeyValue
+		for _, row := range rows {
+			kv, err := p.encodeIndexKv(it, row)
+			if err != nil {
+				return err
+			}
+			if kv != nil {
+				kvs = append(kvs, kv)
+			}
+		}
+		if len(kvs) == 0 {
+			continue
+		}
+		kvGroup, err := p.groupByRange(it, kvs)
+		if err != nil {
+			return err
+		}
+		for _, group := range kvGroup {
+			if _, _, err = p.insertKvsAt(it, group, false, ts); err != nil {
+				log.Error("[index] write index %s on %s.%s failed(%v)", index.GetName(), t.DbName(), t.Name(), err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 写入数据行之前先写入索引项
+func (p *Proxy) insertKvIndexes(t *Table, kvs []*kvrpcpb.KeyValue, ts timestamp.Timestamp) error {
+	rows := make([]map[string]interface{}, 0, len(kvs))
+	for _, kv := range kvs {
+		values, err := decodeKvRow(t, kv)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, values)
+	}
+	return p.insertIndexEntries(t, rows, t.GetIndexes(), ts)
+}
+
+// 删除oldRow的索引项, newRow为更新后的行, 索引项没有变化时保留; newRow为nil表示行已删除
+// 失败只会留下残留的索引项, 不返回错误
+func (p *Proxy) deleteIndexEntries(t *Table, oldRow, newRow map[string]interface{}) {
+	if oldRow == nil {
+		return
+	}
+	for _, index := range t.GetIndexes() {
+		it := p.findIndexTable(t, index)
+		if it == nil {
+			continue
+		}
+		oldKv, err := p.encodeIndexKv(it, oldRow)
+		if err != nil || oldKv == nil {
+			continue
+		}
+		if newRow != nil {
+			newKv, err := p.encodeIndexKv(it, newRow)
+			if err == nil && newKv != nil && bytes.Equal(oldKv.GetKey(), newKv.GetKey()) {
+				continue
+			}
+		}
+		now := p.clock.Now()
+		dreq := &kvrpcpb.DeleteRequest{
+			Key:       oldKv.GetKey(),
+			Timestamp: &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+		}
+		if _, err = p.deleteRemote(it.DbName(), it.Name(), dreq); err != nil {
+			log.Warn("[index] delete index %s entry on %s.%s failed(%v)", index.GetName(), t.DbName(), t.Name(), err)
+		}
+	}
+}
+
+// 读取一行当前的值用于清理旧的索引项, 读取失败时返回nil
+func (p *Proxy) currentRowValues(t *Table, key []byte) map[string]interface{} {
+	fieldList, row, err := p.getRow(t, key)
+	if err != nil {
+		log.Warn("[index] read row %v of %s.%s failed(%v)", key, t.DbName(), t.Name(), err)
+		return nil
+	}
+	if row == nil {
+		return nil
+	}
+	return rowFieldMap(fieldList, row)
+}
+
+// 按索引扫描的代价打分: 索引前缀上每个等值条件2分, 随后一列上有范围条件加1分
+func indexScore(t *Table, index *metapb.Index, pushed []Match) int {
+	var score int
+	for _, id := range index.GetColumnIds() {
+		col := t.FindColumnById(id)
+		if col == nil {
+			return 0
+		}
+		var equal, ranged bool
+		for _, m := range pushed {
+			if m.column != col.GetName() {
+				continue
+			}
+			if m.matchType == Equal {
+				equal = true
+			} else if m.matchType != NotEqual {
+				ranged = true
+			}
+		}
+		if equal {
+			score += 2
+			continue
+		}
+		if ranged {
+			score++
+		}
+		break
+	}
+	return score
+}
+
+// 选择可用的索引, 主键第一列上有约束时按主键范围扫描
+func chooseIndex(t *Table, pushed []Match) *metapb.Index {
+	pks := t.PKS()
+	if len(pks) > 0 {
+		for _, m := range pushed {
+			if m.column == pks[0] && m.matchType != NotEqual {
+				return nil
+			}
+		}
+	}
+	var best *metapb.Index
+	var bestScore int
+	for _, index := range t.GetIndexes() {
+		if index.GetState() != metapb.IndexState_IndexPublic {
+			continue
+		}
+		if score := indexScore(t, index, pushed); score > bestScore {
+			best, bestScore = index, score
+		}
+	}
+	return best
+}
+
+// 事务中已修改过该表时, 需要合并未提交的写入, 不使用索引
+func (p *Proxy) usableIndex(txn *Txn, t *Table, pushed []Match) (*metapb.Index, *Table) {
+	if len(t.GetIndexes()) == 0 {
+		return nil, nil
+	}
+	if txn != nil && len(txn.tableMutations(t)) > 0 {
+		return nil, nil
+	}
+	index := chooseIndex(t, pushed)
+	if index == nil {
+		return nil, nil
+	}
+	it := p.findIndexTable(t, index)
+	if it == nil {
+		return nil, nil
+	}
+	return index, it
+}
+
+// 简单条件在有可用索引时也需要走gateway过滤的查询流程
+func (p *Proxy) hasIndexPlan(txn *Txn, t *Table, where [][]Match) bool {
+	if len(where) != 1 {
+		return false
+	}
+	pushed, err := pushdownMatches(t, where[0])
+	if err != nil {
+		return false
+	}
+	index, _ := p.usableIndex(txn, t, pushed)
+	return index != nil
+}
+
+// 按索引查询: 先扫描索引表, 再按主键回表读取所有列, 返回的行还需要按完整条件过滤
+// scanned为扫描到的索引项个数
+func (p *Proxy) indexSelect(t, it *Table, pushed []Match) (rows []*Row, scanned uint64, err error) {
+	var matches []Match
+	for _, m := range pushed {
+		if it.FindColumn(m.column) != nil {
+			matches = append(matches, m)
+		}
+	}
+	indexFields, err := makeFieldList(it, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return nil, 0, err
+	}
+	rowss, err := p.doSelect(it, indexFields, matches, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	var keys [][]byte
+	for _, rs := range rowss {
+		for _, entry := range rs {
+			scanned++
+			key, err := rowKey(t, indexFields, entry)
+			if err != nil {
+				return nil, scanned, err
+			}
+			keys = append(keys, key)
+		}
+	}
+	rows, err = p.batchGetRows(t, keys)
+	return rows, scanned, err
+}
+
+// 按主键批量回表, 每个range每批一次KvBatchGet, 返回存在的行
+// 没有读到值的key(残留的索引项、range分裂后不在该range中的key、只有主键列的行)再逐行读取确认
+func (p *Proxy) batchGetRows(t *Table, keys [][]byte) ([]*Row, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return nil, err
+	}
+	kvs := make([]*kvrpcpb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		kvs = append(kvs, &kvrpcpb.KeyValue{Key: key})
+	}
+	kvGroup, err := p.groupByRange(t, kvs)
+	if err != nil {
+		return nil, err
+	}
+	proxy := dskv.GetKvProxy()
+	defer dskv.PutKvProxy(proxy)
+	proxy.Init(p.dsCli, p.clock, t.ranges, client.WriteTimeout, client.ReadTimeoutShort)
+
+	rows := make([]*Row, 0, len(keys))
+	for _, group := range kvGroup {
+		req := &kvrpcpb.KvBatchGetRequest{Keys: make([][]byte, 0, len(group))}
+		for _, kv := range group {
+			req.Keys = append(req.Keys, kv.GetKey())
+		}
+		resp, err := proxy.KvBatchGet(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.GetCode() != 0 {
+			log.Error("[index] batch get rows of %s.%s, remote server return code: %v", t.DbName(), t.Name(), resp.GetCode())
+			return nil, fmt.Errorf("remote server return code: %v", resp.GetCode())
+		}
+		values := make(map[string][]byte, len(resp.GetKvs()))
+		for _, kv := range resp.GetKvs() {
+			if len(kv.GetValue()) > 0 {
+				values[string(kv.GetKey())] = kv.GetValue()
+			}
+		}
+		for _, kv := range group {
+			value, ok := values[string(kv.GetKey())]
+			if !ok {
+				_, row, err := p.getRow(t, kv.GetKey())
+				if err != nil {
+					return nil, err
+				}
+				if row != nil {
+					rows = append(rows, row)
+				}
+				continue
+			}
+			rowValues, err := decodeKvRow(t, &kvrpcpb.KeyValue{Key: kv.GetKey(), Value: value})
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, projectRow(fieldList, rowValues))
+		}
+	}
+	return rows, nil
+}
+
+// HandleCreateIndex 在已有的表上创建索引, 存量数据在后台回填
+func (p *Proxy) HandleCreateIndex(db string, stmt *sqlparser.CreateIndex) (*mysql.Result, error) {
+	tableName := string(stmt.Table)
+	indexName := string(stmt.Name)
+	if stmt.Unique {
+		return nil, fmt.Errorf("unique index is not supported")
+	}
+	if p.router.FindTable(db, tableName) == nil {
+		log.Error("[index] table %s.%s doesn.t exist", db, tableName)
+		return nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
+	}
+	var columns []string
+	for _, c := range stmt.Columns {
+		expr, ok := c.(*sqlparser.NonStarExpr)
+		if !ok {
+			return nil, fmt.Errorf("invalid index column")
+		}
+		col, ok := expr.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("invalid index column")
+		}
+		columns = append(columns, string(col.Name))
+	}
+
+	// 上次回填没有完成的索引重新回填
+	p.router.ExpireTable(db, tableName)
+	if t := p.router.FindTable(db, tableName); t != nil {
+		for _, index := range t.GetIndexes() {
+			if index.GetName() == indexName && index.GetState() == metapb.IndexState_IndexWriteOnly {
+				log.Info("[index] resume backfilling index %s on %s.%s", indexName, db, tableName)
+				p.wg.Add(1)
+				go p.backfillIndex(db, tableName, index)
+				return &mysql.Result{}, nil
+			}
+		}
+	}
+
+	index, err := p.msCli.CreateIndex(db, tableName, indexName, columns)
+	if msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrDupIndex) {
+		return nil, mysql.NewDefaultError(mysql.ER_DUP_KEYNAME, indexName)
+	}
+	if err != nil {
+		log.Error("[index] create index %s on %s.%s failed(%v)", indexName, db, tableName, err)
+		return nil, err
+	}
+	p.router.ExpireTable(db, tableName)
+	log.Info("[index] index %s on %s.%s created, start backfilling", indexName, db, tableName)
+	p.wg.Add(1)
+	go p.backfillIndex(db, tableName, index)
+	return &mysql.Result{}, nil
+}
+
+// 回填存量数据, 完成后把索引修改为可用状态
+// 先等待所有gateway的表缓存过期, 之后的写入都会维护索引项, 回填只需要扫描一遍存量数据
+func (p *Proxy) backfillIndex(db, tableName string, index *metapb.Index) {
+	defer p.wg.Done()
+	select {
+	case <-p.ctx.Done():
+		return
+	case <-time.After(tableCacheTTL):
+	}
+
+	var t *Table
+	for {
+		p.router.ExpireTable(db, tableName)
+		t = p.router.FindTable(db, tableName)
+		if t == nil {
+			log.Warn("[index] table %s.%s was deleted, stop backfilling index %s", db, tableName, index.GetName())
+			return
+		}
+		// 等待索引表创建完成
+		if p.findIndexTable(t, index) != nil {
+			break
+		}
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		log.Error("[index] backfill index %s on %s.%s failed(%v)", index.GetName(), db, tableName, err)
+		return
+	}
+	scope := &Scope{
+		Start: util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()),
+		End:   util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()+1),
+	}
+	batch := uint64(indexBackfillBatch)
+	if batch > p.config.MaxLimit {
+		batch = p.config.MaxLimit
+	}
+	var total int
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		default:
+		}
+		rowss, err := p.doSelect(t, fieldList, nil, &Limit{rowCount: batch}, scope)
+		if err != nil {
+			if p.router.FindTable(db, tableName) == nil {
+				log.Warn("[index] table %s.%s was deleted, stop backfilling index %s", db, tableName, index.GetName())
+				return
+			}
+			log.Warn("[index] backfill index %s on %s.%s failed(%v), retry", index.GetName(), db, tableName, err)
+			time.Sleep(time.Second)
+			continue
+		}
+		var rows []map[string]interface{}
+		var lastKey []byte
+		for _, rs := range rowss {
+			for _, row := range rs {
+				rows = append(rows, rowFieldMap(fieldList, row))
+				if lastKey, err = rowKey(t, fieldList, row); err != nil {
+					log.Error("[index] backfill index %s on %s.%s failed(%v)", index.GetName(), db, tableName, err)
+					return
+				}
+			}
+		}
+		if len(rows) == 0 {
+			break
+		}
+		if err = p.insertIndexEntries(t, rows, []*metapb.Index{index}, p.clock.Now()); err != nil {
+			log.Warn("[index] backfill index %s on %s.%s failed(%v), retry", index.GetName(), db, tableName, err)
+			time.Sleep(time.Second)
+			continue
+		}
+		total += len(rows)
+		if uint64(len(rows)) < batch {
+			break
+		}
+		scope.Start = append(lastKey, 0)
+	}
+
+	if err = p.msCli.SetIndexState(db, tableName, index.GetName(), metapb.IndexState_IndexPublic); err != nil {
+		log.Error("[index] set index %s on %s.%s public failed(%v)", index.GetName(), db, tableName, err)
+		return
+	}
+	p.router.ExpireTable(db, tableName)
+	log.Info("[index] index %s on %s.%s is public, %d rows backfilled", index.GetName(), db, tableName, total)
+}
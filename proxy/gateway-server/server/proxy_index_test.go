@@ -0,0 +1,85 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+	"util"
+)
+
+func newIndexTestTable(indexes ...*metapb.Index) *Table {
+	columns := []*columnInfo{
+		&columnInfo{name: "id", typ: metapb.DataType_BigInt, isUnsigned: true, isPK: true},
+		&columnInfo{name: "name", typ: metapb.DataType_Varchar},
+		&columnInfo{name: "age", typ: metapb.DataType_Int},
+	}
+	table := makeTestTable(columns)
+	table.Indexes = indexes
+	return NewTable(table, nil, time.Minute)
+}
+
+func TestChooseIndex(t *testing.T) {
+	byName := &metapb.Index{Name: "idx_name", ColumnIds: []uint64{2}, State: metapb.IndexState_IndexPublic}
+	byNameAge := &metapb.Index{Name: "idx_name_age", ColumnIds: []uint64{2, 3}, State: metapb.IndexState_IndexPublic}
+	byAge := &metapb.Index{Name: "idx_age", ColumnIds: []uint64{3}, State: metapb.IndexState_IndexWriteOnly}
+	table := newIndexTestTable(byName, byNameAge, byAge)
+
+	tests := []struct {
+		where    string
+		expected *metapb.Index
+	}{
+		{"name = 'a'", byName},
+		{"name = 'a' and age = 1", byNameAge},
+		{"name = 'a' and age > 1", byNameAge},
+		{"name > 'a'", byName},
+		{"name like 'a%'", byName},
+		{"age = 1", nil},
+		{"name != 'a'", nil},
+		{"id = 1 and name = 'a'", nil},
+		{"id != 1 and name = 'a'", byName},
+	}
+	for _, tt := range tests {
+		where := parseTestWhere(t, tt.where)
+		pushed, err := pushdownMatches(table, where[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := chooseIndex(table, pushed); actual != tt.expected {
+			t.Errorf("choose index for %s: expected %v, actual %v", tt.where, tt.expected, actual)
+		}
+	}
+}
+
+func TestEncodeIndexKv(t *testing.T) {
+	indexTable := NewTable(&metapb.Table{
+		Name:   indexTableName(1, "idx_name"),
+		DbName: testDBName,
+		DbId:   1,
+		Id:     2,
+		Columns: []*metapb.Column{
+			&metapb.Column{Name: "name", Id: 1, DataType: metapb.DataType_Varchar, PrimaryKey: 1},
+			&metapb.Column{Name: "id", Id: 2, DataType: metapb.DataType_BigInt, Unsigned: true, PrimaryKey: 2},
+		},
+	}, nil, time.Minute)
+
+	kv, err := new(Proxy).encodeIndexKv(indexTable, map[string]interface{}{"id": uint64(10), "name": []byte("abc"), "age": int64(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := util.EncodeStorePrefix(util.Store_Prefix_KV, 2)
+	expected, _ = util.EncodePrimaryKey(expected, indexTable.FindColumn("name"), []byte("abc"))
+	expected, _ = util.EncodePrimaryKey(expected, indexTable.FindColumn("id"), []byte("10"))
+	if !bytes.Equal(kv.GetKey(), expected) {
+		t.Fatalf("unexpected index key %v, expected %v", kv.GetKey(), expected)
+	}
+
+	kv, err = new(Proxy).encodeIndexKv(indexTable, map[string]interface{}{"id": uint64(10), "name": nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kv != nil {
+		t.Fatalf("NULL value should not be indexed")
+	}
+}
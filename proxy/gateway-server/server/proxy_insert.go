@@ -2,10 +2,11 @@ package server
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
-	"time"
 	"sort"
+	"time"
 
 	"proxy/gateway-server/mysql"
 	"proxy/gateway-server/sqlparser"
@@ -19,7 +20,8 @@ import (
 	"golang.org/x/net/context"
 )
 
-func (p *Proxy) HandleInsert(db string, stmt *sqlparser.Insert, args []interface{}) (*mysql.Result, error) {
+// txn不为nil时写入缓存在事务中, 为nil时以隐式事务执行
+func (p *Proxy) HandleInsert(db string, stmt *sqlparser.Insert, args []interface{}, txn *Txn) (res *mysql.Result, err error) {
 	//var parseTime time.Time
 	//start := time.Now()
 	//defer func() {
@@ -33,28 +35,253 @@ func (p *Proxy) HandleInsert(db string, stmt *sqlparser.Insert, args []interface
 	//	}
 	//}()
 
-	parser := &StmtParser{}
+	if txn == nil {
+		err = p.autoCommit(func(txn *Txn) (err error) {
+			res, err = p.HandleInsert(db, stmt, args, txn)
+			return
+		})
+		return res, err
+	}
+	txn.beginStatement()
+	defer func() { txn.endStatement(err) }()
+
+	parser := &StmtParser{args: args}
+	t, colMap, rows, err := p.parseInsert(db, stmt, parser)
+	if err != nil {
+		return nil, err
+	}
+	tableName := t.Name()
+
+	// insert ... on duplicate key update
+	if len(stmt.OnDup) > 0 {
+		sets, err := parser.parseUpdateList(sqlparser.UpdateExprs(stmt.OnDup))
+		if err != nil {
+			log.Error("[insert] parse on duplicate key update clause error(%v)", err)
+			return nil, err
+		}
+		affected, err := p.insertOnDup(txn, t, colMap, rows, sets, parser)
+		if err != nil {
+			log.Error("insert on duplicate error table[%s:%s], err %s", db, tableName, err.Error())
+			return nil, err
+		}
+		return &mysql.Result{AffectedRows: affected}, nil
+	}
 
+	//parseTime = time.Now()
+	// 编码、执行插入
+	res = new(mysql.Result)
+	affected, duplicateKey, err := p.insertRows(txn, t, colMap, rows)
+	if err != nil {
+		log.Error("insert error table[%s:%s], err %s", db, tableName, err.Error())
+		return nil, err
+	}
+	if len(duplicateKey) != 0 {
+		return nil, newDupEntryError()
+	}
+	if affected != uint64(len(rows)) {
+		log.Error("insert error table[%s:%s],request num:%d,inserted num:%d", db, tableName, len(rows),affected)
+		return nil,ErrAffectRows
+	}
+	res.AffectedRows = affected
+	res.Status = 0
+	return res, nil
+}
+
+// HandleReplace handle replace, 已存在的行直接被覆盖
+func (p *Proxy) HandleReplace(db string, stmt *sqlparser.Replace, args []interface{}, txn *Txn) (res *mysql.Result, err error) {
+	if txn == nil {
+		err = p.autoCommit(func(txn *Txn) (err error) {
+			res, err = p.HandleReplace(db, stmt, args, txn)
+			return
+		})
+		return res, err
+	}
+	txn.beginStatement()
+	defer func() { txn.endStatement(err) }()
+
+	parser := &StmtParser{args: args}
+	insert := &sqlparser.Insert{
+		Comments: stmt.Comments,
+		Table:    stmt.Table,
+		Columns:  stmt.Columns,
+		Rows:     stmt.Rows,
+	}
+	t, colMap, rows, err := p.parseInsert(db, insert, parser)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := p.replaceRows(txn, t, colMap, rows)
+	if err != nil {
+		log.Error("replace error table[%s:%s], err %s", db, t.Name(), err.Error())
+		return nil, err
+	}
+	res = new(mysql.Result)
+	res.AffectedRows = affected
+	res.Status = 0
+	return res, nil
+}
+
+// 事务内覆盖写入多行, 同MySQL, 新插入的行affected为1, 替换已存在的行为2(删除和插入)
+func (p *Proxy) replaceRows(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue) (affected uint64, err error) {
+	kvs, olds, err := p.readOverwrittenRows(txn, t, colMap, rows)
+	if err != nil {
+		return 0, err
+	}
+	for i, kv := range kvs {
+		if olds[i] != nil {
+			affected++
+		}
+		// 同一行在事务中已修改过时保留第一次读到的值
+		if err = txn.put(t, kv, &txnBase{exists: olds[i] != nil, row: olds[i]}); err != nil {
+			return 0, err
+		}
+	}
+	return affected + uint64(len(kvs)), nil
+}
+
+// 编码要覆盖写入的行, 并读取每行写入前的值, 行不存在时对应的值为nil
+func (p *Proxy) readOverwrittenRows(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue) ([]*kvrpcpb.KeyValue, []map[string]interface{}, error) {
+	kvs := make([]*kvrpcpb.KeyValue, 0, len(rows))
+	for i, r := range rows {
+		kv, err := p.EncodeRow(t, colMap, r)
+		if err != nil {
+			log.Error("[replace] table %s.%s encode row at %d failed: %v", t.DbName(), t.Name(), i, err)
+			return nil, nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	olds := make([]map[string]interface{}, len(kvs))
+	for i, kv := range kvs {
+		var err error
+		if olds[i], err = p.oldRowValues(txn, t, kv.GetKey()); err != nil {
+			log.Error("[replace] table %s.%s read row at %d failed: %v", t.DbName(), t.Name(), i, err)
+			return nil, nil, err
+		}
+	}
+	return kvs, olds, nil
+}
+
+// 读取一行写入前的值, 事务中优先读取未提交的写入, 行不存在时返回nil
+func (p *Proxy) oldRowValues(txn *Txn, t *Table, key []byte) (map[string]interface{}, error) {
+	if txn != nil {
+		if m := txn.get(key); m != nil {
+			if m.isDelete {
+				return nil, nil
+			}
+			return decodeKvRow(t, m.kv)
+		}
+	}
+	fieldList, row, err := p.getRow(t, key)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return rowFieldMap(fieldList, row), nil
+}
+
+// 事务内执行insert ... on duplicate key update, 逐行插入, 主键冲突时按set子句更新已存在的行
+// 同MySQL, 插入的行affected为1, 更新的行为2, 值未变化的行为0
+func (p *Proxy) insertOnDup(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue, sets []*UpdateColumn, parser *StmtParser) (affected uint64, err error) {
+	for _, s := range sets {
+		if t.FindColumn(s.column) == nil {
+			return 0, fmt.Errorf("Unknown column '%s' in 'field list'", s.column)
+		}
+	}
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return 0, err
+	}
+	allColMap := make(map[string]int, len(fieldList))
+	for i, f := range fieldList {
+		allColMap[f.Column.Name] = i
+	}
+
+	for i, r := range rows {
+		kv, err := p.EncodeRow(t, colMap, r)
+		if err != nil {
+			log.Error("[insert] table %s.%s encode row at %d failed: %v", t.DbName(), t.Name(), i, err)
+			return affected, err
+		}
+		dup, err := p.txnPut(txn, t, kv, true)
+		if err != nil {
+			return affected, err
+		}
+		if !dup {
+			affected++
+			continue
+		}
+
+		// 主键冲突, 按主键查询已存在的行
+		matches := make([]Match, 0, len(t.PKS()))
+		for _, pk := range t.PKS() {
+			matches = append(matches, Match{column: pk, sqlValue: r[colMap[pk]], matchType: Equal})
+		}
+		rowss, err := p.txnSelect(txn, t, fieldList, matches, nil)
+		if err != nil {
+			return affected, err
+		}
+		var old *Row
+		for _, rs := range rowss {
+			if len(rs) > 0 {
+				old = rs[0]
+				break
+			}
+		}
+		if old == nil {
+			log.Warn("[insert] table %s.%s duplicate row at %d not found", t.DbName(), t.Name(), i)
+			return affected, fmt.Errorf("duplicate row at %d was removed concurrently", i)
+		}
+
+		values := make(map[string]interface{}, len(colMap))
+		for col, idx := range colMap {
+			if r[idx] != nil {
+				values[col] = []byte(r[idx])
+			} else {
+				values[col] = nil
+			}
+		}
+		oldValue, newValue, err := p.updateRowValue(t, fieldList, old, sets, values, parser)
+		if err != nil {
+			return affected, err
+		}
+		if rowValueEqual(oldValue, newValue) {
+			continue
+		}
+		oldKv, newKv, err := p.encodeUpdatedRow(t, allColMap, oldValue, newValue)
+		if err != nil {
+			return affected, err
+		}
+		n, err := p.txnWriteUpdatedRows(txn, t, [][2]*kvrpcpb.KeyValue{{oldKv, newKv}})
+		if err != nil {
+			return affected, err
+		}
+		affected += 2 * n
+	}
+	return affected, nil
+}
+
+// 解析insert语句的表、列和行值
+func (p *Proxy) parseInsert(db string, stmt *sqlparser.Insert, parser *StmtParser) (t *Table, colMap map[string]int, rows []InsertRowValue, err error) {
 	// 解析表名
 	tableName := parser.parseTable(stmt)
-	t := p.router.FindTable(db, tableName)
+	t = p.router.FindTable(db, tableName)
 	if t == nil {
 		log.Error("[insert] table %s.%s doesn.t exist", db, tableName)
-		return nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
+		return nil, nil, nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
 	}
 
 	// 解析插入列名
 	cols, err := parser.parseInsertCols(stmt)
 	if err != nil {
 		log.Error("[insert] parse columns error(%v)", err)
-		return nil, fmt.Errorf("handle insert parseColumn err %s", err.Error())
+		return nil, nil, nil, fmt.Errorf("handle insert parseColumn err %s", err.Error())
 	}
 	// 没有指定列名，添加表的所有列
 	if len(cols) == 0 {
 		columns := t.GetAllColumns()
 		if len(columns) == 0 {
 			log.Error("[insert] get table(%s.%s) all columns from router failed", db, tableName)
-			return nil, fmt.Errorf("could not get colums info table(%s.%s)", db, tableName)
+			return nil, nil, nil, fmt.Errorf("could not get colums info table(%s.%s)", db, tableName)
 		}
 		for _, c := range columns {
 			cols = append(cols, c.Name)
@@ -62,54 +289,33 @@ func (p *Proxy) HandleInsert(db string, stmt *sqlparser.Insert, args []interface
 	}
 
 	// 解析插入行值（可能有多行）
-	rows, err := parser.parseInsertValues(stmt)
+	rows, err = parser.parseInsertValues(stmt)
 	if err != nil {
 		log.Error("[insert] table %s.%s parse row values error(%v)", db, tableName, err)
-		return nil, fmt.Errorf("handle insert parseRow err %s", err.Error())
+		return nil, nil, nil, fmt.Errorf("handle insert parseRow err %s", err.Error())
 	}
 	// 检查每行值的个数跟列名个数是否相等
 	for i, r := range rows {
 		if len(r) != len(cols) {
 			log.Error("[insert] table %s.%s Column count doesn't match value count at row %d(%d != %d)", db, tableName, i, len(r), len(cols))
-			return nil, fmt.Errorf("Column count doesn't match value count at row %d", i)
+			return nil, nil, nil, fmt.Errorf("Column count doesn't match value count at row %d", i)
 		}
 	}
 
 	// 按照表的每个列查找对应列值位置
-	colMap, t, err := p.matchInsertValues(t, cols)
+	colMap, t, err = p.matchInsertValues(t, cols)
 	if err != nil {
 		log.Error("[insert] table %s.%s match column values error(%v)", db, tableName, err)
-		return nil, err
+		return nil, nil, nil, err
 	}
 	// 检查是否缺少主键列
-	if err := p.checkPKMissing(t, colMap); err != nil {
+	if err = p.checkPKMissing(t, colMap); err != nil {
 		log.Error("[insert] table %s.%s missing column(%v)", db, tableName, err)
-		return nil, err
+		return nil, nil, nil, err
 	}
+	rows = fillRowTTL(t, colMap, rows)
 
-	//parseTime = time.Now()
-	// 编码、执行插入
-	res := new(mysql.Result)
-	affected, duplicateKey, err := p.insertRows(t, colMap, rows)
-	if err != nil {
-		log.Error("insert error table[%s:%s], err %s", db, tableName, err.Error())
-		return nil, err
-	}else if affected != uint64(len(rows)){
-		log.Error("insert error table[%s:%s],request num:%d,inserted num:%d", db, tableName, len(rows),affected)
-		return nil,ErrAffectRows
-	}
-	if len(duplicateKey) != 0 {
-		resErr := new(mysql.SqlError)
-		resErr.Code = mysql.ER_DUP_ENTRY
-		resErr.State = "23000"
-		message := ` Duplicate entry `
-		message += `for key 'PRIMARY'`
-		resErr.Message = message
-		return nil, resErr
-	}
-	res.AffectedRows = affected
-	res.Status = 0
-	return res, nil
+	return t, colMap, rows, nil
 }
 
 // 查找每列对应的列值的偏移，处理自动添加列逻辑
@@ -137,6 +343,13 @@ func (p *Proxy) matchInsertValues(t *Table, cols []string) (colMap map[string]in
 	db := t.DbName()
 	table := t.Name()
 
+	// 其他gateway可能已经添加或重命名了列, 先按新的表结构匹配
+	if len(unrecognized) > 0 {
+		if nt := p.router.RefreshTable(db, table); nt != nil {
+			return p.matchInsertValues(nt, cols)
+		}
+	}
+
 	// 自动添加列
 	if len(unrecognized) > 0 {
 		addcols := make([]string, 0, len(unrecognized))
@@ -244,7 +457,7 @@ func (p *Proxy) EncodeRow(t *Table, colMap map[string]int, rowValue InsertRowVal
 		}
 	}
 
-	expireAt, err := findRowExpire(colMap, rowValue)
+	expireAt, err := findRowExpire(t, colMap, rowValue)
 	if err != nil {
 		return nil, fmt.Errorf("find row ttl error(%s)", err)
 	}
@@ -256,7 +469,7 @@ func (p *Proxy) EncodeRow(t *Table, colMap map[string]int, rowValue InsertRowVal
 	}, nil
 }
 
-func (p *Proxy) batchInsert(t *Table, colMap map[string]int, rows []InsertRowValue) (affected uint64, duplicateKey []byte, err error) {
+func (p *Proxy) batchInsert(t *Table, colMap map[string]int, rows []InsertRowValue, checkDup bool) (affected uint64, duplicateKey []byte, err error) {
 	var kvPairs []*kvrpcpb.KeyValue
 	var kvGroup [][]*kvrpcpb.KeyValue
 
@@ -269,48 +482,19 @@ func (p *Proxy) batchInsert(t *Table, colMap map[string]int, rows []InsertRowVal
 		}
 		kvPairs = append(kvPairs, kv)
 	}
-	// 首先排序,这个很重要
-	sort.Sort(KvParisSlice(kvPairs))
-	// 按照route的范围划分kv group
-	ggroup := make(map[uint64][]*kvrpcpb.KeyValue)
-	for _, kv := range kvPairs {
-		//log.Debug("==========key[%v]", kv.GetKey())
-		bo := dskv.NewBackoffer(dskv.MsMaxBackoff, context.Background())
-		l, _err := t.ranges.LocateKey(bo, kv.GetKey())
-		if _err != nil {
-			err = _err
-			log.Warn("locate key failed, err %v", err)
-			return
-		}
-		var group []*kvrpcpb.KeyValue
-		var ok bool
-		if group, ok = ggroup[l.Region.Id]; !ok {
-			group = make([]*kvrpcpb.KeyValue, 0)
-			ggroup[l.Region.Id] = group
-		}
-		group = append(group, kv)
-		// 每100个kv切割一下
-		if len(group) == 100 {
-			kvGroup = append(kvGroup, group)
-			group = make([]*kvrpcpb.KeyValue, 0)
-		}
-		// TODO 非常重要
-		ggroup[l.Region.Id] = group
-	}
-	for _, group := range ggroup {
-		if len(group) > 0 {
-			kvGroup = append(kvGroup, group)
-		}
+	kvGroup, err = p.groupByRange(t, kvPairs)
+	if err != nil {
+		return
 	}
 	// 只需要访问一个range
 	if len(kvGroup) == 1 {
-		return p.insert(t, kvGroup[0])
+		return p.insertKvs(t, kvGroup[0], checkDup)
 	}
 	// for more range batch insert
 	var tasks []*InsertTask
 	for _, rows := range kvGroup {
 		task := GetInsertTask()
-		task.init(p, t, rows)
+		task.init(p, t, rows, checkDup)
 		err = p.Submit(task)
 		if err != nil {
 			// release task
@@ -339,9 +523,71 @@ func (p *Proxy) batchInsert(t *Table, colMap map[string]int, rows []InsertRowVal
 	return
 }
 
-func (p *Proxy) insertRows(t *Table, colMap map[string]int, rows []InsertRowValue) (affected uint64, duplicateKey []byte, err error) {
+// 按照route的范围划分kv group, 每个group最多100个kv
+func (p *Proxy) groupByRange(t *Table, kvPairs []*kvrpcpb.KeyValue) ([][]*kvrpcpb.KeyValue, error) {
+	var kvGroup [][]*kvrpcpb.KeyValue
+	// 首先排序,这个很重要
+	sort.Sort(KvParisSlice(kvPairs))
+	ggroup := make(map[uint64][]*kvrpcpb.KeyValue)
+	for _, kv := range kvPairs {
+		//log.Debug("==========key[%v]", kv.GetKey())
+		bo := dskv.NewBackoffer(dskv.MsMaxBackoff, context.Background())
+		l, err := t.ranges.LocateKey(bo, kv.GetKey())
+		if err != nil {
+			log.Warn("locate key failed, err %v", err)
+			return nil, err
+		}
+		var group []*kvrpcpb.KeyValue
+		var ok bool
+		if group, ok = ggroup[l.Region.Id]; !ok {
+			group = make([]*kvrpcpb.KeyValue, 0)
+			ggroup[l.Region.Id] = group
+		}
+		group = append(group, kv)
+		// 每100个kv切割一下
+		if len(group) == 100 {
+			kvGroup = append(kvGroup, group)
+			group = make([]*kvrpcpb.KeyValue, 0)
+		}
+		// TODO 非常重要
+		ggroup[l.Region.Id] = group
+	}
+	for _, group := range ggroup {
+		if len(group) > 0 {
+			kvGroup = append(kvGroup, group)
+		}
+	}
+	return kvGroup, nil
+}
+
+func (p *Proxy) insertRows(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue) (affected uint64, duplicateKey []byte, err error) {
+	return p.writeRows(txn, t, colMap, rows, t.PkDupCheck())
+}
+
+var errDuplicateRows = errors.New("duplicate rows")
+
+// checkDup为false时, 已存在的行会被覆盖
+func (p *Proxy) writeRows(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue, checkDup bool) (affected uint64, duplicateKey []byte, err error) {
+	if txn != nil {
+		return p.txnWriteRows(txn, t, colMap, rows, checkDup)
+	}
+	// 有索引的表以隐式事务写入, 提交时在行锁内清理被覆盖的行的旧索引项
+	if len(t.GetIndexes()) > 0 {
+		err = p.autoCommit(func(txn *Txn) (err error) {
+			affected, duplicateKey, err = p.txnWriteRows(txn, t, colMap, rows, checkDup)
+			if err == nil && len(duplicateKey) != 0 {
+				// 主键冲突时整批都不写入
+				err = errDuplicateRows
+			}
+			return
+		})
+		if err == errDuplicateRows {
+			affected, err = 0, nil
+		}
+		return
+	}
 	if len(rows) > 1 {
-		return p.batchInsert(t, colMap, rows)
+		return p.batchInsert(t, colMap, rows, checkDup)
 	} else {
 		var kvPairs []*kvrpcpb.KeyValue
 		var kv *kvrpcpb.KeyValue
@@ -353,22 +599,34 @@ func (p *Proxy) insertRows(t *Table, colMap map[string]int, rows []InsertRowValu
 			}
 			kvPairs = append(kvPairs, kv)
 		}
-		return p.insert(t, kvPairs)
+		return p.insertKvs(t, kvPairs, checkDup)
 	}
 	return
 }
 
-func (p *Proxy) insert(t *Table, rows []*kvrpcpb.KeyValue) (
+// checkDup为false时, 已存在的行会被覆盖
+// 主键冲突时返回duplicateKey, 不返回错误
+func (p *Proxy) insertKvs(t *Table, rows []*kvrpcpb.KeyValue, checkDup bool) (
+	affected uint64, duplicateKey []byte, err error) {
+	return p.insertKvsAt(t, rows, checkDup, p.clock.Now())
+}
+
+// ts为写入的时间戳, 事务提交时所有写入使用同一个提交时间戳
+func (p *Proxy) insertKvsAt(t *Table, rows []*kvrpcpb.KeyValue, checkDup bool, ts timestamp.Timestamp) (
 	affected uint64, duplicateKey []byte, err error) {
 	if len(rows) == 0 {
 		err = ErrEmptyRow
 		return
 	}
-	now := p.clock.Now()
+	if len(t.GetIndexes()) > 0 {
+		if err = p.insertKvIndexes(t, rows, ts); err != nil {
+			return
+		}
+	}
 	req := &kvrpcpb.InsertRequest{
 		Rows:           rows,
-		CheckDuplicate: t.PkDupCheck(),
-		Timestamp:      &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+		CheckDuplicate: checkDup,
+		Timestamp:      &timestamp.Timestamp{WallTime: ts.WallTime, Logical: ts.Logical},
 	}
 	var resps []*kvrpcpb.InsertResponse
 	proxy := dskv.GetKvProxy()
@@ -385,6 +643,10 @@ func (p *Proxy) insert(t *Table, rows []*kvrpcpb.KeyValue) (
 			affected = resp.GetAffectedKeys()
 		} else if resp.GetDuplicateKey() != nil {
 			duplicateKey = resp.GetDuplicateKey()
+			return
+		} else if resp.GetCode() == dskv.CodeDuplicate {
+			duplicateKey = rows[0].GetKey()
+			return
 		}
 		if resp.GetCode() >0 {
 			err = CodeToErr(int(resp.GetCode()))
@@ -404,6 +666,9 @@ func (p *Proxy) insert(t *Table, rows []*kvrpcpb.KeyValue) (
 			} else if resp.GetDuplicateKey() != nil {
 				duplicateKey = resp.GetDuplicateKey()
 				return
+			} else if resp.GetCode() == dskv.CodeDuplicate {
+				duplicateKey = rows[0].GetKey()
+				return
 			}
 			if resp.GetCode() >0 {
 				err = CodeToErr(int(resp.GetCode()))
@@ -424,10 +689,8 @@ func (p *Proxy) autoAddColumn(t *Table, cols []string) error {
 		if err != nil {
 			return err
 		}
-		if t != nil {
-			table := NewTable(_t, db.cli, 5*time.Minute)
-			table.ranges = t.ranges
-			db.AddTable(table)
+		if _t != nil {
+			db.UpdateTable(_t)
 		}
 	}
 	return nil
@@ -447,13 +710,43 @@ func (p KvParisSlice) Less(i int, j int) bool {
 	return bytes.Compare(p[i].GetKey(), p[j].GetKey()) < 0
 }
 
-func findRowExpire(colMap map[string]int, rowValue InsertRowValue) (int64, error) {
-	idx, ok := colMap[util.TTL_COL_NAME]
+// 表的过期列, 没有设置过期策略时兼容使用ttl列
+func ttlColumnName(t *Table) string {
+	if name := t.GetTtl().GetColumn(); len(name) > 0 {
+		return name
+	}
+	return util.TTL_COL_NAME
+}
+
+// 表设置了按插入时间过期, 插入时没有指定过期列的行过期时间为当前时间加上duration
+func fillRowTTL(t *Table, colMap map[string]int, rows []InsertRowValue) []InsertRowValue {
+	ttl := t.GetTtl()
+	if ttl.GetDuration() <= 0 || t.FindColumn(ttl.GetColumn()) == nil {
+		return rows
+	}
+	if _, ok := colMap[ttl.GetColumn()]; ok || len(rows) == 0 {
+		return rows
+	}
+	// 每行的列数相同, 过期列追加到最后
+	colMap[ttl.GetColumn()] = len(rows[0])
+	expireAt := []byte(strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond)+ttl.GetDuration(), 10))
+	for i, r := range rows {
+		rows[i] = append(r, expireAt)
+	}
+	return rows
+}
+
+func findRowExpire(t *Table, colMap map[string]int, rowValue InsertRowValue) (int64, error) {
+	name := ttlColumnName(t)
+	idx, ok := colMap[name]
 	if !ok {
 		return 0, nil
 	}
 	if idx >= len(rowValue) {
-		return 0, fmt.Errorf("invalid column(%s) pos", util.TTL_COL_NAME)
+		return 0, fmt.Errorf("invalid column(%s) pos", name)
+	}
+	if rowValue[idx] == nil {
+		return 0, nil
 	}
 	ttl, err := strconv.ParseInt(hack.String(rowValue[idx]), 10, 64)
 	if err != nil {
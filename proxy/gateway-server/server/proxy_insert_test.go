@@ -0,0 +1,62 @@
+package server
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+)
+
+func TestFillRowTTL(t *testing.T) {
+	table := NewTable(&metapb.Table{
+		Name: "session",
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "expire_at", Id: 2, DataType: metapb.DataType_BigInt, Nullable: true},
+		},
+		Ttl: &metapb.TableTTL{Column: "expire_at", Duration: 60000},
+	}, nil, tableCacheTTL)
+
+	// 指定了过期列时不自动填充
+	colMap := map[string]int{"id": 0, "expire_at": 1}
+	rows := fillRowTTL(table, colMap, []InsertRowValue{{SQLValue("1"), SQLValue("1000")}})
+	expireAt, err := findRowExpire(table, colMap, rows[0])
+	if err != nil || expireAt != 1000*int64(time.Millisecond) {
+		t.Fatalf("unexpected expire %d, err %v", expireAt, err)
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	colMap = map[string]int{"id": 0}
+	rows = fillRowTTL(table, colMap, []InsertRowValue{{SQLValue("1")}, {SQLValue("2")}})
+	if colMap["expire_at"] != 1 {
+		t.Fatalf("unexpected column map %v", colMap)
+	}
+	for _, r := range rows {
+		ms, err := strconv.ParseInt(string(r[1]), 10, 64)
+		if err != nil || ms < now+60000 || ms > now+61000 {
+			t.Fatalf("unexpected ttl value %s", r[1])
+		}
+		expireAt, err := findRowExpire(table, colMap, r)
+		if err != nil || expireAt != ms*int64(time.Millisecond) {
+			t.Fatalf("unexpected expire %d, err %v", expireAt, err)
+		}
+	}
+
+	// 没有设置过期策略时兼容ttl列
+	table = NewTable(&metapb.Table{
+		Name: "session",
+		Columns: []*metapb.Column{
+			{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+			{Name: "ttl", Id: 2, DataType: metapb.DataType_BigInt, Nullable: true},
+		},
+	}, nil, tableCacheTTL)
+	colMap = map[string]int{"id": 0, "ttl": 1}
+	rows = fillRowTTL(table, colMap, []InsertRowValue{{SQLValue("1"), SQLValue("2000")}})
+	if len(rows[0]) != 2 {
+		t.Fatalf("unexpected row %v", rows[0])
+	}
+	if expireAt, err := findRowExpire(table, colMap, rows[0]); err != nil || expireAt != 2000*int64(time.Millisecond) {
+		t.Fatalf("unexpected expire %d, err %v", expireAt, err)
+	}
+}
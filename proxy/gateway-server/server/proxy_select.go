@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"bytes"
+	"context"
 	"proxy/gateway-server/mysql"
 	"proxy/gateway-server/sqlparser"
 	"model/pkg/kvrpcpb"
@@ -14,7 +15,19 @@ import (
 	"proxy/store/dskv"
 )
 
-func (p *Proxy) HandleSelect(db string, stmt *sqlparser.Select, args []interface{}) (*mysql.Result, error) {
+// 解析后的select语句
+type selectPlan struct {
+	table     *Table
+	fieldList []*kvrpcpb.SelectField
+	columns   []string // 返回给客户端的列, 不包含为排序追加的列
+	where     [][]Match
+	limit     *Limit
+	order     rowOrder   // 没有order by时为nil
+	group     *groupPlan // 不是聚合查询时为nil
+}
+
+// txn不为nil时查询结果包含事务中未提交的写入
+func (p *Proxy) HandleSelect(db string, stmt *sqlparser.Select, args []interface{}, txn *Txn) (*mysql.Result, error) {
 	//var parseTime time.Time
 	//start := time.Now()
 	//defer func() {
@@ -27,7 +40,104 @@ func (p *Proxy) HandleSelect(db string, stmt *sqlparser.Select, args []interface
 	//		log.Info("[select slow log %v %v ", delay.String(), trace.String())
 	//	}
 	//}()
-	parser := &StmtParser{}
+	plan, err := p.planSelect(db, stmt, args)
+	if err != nil {
+		return nil, err
+	}
+	var res *mysql.Result
+	err = p.consistentSelect(plan, func() (err error) {
+		res, err = p.selectResult(stmt, plan, txn)
+		return
+	})
+	return res, err
+}
+
+func (p *Proxy) selectResult(stmt *sqlparser.Select, plan *selectPlan, txn *Txn) (*mysql.Result, error) {
+	if plan.group != nil {
+		rows, err := p.selectGrouped(txn, plan)
+		if err != nil {
+			return nil, err
+		}
+		return buildSortedResult(plan.columns, &sliceIterator{rows: rows})
+	}
+	if plan.order != nil {
+		it, err := p.selectSorted(txn, plan)
+		if err != nil {
+			return nil, err
+		}
+		defer it.close()
+		return buildSortedResult(plan.columns, it)
+	}
+
+	//parseTime = time.Now()
+	// 向dataserver查询
+	rowss, err := p.selectWhere(txn, plan.table, plan.fieldList, plan.where, plan.limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 合并结果
+	return buildSelectResult(stmt, rowss, plan.columns)
+}
+
+// 读取期间有事务在写入时重新读取, 见consistentRead; 按主键读取一行时不需要检查
+func (p *Proxy) consistentSelect(plan *selectPlan, read func() error) error {
+	if isPointWhere(plan.table, plan.where) {
+		return read()
+	}
+	return p.consistentRead(plan.table, read)
+}
+
+func isPointWhere(t *Table, where [][]Match) bool {
+	if len(where) != 1 {
+		return false
+	}
+	for _, pk := range t.PKS() {
+		found := false
+		for _, m := range where[0] {
+			if m.column == pk && m.matchType == Equal {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// 排序后逐行返回查询结果, 用于没有limit的order by查询, 结果不在gateway中缓存
+// 逐行返回的结果不能重新读取, 不检查读取期间是否有事务在写入
+// 不需要排序时返回的rowIterator为nil
+func (p *Proxy) HandleSortedSelect(db string, stmt *sqlparser.Select, args []interface{}, txn *Txn) ([]string, rowIterator, error) {
+	plan, err := p.planSelect(db, stmt, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	if plan.group != nil {
+		var rows []*Row
+		err = p.consistentSelect(plan, func() (err error) {
+			rows, err = p.selectGrouped(txn, plan)
+			return
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return plan.columns, &sliceIterator{rows: rows}, nil
+	}
+	if plan.order == nil {
+		return nil, nil, nil
+	}
+	it, err := p.selectSorted(txn, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan.columns, it, nil
+}
+
+func (p *Proxy) planSelect(db string, stmt *sqlparser.Select, args []interface{}) (*selectPlan, error) {
+	parser := &StmtParser{args: args}
 
 	// 解析表名
 	tableName := parser.parseTable(stmt)
@@ -36,31 +146,49 @@ func (p *Proxy) HandleSelect(db string, stmt *sqlparser.Select, args []interface
 		log.Error("[select] table %s.%s doesn.t exist", db, tableName)
 		return nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
 	}
+	plan, err := newSelectPlan(t, stmt, parser)
+	if isUnknownColumnError(err) {
+		// 表结构有更新时按新的表结构重新生成执行计划
+		if nt := p.router.RefreshTable(db, tableName); nt != nil {
+			return newSelectPlan(nt, stmt, &StmtParser{args: args})
+		}
+	}
+	return plan, err
+}
 
+func newSelectPlan(t *Table, stmt *sqlparser.Select, parser *StmtParser) (*selectPlan, error) {
 	// 解析选择列
 	cols, err := parser.parseSelectCols(stmt)
 	if err != nil {
 		log.Error("[select] parse colum error: %v", err)
 		return nil, fmt.Errorf("handle select parseColumn err %s", err.Error())
 	}
-	fieldList, err := makeFieldList(t, cols)
+	fieldList, hasCol, hasAggre, err := makeSelectFieldList(t, cols)
 	if err != nil {
 		log.Error("[select] find %s.%s field list error(%s), ", t.DbName(), t.Name(), err)
 		return nil, err
 	}
+	grouped := len(stmt.GroupBy) > 0 || stmt.Having != nil
+	if hasCol && hasAggre && !grouped {
+		log.Error("[select] %s.%s select list contains both column and aggregate function", t.DbName(), t.Name())
+		return nil, fmt.Errorf("In aggregated query without GROUP BY SELECT list contains nonaggregated column")
+	}
+	columns, err := fieldList2ColNames(fieldList)
+	if err != nil {
+		log.Error("[select] Table %s.%s covert field list to column name failed(%v)", t.DbName(), t.Name(), err)
+		return nil, fmt.Errorf("covert field list error(%v)", err)
+	}
+	plan := &selectPlan{table: t, fieldList: fieldList, columns: columns}
 
 	// 解析where条件
-	var matchs []Match
 	if stmt.Where != nil {
-		// TODO: 支持OR表达式
-		matchs, err = parser.parseWhere(stmt.Where)
+		plan.where, err = parser.parseWhere(stmt.Where)
 		if err != nil {
 			log.Error("handle select parse where error(%v)", err.Error())
 			return nil, err
 		}
 	}
 
-	var limit *Limit
 	if stmt.Limit != nil {
 		offset, count, err := parseLimit(stmt.Limit)
 		if err != nil {
@@ -71,31 +199,30 @@ func (p *Proxy) HandleSelect(db string, stmt *sqlparser.Select, args []interface
 			log.Warn("limit count exceeding the maximum limit")
 			return nil, ErrExceedMaxLimit
 		}
-		limit = &Limit{offset: offset, rowCount: count}
+		plan.limit = &Limit{offset: offset, rowCount: count}
+	}
+
+	// 聚合查询在合并各range的部分聚合结果后计算having和排序
+	if grouped || hasAggre {
+		if err = plan.parseGroupBy(stmt, parser); err != nil {
+			log.Error("[select] parse group by error(%v)", err)
+			return nil, err
+		}
+	}
+	if len(stmt.OrderBy) > 0 {
+		if err = plan.parseOrderBy(stmt); err != nil {
+			log.Error("[select] parse order by error(%v)", err)
+			return nil, err
+		}
 	}
 
 	if log.GetFileLogger().IsEnableDebug() {
 		log.Debug("where %v", stmt.Where)
 		log.Debug("have %v", stmt.Having)
 		log.Debug("cols %v", cols)
-		log.Debug("matchs %v", matchs)
+		log.Debug("where %v", plan.where)
 	}
-
-	//parseTime = time.Now()
-	// 向dataserver查询
-	rowss, err := p.doSelect(t, fieldList, matchs, limit, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	columns, err := fieldList2ColNames(fieldList)
-	if err != nil {
-		log.Error("[select] Table %s.%s covert field list to column name failed(%v)", t.DbName(), t.Name(), err)
-		return nil, fmt.Errorf("covert field list error(%v)", err)
-	}
-
-	// 合并结果
-	return buildSelectResult(stmt, rowss, columns)
+	return plan, nil
 }
 
 func (p *Proxy) doSelect(t *Table, fieldList []*kvrpcpb.SelectField, matches []Match, limit *Limit, userScope *Scope) ([][]*Row, error) {
@@ -193,7 +320,125 @@ func (p *Proxy) singleSelectRemote(kvproxy *dskv.KvProxy, req *kvrpcpb.SelectReq
 	return [][]*kvrpcpb.Row{rows}, nil
 }
 
+// 查询范围跨多个range时按range拆分, 并发查询后按key的顺序合并
 func (p *Proxy) rangeSelectRemote(kvproxy *dskv.KvProxy, sreq *kvrpcpb.SelectRequest) ([][]*kvrpcpb.Row, error) {
+	if p.config.ScanConcurrency <= 1 {
+		return p.scanScope(kvproxy, sreq, sreq.Scope, sreq.Limit)
+	}
+	scopes, err := splitScanScope(kvproxy.RangeCache, sreq.Scope)
+	if err != nil {
+		log.Error("split scan scope %v failed, err[%v]", sreq.Scope, err)
+		return nil, err
+	}
+	if len(scopes) <= 1 {
+		return p.scanScope(kvproxy, sreq, sreq.Scope, sreq.Limit)
+	}
+	return p.parallelScan(kvproxy, sreq, scopes)
+}
+
+// 按缓存中的range边界拆分查询范围, range可能已经分裂, 每个子范围仍然按顺序查询
+func splitScanScope(cache *dskv.RangeCache, scope *kvrpcpb.Scope) ([]*kvrpcpb.Scope, error) {
+	bo := dskv.NewBackoffer(dskv.GetMaxBackoff, context.Background())
+	locations, err := cache.ListKeyLocationsInKeyRange(bo, scope.Start, scope.Limit)
+	if err != nil {
+		return nil, err
+	}
+	scopes := make([]*kvrpcpb.Scope, 0, len(locations))
+	for _, l := range locations {
+		start, end := scope.Start, scope.Limit
+		if bytes.Compare(l.StartKey, start) > 0 {
+			start = l.StartKey
+		}
+		if len(l.EndKey) != 0 && bytes.Compare(l.EndKey, end) < 0 {
+			end = l.EndKey
+		}
+		scopes = append(scopes, &kvrpcpb.Scope{Start: start, Limit: end})
+	}
+	return scopes, nil
+}
+
+// 并发查询多个子范围, 同时执行的查询不超过ScanConcurrency
+// 按子范围的顺序合并结果, 满足limit后不再发起后续查询
+func (p *Proxy) parallelScan(kvproxy *dskv.KvProxy, sreq *kvrpcpb.SelectRequest, scopes []*kvrpcpb.Scope) ([][]*kvrpcpb.Row, error) {
+	limit := sreq.Limit
+	var subLimit *kvrpcpb.Limit
+	if limit != nil {
+		// 不知道每个子范围跳过的行数, offset在gateway中计算
+		subLimit = &kvrpcpb.Limit{Offset: 0, Count: limit.Offset + limit.Count}
+	}
+	tasks := make([]*scanTask, len(scopes))
+	next := 0
+	launch := func() {
+		task := newScanTask(p, kvproxy, sreq, scopes[next], subLimit)
+		tasks[next] = task
+		next++
+		if err := p.Submit(task); err != nil {
+			// 在Wait中执行
+			log.Warn("submit scan task failed, err[%v]", err)
+		}
+	}
+	for next < len(scopes) && next < p.config.ScanConcurrency {
+		launch()
+	}
+
+	var allRows [][]*kvrpcpb.Row
+	var skipped, count uint64
+	var err error
+	finished := false
+	// 已经发起的查询都要等待结束, kvproxy在返回后会被回收
+	for i := 0; i < next; i++ {
+		task := tasks[i]
+		e := task.Wait()
+		if finished {
+			continue
+		}
+		if e != nil {
+			err = e
+			finished = true
+			continue
+		}
+		for _, rows := range task.rows {
+			if limit != nil {
+				rows, skipped, count = limitScanRows(rows, limit, skipped, count)
+				if count >= limit.Count {
+					finished = true
+				}
+			}
+			if len(rows) > 0 {
+				allRows = append(allRows, rows)
+			}
+			if finished {
+				break
+			}
+		}
+		if !finished && next < len(scopes) {
+			launch()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return allRows, nil
+}
+
+// 按limit截取按顺序合并的行, skipped和count为之前已经跳过和返回的行数
+func limitScanRows(rows []*kvrpcpb.Row, limit *kvrpcpb.Limit, skipped, count uint64) ([]*kvrpcpb.Row, uint64, uint64) {
+	if skipped < limit.Offset {
+		n := limit.Offset - skipped
+		if uint64(len(rows)) <= n {
+			return nil, skipped + uint64(len(rows)), count
+		}
+		rows = rows[n:]
+		skipped = limit.Offset
+	}
+	if uint64(len(rows))+count > limit.Count {
+		rows = rows[:limit.Count-count]
+	}
+	return rows, skipped, count + uint64(len(rows))
+}
+
+// 顺序查询scope范围内的所有range
+func (p *Proxy) scanScope(kvproxy *dskv.KvProxy, sreq *kvrpcpb.SelectRequest, scope *kvrpcpb.Scope, limit *kvrpcpb.Limit) ([][]*kvrpcpb.Row, error) {
 	var key, start, end []byte
 	var resp *kvrpcpb.SelectResponse
 	var route *dskv.KeyLocation
@@ -201,8 +446,6 @@ func (p *Proxy) rangeSelectRemote(kvproxy *dskv.KvProxy, sreq *kvrpcpb.SelectReq
 	var allRows [][]*kvrpcpb.Row
 	var all, count uint64
 	var offset, rawCount uint64
-	scope := sreq.Scope
-	limit := sreq.Limit
 	var subLimit *kvrpcpb.Limit
 
 	start = scope.Start
@@ -238,6 +481,7 @@ func (p *Proxy) rangeSelectRemote(kvproxy *dskv.KvProxy, sreq *kvrpcpb.SelectReq
 			Scope:        scope,
 			FieldList:    sreq.FieldList,
 			WhereFilters: sreq.WhereFilters,
+			GroupBys:     sreq.GroupBys,
 			Limit:        subLimit,
 			Timestamp:    &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
 		}
@@ -0,0 +1,418 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"proxy/gateway-server/errors"
+	"proxy/gateway-server/sqlparser"
+	"util/log"
+)
+
+// 聚合查询的分组和having条件
+// 每个range按分组返回部分聚合结果, gateway按分组列的值合并后再计算having、order by和limit
+type groupPlan struct {
+	keys    []int            // 分组列在fieldList中的位置
+	columns []*metapb.Column // 下推给dataserver的分组列
+	having  [][]Match        // 按聚合结果的列名匹配
+	table   *Table           // 聚合结果组成的虚拟表, 用于计算having条件
+}
+
+// 解析group by和having, 分组列和having中引用的列、聚合函数不在查询列中时追加到fieldList末尾
+func (pl *selectPlan) parseGroupBy(stmt *sqlparser.Select, parser *StmtParser) error {
+	group := &groupPlan{}
+	pl.group = group
+	for _, expr := range stmt.GroupBy {
+		var index int
+		if n, ok := expr.(sqlparser.NumVal); ok {
+			// group by 1 按查询列的位置分组
+			i, err := strconv.Atoi(string(n))
+			if err != nil || i < 1 || i > len(pl.columns) {
+				return fmt.Errorf("Unknown column '%s' in 'group statement'", string(n))
+			}
+			index = i - 1
+		} else {
+			i, err := pl.resolveField(stmt, expr, "group statement")
+			if err != nil {
+				return err
+			}
+			index = i
+		}
+		f := pl.fieldList[index]
+		if f.Typ != kvrpcpb.SelectField_Column {
+			name, _ := makeFieldName(f)
+			return fmt.Errorf("Can't group on '%s'", name)
+		}
+		group.keys = append(group.keys, index)
+		group.columns = append(group.columns, f.Column)
+	}
+
+	if stmt.Having != nil {
+		expr, err := pl.rewriteHaving(stmt, stmt.Having.Expr)
+		if err != nil {
+			return err
+		}
+		if group.having, err = parser.parseMatch(expr); err != nil {
+			return err
+		}
+	}
+	group.table = aggreResultTable(pl.table, pl.fieldList)
+	return nil
+}
+
+// 把having条件中引用的列、别名和聚合函数替换为聚合结果的列名
+// 比较的右边只能是常量
+func (pl *selectPlan) rewriteHaving(stmt *sqlparser.Select, expr sqlparser.BoolExpr) (sqlparser.BoolExpr, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, err := pl.rewriteHaving(stmt, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := pl.rewriteHaving(stmt, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.AndExpr{Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, err := pl.rewriteHaving(stmt, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := pl.rewriteHaving(stmt, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.OrExpr{Left: left, Right: right}, nil
+	case *sqlparser.NotExpr:
+		inner, err := pl.rewriteHaving(stmt, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.NotExpr{Expr: inner}, nil
+	case *sqlparser.ParenBoolExpr:
+		inner, err := pl.rewriteHaving(stmt, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.ParenBoolExpr{Expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		left, err := pl.havingColumn(stmt, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.ComparisonExpr{Operator: e.Operator, Left: left, Right: e.Right}, nil
+	case *sqlparser.RangeCond:
+		left, err := pl.havingColumn(stmt, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.RangeCond{Operator: e.Operator, Left: left, From: e.From, To: e.To}, nil
+	case *sqlparser.NullCheck:
+		left, err := pl.havingColumn(stmt, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &sqlparser.NullCheck{Operator: e.Operator, Expr: left}, nil
+	default:
+		return nil, fmt.Errorf("unsupported having expression %s", sqlparser.String(expr))
+	}
+}
+
+func (pl *selectPlan) havingColumn(stmt *sqlparser.Select, expr sqlparser.ValExpr) (sqlparser.ValExpr, error) {
+	i, err := pl.resolveField(stmt, expr, "having clause")
+	if err != nil {
+		return nil, err
+	}
+	name, err := makeFieldName(pl.fieldList[i])
+	if err != nil {
+		return nil, err
+	}
+	return &sqlparser.ColName{Name: []byte(name)}, nil
+}
+
+// 聚合结果的列类型: count是无符号整数, avg是浮点数, 其他跟原来的列相同
+func aggreResultTable(t *Table, fieldList []*kvrpcpb.SelectField) *Table {
+	columns := make([]*metapb.Column, 0, len(fieldList))
+	names := make(map[string]bool, len(fieldList))
+	for _, f := range fieldList {
+		name, _ := makeFieldName(f)
+		if names[name] {
+			continue
+		}
+		names[name] = true
+		col := &metapb.Column{Name: name, Id: uint64(len(columns) + 1)}
+		switch {
+		case f.AggreFunc == "count":
+			col.DataType = metapb.DataType_BigInt
+			col.Unsigned = true
+		case f.AggreFunc == "avg":
+			col.DataType = metapb.DataType_Double
+		default:
+			col.DataType = f.Column.GetDataType()
+			col.Unsigned = f.Column.GetUnsigned()
+		}
+		columns = append(columns, col)
+	}
+	return NewTable(&metapb.Table{DbName: t.DbName(), Name: t.Name(), Columns: columns}, nil, 0)
+}
+
+// 聚合查询, 返回合并、having过滤、排序并按limit截取后的行, 行中包含追加的列
+// 条件能直接下推时每个range按分组返回部分聚合结果, 否则在gateway中过滤后逐行聚合
+func (p *Proxy) selectGrouped(txn *Txn, plan *selectPlan) ([]*Row, error) {
+	t := plan.table
+	group := plan.group
+	where, err := expandPKIn(t, plan.where)
+	if err != nil {
+		return nil, err
+	}
+	var partials [][]*Row
+	if isSimpleWhere(where) && !p.hasIndexPlan(txn, t, where) && (txn == nil || len(txn.tableMutations(t)) == 0) {
+		partials, err = p.groupSelectRemote(t, plan.fieldList, group.columns, firstAndMatches(where))
+	} else {
+		// 需要在gateway中过滤或者合并事务中的写入, 最多查询MaxLimit行, 超过时报错, 不返回不完整的聚合结果
+		var rows []*txnRow
+		var truncated bool
+		rows, truncated, err = p.filterRows(txn, t, where)
+		if err == nil && truncated {
+			log.Warn("[select] Table %s.%s matched rows exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+			err = ErrExceedMaxLimit
+		}
+		if err == nil && (len(rows) > 0 || len(group.keys) == 0) {
+			partials = [][]*Row{partialAggreRows(plan.fieldList, rows)}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mergeGroupRows(plan.fieldList, group.keys, partials)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		if err = finishAggreRow(plan.fieldList, r); err != nil {
+			return nil, err
+		}
+	}
+	if rows, err = filterHaving(plan.fieldList, group, rows); err != nil {
+		return nil, err
+	}
+
+	if plan.order != nil {
+		plan.order.sort(rows)
+	} else if len(group.keys) > 0 {
+		order := make(rowOrder, 0, len(group.keys))
+		for _, k := range group.keys {
+			order = append(order, orderKey{index: k})
+		}
+		order.sort(rows)
+	}
+	if plan.limit != nil {
+		rows = limitGroupRows(rows, plan.limit)
+	}
+	return rows, nil
+}
+
+// 下推分组和聚合函数, 不限制返回的行数
+func (p *Proxy) groupSelectRemote(t *Table, fieldList []*kvrpcpb.SelectField, groupBys []*metapb.Column, matches []Match) ([][]*Row, error) {
+	pbMatches, err := makePBMatches(t, matches)
+	if err != nil {
+		log.Error("[select]covert filter failed(%v), Table: %s.%s", err, t.DbName(), t.Name())
+		return nil, err
+	}
+	key, scope, err := findPKScope(t, pbMatches)
+	if err != nil {
+		log.Error("[select]get pk scope failed(%v), Table: %s.%s", err, t.DbName(), t.Name())
+		return nil, err
+	}
+	now := p.clock.Now()
+	sreq := &kvrpcpb.SelectRequest{
+		Key:          key,
+		Scope:        scope,
+		FieldList:    fieldList,
+		WhereFilters: pbMatches,
+		GroupBys:     groupBys,
+		Timestamp:    &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+	}
+	return p.selectRemote(t, sreq)
+}
+
+// 按分组列的值合并多个range返回的部分聚合结果, 分组按第一次出现的顺序返回
+func mergeGroupRows(fieldList []*kvrpcpb.SelectField, keys []int, partials [][]*Row) ([]*Row, error) {
+	groups := make(map[string]*Row)
+	var result []*Row
+	var buf []byte
+	keyRow := &Row{fields: make([]Field, len(keys))}
+	for _, rows := range partials {
+		for _, r := range rows {
+			for i, k := range keys {
+				keyRow.fields[i] = r.fields[k]
+			}
+			buf = encodeSortRow(buf[:0], keyRow)
+			g, ok := groups[string(buf)]
+			if !ok {
+				groups[string(buf)] = r
+				result = append(result, r)
+				continue
+			}
+			if err := mergeAggreRow(fieldList, g, r); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// 把src的部分聚合结果合并到dst, 普通列保留dst的值
+// sum和avg的aggreCount是参与计算的非NULL行数
+func mergeAggreRow(fieldList []*kvrpcpb.SelectField, dst, src *Row) error {
+	for i, f := range fieldList {
+		if f.Typ != kvrpcpb.SelectField_AggreFunction {
+			continue
+		}
+		d, s := &dst.fields[i], src.fields[i]
+		switch f.AggreFunc {
+		case "count":
+			v, err := addAggreValue(d.value, s.value)
+			if err != nil {
+				return err
+			}
+			d.value = v
+		case "sum", "avg":
+			if s.value == nil {
+				continue
+			}
+			if d.value == nil {
+				d.value, d.aggreCount = s.value, s.aggreCount
+				continue
+			}
+			v, err := addAggreValue(d.value, s.value)
+			if err != nil {
+				return err
+			}
+			d.value = v
+			d.aggreCount += s.aggreCount
+		case "min":
+			if s.value != nil && (d.value == nil || compareSortValue(s.value, d.value) < 0) {
+				d.value = s.value
+			}
+		case "max":
+			if s.value != nil && (d.value == nil || compareSortValue(s.value, d.value) > 0) {
+				d.value = s.value
+			}
+		}
+	}
+	return nil
+}
+
+// 两个部分聚合值相加, 类型不同时按浮点数相加
+func addAggreValue(a, b interface{}) (interface{}, error) {
+	if a == nil {
+		return b, nil
+	}
+	if b == nil {
+		return a, nil
+	}
+	switch va := a.(type) {
+	case int64:
+		if vb, ok := b.(int64); ok {
+			return va + vb, nil
+		}
+	case uint64:
+		if vb, ok := b.(uint64); ok {
+			return va + vb, nil
+		}
+	case float64:
+		if vb, ok := b.(float64); ok {
+			return va + vb, nil
+		}
+	}
+	fa, err := aggreFloat(a)
+	if err != nil {
+		return nil, err
+	}
+	fb, err := aggreFloat(b)
+	if err != nil {
+		return nil, err
+	}
+	return fa + fb, nil
+}
+
+func aggreFloat(v interface{}) (float64, error) {
+	switch value := v.(type) {
+	case int64:
+		return float64(value), nil
+	case uint64:
+		return float64(value), nil
+	case float64:
+		return value, nil
+	case []byte:
+		return strconv.ParseFloat(string(value), 64)
+	default:
+		return 0, errors.ErrSumColumnType
+	}
+}
+
+// 计算合并后的最终值: avg = sum / count
+func finishAggreRow(fieldList []*kvrpcpb.SelectField, r *Row) error {
+	for i, f := range fieldList {
+		if f.AggreFunc != "avg" {
+			continue
+		}
+		field := &r.fields[i]
+		if field.value == nil || field.aggreCount == 0 {
+			field.value = nil
+			continue
+		}
+		sum, err := aggreFloat(field.value)
+		if err != nil {
+			return err
+		}
+		field.value = sum / float64(field.aggreCount)
+	}
+	return nil
+}
+
+// 保留满足having条件的分组
+func filterHaving(fieldList []*kvrpcpb.SelectField, group *groupPlan, rows []*Row) ([]*Row, error) {
+	if len(group.having) == 0 {
+		return rows, nil
+	}
+	names, err := fieldList2ColNames(fieldList)
+	if err != nil {
+		return nil, err
+	}
+	result := rows[:0]
+	for _, r := range rows {
+		values := make(map[string]interface{}, len(names))
+		for i, name := range names {
+			values[name] = r.fields[i].value
+		}
+		for _, and := range group.having {
+			ok, err := matchRow(group.table, values, and)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				result = append(result, r)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func limitGroupRows(rows []*Row, limit *Limit) []*Row {
+	if limit.offset >= uint64(len(rows)) {
+		return nil
+	}
+	rows = rows[limit.offset:]
+	if limit.rowCount < uint64(len(rows)) {
+		rows = rows[:limit.rowCount]
+	}
+	return rows
+}
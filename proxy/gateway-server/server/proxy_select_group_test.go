@@ -0,0 +1,133 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"proxy/gateway-server/sqlparser"
+)
+
+func planTestSelect(t *testing.T, sql string) (*selectPlan, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newSelectPlan(newTxnTestTable(), stmt.(*sqlparser.Select), &StmtParser{})
+}
+
+func TestParseGroupBy(t *testing.T) {
+	plan, err := planTestSelect(t, "select name, count(*) as c, avg(balance) from account group by name having c > 1 and sum(balance) >= 10 order by 3 desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, _ := fieldList2ColNames(plan.fieldList)
+	expected := []string{"name", "count(*)", "avg(balance)", "sum(balance)"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) || len(plan.columns) != 3 {
+		t.Fatalf("expected field list %v, actual %v, columns %v", expected, names, plan.columns)
+	}
+	if fmt.Sprint(plan.group.keys) != "[0]" || len(plan.group.having) != 1 || len(plan.group.having[0]) != 2 {
+		t.Fatalf("unexpected group plan %+v", plan.group)
+	}
+	if fmt.Sprint(plan.order) != fmt.Sprint(rowOrder{{index: 2, desc: true}}) {
+		t.Fatalf("unexpected order %v", plan.order)
+	}
+
+	// 分组列不在查询列中
+	plan, err = planTestSelect(t, "select count(*) from account group by name order by max(id)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, _ = fieldList2ColNames(plan.fieldList)
+	expected = []string{"count(*)", "name", "max(id)"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) || len(plan.columns) != 1 {
+		t.Fatalf("expected field list %v, actual %v, columns %v", expected, names, plan.columns)
+	}
+
+	for _, sql := range []string{
+		"select name, count(*) from account",
+		"select count(*) from account group by 1",
+		"select count(*) from account group by unknown",
+		"select count(*) from account having name = 'a'",
+		"select name from account group by name having count(*) > count(id)",
+		"select id from account order by count(*)",
+	} {
+		if _, err := planTestSelect(t, sql); err == nil {
+			t.Errorf("%s: expected error", sql)
+		}
+	}
+}
+
+func makeGroupTestRow(name interface{}, count uint64, sum interface{}, sumCount int64) *Row {
+	return &Row{fields: []Field{
+		{col: "name", value: name},
+		{col: "count(*)", value: count, aggreCount: 0},
+		{col: "avg(balance)", value: sum, aggreCount: sumCount},
+		{col: "sum(balance)", value: sum, aggreCount: sumCount},
+		{col: "min(balance)", value: sum},
+	}}
+}
+
+func TestMergeGroupRows(t *testing.T) {
+	plan, err := planTestSelect(t, "select name, count(*), avg(balance), sum(balance), min(balance) from account group by name having count(*) > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 两个range返回的部分聚合结果
+	partials := [][]*Row{
+		{
+			makeGroupTestRow([]byte("a"), 2, 3.0, 2),
+			makeGroupTestRow([]byte("b"), 1, 5.0, 1),
+			makeGroupTestRow(nil, 2, nil, 0),
+		},
+		{
+			makeGroupTestRow([]byte("b"), 3, 1.0, 2),
+			makeGroupTestRow([]byte("c"), 1, 4.0, 1),
+			makeGroupTestRow(nil, 1, 2.0, 1),
+		},
+	}
+	rows, err := mergeGroupRows(plan.fieldList, plan.group.keys, partials)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rows {
+		if err := finishAggreRow(plan.fieldList, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rows, err = filterHaving(plan.fieldList, plan.group, rows); err != nil {
+		t.Fatal(err)
+	}
+	rowOrder{{index: 0}}.sort(rows)
+	var actual []string
+	for _, r := range rows {
+		actual = append(actual, fmt.Sprintf("%s %v %v %v %v", r.fields[0].value, r.fields[1].value,
+			r.fields[2].value, r.fields[3].value, r.fields[4].value))
+	}
+	expected := []string{"%!s(<nil>) 3 2 2 2", "a 2 1.5 3 3", "b 4 2 6 1"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestAddAggreValue(t *testing.T) {
+	tests := []struct {
+		a, b     interface{}
+		expected interface{}
+	}{
+		{nil, nil, nil},
+		{int64(1), nil, int64(1)},
+		{int64(1), int64(2), int64(3)},
+		{uint64(1), uint64(2), uint64(3)},
+		{int64(1), 1.5, 2.5},
+		{[]byte("1.5"), uint64(1), 2.5},
+	}
+	for i, tt := range tests {
+		actual, err := addAggreValue(tt.a, tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("test %d: expected %v(%T), actual %v(%T)", i, tt.expected, tt.expected, actual, actual)
+		}
+	}
+}
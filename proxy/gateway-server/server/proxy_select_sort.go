@@ -0,0 +1,809 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"pkg-go/ds_client"
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	"proxy/store/dskv"
+	"util/hack"
+	"util/log"
+)
+
+// order by的一个排序列
+type orderKey struct {
+	index int // 在查询列中的位置
+	desc  bool
+}
+
+type rowOrder []orderKey
+
+func (o rowOrder) less(a, b *Row) bool {
+	for _, k := range o {
+		c := compareSortValue(a.fields[k.index].value, b.fields[k.index].value)
+		if k.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
+
+func (o rowOrder) sort(rows []*Row) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return o.less(rows[i], rows[j])
+	})
+}
+
+// 按升序比较同一列的两个值, NULL最小
+func compareSortValue(a, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch va := a.(type) {
+	case int64:
+		if vb, ok := b.(int64); ok {
+			return compareInt(va, vb)
+		}
+	case uint64:
+		if vb, ok := b.(uint64); ok {
+			return compareUint(va, vb)
+		}
+	case float64:
+		if vb, ok := b.(float64); ok {
+			return compareFloat(va, vb)
+		}
+	case []byte:
+		if vb, ok := b.([]byte); ok {
+			return bytes.Compare(va, vb)
+		}
+	}
+	return 0
+}
+
+// 解析order by, 排序列不在查询列中时追加到fieldList末尾, 返回结果前去掉
+func (pl *selectPlan) parseOrderBy(stmt *sqlparser.Select) error {
+	order := make(rowOrder, 0, len(stmt.OrderBy))
+	for _, o := range stmt.OrderBy {
+		key := orderKey{desc: o.Direction == sqlparser.AST_DESC}
+		if n, ok := o.Expr.(sqlparser.NumVal); ok {
+			// order by 1 按查询列的位置排序
+			i, err := strconv.Atoi(string(n))
+			if err != nil || i < 1 || i > len(pl.columns) {
+				return fmt.Errorf("Unknown column '%s' in 'order clause'", string(n))
+			}
+			key.index = i - 1
+		} else {
+			i, err := pl.resolveField(stmt, o.Expr, "order clause")
+			if err != nil {
+				return err
+			}
+			key.index = i
+		}
+		order = append(order, key)
+	}
+	pl.order = order
+	return nil
+}
+
+// 查找order by、group by、having中引用的列、别名或者聚合函数在fieldList中的位置
+// 不在查询列中时追加到fieldList末尾
+func (pl *selectPlan) resolveField(stmt *sqlparser.Select, expr sqlparser.ValExpr, clause string) (int, error) {
+	switch e := expr.(type) {
+	case *sqlparser.ColName:
+		name := hack.String(e.Name)
+		if len(e.Qualifier) == 0 {
+			if i := selectAliasIndex(pl.table, stmt, name); i >= 0 {
+				return i, nil
+			}
+		}
+		for i, f := range pl.fieldList {
+			if f.Typ == kvrpcpb.SelectField_Column && f.Column.GetName() == name {
+				return i, nil
+			}
+		}
+		col := pl.table.FindColumn(name)
+		// 没有group by的聚合查询不能引用普通列
+		if col == nil || (pl.group != nil && len(stmt.GroupBy) == 0) {
+			return -1, fmt.Errorf("Unknown column '%s' in '%s'", name, clause)
+		}
+		pl.fieldList = append(pl.fieldList, &kvrpcpb.SelectField{Typ: kvrpcpb.SelectField_Column, Column: col})
+		return len(pl.fieldList) - 1, nil
+	case *sqlparser.FuncExpr:
+		if pl.group == nil {
+			return -1, fmt.Errorf("Invalid use of group function")
+		}
+		aggreFunc, colName, err := parseAggreFunc(e)
+		if err != nil {
+			return -1, err
+		}
+		if _, ok := supportedAggreFuncs[aggreFunc]; !ok {
+			return -1, fmt.Errorf("unsupported aggregate function[%s]", aggreFunc)
+		}
+		for i, f := range pl.fieldList {
+			if f.Typ == kvrpcpb.SelectField_AggreFunction && f.AggreFunc == aggreFunc && f.Column.GetName() == colName {
+				return i, nil
+			}
+		}
+		var col *metapb.Column
+		if len(colName) > 0 {
+			if col = pl.table.FindColumn(colName); col == nil {
+				return -1, fmt.Errorf("Unknown column '%s' in '%s'", colName, clause)
+			}
+		}
+		pl.fieldList = append(pl.fieldList, &kvrpcpb.SelectField{
+			Typ:       kvrpcpb.SelectField_AggreFunction,
+			AggreFunc: aggreFunc,
+			Column:    col,
+		})
+		return len(pl.fieldList) - 1, nil
+	default:
+		return -1, fmt.Errorf("unsupported expression %s in '%s'", sqlparser.String(expr), clause)
+	}
+}
+
+// 别名对应的查询列在fieldList中的位置, 没有时返回-1
+func selectAliasIndex(t *Table, stmt *sqlparser.Select, alias string) int {
+	var index int
+	for _, expr := range stmt.SelectExprs {
+		switch e := expr.(type) {
+		case *sqlparser.StarExpr:
+			index += len(t.GetColumns())
+		case *sqlparser.NonStarExpr:
+			if len(e.As) > 0 && strings.EqualFold(string(e.As), alias) {
+				return index
+			}
+			index++
+		}
+	}
+	return -1
+}
+
+// 接收查询到的行
+type rowCollector interface {
+	add(r *Row) error
+}
+
+// 按顺序逐行读取排序后的结果, 读完时返回nil
+type rowIterator interface {
+	next() (*Row, error)
+	close()
+}
+
+// 保留排序后的前limit行, 堆顶是当前保留的最后一行
+type topRows struct {
+	order rowOrder
+	limit int
+	rows  []*Row
+}
+
+func newTopRows(order rowOrder, limit int) *topRows {
+	return &topRows{order: order, limit: limit}
+}
+
+func (h *topRows) Len() int           { return len(h.rows) }
+func (h *topRows) Less(i, j int) bool { return h.order.less(h.rows[j], h.rows[i]) }
+func (h *topRows) Swap(i, j int)      { h.rows[i], h.rows[j] = h.rows[j], h.rows[i] }
+
+func (h *topRows) Push(x interface{}) {
+	h.rows = append(h.rows, x.(*Row))
+}
+
+func (h *topRows) Pop() interface{} {
+	n := len(h.rows)
+	r := h.rows[n-1]
+	h.rows = h.rows[:n-1]
+	return r
+}
+
+func (h *topRows) add(r *Row) error {
+	if h.limit <= 0 {
+		return nil
+	}
+	if len(h.rows) < h.limit {
+		heap.Push(h, r)
+	} else if h.order.less(r, h.rows[0]) {
+		h.rows[0] = r
+		heap.Fix(h, 0)
+	}
+	return nil
+}
+
+// 外部排序, 内存中最多缓存bufferRows行, 超过后排序写入临时文件, 最后多路归并
+// 多个子范围的查询并发写入
+type externalSorter struct {
+	sync.Mutex
+	order      rowOrder
+	names      []string // 列名, 从临时文件读回行时使用
+	bufferRows int
+	dir        string
+	rows       []*Row
+	runs       []*os.File
+}
+
+func newExternalSorter(order rowOrder, names []string, bufferRows int, dir string) *externalSorter {
+	return &externalSorter{
+		order:      order,
+		names:      names,
+		bufferRows: bufferRows,
+		dir:        dir,
+	}
+}
+
+func (s *externalSorter) add(r *Row) error {
+	s.Lock()
+	defer s.Unlock()
+	s.rows = append(s.rows, r)
+	if len(s.rows) >= s.bufferRows {
+		return s.spill()
+	}
+	return nil
+}
+
+// 排序内存中的行并写入一个临时文件
+func (s *externalSorter) spill() error {
+	s.order.sort(s.rows)
+	f, err := ioutil.TempFile(s.dir, "sharkstore-sort-")
+	if err != nil {
+		log.Error("[sort] create temp file failed(%v)", err)
+		return err
+	}
+	s.runs = append(s.runs, f)
+	w := bufio.NewWriter(f)
+	var buf []byte
+	for _, r := range s.rows {
+		buf = encodeSortRow(buf[:0], r)
+		if _, err = w.Write(buf); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		log.Error("[sort] write temp file %s failed(%v)", f.Name(), err)
+		return err
+	}
+	log.Debug("[sort] spill %d rows to %s", len(s.rows), f.Name())
+	for i := range s.rows {
+		s.rows[i] = nil
+	}
+	s.rows = s.rows[:0]
+	return nil
+}
+
+// 返回排序后的所有行, 临时文件由返回的rowIterator负责删除
+func (s *externalSorter) iterator() (rowIterator, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.order.sort(s.rows)
+	if len(s.runs) == 0 {
+		return &sliceIterator{rows: s.rows}, nil
+	}
+	its := make([]rowIterator, 0, len(s.runs)+1)
+	for _, f := range s.runs {
+		its = append(its, &runIterator{file: f, reader: bufio.NewReader(f), names: s.names})
+	}
+	its = append(its, &sliceIterator{rows: s.rows})
+	s.runs = nil
+	s.rows = nil
+	return newMergeIterator(s.order, its)
+}
+
+// 删除还没有交给rowIterator的临时文件
+func (s *externalSorter) close() {
+	s.Lock()
+	defer s.Unlock()
+	for _, f := range s.runs {
+		removeSortFile(f)
+	}
+	s.runs = nil
+	s.rows = nil
+}
+
+func removeSortFile(f *os.File) {
+	f.Close()
+	if err := os.Remove(f.Name()); err != nil {
+		log.Warn("[sort] remove temp file %s failed(%v)", f.Name(), err)
+	}
+}
+
+// 临时文件中值的类型
+const (
+	sortValueNull byte = iota
+	sortValueInt
+	sortValueUint
+	sortValueFloat
+	sortValueBytes
+)
+
+// 依次编码一行的所有列, 每列为类型和值
+func encodeSortRow(buf []byte, r *Row) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	for _, f := range r.fields {
+		switch v := f.value.(type) {
+		case int64:
+			buf = append(buf, sortValueInt)
+			buf = append(buf, tmp[:binary.PutVarint(tmp[:], v)]...)
+		case uint64:
+			buf = append(buf, sortValueUint)
+			buf = append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+		case float64:
+			buf = append(buf, sortValueFloat)
+			binary.BigEndian.PutUint64(tmp[:8], math.Float64bits(v))
+			buf = append(buf, tmp[:8]...)
+		case []byte:
+			buf = append(buf, sortValueBytes)
+			buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(len(v)))]...)
+			buf = append(buf, v...)
+		default:
+			buf = append(buf, sortValueNull)
+		}
+	}
+	return buf
+}
+
+// 解码一行, 文件结束时返回io.EOF
+func decodeSortRow(reader *bufio.Reader, names []string) (*Row, error) {
+	r := &Row{fields: make([]Field, len(names))}
+	for i := range names {
+		tag, err := reader.ReadByte()
+		if err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		var value interface{}
+		switch tag {
+		case sortValueNull:
+		case sortValueInt:
+			value, err = binary.ReadVarint(reader)
+		case sortValueUint:
+			value, err = binary.ReadUvarint(reader)
+		case sortValueFloat:
+			var b [8]byte
+			if _, err = io.ReadFull(reader, b[:]); err == nil {
+				value = math.Float64frombits(binary.BigEndian.Uint64(b[:]))
+			}
+		case sortValueBytes:
+			var n uint64
+			if n, err = binary.ReadUvarint(reader); err == nil {
+				b := make([]byte, n)
+				_, err = io.ReadFull(reader, b)
+				value = b
+			}
+		default:
+			err = fmt.Errorf("invalid sort value type %d", tag)
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.fields[i].col = names[i]
+		r.fields[i].value = value
+	}
+	return r, nil
+}
+
+type sliceIterator struct {
+	rows []*Row
+	pos  int
+}
+
+func (it *sliceIterator) next() (*Row, error) {
+	if it.pos >= len(it.rows) {
+		return nil, nil
+	}
+	r := it.rows[it.pos]
+	it.pos++
+	return r, nil
+}
+
+func (it *sliceIterator) close() {
+	it.rows = nil
+}
+
+// 按顺序读取一个临时文件
+type runIterator struct {
+	file   *os.File
+	reader *bufio.Reader
+	names  []string
+}
+
+func (it *runIterator) next() (*Row, error) {
+	r, err := decodeSortRow(it.reader, it.names)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("[sort] read temp file %s failed(%v)", it.file.Name(), err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (it *runIterator) close() {
+	removeSortFile(it.file)
+}
+
+type mergeItem struct {
+	row *Row
+	it  rowIterator
+}
+
+// 多路归并多个有序的rowIterator
+type mergeIterator struct {
+	order rowOrder
+	its   []rowIterator
+	items []mergeItem
+}
+
+func newMergeIterator(order rowOrder, its []rowIterator) (*mergeIterator, error) {
+	m := &mergeIterator{order: order, its: its, items: make([]mergeItem, 0, len(its))}
+	for _, it := range its {
+		r, err := it.next()
+		if err != nil {
+			m.close()
+			return nil, err
+		}
+		if r != nil {
+			m.items = append(m.items, mergeItem{row: r, it: it})
+		}
+	}
+	heap.Init(m)
+	return m, nil
+}
+
+func (m *mergeIterator) Len() int           { return len(m.items) }
+func (m *mergeIterator) Less(i, j int) bool { return m.order.less(m.items[i].row, m.items[j].row) }
+func (m *mergeIterator) Swap(i, j int)      { m.items[i], m.items[j] = m.items[j], m.items[i] }
+
+func (m *mergeIterator) Push(x interface{}) {
+	m.items = append(m.items, x.(mergeItem))
+}
+
+func (m *mergeIterator) Pop() interface{} {
+	n := len(m.items)
+	item := m.items[n-1]
+	m.items = m.items[:n-1]
+	return item
+}
+
+func (m *mergeIterator) next() (*Row, error) {
+	if len(m.items) == 0 {
+		return nil, nil
+	}
+	r := m.items[0].row
+	nr, err := m.items[0].it.next()
+	if err != nil {
+		return nil, err
+	}
+	if nr == nil {
+		heap.Pop(m)
+	} else {
+		m.items[0].row = nr
+		heap.Fix(m, 0)
+	}
+	return r, nil
+}
+
+func (m *mergeIterator) close() {
+	for _, it := range m.its {
+		it.close()
+	}
+	m.items = nil
+}
+
+// 跳过offset行, 最多返回count行
+type limitIterator struct {
+	rowIterator
+	offset uint64
+	count  uint64
+}
+
+func (it *limitIterator) next() (*Row, error) {
+	for ; it.offset > 0; it.offset-- {
+		r, err := it.rowIterator.next()
+		if r == nil || err != nil {
+			return nil, err
+		}
+	}
+	if it.count == 0 {
+		return nil, nil
+	}
+	it.count--
+	return it.rowIterator.next()
+}
+
+// 收集order by查询的行
+// offset+count不超过排序缓存时每个子范围只保留前offset+count行, 否则使用外部排序
+type rowSorter struct {
+	order  rowOrder
+	top    *topRows
+	sorter *externalSorter
+}
+
+func newRowSorter(p *Proxy, order rowOrder, fieldList []*kvrpcpb.SelectField, limit *Limit) (*rowSorter, error) {
+	bufferRows := p.config.SortBufferRows
+	if bufferRows <= 0 {
+		bufferRows = DefaultSortBufferRows
+	}
+	s := &rowSorter{order: order}
+	if limit != nil && limit.offset+limit.rowCount <= uint64(bufferRows) {
+		s.top = newTopRows(order, int(limit.offset+limit.rowCount))
+		return s, nil
+	}
+	names, err := fieldList2ColNames(fieldList)
+	if err != nil {
+		return nil, err
+	}
+	s.sorter = newExternalSorter(order, names, bufferRows, p.config.SortTempDir)
+	return s, nil
+}
+
+// 每个子范围使用的collector
+func (s *rowSorter) rangeCollector() rowCollector {
+	if s.top != nil {
+		return newTopRows(s.order, s.top.limit)
+	}
+	return s.sorter
+}
+
+// 子范围查询完成后合并结果
+func (s *rowSorter) mergeRange(c rowCollector) error {
+	if top, ok := c.(*topRows); ok && top != s.top {
+		for _, r := range top.rows {
+			s.top.add(r)
+		}
+	}
+	return nil
+}
+
+func (s *rowSorter) add(r *Row) error {
+	if s.top != nil {
+		return s.top.add(r)
+	}
+	return s.sorter.add(r)
+}
+
+func (s *rowSorter) iterator(limit *Limit) (rowIterator, error) {
+	var it rowIterator
+	if s.top != nil {
+		s.order.sort(s.top.rows)
+		it = &sliceIterator{rows: s.top.rows}
+	} else {
+		var err error
+		if it, err = s.sorter.iterator(); err != nil {
+			return nil, err
+		}
+	}
+	if limit != nil {
+		it = &limitIterator{rowIterator: it, offset: limit.offset, count: limit.rowCount}
+	}
+	return it, nil
+}
+
+func (s *rowSorter) close() {
+	if s.sorter != nil {
+		s.sorter.close()
+	}
+}
+
+// 有order by的查询, 返回排序并按limit截取后的行, 行中包含为排序追加的列
+// 条件能直接下推时分页查询每个range, 不受MaxLimit的限制
+func (p *Proxy) selectSorted(txn *Txn, plan *selectPlan) (rowIterator, error) {
+	t := plan.table
+	where, err := expandPKIn(t, plan.where)
+	if err != nil {
+		return nil, err
+	}
+	s, err := newRowSorter(p, plan.order, plan.fieldList, plan.limit)
+	if err != nil {
+		return nil, err
+	}
+	if isSimpleWhere(where) && !p.hasIndexPlan(txn, t, where) && (txn == nil || len(txn.tableMutations(t)) == 0) {
+		err = p.sortScan(t, plan.fieldList, firstAndMatches(where), s)
+	} else {
+		// 需要在gateway中过滤或者合并事务中的写入, 最多查询MaxLimit行, 超过时报错, 不返回不完整的排序结果
+		var rows []*txnRow
+		var truncated bool
+		rows, truncated, err = p.filterRows(txn, t, where)
+		if err == nil && truncated {
+			log.Warn("[select] Table %s.%s matched rows exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+			err = ErrExceedMaxLimit
+		}
+		if err == nil {
+			for _, r := range limitRows(plan.fieldList, rows, nil) {
+				if err = s.add(r); err != nil {
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		s.close()
+		return nil, err
+	}
+	it, err := s.iterator(plan.limit)
+	if err != nil {
+		s.close()
+		return nil, err
+	}
+	return it, nil
+}
+
+// 并发分页查询所有子范围, 每个子范围的行交给rowSorter
+func (p *Proxy) sortScan(t *Table, fieldList []*kvrpcpb.SelectField, matches []Match, s *rowSorter) error {
+	pbMatches, err := makePBMatches(t, matches)
+	if err != nil {
+		log.Error("[select]covert filter failed(%v), Table: %s.%s", err, t.DbName(), t.Name())
+		return err
+	}
+	key, scope, err := findPKScope(t, pbMatches)
+	if err != nil {
+		log.Error("[select]get pk scope failed(%v), Table: %s.%s", err, t.DbName(), t.Name())
+		return err
+	}
+	if key != nil {
+		// 主键完全指定, 最多一行
+		return p.scanRows(t, fieldList, matches, s)
+	}
+
+	proxy := dskv.GetKvProxy()
+	defer dskv.PutKvProxy(proxy)
+	proxy.Init(p.dsCli, p.clock, t.ranges, client.WriteTimeout, client.ReadTimeoutShort)
+
+	sreq := &kvrpcpb.SelectRequest{FieldList: fieldList, WhereFilters: pbMatches}
+	scopes := []*kvrpcpb.Scope{scope}
+	concurrency := p.config.ScanConcurrency
+	if concurrency > 1 {
+		if scopes, err = splitScanScope(proxy.RangeCache, scope); err != nil {
+			log.Error("split scan scope %v failed, err[%v]", scope, err)
+			return err
+		}
+	} else {
+		concurrency = 1
+	}
+
+	tasks := make([]*sortScanTask, len(scopes))
+	next := 0
+	launch := func() {
+		task := newSortScanTask(p, proxy, t, sreq, scopes[next], s.rangeCollector())
+		tasks[next] = task
+		next++
+		if err := p.Submit(task); err != nil {
+			// 在Wait中执行
+			log.Warn("submit sort scan task failed, err[%v]", err)
+		}
+	}
+	for next < len(scopes) && next < concurrency {
+		launch()
+	}
+	// 已经发起的查询都要等待结束, kvproxy在返回后会被回收
+	for i := 0; i < next; i++ {
+		e := tasks[i].Wait()
+		if err != nil {
+			continue
+		}
+		if e == nil {
+			e = s.mergeRange(tasks[i].collector)
+		}
+		if e != nil {
+			err = e
+			continue
+		}
+		if next < len(scopes) {
+			launch()
+		}
+	}
+	return err
+}
+
+// 分页查询scope范围内的所有行, 每页最多MaxLimit行, 下一页从上一页最后一行之后开始
+func (p *Proxy) pageScope(kvproxy *dskv.KvProxy, t *Table, sreq *kvrpcpb.SelectRequest, scope *kvrpcpb.Scope, c rowCollector) error {
+	pageSize := p.config.MaxLimit
+	start := scope.Start
+	for {
+		now := p.clock.Now()
+		req := &kvrpcpb.SelectRequest{
+			Scope:        &kvrpcpb.Scope{Start: start, Limit: scope.Limit},
+			FieldList:    sreq.FieldList,
+			WhereFilters: sreq.WhereFilters,
+			Limit:        &kvrpcpb.Limit{Offset: 0, Count: pageSize},
+			Timestamp:    &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+		}
+		resp, route, err := kvproxy.SqlQuery(req, start)
+		if err != nil {
+			return err
+		}
+		if resp.GetCode() != 0 {
+			log.Error("remote server return code: %v", resp.GetCode())
+			return fmt.Errorf("remote server return code: %v", resp.GetCode())
+		}
+		pbRows := resp.GetRows()
+		for _, pr := range pbRows {
+			r, err := decodeRow(t, sreq.FieldList, pr)
+			if err != nil {
+				return err
+			}
+			if r == nil {
+				continue
+			}
+			if err = c.add(r); err != nil {
+				return err
+			}
+		}
+		if uint64(len(pbRows)) >= pageSize {
+			// 当前range可能还有数据
+			last := pbRows[len(pbRows)-1].GetKey()
+			if len(last) == 0 {
+				return fmt.Errorf("missing row key in select response")
+			}
+			start = append(append(make([]byte, 0, len(last)+1), last...), 0)
+			continue
+		}
+		if route == nil || len(route.EndKey) == 0 || bytes.Compare(route.EndKey, scope.Limit) >= 0 {
+			return nil
+		}
+		start = route.EndKey
+	}
+}
+
+// 把排序后的行转换为结果集, 去掉为排序追加的列
+func buildSortedResult(columns []string, it rowIterator) (*mysql.Result, error) {
+	var values [][]interface{}
+	for {
+		r, err := it.next()
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			break
+		}
+		vs := make([]interface{}, len(columns))
+		for i := range vs {
+			vs[i] = r.fields[i].value
+		}
+		values = append(values, vs)
+	}
+	if len(values) == 0 {
+		return &mysql.Result{Resultset: newEmptyResultSet(columns)}, nil
+	}
+	r, err := buildResultset(nil, columns, values)
+	if err != nil {
+		log.Error("build result set failed(%v), columns: %v", err, columns)
+		return nil, err
+	}
+	return &mysql.Result{AffectedRows: uint64(len(values)), Resultset: r}, nil
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"proxy/gateway-server/sqlparser"
+)
+
+func parseTestOrderBy(t *testing.T, sql string) (*selectPlan, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := stmt.(*sqlparser.Select)
+	table := newTxnTestTable()
+	parser := &StmtParser{}
+	cols, err := parser.parseSelectCols(sel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fieldList, err := makeFieldList(table, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns, err := fieldList2ColNames(fieldList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := &selectPlan{table: table, fieldList: fieldList, columns: columns}
+	return plan, plan.parseOrderBy(sel)
+}
+
+func TestParseOrderBy(t *testing.T) {
+	plan, err := parseTestOrderBy(t, "select id, name from account order by balance desc, 2, id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.columns) != 2 || len(plan.fieldList) != 3 || plan.fieldList[2].Column.GetName() != "balance" {
+		t.Fatalf("unexpected columns %v, field list %v", plan.columns, plan.fieldList)
+	}
+	expected := rowOrder{{index: 2, desc: true}, {index: 1}, {index: 0}}
+	if fmt.Sprint(plan.order) != fmt.Sprint(expected) {
+		t.Fatalf("expected order %v, actual %v", expected, plan.order)
+	}
+
+	for _, sql := range []string{
+		"select id from account order by unknown",
+		"select id from account order by 2",
+		"select id from account order by id + 1",
+	} {
+		if _, err := parseTestOrderBy(t, sql); err == nil {
+			t.Errorf("%s: expected error", sql)
+		}
+	}
+}
+
+func makeSortTestRows(n int) []*Row {
+	rows := make([]*Row, 0, n)
+	for i := 0; i < n; i++ {
+		r := &Row{fields: make([]Field, 3)}
+		r.fields[0] = Field{col: "id", value: uint64(i)}
+		if i%7 != 0 {
+			r.fields[1] = Field{col: "name", value: []byte(fmt.Sprintf("name-%d", rand.Intn(20)))}
+		} else {
+			r.fields[1] = Field{col: "name"}
+		}
+		r.fields[2] = Field{col: "balance", value: rand.Float64() * 100}
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+func sortTestRows(t *testing.T, s *rowSorter, rows []*Row, limit *Limit, ranges int) []string {
+	var collectors []rowCollector
+	for i := 0; i < ranges; i++ {
+		collectors = append(collectors, s.rangeCollector())
+	}
+	for i, r := range rows {
+		if err := collectors[i%ranges].add(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range collectors {
+		if err := s.mergeRange(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	it, err := s.iterator(limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.close()
+	var result []string
+	for {
+		r, err := it.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r == nil {
+			break
+		}
+		result = append(result, fmt.Sprintf("%v %s %v", r.fields[0].value, r.fields[1].value, r.fields[2].value))
+	}
+	return result
+}
+
+func TestRowSorter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sort_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	p := &Proxy{config: &Config{SortBufferRows: 10, SortTempDir: dir}}
+	order := rowOrder{{index: 1, desc: true}, {index: 2}}
+	rows := makeSortTestRows(100)
+
+	sorted := make([]*Row, len(rows))
+	copy(sorted, rows)
+	order.sort(sorted)
+	var all []string
+	for _, r := range sorted {
+		all = append(all, fmt.Sprintf("%v %s %v", r.fields[0].value, r.fields[1].value, r.fields[2].value))
+	}
+	fieldList, err := makeFieldList(newTxnTestTable(), []*SelColumn{&SelColumn{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		limit    *Limit
+		ranges   int
+		expected []string
+	}{
+		// 每个子范围保留前n行
+		{&Limit{offset: 0, rowCount: 5}, 4, all[:5]},
+		{&Limit{offset: 3, rowCount: 7}, 3, all[3:10]},
+		// 外部排序
+		{nil, 4, all},
+		{&Limit{offset: 95, rowCount: 10}, 2, all[95:]},
+		{&Limit{offset: 200, rowCount: 10}, 2, nil},
+	}
+	for i, tt := range tests {
+		s, err := newRowSorter(p, order, fieldList, tt.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual := sortTestRows(t, s, rows, tt.limit, tt.ranges)
+		if fmt.Sprint(actual) != fmt.Sprint(tt.expected) {
+			t.Errorf("test %d: expected %v, actual %v", i, tt.expected, actual)
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 0 {
+			t.Errorf("test %d: temp files are not removed", i)
+		}
+	}
+}
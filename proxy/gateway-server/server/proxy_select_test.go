@@ -0,0 +1,37 @@
+package server
+
+import (
+	"testing"
+
+	"model/pkg/kvrpcpb"
+)
+
+func TestLimitScanRows(t *testing.T) {
+	makeRows := func(n int) []*kvrpcpb.Row {
+		rows := make([]*kvrpcpb.Row, n)
+		for i := range rows {
+			rows[i] = &kvrpcpb.Row{Key: []byte{byte(i)}}
+		}
+		return rows
+	}
+	limit := &kvrpcpb.Limit{Offset: 3, Count: 4}
+	var skipped, count uint64
+	var result []*kvrpcpb.Row
+
+	// 三个子范围分别返回2, 3, 5行
+	for _, n := range []int{2, 3, 5} {
+		var rows []*kvrpcpb.Row
+		rows, skipped, count = limitScanRows(makeRows(n), limit, skipped, count)
+		result = append(result, rows...)
+	}
+	if skipped != 3 || count != 4 || len(result) != 4 {
+		t.Fatalf("unexpected limit result: skipped %d, count %d, rows %d", skipped, count, len(result))
+	}
+	// 第二个子范围跳过1行, 剩下2行; 第三个子范围取前2行
+	expected := []byte{1, 2, 0, 1}
+	for i, r := range result {
+		if r.Key[0] != expected[i] {
+			t.Fatalf("row %d: expected key %d, actual: %d", i, expected[i], r.Key[0])
+		}
+	}
+}
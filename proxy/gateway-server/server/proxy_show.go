@@ -0,0 +1,582 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"proxy/gateway-server/errors"
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	"util/log"
+)
+
+const informationSchema = "information_schema"
+
+// 虚拟表, 用于SHOW语句和information_schema, 数据来自路由缓存
+// 列名小写, 与sqlparser解析出的列名一致; names是结果集中显示的列名
+type virtualTable struct {
+	*Table
+	names []string
+	rows  []map[string]interface{}
+}
+
+func newVirtualTable(db, name string, names ...string) *virtualTable {
+	table := &metapb.Table{Name: name, DbName: db}
+	for i, n := range names {
+		typ := metapb.DataType_Varchar
+		// 以#结尾的列为数值列
+		if strings.HasSuffix(n, "#") {
+			n = strings.TrimSuffix(n, "#")
+			names[i] = n
+			typ = metapb.DataType_BigInt
+		}
+		table.Columns = append(table.Columns, &metapb.Column{
+			Name:     strings.ToLower(n),
+			Id:       uint64(i + 1),
+			DataType: typ,
+			Unsigned: true,
+			Nullable: true,
+		})
+	}
+	return &virtualTable{Table: NewTable(table, nil, 0), names: names}
+}
+
+// 按列的顺序添加一行, string转换为[]byte, int转换为uint64
+func (vt *virtualTable) addRow(values ...interface{}) {
+	row := make(map[string]interface{}, len(values))
+	for i, col := range vt.GetColumns() {
+		switch v := values[i].(type) {
+		case string:
+			row[col.GetName()] = []byte(v)
+		case int:
+			row[col.GetName()] = uint64(v)
+		default:
+			row[col.GetName()] = v
+		}
+	}
+	vt.rows = append(vt.rows, row)
+}
+
+func (vt *virtualTable) filter(where [][]Match) ([]*txnRow, error) {
+	var rows []*txnRow
+	for _, values := range vt.rows {
+		match := len(where) == 0
+		for _, and := range where {
+			ok, err := matchRow(vt.Table, values, and)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				match = true
+				break
+			}
+		}
+		if match {
+			rows = append(rows, &txnRow{values: values})
+		}
+	}
+	return rows, nil
+}
+
+// SHOW语句的结果, LIKE匹配第一列
+func (vt *virtualTable) showResult(likeOrWhere sqlparser.Expr) (*mysql.Result, error) {
+	var where [][]Match
+	if expr, ok := likeOrWhere.(*sqlparser.ComparisonExpr); ok && expr.Left == nil {
+		pattern, ok := expr.Right.(sqlparser.StrVal)
+		if !ok {
+			return nil, fmt.Errorf("invalid like pattern %s", sqlparser.String(expr.Right))
+		}
+		where = [][]Match{{{column: vt.GetColumns()[0].GetName(), sqlValue: pattern, matchType: Like}}}
+	} else if likeOrWhere != nil {
+		expr, ok := likeOrWhere.(sqlparser.BoolExpr)
+		if !ok {
+			return nil, fmt.Errorf("invalid where clause %s", sqlparser.String(likeOrWhere))
+		}
+		var err error
+		if where, err = new(StmtParser).parseMatch(expr); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := vt.filter(where)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]interface{}, 0, len(rows))
+	for _, row := range rows {
+		value := make([]interface{}, 0, len(vt.names))
+		for _, col := range vt.GetColumns() {
+			value = append(value, row.values[col.GetName()])
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return &mysql.Result{Resultset: newEmptyResultSet(vt.names)}, nil
+	}
+	r, err := buildResultset(nil, vt.names, values)
+	if err != nil {
+		return nil, err
+	}
+	return &mysql.Result{Resultset: r}, nil
+}
+
+// 在虚拟表上执行select, 不下推到dataserver
+func (vt *virtualTable) selectResult(stmt *sqlparser.Select, args []interface{}) (*mysql.Result, error) {
+	parser := &StmtParser{args: args}
+	cols, err := parser.parseSelectCols(stmt)
+	if err != nil {
+		return nil, err
+	}
+	fieldList, err := makeFieldList(vt.Table, cols)
+	if err != nil {
+		return nil, err
+	}
+	var where [][]Match
+	if stmt.Where != nil {
+		if where, err = parser.parseWhere(stmt.Where); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := vt.filter(where)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowss [][]*Row
+	var hasAggre bool
+	for _, f := range fieldList {
+		if f.Typ == kvrpcpb.SelectField_AggreFunction {
+			hasAggre = true
+			break
+		}
+	}
+	if hasAggre {
+		rowss = [][]*Row{partialAggreRows(fieldList, rows)}
+	} else {
+		rowss = [][]*Row{limitRows(fieldList, rows, nil)}
+	}
+	columns, err := fieldList2ColNames(fieldList)
+	if err != nil {
+		return nil, err
+	}
+	res, err := buildSelectResult(stmt, rowss, columns)
+	if err != nil {
+		return nil, err
+	}
+	// 排序之后再截取
+	if err = limitSelectResult(res.Resultset, stmt); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *Proxy) HandleShow(db string, stmt *sqlparser.Show) (*mysql.Result, error) {
+	if stmt.From != nil {
+		db = string(stmt.From.(sqlparser.StrVal))
+	}
+	var vt *virtualTable
+	var err error
+	switch stmt.Section {
+	case sqlparser.AST_SHOW_DATABASES:
+		vt = newVirtualTable("", "databases", "Database")
+		var dbs []*DataBase
+		if dbs, err = p.router.GetAllDBs(); err != nil {
+			return nil, err
+		}
+		vt.addRow(informationSchema)
+		for _, d := range dbs {
+			vt.addRow(d.GetName())
+		}
+	case sqlparser.AST_SHOW_TABLES:
+		if len(db) == 0 {
+			return nil, errors.ErrNoDatabase
+		}
+		names := []string{"Tables_in_" + db}
+		if stmt.Key == "full" {
+			names = append(names, "Table_type")
+		}
+		vt = newVirtualTable("", "tables", names...)
+		tables, tableType, err := p.showTables(db)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range tables {
+			if stmt.Key == "full" {
+				vt.addRow(name, tableType)
+			} else {
+				vt.addRow(name)
+			}
+		}
+	case sqlparser.AST_SHOW_TABLE_STATUS:
+		vt, err = p.showTableStatus(db)
+	case sqlparser.AST_SHOW_COLUMNS:
+		vt, err = p.showColumns(db, stmt)
+	case sqlparser.AST_SHOW_INDEX:
+		vt, err = p.showIndex(db, stmt)
+	case sqlparser.AST_SHOW_CREATE_TABLE:
+		vt, err = p.showCreateTable(db, stmt)
+	case sqlparser.AST_SHOW_CREATE_DATABASE:
+		if p.router.FindDB(db) == nil {
+			return nil, mysql.NewDefaultError(mysql.ER_BAD_DB_ERROR, db)
+		}
+		vt = newVirtualTable("", "create database", "Database", "Create Database")
+		vt.addRow(db, fmt.Sprintf("CREATE DATABASE `%s`", db))
+	case sqlparser.AST_SHOW_WARNINGS:
+		vt = newVirtualTable("", "warnings", "Level", "Code#", "Message")
+	default:
+		return nil, fmt.Errorf("statement show %s not support now", stmt.Section)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vt.showResult(stmt.LikeOrWhere)
+}
+
+// 返回库中的用户表名和表类型, 索引表不可见
+func (p *Proxy) showTables(db string) ([]string, string, error) {
+	if strings.ToLower(db) == informationSchema {
+		var names []string
+		for _, name := range informationSchemaTables {
+			names = append(names, strings.ToUpper(name))
+		}
+		return names, "SYSTEM VIEW", nil
+	}
+	tables, err := p.allTables(db)
+	if err != nil {
+		return nil, "", err
+	}
+	var names []string
+	for _, t := range tables {
+		names = append(names, t.Name())
+	}
+	return names, "BASE TABLE", nil
+}
+
+func (p *Proxy) allTables(db string) ([]*Table, error) {
+	d := p.router.FindDB(db)
+	if d == nil {
+		return nil, mysql.NewDefaultError(mysql.ER_BAD_DB_ERROR, db)
+	}
+	tables, err := d.GetAllTables()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Table, 0, len(tables))
+	for _, t := range tables {
+		if !strings.HasPrefix(t.Name(), "$") {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (p *Proxy) showTableStatus(db string) (*virtualTable, error) {
+	if len(db) == 0 {
+		return nil, errors.ErrNoDatabase
+	}
+	vt := newVirtualTable("", "table status", "Name", "Engine", "Version#", "Row_format", "Rows#", "Avg_row_length#",
+		"Data_length#", "Max_data_length#", "Index_length#", "Data_free#", "Auto_increment#", "Create_time",
+		"Update_time", "Check_time", "Collation", "Checksum", "Create_options", "Comment")
+	tables, err := p.allTables(db)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		vt.addRow(t.Name(), "sharkstore", 10, "Dynamic", nil, nil, nil, nil, nil, nil, nil,
+			formatCreateTime(t), nil, nil, mysql.DEFAULT_COLLATION_NAME, nil, "", "")
+	}
+	return vt, nil
+}
+
+func formatCreateTime(t *Table) interface{} {
+	if t.GetCreateTime() == 0 {
+		return nil
+	}
+	return time.Unix(t.GetCreateTime(), 0).Format("2006-01-02 15:04:05")
+}
+
+func (p *Proxy) findShowTable(db string, name *sqlparser.TableName) (*Table, error) {
+	if name == nil {
+		return nil, fmt.Errorf("table name is required")
+	}
+	if len(name.Qualifier) > 0 {
+		db = string(name.Qualifier)
+	}
+	if len(db) == 0 {
+		return nil, errors.ErrNoDatabase
+	}
+	t := p.router.FindTable(db, string(name.Name))
+	if t == nil {
+		return nil, mysql.NewDefaultError(mysql.ER_NO_SUCH_TABLE, db, string(name.Name))
+	}
+	return t, nil
+}
+
+func (p *Proxy) showColumns(db string, stmt *sqlparser.Show) (*virtualTable, error) {
+	t, err := p.findShowTable(db, stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	full := stmt.Key == "full"
+	var vt *virtualTable
+	if full {
+		vt = newVirtualTable("", "columns", "Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment")
+	} else {
+		vt = newVirtualTable("", "columns", "Field", "Type", "Null", "Key", "Default", "Extra")
+	}
+	for _, col := range t.GetAllColumns() {
+		var collation interface{}
+		if col.GetDataType() == metapb.DataType_Varchar {
+			collation = mysql.DEFAULT_COLLATION_NAME
+		}
+		if full {
+			vt.addRow(col.GetName(), columnTypeName(col), collation, columnNullable(col), columnKey(t, col),
+				columnDefault(col), "", "select,insert,update", "")
+		} else {
+			vt.addRow(col.GetName(), columnTypeName(col), columnNullable(col), columnKey(t, col), columnDefault(col), "")
+		}
+	}
+	return vt, nil
+}
+
+func (p *Proxy) showIndex(db string, stmt *sqlparser.Show) (*virtualTable, error) {
+	t, err := p.findShowTable(db, stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	vt := newVirtualTable("", "index", "Table", "Non_unique#", "Key_name", "Seq_in_index#", "Column_name", "Collation",
+		"Cardinality#", "Sub_part#", "Packed", "Null", "Index_type", "Comment", "Index_comment")
+	for i, pk := range t.PKS() {
+		vt.addRow(t.Name(), 0, "PRIMARY", i+1, pk, "A", nil, nil, nil, "", "BTREE", "", "")
+	}
+	for _, index := range t.GetIndexes() {
+		var comment string
+		if index.GetState() != metapb.IndexState_IndexPublic {
+			comment = "write only"
+		}
+		for i, id := range index.GetColumnIds() {
+			col := t.FindColumnById(id)
+			if col == nil {
+				continue
+			}
+			vt.addRow(t.Name(), 1, index.GetName(), i+1, col.GetName(), "A", nil, nil, nil,
+				columnNullable(col), "BTREE", comment, "")
+		}
+	}
+	return vt, nil
+}
+
+func (p *Proxy) showCreateTable(db string, stmt *sqlparser.Show) (*virtualTable, error) {
+	t, err := p.findShowTable(db, stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	vt := newVirtualTable("", "create table", "Table", "Create Table")
+	vt.addRow(t.Name(), showCreateTableSql(t))
+	return vt, nil
+}
+
+func showCreateTableSql(t *Table) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE TABLE `%s` (\n", t.Name())
+	for _, col := range t.GetAllColumns() {
+		fmt.Fprintf(&buf, "  `%s` %s", col.GetName(), columnTypeName(col))
+		if col.GetNullable() {
+			if len(col.GetDefaultValue()) == 0 {
+				buf.WriteString(" DEFAULT NULL")
+			}
+		} else {
+			buf.WriteString(" NOT NULL")
+		}
+		if len(col.GetDefaultValue()) > 0 {
+			fmt.Fprintf(&buf, " DEFAULT '%s'", strings.Replace(string(col.GetDefaultValue()), "'", "''", -1))
+		}
+		buf.WriteString(",\n")
+	}
+	fmt.Fprintf(&buf, "  PRIMARY KEY (`%s`)", strings.Join(t.PKS(), "`,`"))
+	for _, index := range t.GetIndexes() {
+		var names []string
+		for _, id := range index.GetColumnIds() {
+			if col := t.FindColumnById(id); col != nil {
+				names = append(names, col.GetName())
+			}
+		}
+		fmt.Fprintf(&buf, ",\n  KEY `%s` (`%s`)", index.GetName(), strings.Join(names, "`,`"))
+	}
+	fmt.Fprintf(&buf, "\n) ENGINE=sharkstore DEFAULT CHARSET=%s", mysql.DEFAULT_CHARSET)
+	return buf.String()
+}
+
+// 列类型对应的MySQL类型
+func columnTypeName(col *metapb.Column) string {
+	var name string
+	switch col.GetDataType() {
+	case metapb.DataType_Tinyint:
+		name = "tinyint"
+	case metapb.DataType_Smallint:
+		name = "smallint"
+	case metapb.DataType_Int:
+		name = "int"
+	case metapb.DataType_BigInt:
+		name = "bigint"
+	case metapb.DataType_Float:
+		name = "float"
+	case metapb.DataType_Double:
+		name = "double"
+	case metapb.DataType_Varchar:
+		if col.GetScale() > 0 {
+			return fmt.Sprintf("varchar(%d)", col.GetScale())
+		}
+		return "text"
+	case metapb.DataType_Binary:
+		return "blob"
+	case metapb.DataType_Date:
+		return "date"
+	case metapb.DataType_TimeStamp:
+		return "datetime"
+	default:
+		return strings.ToLower(col.GetDataType().String())
+	}
+	if col.GetDataType() == metapb.DataType_Float || col.GetDataType() == metapb.DataType_Double {
+		if col.GetPrecision() > 0 {
+			name = fmt.Sprintf("%s(%d,%d)", name, col.GetPrecision(), col.GetScale())
+		}
+	}
+	if col.GetUnsigned() {
+		name += " unsigned"
+	}
+	return name
+}
+
+func columnNullable(col *metapb.Column) string {
+	if col.GetNullable() {
+		return "YES"
+	}
+	return "NO"
+}
+
+func columnKey(t *Table, col *metapb.Column) string {
+	if col.GetPrimaryKey() > 0 {
+		return "PRI"
+	}
+	for _, index := range t.GetIndexes() {
+		if len(index.GetColumnIds()) > 0 && index.GetColumnIds()[0] == col.GetId() {
+			return "MUL"
+		}
+	}
+	return ""
+}
+
+func columnDefault(col *metapb.Column) interface{} {
+	if len(col.GetDefaultValue()) == 0 {
+		return nil
+	}
+	return col.GetDefaultValue()
+}
+
+// information_schema中支持的表
+var informationSchemaTables = []string{"schemata", "tables", "columns"}
+
+// 查询information_schema的表名, 非information_schema的查询返回空
+func informationSchemaTable(db string, stmt *sqlparser.Select) string {
+	if len(stmt.From) != 1 {
+		return ""
+	}
+	tableExpr, ok := stmt.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return ""
+	}
+	name, ok := tableExpr.Expr.(*sqlparser.TableName)
+	if !ok {
+		return ""
+	}
+	if len(name.Qualifier) > 0 {
+		db = string(name.Qualifier)
+	}
+	if strings.ToLower(db) != informationSchema {
+		return ""
+	}
+	return strings.ToLower(string(name.Name))
+}
+
+func (p *Proxy) HandleInformationSchema(table string, stmt *sqlparser.Select, args []interface{}) (*mysql.Result, error) {
+	var vt *virtualTable
+	switch table {
+	case "schemata":
+		vt = newVirtualTable(informationSchema, table, "CATALOG_NAME", "SCHEMA_NAME", "DEFAULT_CHARACTER_SET_NAME",
+			"DEFAULT_COLLATION_NAME", "SQL_PATH")
+		dbs, err := p.router.GetAllDBs()
+		if err != nil {
+			return nil, err
+		}
+		vt.addRow("def", informationSchema, mysql.DEFAULT_CHARSET, mysql.DEFAULT_COLLATION_NAME, nil)
+		for _, d := range dbs {
+			vt.addRow("def", d.GetName(), mysql.DEFAULT_CHARSET, mysql.DEFAULT_COLLATION_NAME, nil)
+		}
+	case "tables":
+		vt = newVirtualTable(informationSchema, table, "TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "TABLE_TYPE",
+			"ENGINE", "VERSION#", "ROW_FORMAT", "TABLE_ROWS#", "AVG_ROW_LENGTH#", "DATA_LENGTH#", "INDEX_LENGTH#",
+			"AUTO_INCREMENT#", "CREATE_TIME", "UPDATE_TIME", "TABLE_COLLATION", "TABLE_COMMENT")
+		err := p.eachTable(func(t *Table) {
+			vt.addRow("def", t.DbName(), t.Name(), "BASE TABLE", "sharkstore", 10, "Dynamic", nil, nil, nil, nil,
+				nil, formatCreateTime(t), nil, mysql.DEFAULT_COLLATION_NAME, "")
+		})
+		if err != nil {
+			return nil, err
+		}
+	case "columns":
+		vt = newVirtualTable(informationSchema, table, "TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME",
+			"ORDINAL_POSITION#", "COLUMN_DEFAULT", "IS_NULLABLE", "DATA_TYPE", "CHARACTER_MAXIMUM_LENGTH#",
+			"NUMERIC_PRECISION#", "NUMERIC_SCALE#", "COLUMN_TYPE", "COLUMN_KEY", "EXTRA", "PRIVILEGES", "COLUMN_COMMENT")
+		err := p.eachTable(func(t *Table) {
+			for i, col := range t.GetAllColumns() {
+				var maxLength, precision, scale interface{}
+				switch col.GetDataType() {
+				case metapb.DataType_Varchar:
+					if col.GetScale() > 0 {
+						maxLength = int(col.GetScale())
+					}
+				case metapb.DataType_Float, metapb.DataType_Double:
+					if col.GetPrecision() > 0 {
+						precision, scale = int(col.GetPrecision()), int(col.GetScale())
+					}
+				}
+				typ := columnTypeName(col)
+				if n := strings.IndexAny(typ, "( "); n > 0 {
+					typ = typ[:n]
+				}
+				vt.addRow("def", t.DbName(), t.Name(), col.GetName(), i+1, columnDefault(col), columnNullable(col),
+					typ, maxLength, precision, scale, columnTypeName(col), columnKey(t, col), "",
+					"select,insert,update", "")
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, mysql.NewDefaultError(mysql.ER_NO_SUCH_TABLE, informationSchema, table)
+	}
+	return vt.selectResult(stmt, args)
+}
+
+// 遍历所有库的用户表
+func (p *Proxy) eachTable(fn func(t *Table)) error {
+	dbs, err := p.router.GetAllDBs()
+	if err != nil {
+		return err
+	}
+	for _, d := range dbs {
+		tables, err := p.allTables(d.GetName())
+		if err != nil {
+			log.Warn("[show] load tables of %s failed(%v)", d.GetName(), err)
+			continue
+		}
+		for _, t := range tables {
+			fn(t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"model/pkg/metapb"
+	"proxy/gateway-server/sqlparser"
+)
+
+func newShowTestTable() *virtualTable {
+	vt := newVirtualTable(informationSchema, "tables", "TABLE_SCHEMA", "TABLE_NAME", "TABLE_ROWS#")
+	vt.addRow("db1", "user", 10)
+	vt.addRow("db1", "order", 2)
+	vt.addRow("db2", "user", nil)
+	return vt
+}
+
+func TestVirtualTableShow(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected int
+	}{
+		{"show tables", 3},
+		{"show tables like 'db1'", 2},
+		{"show tables like 'db%'", 3},
+		{"show tables where table_name = 'user'", 2},
+		{"show tables where table_name = 'user' and table_rows > 5", 1},
+		{"show tables where table_schema = 'db2' or table_name = 'order'", 2},
+	}
+	for _, tt := range tests {
+		stmt, err := sqlparser.Parse(tt.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := newShowTestTable().showResult(stmt.(*sqlparser.Show).LikeOrWhere)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.sql, err)
+		}
+		if len(res.Values) != tt.expected {
+			t.Errorf("%s: expected %d rows, actual %v", tt.sql, tt.expected, res.Values)
+		}
+		if len(res.Fields) != 3 || string(res.Fields[2].Name) != "TABLE_ROWS" {
+			t.Errorf("%s: unexpected fields %v", tt.sql, res.Fields)
+		}
+	}
+}
+
+func TestVirtualTableSelect(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected string
+	}{
+		{"select table_name from information_schema.tables where table_schema = 'db1' order by table_name", "[[order] [user]]"},
+		{"select table_schema, table_rows from information_schema.tables where table_rows >= 2 order by table_rows desc limit 1", "[[db1 10]]"},
+		{"select count(*) from information_schema.tables where table_name = 'user'", "[[2]]"},
+	}
+	for _, tt := range tests {
+		stmt, err := sqlparser.Parse(tt.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sel := stmt.(*sqlparser.Select)
+		if table := informationSchemaTable("", sel); table != "tables" {
+			t.Fatalf("%s: unexpected information_schema table %q", tt.sql, table)
+		}
+		res, err := newShowTestTable().selectResult(sel, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.sql, err)
+		}
+		var actual [][]string
+		for _, row := range res.Values {
+			var values []string
+			for _, v := range row {
+				values = append(values, fmt.Sprintf("%s", formatTestValue(v)))
+			}
+			actual = append(actual, values)
+		}
+		if fmt.Sprint(actual) != tt.expected {
+			t.Errorf("%s: expected %s, actual %v", tt.sql, tt.expected, actual)
+		}
+	}
+}
+
+func formatTestValue(v interface{}) []byte {
+	b, _ := formatValue(v)
+	return b
+}
+
+func TestShowCreateTable(t *testing.T) {
+	table := NewTable(&metapb.Table{
+		Name: "user",
+		Columns: []*metapb.Column{
+			&metapb.Column{Name: "id", Id: 1, DataType: metapb.DataType_BigInt, Unsigned: true, PrimaryKey: 1},
+			&metapb.Column{Name: "name", Id: 2, DataType: metapb.DataType_Varchar, Scale: 64, Nullable: true},
+			&metapb.Column{Name: "score", Id: 3, DataType: metapb.DataType_Double, Precision: 10, Scale: 2, DefaultValue: []byte("0")},
+		},
+		Indexes: []*metapb.Index{&metapb.Index{Name: "idx_name", ColumnIds: []uint64{2}}},
+	}, nil, time.Minute)
+	expected := "CREATE TABLE `user` (\n" +
+		"  `id` bigint unsigned NOT NULL,\n" +
+		"  `name` varchar(64) DEFAULT NULL,\n" +
+		"  `score` double(10,2) NOT NULL DEFAULT '0',\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_name` (`name`)\n" +
+		") ENGINE=sharkstore DEFAULT CHARSET=utf8"
+	if actual := showCreateTableSql(table); actual != expected {
+		t.Fatalf("unexpected create table:\n%s", actual)
+	}
+
+	// 生成的建表语句可以重新解析
+	stmt, err := sqlparser.Parse(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns, indexes, err := buildTableColumns(stmt.(*sqlparser.CreateTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 3 || len(indexes) != 1 || columns[2].GetScale() != 2 {
+		t.Fatalf("unexpected columns %v, indexes %v", columns, indexes)
+	}
+}
@@ -1161,3 +1161,28 @@ func TestProxyInsert(t *testing.T) {
 	testProxyInsert(t, p, 1, "insert into "+testTableName+"(iD,nAMe,bAlaNce) values(3, 'myname3', 3.1)")
 	testProxyInsert(t, p, 1, "insert into "+testTableName+"(iD,nAMe,bAlaNce) values(4, 'myname4', NULL)")
 }
+
+func TestProxyReplace(t *testing.T) {
+	columns := []*columnInfo{
+		&columnInfo{name: "id", typ: metapb.DataType_BigInt, isUnsigned: true, isPK: true},
+		&columnInfo{name: "name", typ: metapb.DataType_Varchar},
+	}
+	db := &metapb.DataBase{Name: testDBName, Id: 1}
+	table := makeTestTable(columns)
+	start := util.EncodeStorePrefix(util.Store_Prefix_KV, table.GetId())
+	r := util.BytesPrefix(start)
+	rng := &metapb.Range{
+		Id:  1,
+		TableId: 1,
+		StartKey: r.Start,
+		EndKey: r.Limit,
+		RangeEpoch: &metapb.RangeEpoch{ConfVer: 1, Version: 1},
+		Peers: []*metapb.Peer{&metapb.Peer{Id: 2, NodeId: 1}},
+	}
+	p := newTestProxy(db, table, rng)
+	defer p.Close()
+
+	testProxyReplace(t, p, 1, "replace into "+testTableName+"(id,name) values(1, 'myname')")
+	testProxyReplace(t, p, 2, "replace into "+testTableName+"(id,name) values(1, 'myname'), (2, 'myname2')")
+	testProxyReplace(t, p, 1, "replace into "+testTableName+" set id = 3, name = 'myname3'")
+}
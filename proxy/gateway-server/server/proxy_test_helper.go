@@ -13,6 +13,7 @@ import (
 	"proxy/gateway-server/sqlparser"
 	"model/pkg/kvrpcpb"
 	"model/pkg/metapb"
+	"util"
 	"util/assert"
 	"util/hlc"
 	"proxy/store/dskv/mock_ms"
@@ -54,10 +55,11 @@ func newTestProxy(db *metapb.DataBase, table *metapb.Table, rng *metapb.Range) *
 	ms.SetTable(table)
 	ms.SetNode(node)
 	ms.SetRange(rng)
-	go ms.Start()
-	time.Sleep(time.Second)
 	ds := mock_ds.NewDsRpcServer("127.0.0.1:6060", dsPath)
 	ds.SetRange(rng)
+	setTestTxnTables(ms, ds)
+	go ms.Start()
+	time.Sleep(time.Second)
 	go ds.Start()
 	time.Sleep(time.Second)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -98,6 +100,45 @@ func newTestProxy(db *metapb.DataBase, table *metapb.Table, rng *metapb.Range) *
 	return p
 }
 
+// 事务使用的系统库和系统表, 系统表的数据在mock dataserver上按行保存
+func setTestTxnTables(ms *mock_ms.Cluster, ds *mock_ds.DsRpcServer) {
+	const dbId = 1000
+	ms.SetDb(&metapb.DataBase{Name: txnDBName, Id: dbId})
+	for i, def := range txnTableDefs {
+		id := uint64(dbId + 1 + i)
+		columns := make([]*metapb.Column, 0, len(def.columns))
+		for j, c := range def.columns {
+			columns = append(columns, &metapb.Column{
+				Name:       c.GetName(),
+				Id:         uint64(j + 1),
+				DataType:   c.GetDataType(),
+				Unsigned:   c.GetUnsigned(),
+				PrimaryKey: c.GetPrimaryKey(),
+			})
+		}
+		table := &metapb.Table{
+			Name:    def.name,
+			DbName:  txnDBName,
+			DbId:    dbId,
+			Id:      id,
+			Columns: columns,
+			Epoch:   &metapb.TableEpoch{ConfVer: 1, Version: 1},
+		}
+		rng := &metapb.Range{
+			Id:         id,
+			TableId:    id,
+			StartKey:   util.EncodeStorePrefix(util.Store_Prefix_KV, id),
+			EndKey:     util.EncodeStorePrefix(util.Store_Prefix_KV, id+1),
+			RangeEpoch: &metapb.RangeEpoch{ConfVer: 1, Version: 1},
+			Peers:      []*metapb.Peer{&metapb.Peer{Id: id + 1000, NodeId: 1}},
+		}
+		ms.SetTable(table)
+		ms.SetRange(rng)
+		ds.SetTable(table)
+		ds.SetRange(rng)
+	}
+}
+
 //func newDsTestProxy(columns []*columnInfo, ranges []*util.Range) *Proxy {
 //	table := makeTestTable(columns)
 //
@@ -166,7 +207,7 @@ func testProxyInsert(t *testing.T, p *Proxy, expectedAffected uint64, sql string
 	if !ok {
 		t.Fatalf("not insert stamentent: %s", sql)
 	}
-	res, err := p.HandleInsert(testDBName, stmt, nil)
+	res, err := p.HandleInsert(testDBName, stmt, nil, nil)
 	if err != nil {
 		t.Fatalf("insert failed: %v, sql: %v", err, sql)
 	}
@@ -190,7 +231,7 @@ func testProxyDelete(t *testing.T, p *Proxy, expectAffected uint64, sql string)
 	if !ok {
 		t.Fatalf("not delete stamentent: %s", sql)
 	}
-	res, err := p.HandleDelete(testDBName, stmt, nil)
+	res, err := p.HandleDelete(testDBName, stmt, nil, nil)
 	if err != nil {
 		t.Fatalf("delete faile: %v, sql: %s", err, sql)
 	}
@@ -213,7 +254,7 @@ func testProxySelect(t *testing.T, p *Proxy, expectResult [][]string, sql string
 	if !ok {
 		t.Fatalf("not select stamentent: %s", sql)
 	}
-	r, err := p.HandleSelect(testDBName, stmt, nil)
+	r, err := p.HandleSelect(testDBName, stmt, nil, nil)
 	if err != nil {
 		t.Fatalf("select failed: %v, sql: %v", err, sql)
 	}
@@ -415,12 +456,35 @@ func getSelectFilter(tt *testing.T, p *Proxy, db string, stmt *sqlparser.Select)
 	// 解析where条件
 	var matchs []Match
 	if stmt.Where != nil {
-		// TODO: 支持OR表达式
-		matchs, err = parser.parseWhere(stmt.Where)
+		where, err := parser.parseWhere(stmt.Where)
 		if err != nil {
 			tt.Fatal("handle select parse where error(%v)", err.Error())
 		}
+		if len(where) > 1 {
+			tt.Fatal("OR expression is not supported in test filter")
+		}
+		matchs = firstAndMatches(where)
 	}
 
 	return &Filter{columns: columns, matchs: matchs}
 }
+
+func testProxyReplace(t *testing.T, p *Proxy, expectedAffected uint64, sql string) {
+	t.Logf("sql> %s ", sql)
+
+	sqlstmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, ok := sqlstmt.(*sqlparser.Replace)
+	if !ok {
+		t.Fatalf("not replace stamentent: %s", sql)
+	}
+	res, err := p.HandleReplace(testDBName, stmt, nil, nil)
+	if err != nil {
+		t.Fatalf("replace failed: %v, sql: %v", err, sql)
+	}
+	if res.AffectedRows != expectedAffected {
+		t.Fatalf("replace failed. unexpectecd affected rows: %v, expected: %v", res.AffectedRows, expectedAffected)
+	}
+}
@@ -0,0 +1,463 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"proxy/gateway-server/mysql"
+	"proxy/gateway-server/sqlparser"
+	"util/hack"
+	"util/log"
+)
+
+// HandleUpdate handle update
+// txn不为nil时写入缓存在事务中, 为nil时以隐式事务执行, 提交时检查更新的行没有被其他写入修改
+func (p *Proxy) HandleUpdate(db string, stmt *sqlparser.Update, args []interface{}, txn *Txn) (res *mysql.Result, err error) {
+	if txn == nil {
+		err = p.autoCommit(func(txn *Txn) (err error) {
+			res, err = p.HandleUpdate(db, stmt, args, txn)
+			return
+		})
+		return res, err
+	}
+	txn.beginStatement()
+	defer func() { txn.endStatement(err) }()
+
+	parser := &StmtParser{args: args}
+
+	// 解析表名
+	tableName := parser.parseTable(stmt)
+	t := p.router.FindTable(db, tableName)
+	if t == nil {
+		log.Error("[update] table %s.%s doesn.t exist", db, tableName)
+		return nil, fmt.Errorf("Table '%s.%s' doesn't exist", db, tableName)
+	}
+
+	// 解析set子句
+	sets, err := parser.parseUpdateExprs(stmt)
+	if err != nil {
+		log.Error("[update] parse set clause error(%v)", err)
+		return nil, err
+	}
+	if !hasUpdateColumns(t, sets) {
+		// 表结构有更新时按新的表结构检查
+		if nt := p.router.RefreshTable(db, tableName); nt != nil {
+			t = nt
+		}
+	}
+	for _, s := range sets {
+		if t.FindColumn(s.column) == nil {
+			log.Error("[update] invalid column[%s %s %s] in set clause", db, tableName, s.column)
+			return nil, fmt.Errorf("Unknown column '%s' in 'field list'", s.column)
+		}
+	}
+
+	if stmt.OrderBy != nil {
+		return nil, fmt.Errorf("update with order by is currently not supported")
+	}
+
+	// 解析where条件
+	var where [][]Match
+	if stmt.Where != nil {
+		where, err = parser.parseWhere(stmt.Where)
+		if err != nil {
+			log.Error("handle update parse where error(%v)", err)
+			return nil, err
+		}
+	}
+
+	var limit *Limit
+	if stmt.Limit != nil {
+		offset, count, err := parseLimit(stmt.Limit)
+		if err != nil {
+			log.Error("update parse limit error[%v]", err)
+			return nil, err
+		}
+		if count > DefaultMaxRawCount {
+			log.Warn("limit count exceeding the maximum limit")
+			return nil, ErrExceedMaxLimit
+		}
+		limit = &Limit{offset: offset, rowCount: count}
+	}
+
+	if log.GetFileLogger().IsEnableDebug() {
+		log.Debug("[update] sets %v, where %v", sets, where)
+	}
+
+	affectedRows, err := p.doUpdate(txn, t, sets, where, limit, parser)
+	if err != nil {
+		return nil, err
+	}
+	res = new(mysql.Result)
+	res.AffectedRows = affectedRows
+	res.Status = 0
+	return res, nil
+}
+
+func hasUpdateColumns(t *Table, sets []*UpdateColumn) bool {
+	for _, s := range sets {
+		if t.FindColumn(s.column) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// 先查询出匹配的行，在proxy计算新值后重新编码写回
+// affected只统计值真正发生变化的行
+func (p *Proxy) doUpdate(txn *Txn, t *Table, sets []*UpdateColumn, where [][]Match, limit *Limit, parser *StmtParser) (affected uint64, err error) {
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		log.Error("[update] find %s.%s field list error(%s), ", t.DbName(), t.Name(), err)
+		return 0, err
+	}
+	colMap := make(map[string]int, len(fieldList))
+	for i, f := range fieldList {
+		colMap[f.Column.Name] = i
+	}
+
+	where, err = expandPKIn(t, where)
+	if err != nil {
+		return 0, err
+	}
+	var rowss [][]*Row
+	var truncated bool
+	if isSimpleWhere(where) && !p.hasIndexPlan(txn, t, where) {
+		rowss, err = p.txnSelect(txn, t, fieldList, firstAndMatches(where), limit)
+		if err != nil {
+			return 0, err
+		}
+		var matched uint64
+		for _, rows := range rowss {
+			matched += uint64(len(rows))
+		}
+		truncated = matched >= p.config.MaxLimit
+	} else {
+		var rows []*txnRow
+		rows, truncated, err = p.filterRows(txn, t, where)
+		if err != nil {
+			return 0, err
+		}
+		rowss = [][]*Row{limitRows(fieldList, rows, limit)}
+	}
+	// 没有指定limit时，查询结果被MaxLimit截断，不能只更新部分行
+	if limit == nil && truncated {
+		log.Warn("[update] Table %s.%s matched rows exceeding the maximum limit(%d)", t.DbName(), t.Name(), p.config.MaxLimit)
+		return 0, ErrExceedMaxLimit
+	}
+
+	var changes [][2]*kvrpcpb.KeyValue
+	for _, rows := range rowss {
+		for _, row := range rows {
+			oldValue, newValue, err := p.updateRowValue(t, fieldList, row, sets, nil, parser)
+			if err != nil {
+				log.Error("[update] Table %s.%s compute new row value failed(%v)", t.DbName(), t.Name(), err)
+				return 0, err
+			}
+			if rowValueEqual(oldValue, newValue) {
+				continue
+			}
+			oldKv, newKv, err := p.encodeUpdatedRow(t, colMap, oldValue, newValue)
+			if err != nil {
+				return 0, err
+			}
+			changes = append(changes, [2]*kvrpcpb.KeyValue{oldKv, newKv})
+		}
+	}
+
+	return p.txnWriteUpdatedRows(txn, t, changes)
+}
+
+func (p *Proxy) encodeUpdatedRow(t *Table, colMap map[string]int, oldValue, newValue InsertRowValue) (oldKv, newKv *kvrpcpb.KeyValue, err error) {
+	newKv, err = p.EncodeRow(t, colMap, newValue)
+	if err != nil {
+		log.Error("[update] Table %s.%s encode row failed(%v)", t.DbName(), t.Name(), err)
+		return nil, nil, err
+	}
+	oldKv, err = p.EncodeRow(t, colMap, oldValue)
+	if err != nil {
+		log.Error("[update] Table %s.%s encode old row failed(%v)", t.DbName(), t.Name(), err)
+		return nil, nil, err
+	}
+	return oldKv, newKv, nil
+}
+
+// 计算一行更新前后的值，赋值按从左到右的顺序，后面的表达式可以引用前面已更新的列
+// values为insert ... on duplicate key update中插入的值，供VALUES(col)引用
+func (p *Proxy) updateRowValue(t *Table, fieldList []*kvrpcpb.SelectField, row *Row, sets []*UpdateColumn, values map[string]interface{}, parser *StmtParser) (oldValue, newValue InsertRowValue, err error) {
+	if len(row.fields) != len(fieldList) {
+		return nil, nil, fmt.Errorf("inconsistent row field size(%d != %d)", len(row.fields), len(fieldList))
+	}
+	current := make(map[string]interface{}, len(row.fields))
+	for i, f := range fieldList {
+		current[f.Column.Name] = row.fields[i].value
+	}
+	for _, s := range sets {
+		col := t.FindColumn(s.column)
+		if col == nil {
+			return nil, nil, fmt.Errorf("Unknown column '%s' in 'field list'", s.column)
+		}
+		v, err := evalUpdateExpr(s.expr, current, values, parser)
+		if err != nil {
+			return nil, nil, fmt.Errorf("evaluate column(%s) failed(%v)", s.column, err)
+		}
+		if v, err = convertColumnValue(col, v); err != nil {
+			return nil, nil, err
+		}
+		current[s.column] = v
+	}
+
+	oldValue = make(InsertRowValue, len(fieldList))
+	newValue = make(InsertRowValue, len(fieldList))
+	for i, f := range fieldList {
+		if oldValue[i], err = toSQLValue(row.fields[i].value); err != nil {
+			return nil, nil, err
+		}
+		if newValue[i], err = toSQLValue(current[f.Column.Name]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return oldValue, newValue, nil
+}
+
+// 计算set子句的表达式，row为当前行各列的值
+func evalUpdateExpr(expr sqlparser.Expr, row, values map[string]interface{}, parser *StmtParser) (interface{}, error) {
+	switch v := expr.(type) {
+	case sqlparser.StrVal:
+		return []byte(v), nil
+	case sqlparser.NumVal:
+		return parseNumber(v)
+	case *sqlparser.NullVal:
+		return nil, nil
+	case sqlparser.ValArg:
+		val, err := parser.parseValArg(v)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		return []byte(val), nil
+	case *sqlparser.ColName:
+		val, ok := row[string(v.Name)]
+		if !ok {
+			return nil, fmt.Errorf("Unknown column '%s' in 'field list'", string(v.Name))
+		}
+		return val, nil
+	case sqlparser.ValTuple:
+		// 括号表达式
+		if len(v) != 1 {
+			return nil, fmt.Errorf("operand should contain 1 column(s)")
+		}
+		return evalUpdateExpr(v[0], row, values, parser)
+	case *sqlparser.FuncExpr:
+		return evalValuesFunc(v, values)
+	case *sqlparser.UnaryExpr:
+		val, err := evalUpdateExpr(v.Expr, row, values, parser)
+		if err != nil || val == nil {
+			return nil, err
+		}
+		switch v.Operator {
+		case sqlparser.AST_UPLUS:
+			return val, nil
+		case sqlparser.AST_UMINUS:
+			return evalArithmetic(sqlparser.AST_MINUS, int64(0), val)
+		default:
+			return nil, fmt.Errorf("unsupported unary operator(%c)", v.Operator)
+		}
+	case *sqlparser.BinaryExpr:
+		left, err := evalUpdateExpr(v.Left, row, values, parser)
+		if err != nil {
+			return nil, err
+		}
+		right, err := evalUpdateExpr(v.Right, row, values, parser)
+		if err != nil {
+			return nil, err
+		}
+		// 任一操作数为NULL，结果为NULL
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		return evalArithmetic(v.Operator, left, right)
+	default:
+		return nil, fmt.Errorf("unsupported update value type(%T)", expr)
+	}
+}
+
+// VALUES(col), 引用insert语句中该列插入的值
+func evalValuesFunc(expr *sqlparser.FuncExpr, values map[string]interface{}) (interface{}, error) {
+	if !strings.EqualFold(string(expr.Name), "values") {
+		return nil, fmt.Errorf("unsupported function(%s)", string(expr.Name))
+	}
+	if values == nil {
+		return nil, fmt.Errorf("VALUES() is only supported in on duplicate key update clause")
+	}
+	if len(expr.Exprs) != 1 {
+		return nil, fmt.Errorf("invalid VALUES() arg size(%d)", len(expr.Exprs))
+	}
+	arg, ok := expr.Exprs[0].(*sqlparser.NonStarExpr)
+	if !ok {
+		return nil, fmt.Errorf("invalid VALUES() arg type(%T)", expr.Exprs[0])
+	}
+	col, ok := arg.Expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid VALUES() arg type(%T)", arg.Expr)
+	}
+	// 没有插入的列为NULL
+	return values[string(col.Name)], nil
+}
+
+func evalArithmetic(op byte, left, right interface{}) (interface{}, error) {
+	l, err := toNumber(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := toNumber(right)
+	if err != nil {
+		return nil, err
+	}
+	li, lok := l.(int64)
+	ri, rok := r.(int64)
+	// 整数运算, 除法按浮点计算
+	if lok && rok && op != sqlparser.AST_DIV {
+		switch op {
+		case sqlparser.AST_PLUS:
+			return li + ri, nil
+		case sqlparser.AST_MINUS:
+			return li - ri, nil
+		case sqlparser.AST_MULT:
+			return li * ri, nil
+		case sqlparser.AST_MOD:
+			if ri == 0 {
+				return nil, nil
+			}
+			return li % ri, nil
+		}
+		return nil, fmt.Errorf("unsupported arithmetic operator(%c)", op)
+	}
+
+	lf, rf := toFloat(l), toFloat(r)
+	switch op {
+	case sqlparser.AST_PLUS:
+		return lf + rf, nil
+	case sqlparser.AST_MINUS:
+		return lf - rf, nil
+	case sqlparser.AST_MULT:
+		return lf * rf, nil
+	case sqlparser.AST_DIV:
+		if rf == 0 {
+			return nil, nil
+		}
+		return lf / rf, nil
+	case sqlparser.AST_MOD:
+		if rf == 0 {
+			return nil, nil
+		}
+		return math.Mod(lf, rf), nil
+	}
+	return nil, fmt.Errorf("unsupported arithmetic operator(%c)", op)
+}
+
+// 转换为int64或者float64
+func toNumber(v interface{}) (interface{}, error) {
+	switch n := v.(type) {
+	case int64:
+		return n, nil
+	case uint64:
+		if n > math.MaxInt64 {
+			return float64(n), nil
+		}
+		return int64(n), nil
+	case float64:
+		return n, nil
+	case []byte:
+		return parseNumber(n)
+	default:
+		return nil, fmt.Errorf("invalid arithmetic operand type(%T)", v)
+	}
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
+
+func parseNumber(b []byte) (interface{}, error) {
+	s := hack.String(b)
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number(%s)", s)
+	}
+	return f, nil
+}
+
+// 按照列类型转换计算结果, 整型列的浮点值四舍五入
+func convertColumnValue(col *metapb.Column, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch col.DataType {
+	case metapb.DataType_Tinyint, metapb.DataType_Smallint, metapb.DataType_Int, metapb.DataType_BigInt:
+		if f, ok := v.(float64); ok {
+			v = int64(math.Floor(f + 0.5))
+		}
+		if i, ok := v.(int64); ok && col.Unsigned {
+			if i < 0 {
+				return nil, fmt.Errorf("Out of range value for column '%s'", col.Name)
+			}
+			return uint64(i), nil
+		}
+	case metapb.DataType_Varchar, metapb.DataType_Binary, metapb.DataType_Date, metapb.DataType_TimeStamp:
+		b, err := formatValue(v)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	return v, nil
+}
+
+func toSQLValue(v interface{}) (SQLValue, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := formatValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return SQLValue(b), nil
+}
+
+func rowValueEqual(a, b InsertRowValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) || !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+
+func newDupEntryError() error {
+	resErr := new(mysql.SqlError)
+	resErr.Code = mysql.ER_DUP_ENTRY
+	resErr.State = "23000"
+	resErr.Message = ` Duplicate entry for key 'PRIMARY'`
+	return resErr
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"testing"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"proxy/gateway-server/sqlparser"
+)
+
+func TestEvalUpdateExpr(t *testing.T) {
+	row := map[string]interface{}{
+		"a": int64(5),
+		"b": uint64(3),
+		"c": float64(1.5),
+		"d": []byte("foo"),
+		"e": nil,
+	}
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"'bar'", "bar"},
+		{"10", "10"},
+		{"-10", "-10"},
+		{"a", "5"},
+		{"d", "foo"},
+		{"a + 1", "6"},
+		{"a - b", "2"},
+		{"(a + b) * 2", "16"},
+		{"a / 2", "2.5"},
+		{"a % b", "2"},
+		{"c * 2", "3"},
+		{"-a", "-5"},
+		{"?", "7"},
+		{"e + 1", "NULL"},
+		{"null", "NULL"},
+	}
+	parser := &StmtParser{args: []interface{}{int64(7)}}
+	for _, tt := range tests {
+		stmt, err := sqlparser.Parse("update mytable set x = " + tt.expr)
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", tt.expr, err)
+		}
+		v, err := evalUpdateExpr(stmt.(*sqlparser.Update).Exprs[0].Expr, row, nil, parser)
+		if err != nil {
+			t.Fatalf("eval %s failed: %v", tt.expr, err)
+		}
+		actual, err := formatValue(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != tt.expected {
+			t.Fatalf("eval %s: expected: %s, actual: %s", tt.expr, tt.expected, string(actual))
+		}
+	}
+
+	stmt, err := sqlparser.Parse("update mytable set x = d + 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = evalUpdateExpr(stmt.(*sqlparser.Update).Exprs[0].Expr, row, nil, parser); err == nil {
+		t.Fatal("expected invalid number error")
+	}
+}
+
+func TestEvalValuesFunc(t *testing.T) {
+	row := map[string]interface{}{"a": int64(5)}
+	values := map[string]interface{}{"a": []byte("3"), "b": nil}
+	stmt, err := sqlparser.Parse("insert into mytable (a) values (3) on duplicate key update a = a + values(a)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expr := stmt.(*sqlparser.Insert).OnDup[0].Expr
+	v, err := evalUpdateExpr(expr, row, values, &StmtParser{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(8) {
+		t.Fatalf("unexpected value: %v", v)
+	}
+	// update语句中不能使用VALUES()
+	if _, err = evalUpdateExpr(expr, row, nil, &StmtParser{}); err == nil {
+		t.Fatal("expected VALUES() error")
+	}
+}
+
+func TestConvertColumnValue(t *testing.T) {
+	intCol := &metapb.Column{Name: "i", DataType: metapb.DataType_BigInt}
+	v, err := convertColumnValue(intCol, float64(2.5))
+	if err != nil || v != int64(3) {
+		t.Fatalf("unexpected value: %v, err: %v", v, err)
+	}
+	uintCol := &metapb.Column{Name: "u", DataType: metapb.DataType_BigInt, Unsigned: true}
+	if _, err = convertColumnValue(uintCol, int64(-1)); err == nil {
+		t.Fatal("expected out of range error")
+	}
+	strCol := &metapb.Column{Name: "s", DataType: metapb.DataType_Varchar}
+	v, err = convertColumnValue(strCol, int64(12))
+	if err != nil || string(v.([]byte)) != "12" {
+		t.Fatalf("unexpected value: %v, err: %v", v, err)
+	}
+}
+
+func TestParamCount(t *testing.T) {
+	if n := paramCount("update t set a = ?, b = '?' where id = ? and name = :name"); n != 2 {
+		t.Fatalf("expected 2 params, actual: %d", n)
+	}
+}
+
+func TestTxnWriteUpdatedRows(t *testing.T) {
+	table := newTxnTestTable()
+	oldKv := encodeTxnTestRow(t, table, "1", "a", "1.5")
+	newKv := encodeTxnTestRow(t, table, "1", "b", "1.5")
+	txn := newTxn("1", timestamp.Timestamp{WallTime: 1}, 0)
+	// 主键不变时只写入新行, 提交时按更新前的行检查冲突
+	affected, err := new(Proxy).txnWriteUpdatedRows(txn, table, [][2]*kvrpcpb.KeyValue{{oldKv, newKv}})
+	if err != nil || affected != 1 {
+		t.Fatalf("expected 1 updated row, actual: %d, %v", affected, err)
+	}
+	m := txn.get(oldKv.GetKey())
+	if m == nil || m.isDelete || m.base == nil || !m.base.exists {
+		t.Fatalf("unexpected mutation: %v", m)
+	}
+	if string(m.base.row["name"].([]byte)) != "a" {
+		t.Fatalf("expected base row of the old value, actual: %v", m.base.row)
+	}
+}
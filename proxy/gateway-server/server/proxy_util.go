@@ -3,6 +3,7 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"model/pkg/metapb"
 
@@ -16,30 +17,46 @@ var supportedAggreFuncs = map[string]struct{}{
 	"max":   struct{}{},
 	"sum":   struct{}{},
 	"count": struct{}{},
-	// TODO:
-	// "avg":   struct{}{},
+	"avg":   struct{}{},
+}
+
+// 本地缓存的表结构中找不到列, 可能是其他gateway修改了表结构(如重命名列)而本地缓存还没有过期
+func isUnknownColumnError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "Unknown column")
 }
 
 func makeFieldList(t *Table, selCols []*SelColumn) ([]*kvrpcpb.SelectField, error) {
-	fieldList := make([]*kvrpcpb.SelectField, 0, len(selCols))
-	var hasAggre, hasCol bool
+	fieldList, hasCol, hasAggre, err := makeSelectFieldList(t, selCols)
+	if err != nil {
+		return nil, err
+	}
+	// 有聚合函数没group by时，普通列和聚合函数只能出现一种
+	if hasCol && hasAggre {
+		return nil, fmt.Errorf("In aggregated query without GROUP BY SELECT list contains nonaggregated column")
+	}
+	return fieldList, nil
+}
+
+// 查询列转换为fieldList, 不检查普通列和聚合函数是否同时出现
+func makeSelectFieldList(t *Table, selCols []*SelColumn) (fieldList []*kvrpcpb.SelectField, hasCol, hasAggre bool, err error) {
+	fieldList = make([]*kvrpcpb.SelectField, 0, len(selCols))
 	// field list
 	for _, sc := range selCols {
 		// aggregate function
 		if sc.aggreFunc != "" {
 			_, ok := supportedAggreFuncs[sc.aggreFunc]
 			if !ok {
-				return nil, fmt.Errorf("unsupported aggregate function[%s]", sc.aggreFunc)
+				return nil, false, false, fmt.Errorf("unsupported aggregate function[%s]", sc.aggreFunc)
 			}
 			var aggreCol *metapb.Column
 			// check aggre column exist
 			if sc.col == "" && sc.aggreFunc != "count" { // count(*) is ok
-				return nil, fmt.Errorf("aggregate column(%s) is required", sc.col)
+				return nil, false, false, fmt.Errorf("aggregate column(%s) is required", sc.col)
 			}
 			if sc.col != "" {
 				aggreCol = t.FindColumn(sc.col)
 				if aggreCol == nil {
-					return nil, fmt.Errorf("Unknown aggregate column '%s' in 'field list'", sc.col)
+					return nil, false, false, fmt.Errorf("Unknown aggregate column '%s' in 'field list'", sc.col)
 				}
 			}
 			fieldList = append(fieldList, &kvrpcpb.SelectField{
@@ -65,19 +82,14 @@ func makeFieldList(t *Table, selCols []*SelColumn) ([]*kvrpcpb.SelectField, erro
 
 		col := t.FindColumn(sc.col)
 		if col == nil {
-			return nil, fmt.Errorf("Unknown column '%s' in 'field list'", sc.col)
+			return nil, false, false, fmt.Errorf("Unknown column '%s' in 'field list'", sc.col)
 		}
 		fieldList = append(fieldList, &kvrpcpb.SelectField{
 			Typ:    kvrpcpb.SelectField_Column,
 			Column: col,
 		})
 	}
-	// 有聚合函数没group by时，普通列和聚合函数只能出现一种
-	// TODO: 支持group by
-	if hasCol && hasAggre {
-		return nil, fmt.Errorf("In aggregated query without GROUP BY SELECT list contains nonaggregated column")
-	}
-	return fieldList, nil
+	return fieldList, hasCol, hasAggre, nil
 }
 
 func fieldList2ColNames(fieldList []*kvrpcpb.SelectField) ([]string, error) {
@@ -121,6 +133,10 @@ func makePBMatches(t *Table, matches []Match) ([]*kvrpcpb.Match, error) {
 			log.Error("invalid column[%s %s %s] in where clause", t.DbName(), t.Name(), m.column)
 			return nil, fmt.Errorf("Unknown column '%s' in 'where clause'", m.column)
 		}
+		// dataserver不支持的条件需要在gateway中过滤
+		if !isPushdownMatch(m) {
+			return nil, fmt.Errorf("match type(%v) of column '%s' can not push down", m.matchType, m.column)
+		}
 		pbMatches = append(pbMatches, &kvrpcpb.Match{
 			Column:    col,
 			Threshold: m.sqlValue,
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -85,6 +86,9 @@ func (rr *Router) findDbFromRemote(dbname string) *DataBase {
 		log.Error("get db[%s] from master server failed, err[%v]", dbname, err)
 		return nil
 	}
+	if _db == nil {
+		return nil
+	}
 	return NewDataBase(_db, rr.cli)
 }
 
@@ -122,6 +126,23 @@ func (rr *Router) FindTable(dbName, tableName string) *Table {
 	return nil
 }
 
+// 表结构有更新时返回新的表, 否则返回nil
+func (rr *Router) RefreshTable(dbName, tableName string) *Table {
+	db := rr.FindDB(dbName)
+	if db != nil {
+		return db.RefreshTable(tableName)
+	}
+	return nil
+}
+
+func (rr *Router) UpdateTable(dbName string, t *metapb.Table) *Table {
+	db := rr.FindDB(dbName)
+	if db != nil {
+		return db.UpdateTable(t)
+	}
+	return nil
+}
+
 func (rr *Router) FindColumn(db, table, clo string) *metapb.Column {
 	t := rr.FindTable(db, table)
 	if t != nil {
@@ -136,4 +157,48 @@ func (rr *Router) GetAllColumns(db, table string) []*metapb.Column {
 		return t.GetAllColumns()
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (rr *Router) ExpireTable(dbName, tableName string) {
+	db := rr.FindDB(dbName)
+	if db != nil {
+		db.ExpireTable(tableName)
+	}
+}
+
+// 创建或删除库后清除库的缓存
+func (rr *Router) ExpireDB(dbName string) {
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	if db, ok := rr.dbNs[dbName]; ok {
+		delete(rr.dbNs, dbName)
+		delete(rr.dbIs, db.GetId())
+	}
+	rr.missDbs.Delete(dbName)
+}
+
+// 从master-server加载所有库, 按库名排序
+func (rr *Router) GetAllDBs() ([]*DataBase, error) {
+	dbs, err := rr.cli.GetDatabases()
+	if err != nil {
+		log.Error("get databases from master server failed, err[%v]", err)
+		return nil, err
+	}
+	rr.lock.Lock()
+	defer rr.lock.Unlock()
+	result := make([]*DataBase, 0, len(dbs))
+	for _, _db := range dbs {
+		db, ok := rr.dbNs[_db.GetName()]
+		if !ok || db.GetId() != _db.GetId() {
+			db = NewDataBase(_db, rr.cli)
+			rr.dbNs[db.GetName()] = db
+			rr.dbIs[db.GetId()] = db
+			rr.missDbs.Delete(db.GetName())
+		}
+		result = append(result, db)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result, nil
+}
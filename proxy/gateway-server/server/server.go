@@ -68,6 +68,9 @@ type Server struct {
 	proxy   *Proxy
 	httpSvr *server.Server
 
+	counter   *Counter
+	startTime time.Time
+
 	listener net.Listener
 	running  bool
 }
@@ -91,7 +94,8 @@ func NewServer(cfg *Config) (*Server, error) {
 	s := new(Server)
 
 	s.cfg = cfg
-	//s.counter = new(Counter)
+	s.counter = new(Counter)
+	s.startTime = time.Now()
 	s.addr = fmt.Sprintf(":%d", cfg.SqlPort)
 	s.lockRpcAddr = fmt.Sprintf(":%d", cfg.LockRpcPort)
 	s.user = cfg.User
@@ -219,7 +223,7 @@ func (s *Server) newClientConn(co net.Conn) *ClientConn {
 }
 
 func (s *Server) onConn(c net.Conn) {
-	//s.counter.IncrClientConns()
+	s.counter.IncrClientConns()
 	conn := s.newClientConn(c) //新建一个conn
 
 	defer func() {
@@ -233,7 +237,7 @@ func (s *Server) onConn(c net.Conn) {
 		}
 
 		conn.Close()
-		//s.counter.DecrClientConns()
+		s.counter.DecrClientConns()
 	}()
 
 	if allowConnect := conn.IsAllowConnect(); allowConnect == false {
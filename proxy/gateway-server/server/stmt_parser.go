@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"proxy/gateway-server/sqlparser"
 	"util/hack"
@@ -23,15 +24,35 @@ var (
 	LessOrEqual   MatchType = 4
 	Larger        MatchType = 5
 	LargerOrEqual MatchType = 6
+
+	// 以下类型不能下推到dataserver, 在gateway中计算
+	In        MatchType = 7
+	NotIn     MatchType = 8
+	Like      MatchType = 9
+	NotLike   MatchType = 10
+	IsNull    MatchType = 11
+	IsNotNull MatchType = 12
 )
 
+// where条件最多展开的OR分支个数
+const maxWhereDisjuncts = 1024
+
 type StmtParser struct {
+	args []interface{} // prepare语句绑定的参数
 }
 
 type Match struct {
 	column    string
 	sqlValue  []byte
 	matchType MatchType
+	values    [][]byte // IN, NOT IN的值列表
+	hasNull   bool     // IN, NOT IN的值列表中有NULL
+}
+
+// UpdateColumn update语句set子句中的一个赋值
+type UpdateColumn struct {
+	column string
+	expr   sqlparser.ValExpr
 }
 
 type Limit struct {
@@ -172,6 +193,12 @@ func (s *StmtParser) parseInsertValues(insert *sqlparser.Insert) ([]InsertRowVal
 				rowValue = append(rowValue, SQLValue(val))
 			case *sqlparser.NullVal:
 				rowValue = append(rowValue, SQLValue(nil))
+			case sqlparser.ValArg:
+				v, err := s.parseValArg(val)
+				if err != nil {
+					return nil, err
+				}
+				rowValue = append(rowValue, v)
 			default:
 				return nil, fmt.Errorf("unsupported insert value type(%T) at row %d filed %d", valExpr, i, j)
 			}
@@ -181,6 +208,55 @@ func (s *StmtParser) parseInsertValues(insert *sqlparser.Insert) ([]InsertRowVal
 	return rowValues, nil
 }
 
+// 解析update语句的set子句
+func (s *StmtParser) parseUpdateExprs(stmt *sqlparser.Update) ([]*UpdateColumn, error) {
+	return s.parseUpdateList(stmt.Exprs)
+}
+
+// 解析赋值列表, update的set子句或者insert的on duplicate key update子句
+func (s *StmtParser) parseUpdateList(exprs sqlparser.UpdateExprs) ([]*UpdateColumn, error) {
+	if len(exprs) == 0 {
+		return nil, fmt.Errorf("empty update set clause")
+	}
+	cols := make([]*UpdateColumn, 0, len(exprs))
+	exists := make(map[string]struct{}, len(exprs))
+	for _, expr := range exprs {
+		if expr.Name == nil || len(expr.Name.Name) == 0 {
+			return nil, fmt.Errorf("invalid update column(empty)")
+		}
+		colName := string(expr.Name.Name)
+		if _, ok := exists[colName]; ok {
+			return nil, fmt.Errorf("duplicate column(%s) for update", colName)
+		}
+		exists[colName] = struct{}{}
+		cols = append(cols, &UpdateColumn{column: colName, expr: expr.Expr})
+	}
+	return cols, nil
+}
+
+// 解析prepare语句的绑定参数(:v1, :v2 ...), NULL参数返回nil
+func (s *StmtParser) parseValArg(arg sqlparser.ValArg) (SQLValue, error) {
+	name := string(arg)
+	if !strings.HasPrefix(name, ":v") {
+		return nil, fmt.Errorf("unsupported bind variable(%s)", name)
+	}
+	index, err := strconv.Atoi(name[2:])
+	if err != nil || index <= 0 {
+		return nil, fmt.Errorf("invalid bind variable(%s)", name)
+	}
+	if index > len(s.args) {
+		return nil, fmt.Errorf("missing argument for bind variable(%s)", name)
+	}
+	if s.args[index-1] == nil {
+		return nil, nil
+	}
+	value, err := formatValue(s.args[index-1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid argument for bind variable(%s): %v", name, err)
+	}
+	return SQLValue(value), nil
+}
+
 func (s *StmtParser) parseOperator(operator string) MatchType {
 	switch operator {
 	case sqlparser.AST_EQ:
@@ -195,41 +271,147 @@ func (s *StmtParser) parseOperator(operator string) MatchType {
 		return LargerOrEqual
 	case sqlparser.AST_NE:
 		return NotEqual
-	case sqlparser.AST_NSE:
 	case sqlparser.AST_IN:
+		return In
 	case sqlparser.AST_NOT_IN:
+		return NotIn
 	case sqlparser.AST_LIKE:
+		return Like
 	case sqlparser.AST_NOT_LIKE:
+		return NotLike
+	case sqlparser.AST_NSE:
 	}
 	return Invalid
 }
 
 func (s *StmtParser) parseComparison(expr *sqlparser.ComparisonExpr) (*Match, error) {
-	var column string
-	if col, ok := expr.Left.(*sqlparser.ColName); ok {
-		column = string(col.Name)
-	} else {
-		log.Error("invalid expr")
-		return nil, fmt.Errorf("expr.left transfer type err %v", expr.Left)
+	column, err := s.parseColumnName(expr.Left)
+	if err != nil {
+		return nil, err
 	}
 	matchType := s.parseOperator(expr.Operator)
 	if matchType == Invalid {
 		return nil, fmt.Errorf("unsupported comparsion operator(%s)", expr.Operator)
 	}
-	var value []byte
-	switch lrVal := expr.Right.(type) {
+	if matchType == In || matchType == NotIn {
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("unsupported %s value type(%T)", expr.Operator, expr.Right)
+		}
+		values := make([][]byte, 0, len(tuple))
+		var hasNull bool
+		for _, v := range tuple {
+			value, err := s.parseMatchValue(v)
+			if err != nil {
+				return nil, err
+			}
+			// NULL不会匹配任何值, 但是没有匹配时结果为NULL而不是false, 取反后仍然不成立
+			if value == nil {
+				hasNull = true
+				continue
+			}
+			values = append(values, value)
+		}
+		// 只有一个值的IN等价于等值条件
+		if matchType == In && len(values) == 1 && !hasNull {
+			return &Match{column: column, sqlValue: values[0], matchType: Equal}, nil
+		}
+		return &Match{column: column, matchType: matchType, values: values, hasNull: hasNull}, nil
+	}
+
+	value, err := s.parseMatchValue(expr.Right)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("unsupported NULL value in comparison of column(%s)", column)
+	}
+	return &Match{column: column, sqlValue: value, matchType: matchType}, nil
+}
+
+func (s *StmtParser) parseColumnName(expr sqlparser.ValExpr) (string, error) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		log.Error("invalid expr")
+		return "", fmt.Errorf("expr.left transfer type err %v", expr)
+	}
+	return string(col.Name), nil
+}
+
+// 解析条件中的值, NULL返回nil
+func (s *StmtParser) parseMatchValue(expr sqlparser.ValExpr) ([]byte, error) {
+	switch val := expr.(type) {
 	case sqlparser.StrVal:
-		value = []byte(lrVal)
+		return []byte(val), nil
 	case sqlparser.NumVal:
-		value = []byte(lrVal)
+		return []byte(val), nil
+	case *sqlparser.NullVal:
+		return nil, nil
+	case sqlparser.ValArg:
+		v, err := s.parseValArg(val)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, nil
+		}
+		return []byte(v), nil
 	default:
 		log.Debug("unknown val type")
-		return nil, fmt.Errorf("expr type unsupported, unknown val type %v", expr.Right)
+		return nil, fmt.Errorf("expr type unsupported, unknown val type %v", expr)
+	}
+}
+
+// between转换为两个范围条件, not between转换为两个条件的OR
+func (s *StmtParser) parseRangeCond(expr *sqlparser.RangeCond) ([][]Match, error) {
+	column, err := s.parseColumnName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.parseMatchValue(expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.parseMatchValue(expr.To)
+	if err != nil {
+		return nil, err
+	}
+	if from == nil || to == nil {
+		return nil, fmt.Errorf("unsupported NULL value in %s of column(%s)", expr.Operator, column)
+	}
+	switch expr.Operator {
+	case sqlparser.AST_BETWEEN:
+		return [][]Match{{
+			Match{column: column, sqlValue: from, matchType: LargerOrEqual},
+			Match{column: column, sqlValue: to, matchType: LessOrEqual},
+		}}, nil
+	case sqlparser.AST_NOT_BETWEEN:
+		return [][]Match{
+			{Match{column: column, sqlValue: from, matchType: Less}},
+			{Match{column: column, sqlValue: to, matchType: Larger}},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported range operator(%s)", expr.Operator)
 	}
-	return &Match{column: column, sqlValue: value, matchType: matchType}, nil
 }
 
-func (s *StmtParser) parseMatch(_expr sqlparser.BoolExpr) (matches []Match, err error) {
+func (s *StmtParser) parseNullCheck(expr *sqlparser.NullCheck) (*Match, error) {
+	column, err := s.parseColumnName(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.AST_IS_NULL:
+		return &Match{column: column, matchType: IsNull}, nil
+	case sqlparser.AST_IS_NOT_NULL:
+		return &Match{column: column, matchType: IsNotNull}, nil
+	default:
+		return nil, fmt.Errorf("unsupported null check operator(%s)", expr.Operator)
+	}
+}
+
+// 解析为析取范式: 返回值中每一项为AND条件, 各项之间为OR关系
+func (s *StmtParser) parseMatch(_expr sqlparser.BoolExpr) (matches [][]Match, err error) {
 	switch expr := _expr.(type) {
 	case *sqlparser.AndExpr:
 		leftMatches, err := s.parseMatch(expr.Left)
@@ -240,23 +422,121 @@ func (s *StmtParser) parseMatch(_expr sqlparser.BoolExpr) (matches []Match, err
 		if err != nil {
 			return nil, err
 		}
-		matches = append(matches, leftMatches...)
-		matches = append(matches, rigthMatches...)
+		return andMatches(leftMatches, rigthMatches)
+	case *sqlparser.OrExpr:
+		leftMatches, err := s.parseMatch(expr.Left)
+		if err != nil {
+			return nil, err
+		}
+		rigthMatches, err := s.parseMatch(expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return orMatches(leftMatches, rigthMatches)
+	case *sqlparser.NotExpr:
+		inner, err := s.parseMatch(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return notMatches(inner)
+	case *sqlparser.ParenBoolExpr:
+		return s.parseMatch(expr.Expr)
 	case *sqlparser.ComparisonExpr:
 		match, err := s.parseComparison(expr)
 		if err != nil {
 			return nil, err
 		}
-		matches = append(matches, *match)
+		matches = [][]Match{{*match}}
+	case *sqlparser.RangeCond:
+		return s.parseRangeCond(expr)
+	case *sqlparser.NullCheck:
+		match, err := s.parseNullCheck(expr)
+		if err != nil {
+			return nil, err
+		}
+		matches = [][]Match{{*match}}
 	default:
 		return nil, fmt.Errorf("unsupported expr type: %T", expr)
 	}
 	return
 }
 
-// now only support where exp; where exp1 and exp2
-func (s *StmtParser) parseWhere(where *sqlparser.Where) ([]Match, error) {
-	// TODO: 支持OR表达式
+// (a OR b) AND (c OR d) => (a AND c) OR (a AND d) OR (b AND c) OR (b AND d)
+func andMatches(left, right [][]Match) ([][]Match, error) {
+	if len(left)*len(right) > maxWhereDisjuncts {
+		return nil, fmt.Errorf("too many OR conditions in where clause(more than %d)", maxWhereDisjuncts)
+	}
+	matches := make([][]Match, 0, len(left)*len(right))
+	for _, l := range left {
+		for _, r := range right {
+			and := make([]Match, 0, len(l)+len(r))
+			and = append(and, l...)
+			and = append(and, r...)
+			matches = append(matches, and)
+		}
+	}
+	return matches, nil
+}
+
+func orMatches(left, right [][]Match) ([][]Match, error) {
+	if len(left)+len(right) > maxWhereDisjuncts {
+		return nil, fmt.Errorf("too many OR conditions in where clause(more than %d)", maxWhereDisjuncts)
+	}
+	matches := make([][]Match, 0, len(left)+len(right))
+	matches = append(matches, left...)
+	matches = append(matches, right...)
+	return matches, nil
+}
+
+// NOT ((a AND b) OR c) => (NOT a OR NOT b) AND NOT c
+func notMatches(matches [][]Match) ([][]Match, error) {
+	result := [][]Match{{}}
+	for _, and := range matches {
+		ors := make([][]Match, 0, len(and))
+		for _, m := range and {
+			ors = append(ors, []Match{negateMatch(m)})
+		}
+		var err error
+		if result, err = andMatches(result, ors); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// 列值为NULL时条件和取反后的条件都不成立, 跟MySQL一致
+func negateMatch(m Match) Match {
+	switch m.matchType {
+	case Equal:
+		m.matchType = NotEqual
+	case NotEqual:
+		m.matchType = Equal
+	case Less:
+		m.matchType = LargerOrEqual
+	case LessOrEqual:
+		m.matchType = Larger
+	case Larger:
+		m.matchType = LessOrEqual
+	case LargerOrEqual:
+		m.matchType = Less
+	case In:
+		m.matchType = NotIn
+	case NotIn:
+		m.matchType = In
+	case Like:
+		m.matchType = NotLike
+	case NotLike:
+		m.matchType = Like
+	case IsNull:
+		m.matchType = IsNotNull
+	case IsNotNull:
+		m.matchType = IsNull
+	}
+	return m
+}
+
+// 解析where条件, 返回析取范式
+func (s *StmtParser) parseWhere(where *sqlparser.Where) ([][]Match, error) {
 	return s.parseMatch(where.Expr)
 }
 
@@ -265,7 +545,7 @@ func parseAggreFunc(expr *sqlparser.FuncExpr) (aggreFunc, aggreCol string, err e
 		return "", "", fmt.Errorf("invalid aggregate function(%v) arg size(%d)", hack.String(expr.Name), len(expr.Exprs))
 	}
 
-	funcName := string(expr.Name)
+	funcName := strings.ToLower(string(expr.Name))
 
 	switch argExpr := expr.Exprs[0].(type) {
 	case *sqlparser.NonStarExpr:
@@ -134,3 +134,93 @@ func TestParseSelectCols(t *testing.T) {
 	}
 
 }
+
+func TestParseUpdateExprs(t *testing.T) {
+	sql := "update mytable set name = 'foo', age = age + 1 where id = ?"
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update, ok := stmt.(*sqlparser.Update)
+	if !ok {
+		t.Fatalf("not update statemnet")
+	}
+	stparser := StmtParser{args: []interface{}{int64(10)}}
+	cols, err := stparser.parseUpdateExprs(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0].column != "name" || cols[1].column != "age" {
+		t.Fatalf("parse error: unexpected update columns: %v", cols)
+	}
+	matches, err := stparser.parseWhere(update.Where)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]Match{{{column: "id", sqlValue: []byte("10"), matchType: Equal}}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("parse error: expected: %v, actual: %v", expected, matches)
+	}
+
+	// 测试重复列
+	stmt, err = sqlparser.Parse("update mytable set name = 'foo', name = 'bar'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stparser.parseUpdateExprs(stmt.(*sqlparser.Update)); err == nil {
+		t.Fatal("expected duplicate column error")
+	}
+}
+
+func TestParseValArg(t *testing.T) {
+	stparser := StmtParser{args: []interface{}{int64(-1), []byte("foo"), nil}}
+	v, err := stparser.parseValArg(sqlparser.ValArg(":v1"))
+	if err != nil || string(v) != "-1" {
+		t.Fatalf("unexpected value: %v, err: %v", v, err)
+	}
+	v, err = stparser.parseValArg(sqlparser.ValArg(":v2"))
+	if err != nil || string(v) != "foo" {
+		t.Fatalf("unexpected value: %v, err: %v", v, err)
+	}
+	v, err = stparser.parseValArg(sqlparser.ValArg(":v3"))
+	if err != nil || v != nil {
+		t.Fatalf("expected NULL value: %v, err: %v", v, err)
+	}
+	if _, err = stparser.parseValArg(sqlparser.ValArg(":v4")); err == nil {
+		t.Fatal("expected missing argument error")
+	}
+}
+
+func TestParseWhere(t *testing.T) {
+	id := func(typ MatchType, v string) Match {
+		return Match{column: "id", sqlValue: []byte(v), matchType: typ}
+	}
+	tests := []struct {
+		where    string
+		expected [][]Match
+	}{
+		{"id = 1 or id = 2", [][]Match{{id(Equal, "1")}, {id(Equal, "2")}}},
+		{"(id = 1 or id = 2) and id != 3", [][]Match{{id(Equal, "1"), id(NotEqual, "3")}, {id(Equal, "2"), id(NotEqual, "3")}}},
+		{"id in (3)", [][]Match{{id(Equal, "3")}}},
+		{"id not in (1, 2)", [][]Match{{Match{column: "id", matchType: NotIn, values: [][]byte{[]byte("1"), []byte("2")}}}}},
+		{"id not in (1, NULL)", [][]Match{{Match{column: "id", matchType: NotIn, values: [][]byte{[]byte("1")}, hasNull: true}}}},
+		{"not id in (1, NULL)", [][]Match{{Match{column: "id", matchType: NotIn, values: [][]byte{[]byte("1")}, hasNull: true}}}},
+		{"id between 1 and 5", [][]Match{{id(LargerOrEqual, "1"), id(LessOrEqual, "5")}}},
+		{"id not between 1 and 5", [][]Match{{id(Less, "1")}, {id(Larger, "5")}}},
+		{"not (id > 1 or id is null)", [][]Match{{id(LessOrEqual, "1"), Match{column: "id", matchType: IsNotNull}}}},
+		{"id like 'a%'", [][]Match{{id(Like, "a%")}}},
+	}
+	for _, tt := range tests {
+		stmt, err := sqlparser.Parse("select * from mytable where " + tt.where)
+		if err != nil {
+			t.Fatal(err)
+		}
+		where, err := new(StmtParser).parseWhere(stmt.(*sqlparser.Select).Where)
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", tt.where, err)
+		}
+		if !reflect.DeepEqual(where, tt.expected) {
+			t.Fatalf("parse %s: expected: %v, actual: %v", tt.where, tt.expected, where)
+		}
+	}
+}
@@ -16,6 +16,8 @@ type Table struct {
 	primaryKeys []string
 	cli         client.Client
 	deadline    time.Time
+	// 上次因找不到列从master-server刷新表结构的时间
+	refreshTime time.Time
 
 	ranges  *dskv.RangeCache
 	//routes  map[uint64]*Route
@@ -133,14 +135,14 @@ func (t *Table) AddColumn(c *metapb.Column) {
 	}
 
 	t.cLock.Lock()
-	defer t.cLock.Lock()
+	defer t.cLock.Unlock()
 	t.columns[c.Name] = c
 	t.columnIds[c.Id] = c
 }
 
 func (t *Table) DeleteColumn(columnName string) {
 	t.cLock.Lock()
-	defer t.cLock.Lock()
+	defer t.cLock.Unlock()
 	if c, ok := t.columns[columnName]; ok {
 		delete(t.columns, columnName)
 		delete(t.columnIds, c.Id)
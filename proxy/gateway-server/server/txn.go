@@ -0,0 +1,747 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"proxy/gateway-server/mysql"
+	"util"
+	"util/encoding"
+	"util/log"
+)
+
+var (
+	ErrTxnTooLarge      = errors.New("too many rows modified in transaction")
+	ErrTxnTimeout       = errors.New("transaction timeout, please restart transaction")
+	ErrTxnReadConflict  = errors.New("read conflicts with committing transactions, please retry")
+	errTxnWindowExpired = errors.New("transaction apply window expired")
+)
+
+const (
+	// 隐式事务冲突时重新执行语句的次数
+	txnAutoCommitRetries = 3
+	txnConflictBackoff   = 20 * time.Millisecond
+	// 读取到正在写入的事务时重新读取的次数
+	txnReadRetries       = 20
+	txnReadRetryInterval = 50 * time.Millisecond
+)
+
+// 事务冲突, 同MySQL返回死锁错误码, 客户端需要重试事务
+func newTxnConflictError() error {
+	return mysql.NewDefaultError(mysql.ER_LOCK_DEADLOCK)
+}
+
+// Txn 客户端连接上的一个事务
+// 事务内的写入只缓存在gateway中, 事务内的查询会合并缓存的写入;
+// 提交时在dataserver上给修改的行加锁并做冲突检查, 然后把所有写入以同一个提交时间戳写入dataserver,
+// 锁和事务记录见txn_store.go
+// 查询读取的是dataserver上最新提交的数据, 只有被事务修改的行在提交时会重新校验
+type Txn struct {
+	id           string
+	startTs      timestamp.Timestamp
+	startTime    time.Time
+	maxMutations int
+
+	mutations map[string]*txnMutation
+	// 当前语句修改前的状态, 语句失败时回滚
+	undo []txnUndo
+}
+
+type txnMutation struct {
+	table    *Table
+	kv       *kvrpcpb.KeyValue // 删除时只有Key
+	isDelete bool
+	// 第一次修改前读到的行, 提交时重新读取比较; nil表示盲写, 不校验
+	base *txnBase
+}
+
+type txnBase struct {
+	exists bool
+	row    map[string]interface{}
+}
+
+type txnUndo struct {
+	key  string
+	prev *txnMutation
+}
+
+func newTxn(id string, startTs timestamp.Timestamp, maxMutations int) *Txn {
+	if maxMutations <= 0 {
+		maxMutations = DefaultTxnMaxMutations
+	}
+	return &Txn{
+		id:           id,
+		startTs:      startTs,
+		startTime:    time.Now(),
+		maxMutations: maxMutations,
+		mutations:    make(map[string]*txnMutation),
+	}
+}
+
+func (txn *Txn) ID() string {
+	return txn.id
+}
+
+func (txn *Txn) Size() int {
+	return len(txn.mutations)
+}
+
+func (txn *Txn) beginStatement() {
+	txn.undo = txn.undo[:0]
+}
+
+// 语句执行失败时撤销该语句的所有写入, 保证语句的原子性
+func (txn *Txn) endStatement(err error) {
+	if err != nil {
+		for i := len(txn.undo) - 1; i >= 0; i-- {
+			u := txn.undo[i]
+			if u.prev == nil {
+				delete(txn.mutations, u.key)
+			} else {
+				txn.mutations[u.key] = u.prev
+			}
+		}
+	}
+	txn.undo = txn.undo[:0]
+}
+
+func (txn *Txn) get(key []byte) *txnMutation {
+	return txn.mutations[string(key)]
+}
+
+func (txn *Txn) add(m *txnMutation) error {
+	key := string(m.kv.GetKey())
+	prev, ok := txn.mutations[key]
+	if !ok && len(txn.mutations) >= txn.maxMutations {
+		return ErrTxnTooLarge
+	}
+	// 同一行多次修改时保留第一次修改前读到的值
+	if prev != nil {
+		m.base = prev.base
+	}
+	txn.undo = append(txn.undo, txnUndo{key: key, prev: prev})
+	txn.mutations[key] = m
+	return nil
+}
+
+func (txn *Txn) put(t *Table, kv *kvrpcpb.KeyValue, base *txnBase) error {
+	return txn.add(&txnMutation{table: t, kv: kv, base: base})
+}
+
+func (txn *Txn) delete(t *Table, key []byte, base *txnBase) error {
+	return txn.add(&txnMutation{table: t, kv: &kvrpcpb.KeyValue{Key: key}, isDelete: true, base: base})
+}
+
+// 按key排序的所有写入
+func (txn *Txn) sortedMutations() []*txnMutation {
+	muts := make([]*txnMutation, 0, len(txn.mutations))
+	for _, m := range txn.mutations {
+		muts = append(muts, m)
+	}
+	sort.Slice(muts, func(i, j int) bool {
+		return bytes.Compare(muts[i].kv.GetKey(), muts[j].kv.GetKey()) < 0
+	})
+	return muts
+}
+
+// 某个表上的写入, 按key排序
+func (txn *Txn) tableMutations(t *Table) []*txnMutation {
+	var muts []*txnMutation
+	for _, m := range txn.mutations {
+		if m.table.GetId() == t.GetId() {
+			muts = append(muts, m)
+		}
+	}
+	sort.Slice(muts, func(i, j int) bool {
+		return bytes.Compare(muts[i].kv.GetKey(), muts[j].kv.GetKey()) < 0
+	})
+	return muts
+}
+
+func (txn *Txn) record(commitTs timestamp.Timestamp) *kvrpcpb.TxnRecord {
+	startTs := txn.startTs
+	rec := &kvrpcpb.TxnRecord{
+		TxnId:     txn.id,
+		Status:    kvrpcpb.TxnStatus_TXN_Committed,
+		StartTs:   &startTs,
+		CommitTs:  &commitTs,
+		Mutations: make([]*kvrpcpb.TxnMutation, 0, len(txn.mutations)),
+	}
+	for _, m := range txn.sortedMutations() {
+		rec.Mutations = append(rec.Mutations, &kvrpcpb.TxnMutation{
+			DbName:    m.table.DbName(),
+			TableName: m.table.Name(),
+			IsDelete:  m.isDelete,
+			Kv:        m.kv,
+		})
+	}
+	return rec
+}
+
+func (p *Proxy) txnTimeout() time.Duration {
+	if p.config.TxnTimeoutSec <= 0 {
+		return time.Duration(DefaultTxnTimeoutSec) * time.Second
+	}
+	return time.Duration(p.config.TxnTimeoutSec) * time.Second
+}
+
+// 事务ID在所有gateway之间唯一
+func (p *Proxy) nextTxnId(ts timestamp.Timestamp) string {
+	seq := atomic.AddUint64(&p.txnSeq, 1)
+	var r [4]byte
+	rand.Read(r[:])
+	return fmt.Sprintf("%d-%d-%x", ts.WallTime, seq, r)
+}
+
+// BeginTxn 开始一个事务
+func (p *Proxy) BeginTxn() *Txn {
+	startTs := p.clock.Now()
+	txn := newTxn(p.nextTxnId(startTs), startTs, p.config.TxnMaxMutations)
+	if log.GetFileLogger().IsEnableDebug() {
+		log.Debug("[txn] begin txn %s", txn.id)
+	}
+	return txn
+}
+
+// RollbackTxn 回滚事务, 事务的写入只缓存在gateway中, 直接丢弃即可
+func (p *Proxy) RollbackTxn(txn *Txn) {
+	if log.GetFileLogger().IsEnableDebug() {
+		log.Debug("[txn] rollback txn %s, %d mutations discarded", txn.id, len(txn.mutations))
+	}
+	txn.mutations = nil
+	txn.undo = nil
+}
+
+// CommitTxn 提交事务
+// 1. 在dataserver上按key的顺序给修改的行加锁
+// 2. 重新读取被修改的行, 跟事务第一次读到的值比较
+// 3. 写入事务记录(提交点), 只修改一行时不需要事务记录
+// 4. 以提交时间戳写入数据行, 释放锁; 写入窗口内没有完成时由其他gateway恢复
+func (p *Proxy) CommitTxn(txn *Txn) error {
+	if txn == nil || len(txn.mutations) == 0 {
+		return nil
+	}
+	if time.Since(txn.startTime) > p.txnTimeout() {
+		log.Warn("[txn] txn %s timeout, started at %v", txn.id, txn.startTime)
+		return ErrTxnTimeout
+	}
+
+	muts := txn.sortedMutations()
+	keys := make([][]byte, 0, len(muts))
+	for _, m := range muts {
+		keys = append(keys, m.kv.GetKey())
+	}
+	lockTime := time.Now()
+	if err := p.lockTxnRows(txn.id, keys, lockTime.UnixNano()); err != nil {
+		log.Warn("[txn] txn %s lock rows failed(%v)", txn.id, err)
+		return err
+	}
+	release := func() {
+		if err := p.releaseTxnLocks(txn.id, keys); err != nil {
+			log.Warn("[txn] release locks of txn %s failed(%v)", txn.id, err)
+		}
+	}
+	for _, m := range muts {
+		if m.base == nil {
+			continue
+		}
+		ok, err := p.validateTxnBase(m)
+		if err != nil {
+			log.Error("[txn] txn %s validate row failed(%v)", txn.id, err)
+			release()
+			return err
+		}
+		if !ok {
+			log.Warn("[txn] txn %s row %v modified by others", txn.id, m.kv.GetKey())
+			release()
+			return newTxnConflictError()
+		}
+	}
+	deadline := lockTime.Add(txnApplyWindow)
+	if checkTxnDeadline(deadline) != nil {
+		log.Warn("[txn] txn %s lock rows timeout", txn.id)
+		release()
+		return newTxnConflictError()
+	}
+
+	rec := txn.record(p.clock.Now())
+	if len(muts) == 1 {
+		// 单行的写入是原子的, 加锁后的写入窗口内直接写入
+		// 写入失败时写请求可能还在途中, 锁超时后由其他事务回滚
+		if err := p.applyTxnRecord(rec, deadline); err != nil {
+			log.Error("[txn] txn %s apply mutation failed(%v)", txn.id, err)
+			return err
+		}
+		release()
+		return nil
+	}
+
+	commitTime := time.Now()
+	dup, err := p.putTxnRecord(txn.id, 0, kvrpcpb.TxnStatus_TXN_Committed, commitTime.UnixNano(), rec)
+	if err != nil {
+		log.Error("[txn] txn %s write txn record failed(%v)", txn.id, err)
+		return mysql.NewError(mysql.ER_ERROR_DURING_COMMIT,
+			fmt.Sprintf("commit of transaction %s is in doubt(%v)", txn.id, err))
+	}
+	if dup {
+		// 加锁超时, 已经被其他gateway回滚
+		log.Warn("[txn] txn %s was aborted by others", txn.id)
+		return newTxnConflictError()
+	}
+	if err = p.rollForwardTxn(rec, commitTime.UnixNano(), commitTime.Add(txnApplyWindow)); err != nil {
+		log.Error("[txn] txn %s apply mutations failed(%v), it will be recovered", txn.id, err)
+		return mysql.NewError(mysql.ER_ERROR_DURING_COMMIT,
+			fmt.Sprintf("transaction %s is committed but not fully applied(%v), it will be recovered", txn.id, err))
+	}
+	if log.GetFileLogger().IsEnableDebug() {
+		log.Debug("[txn] commit txn %s, %d mutations", txn.id, len(muts))
+	}
+	return nil
+}
+
+// 非事务的写入语句以隐式事务执行, 跟显式事务一样加锁; 冲突时重新执行整个语句
+func (p *Proxy) autoCommit(f func(txn *Txn) error) (err error) {
+	for i := 0; ; i++ {
+		txn := p.BeginTxn()
+		if err = f(txn); err == nil {
+			err = p.CommitTxn(txn)
+		} else {
+			p.RollbackTxn(txn)
+		}
+		if !isTxnConflict(err) || i >= txnAutoCommitRetries {
+			return err
+		}
+		time.Sleep(time.Duration(i+1) * txnConflictBackoff)
+	}
+}
+
+func isTxnConflict(err error) bool {
+	e, ok := err.(*mysql.SqlError)
+	return ok && e.Code == mysql.ER_LOCK_DEADLOCK
+}
+
+// 比较dataserver上当前的行跟事务第一次读到的行
+func (p *Proxy) validateTxnBase(m *txnMutation) (bool, error) {
+	fieldList, row, err := p.getRow(m.table, m.kv.GetKey())
+	if err != nil {
+		return false, err
+	}
+	if row == nil || !m.base.exists {
+		return (row != nil) == m.base.exists, nil
+	}
+	return rowFieldsEqual(rowFieldMap(fieldList, row), m.base.row), nil
+}
+
+// 把事务的所有写入以提交时间戳写入dataserver, 可以重复执行
+// 每次写请求前检查deadline, 超过后返回errTxnWindowExpired
+func (p *Proxy) applyTxnRecord(rec *kvrpcpb.TxnRecord, deadline time.Time) error {
+	commitTs := *rec.GetCommitTs()
+	tables := make(map[uint64]*Table)
+	puts := make(map[uint64][]*kvrpcpb.KeyValue)
+	// 有索引的表在写入后清理旧的索引项
+	type oldRow struct {
+		t      *Table
+		kv     *kvrpcpb.KeyValue
+		values map[string]interface{}
+	}
+	var olds []oldRow
+	for _, m := range rec.GetMutations() {
+		t := p.router.FindTable(m.GetDbName(), m.GetTableName())
+		if t == nil {
+			log.Warn("[txn] table %s.%s of txn %s doesn't exist, skip", m.GetDbName(), m.GetTableName(), rec.GetTxnId())
+			continue
+		}
+		var old map[string]interface{}
+		if len(t.GetIndexes()) > 0 {
+			old = p.currentRowValues(t, m.GetKv().GetKey())
+		}
+		if m.GetIsDelete() {
+			if err := checkTxnDeadline(deadline); err != nil {
+				return err
+			}
+			dreq := &kvrpcpb.DeleteRequest{
+				Key:       m.GetKv().GetKey(),
+				Timestamp: &timestamp.Timestamp{WallTime: commitTs.WallTime, Logical: commitTs.Logical},
+			}
+			if _, err := p.deleteRemote(t.DbName(), t.Name(), dreq); err != nil {
+				return err
+			}
+			if err := checkTxnDeadline(deadline); err != nil {
+				return err
+			}
+			p.deleteIndexEntries(t, old, nil)
+			continue
+		}
+		if old != nil {
+			olds = append(olds, oldRow{t: t, kv: m.GetKv(), values: old})
+		}
+		tables[t.GetId()] = t
+		puts[t.GetId()] = append(puts[t.GetId()], m.GetKv())
+	}
+	for id, kvs := range puts {
+		t := tables[id]
+		kvGroup, err := p.groupByRange(t, kvs)
+		if err != nil {
+			return err
+		}
+		for _, group := range kvGroup {
+			if err = checkTxnDeadline(deadline); err != nil {
+				return err
+			}
+			if _, _, err = p.insertKvsAt(t, group, false, commitTs); err != nil {
+				return err
+			}
+		}
+	}
+	for _, old := range olds {
+		values, err := decodeKvRow(old.t, old.kv)
+		if err != nil {
+			log.Warn("[txn] decode row of %s.%s failed(%v)", old.t.DbName(), old.t.Name(), err)
+			continue
+		}
+		if err = checkTxnDeadline(deadline); err != nil {
+			return err
+		}
+		p.deleteIndexEntries(old.t, old.values, values)
+	}
+	return nil
+}
+
+// 按主键读取一行的所有列, 不存在时row为nil
+func (p *Proxy) getRow(t *Table, key []byte) ([]*kvrpcpb.SelectField, *Row, error) {
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return nil, nil, err
+	}
+	now := p.clock.Now()
+	req := &kvrpcpb.SelectRequest{
+		Key:       key,
+		FieldList: fieldList,
+		Limit:     &kvrpcpb.Limit{Offset: 0, Count: 1},
+		Timestamp: &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+	}
+	rowss, err := p.selectRemote(t, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, rows := range rowss {
+		if len(rows) > 0 {
+			return fieldList, rows[0], nil
+		}
+	}
+	return fieldList, nil, nil
+}
+
+// 事务内写入一行, checkDup时该行已存在返回true, 不写入
+func (p *Proxy) txnPut(txn *Txn, t *Table, kv *kvrpcpb.KeyValue, checkDup bool) (bool, error) {
+	if !checkDup {
+		return false, txn.put(t, kv, nil)
+	}
+	if m := txn.get(kv.GetKey()); m != nil {
+		if !m.isDelete {
+			return true, nil
+		}
+		return false, txn.put(t, kv, nil)
+	}
+	_, row, err := p.getRow(t, kv.GetKey())
+	if err != nil {
+		return false, err
+	}
+	if row != nil {
+		return true, nil
+	}
+	return false, txn.put(t, kv, &txnBase{exists: false})
+}
+
+// 事务内插入多行
+func (p *Proxy) txnWriteRows(txn *Txn, t *Table, colMap map[string]int, rows []InsertRowValue, checkDup bool) (affected uint64, duplicateKey []byte, err error) {
+	for i, r := range rows {
+		kv, err := p.EncodeRow(t, colMap, r)
+		if err != nil {
+			log.Error("[insert] table %s.%s encode row at %d failed: %v", t.DbName(), t.Name(), i, err)
+			return affected, nil, err
+		}
+		dup, err := p.txnPut(txn, t, kv, checkDup)
+		if err != nil {
+			return affected, nil, err
+		}
+		if dup {
+			return affected, kv.GetKey(), nil
+		}
+		affected++
+	}
+	return affected, nil, nil
+}
+
+// 事务内更新多行, changes中每项为更新前后的行
+func (p *Proxy) txnWriteUpdatedRows(txn *Txn, t *Table, changes [][2]*kvrpcpb.KeyValue) (affected uint64, err error) {
+	for _, change := range changes {
+		oldKv, newKv := change[0], change[1]
+		row, err := decodeKvRow(t, oldKv)
+		if err != nil {
+			return affected, err
+		}
+		base := &txnBase{exists: true, row: row}
+		if bytes.Equal(oldKv.GetKey(), newKv.GetKey()) {
+			if err = txn.put(t, newKv, base); err != nil {
+				return affected, err
+			}
+			affected++
+			continue
+		}
+		dup, err := p.txnPut(txn, t, newKv, true)
+		if err != nil {
+			return affected, err
+		}
+		if dup {
+			return affected, newDupEntryError()
+		}
+		if err = txn.delete(t, oldKv.GetKey(), base); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+type txnRow struct {
+	key    []byte
+	values map[string]interface{}
+}
+
+// 事务内查询, 在dataserver的查询结果上合并事务中还没提交的写入
+func (p *Proxy) txnSelect(txn *Txn, t *Table, fieldList []*kvrpcpb.SelectField, matches []Match, limit *Limit) ([][]*Row, error) {
+	if txn == nil {
+		return p.doSelect(t, fieldList, matches, limit, nil)
+	}
+	muts := txn.tableMutations(t)
+	if len(muts) == 0 {
+		return p.doSelect(t, fieldList, matches, limit, nil)
+	}
+	for _, f := range fieldList {
+		if f.Typ != kvrpcpb.SelectField_Column {
+			return nil, fmt.Errorf("aggregate query on table with uncommitted changes is not supported in transaction")
+		}
+	}
+
+	allFields, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return nil, err
+	}
+	// 被事务修改过的行会从结果中去掉, 需要多取一些
+	var remoteLimit *Limit
+	if limit != nil {
+		count := limit.offset + limit.rowCount + uint64(len(muts))
+		if count > p.config.MaxLimit {
+			count = p.config.MaxLimit
+		}
+		remoteLimit = &Limit{offset: 0, rowCount: count}
+	}
+	rowss, err := p.doSelect(t, allFields, matches, remoteLimit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []*txnRow
+	for _, rows := range rowss {
+		for _, row := range rows {
+			key, err := rowKey(t, allFields, row)
+			if err != nil {
+				return nil, err
+			}
+			if txn.get(key) != nil {
+				continue
+			}
+			merged = append(merged, &txnRow{key: key, values: rowFieldMap(allFields, row)})
+		}
+	}
+	for _, m := range muts {
+		if m.isDelete {
+			continue
+		}
+		values, err := decodeKvRow(t, m.kv)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := matchRow(t, values, matches)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			merged = append(merged, &txnRow{key: m.kv.GetKey(), values: values})
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return bytes.Compare(merged[i].key, merged[j].key) < 0
+	})
+
+	if limit != nil {
+		if limit.offset >= uint64(len(merged)) {
+			merged = nil
+		} else {
+			merged = merged[limit.offset:]
+			if uint64(len(merged)) > limit.rowCount {
+				merged = merged[:limit.rowCount]
+			}
+		}
+	}
+
+	rows := make([]*Row, 0, len(merged))
+	for _, r := range merged {
+		row := &Row{fields: make([]Field, len(fieldList))}
+		for i, f := range fieldList {
+			row.fields[i].col = f.Column.Name
+			row.fields[i].value = r.values[f.Column.Name]
+		}
+		rows = append(rows, row)
+	}
+	return [][]*Row{rows}, nil
+}
+
+func rowFieldMap(fieldList []*kvrpcpb.SelectField, row *Row) map[string]interface{} {
+	values := make(map[string]interface{}, len(fieldList))
+	for i, f := range fieldList {
+		if i < len(row.fields) && f.Column != nil {
+			values[f.Column.Name] = row.fields[i].value
+		}
+	}
+	return values
+}
+
+// 不存在的列按NULL处理
+func rowFieldsEqual(a, b map[string]interface{}) bool {
+	for col, v := range a {
+		if !fieldValueEqual(v, b[col]) {
+			return false
+		}
+	}
+	for col, v := range b {
+		if _, ok := a[col]; !ok && v != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldValueEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	av, err := formatValue(a)
+	if err != nil {
+		return false
+	}
+	bv, err := formatValue(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(av, bv)
+}
+
+// 根据行中的主键列计算行的key
+func rowKey(t *Table, fieldList []*kvrpcpb.SelectField, row *Row) ([]byte, error) {
+	values := rowFieldMap(fieldList, row)
+	key := util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId())
+	for _, pk := range t.PKS() {
+		col := t.FindColumn(pk)
+		if col == nil {
+			return nil, fmt.Errorf("invalid pk column(%s)", pk)
+		}
+		v, ok := values[pk]
+		if !ok || v == nil {
+			return nil, fmt.Errorf("pk(%s) is missing in row", pk)
+		}
+		sv, err := formatValue(v)
+		if err != nil {
+			return nil, err
+		}
+		if key, err = util.EncodePrimaryKey(key, col, sv); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// 解码一行的所有列
+func decodeKvRow(t *Table, kv *kvrpcpb.KeyValue) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(t.GetColumns()))
+	buf := kv.GetKey()
+	if len(buf) < 9 {
+		return nil, fmt.Errorf("invalid row key(%v)", buf)
+	}
+	buf = buf[9:]
+	var err error
+	for _, pk := range t.PKS() {
+		col := t.FindColumn(pk)
+		if col == nil {
+			return nil, fmt.Errorf("invalid pk column(%s)", pk)
+		}
+		var v interface{}
+		switch col.DataType {
+		case metapb.DataType_Tinyint, metapb.DataType_Smallint, metapb.DataType_Int, metapb.DataType_BigInt:
+			if col.Unsigned {
+				buf, v, err = decodeUvarintValue(buf)
+			} else {
+				buf, v, err = decodeVarintValue(buf)
+			}
+		case metapb.DataType_Float, metapb.DataType_Double:
+			buf, v, err = decodeFloatValue(buf)
+		case metapb.DataType_Varchar, metapb.DataType_Binary, metapb.DataType_Date, metapb.DataType_TimeStamp:
+			buf, v, err = decodeBytesValue(buf)
+		default:
+			err = fmt.Errorf("unsupported type(%s) when decoding pk(%s)", col.DataType.String(), col.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode pk(%s) failed(%v)", pk, err)
+		}
+		values[col.Name] = v
+	}
+
+	buf = kv.GetValue()
+	for len(buf) > 0 {
+		_, _, colID, _, err := encoding.DecodeValueTag(buf)
+		if err != nil {
+			return nil, err
+		}
+		col := t.FindColumnById(uint64(colID))
+		if col == nil {
+			// 列已经被删除
+			_, n, err := encoding.PeekValueLength(buf)
+			if err != nil {
+				return nil, err
+			}
+			buf = buf[n:]
+			continue
+		}
+		var v interface{}
+		if buf, v, err = util.DecodeColumnValue(buf, col); err != nil {
+			return nil, err
+		}
+		values[col.Name] = v
+	}
+	return values, nil
+}
+
+func decodeUvarintValue(buf []byte) ([]byte, interface{}, error) {
+	return encoding.DecodeUvarintAscending(buf)
+}
+
+func decodeVarintValue(buf []byte) ([]byte, interface{}, error) {
+	return encoding.DecodeVarintAscending(buf)
+}
+
+func decodeFloatValue(buf []byte) ([]byte, interface{}, error) {
+	return encoding.DecodeFloatAscending(buf)
+}
+
+func decodeBytesValue(buf []byte) ([]byte, interface{}, error) {
+	return encoding.DecodeBytesAscending(buf, nil)
+}
@@ -0,0 +1,802 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"master-server/server"
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"model/pkg/timestamp"
+	msClient "pkg-go/ms_client"
+	"util"
+	"util/log"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// 事务的锁和事务记录保存在dataserver的系统表中, 所有gateway共享:
+// txn_lock   提交时按key的顺序给修改的行加锁, 写入完成后释放, 其他事务遇到锁时冲突
+// txn_record 事务记录, 写入成功即为提交点, 包含事务的所有写入, 提交的gateway失败后由其他gateway继续写入
+// txn_commit 每个表上提交的事务, 读取时检查读取期间是否有事务在写入该表, 避免看到只写入了一部分的事务
+//
+// gateway之间没有通信, 同一个事务的写入者之间靠时间窗口隔离:
+// 提交的gateway只在提交后txnApplyWindow内发出写请求, 之后由其他gateway认领恢复;
+// 第n轮恢复从提交后n*(txnApplyWindow+txnWriteDelay)开始, 同样只在txnApplyWindow内发出写请求,
+// 上一轮在途的写请求在下一轮开始前都已经落盘
+// 只修改一行的事务不写事务记录, 加锁后txnApplyWindow内直接写入, 锁在txnLockTTL后才能被清理
+//
+// REST接口对有索引的表的写入以隐式事务执行; 其他REST写入和KV接口的写入不加锁, 不参与事务的隔离
+
+const (
+	txnDBName          = "sharkstore_txn"
+	txnLockTableName   = "txn_lock"
+	txnRecordTableName = "txn_record"
+	txnCommitTableName = "txn_commit"
+)
+
+const (
+	// 提交或者认领恢复后可以发出写请求的时间
+	txnApplyWindow = 10 * time.Second
+	// 需要大于一次写调用(包括索引写入和KvProxy内部的重试)的最长时间加上gateway之间的时钟偏差
+	txnWriteDelay = 2 * time.Minute
+	// 没有事务记录的锁超过该时间后认为加锁的gateway已经失败, 可以回滚
+	txnLockTTL = txnApplyWindow + txnWriteDelay
+	// gateway之间的最大时钟偏差
+	txnClockSkew = time.Second
+	// 写入完成的提交记录和回滚记录保留的时间, 超过该时间的读取需要重试
+	txnCommitRetention = 30 * time.Second
+	// 后台检查未完成事务的间隔
+	txnCheckInterval = 5 * time.Second
+)
+
+// 被锁住的行
+//
+//	CREATE TABLE txn_lock (
+//	  `k` binary NOT NULL,          行的key
+//	  `txn_id` varchar NOT NULL,
+//	  `lock_time` bigint NOT NULL,  加锁时间(纳秒)
+//	  PRIMARY KEY (`k`))
+var txnLockColumns = []*metapb.Column{
+	&metapb.Column{Name: "k", DataType: metapb.DataType_Binary, PrimaryKey: 1},
+	&metapb.Column{Name: "txn_id", DataType: metapb.DataType_Varchar},
+	&metapb.Column{Name: "lock_time", DataType: metapb.DataType_BigInt},
+}
+
+// 事务记录, seq为0的行是事务记录, seq大于0的行是第seq轮恢复的认领记录
+//
+//	CREATE TABLE txn_record (
+//	  `txn_id` varchar NOT NULL,
+//	  `seq` bigint NOT NULL,
+//	  `status` bigint NOT NULL,      kvrpcpb.TxnStatus
+//	  `commit_time` bigint NOT NULL, 提交或回滚的时间(纳秒), 认领记录为认领的时间
+//	  `data` binary,                 序列化的kvrpcpb.TxnRecord
+//	  PRIMARY KEY (`txn_id`, `seq`))
+var txnRecordColumns = []*metapb.Column{
+	&metapb.Column{Name: "txn_id", DataType: metapb.DataType_Varchar, PrimaryKey: 1},
+	&metapb.Column{Name: "seq", DataType: metapb.DataType_BigInt, PrimaryKey: 1},
+	&metapb.Column{Name: "status", DataType: metapb.DataType_BigInt},
+	&metapb.Column{Name: "commit_time", DataType: metapb.DataType_BigInt},
+	&metapb.Column{Name: "data", DataType: metapb.DataType_Binary},
+}
+
+// 每个表上提交的事务
+//
+//	CREATE TABLE txn_commit (
+//	  `table_id` bigint unsigned NOT NULL,
+//	  `txn_id` varchar NOT NULL,
+//	  `commit_time` bigint NOT NULL,
+//	  `finish_time` bigint NOT NULL,  写入完成的时间(纳秒), 0表示还在写入
+//	  PRIMARY KEY (`table_id`, `txn_id`))
+var txnCommitColumns = []*metapb.Column{
+	&metapb.Column{Name: "table_id", DataType: metapb.DataType_BigInt, Unsigned: true, PrimaryKey: 1},
+	&metapb.Column{Name: "txn_id", DataType: metapb.DataType_Varchar, PrimaryKey: 1},
+	&metapb.Column{Name: "commit_time", DataType: metapb.DataType_BigInt},
+	&metapb.Column{Name: "finish_time", DataType: metapb.DataType_BigInt},
+}
+
+var txnTableDefs = []struct {
+	name    string
+	columns []*metapb.Column
+}{
+	{txnLockTableName, txnLockColumns},
+	{txnRecordTableName, txnRecordColumns},
+	{txnCommitTableName, txnCommitColumns},
+}
+
+// 创建事务使用的系统库和系统表, 已存在时直接使用
+func (p *Proxy) prepareTxnTables() error {
+	err := p.msCli.CreateDatabase(txnDBName)
+	if err != nil && !msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrDupDatabase) {
+		log.Error("[txn] create database %s failed(%v)", txnDBName, err)
+		return err
+	}
+	for _, def := range txnTableDefs {
+		properties, err := json.Marshal(&server.TableProperty{Columns: def.columns})
+		if err != nil {
+			return err
+		}
+		err = p.msCli.CreateTable(txnDBName, def.name, string(properties), nil)
+		if err != nil && !msClient.IsSchemaError(err, mspb.SchemaErrorCode_SchemaErrDupTable) {
+			log.Error("[txn] create table %s.%s failed(%v)", txnDBName, def.name, err)
+			return err
+		}
+		deadline := time.Now().Add(createTableTimeout)
+		for {
+			t, err := p.msCli.GetTable(txnDBName, def.name)
+			if err == nil && t != nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("table %s.%s is created but not ready yet", txnDBName, def.name)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+func (p *Proxy) txnTable(name string) (*Table, error) {
+	t := p.router.FindTable(txnDBName, name)
+	if t == nil {
+		log.Error("[txn] table %s.%s doesn't exist", txnDBName, name)
+		return nil, ErrNotExistTable
+	}
+	return t, nil
+}
+
+// 按列名编码系统表的一行
+func (p *Proxy) encodeTxnRow(t *Table, values map[string]SQLValue) (*kvrpcpb.KeyValue, error) {
+	colMap := make(map[string]int, len(values))
+	row := make(InsertRowValue, 0, len(values))
+	for col, v := range values {
+		colMap[col] = len(row)
+		row = append(row, v)
+	}
+	return p.EncodeRow(t, colMap, row)
+}
+
+func txnIntValue(v int64) SQLValue {
+	return SQLValue(strconv.FormatInt(v, 10))
+}
+
+func txnInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	}
+	return 0
+}
+
+func txnString(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return ""
+}
+
+// 表的所有行
+func tableScope(t *Table) *kvrpcpb.Scope {
+	return &kvrpcpb.Scope{
+		Start: util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()),
+		Limit: util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()+1),
+	}
+}
+
+// 按range分组写入系统表, checkDup时返回已存在的key
+func (p *Proxy) putTxnRows(t *Table, kvs []*kvrpcpb.KeyValue, checkDup bool) ([]byte, error) {
+	groups, err := p.groupByRange(t, kvs)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		_, dup, err := p.insertKvs(t, group, checkDup)
+		if err != nil || len(dup) != 0 {
+			return dup, err
+		}
+	}
+	return nil, nil
+}
+
+// 删除系统表中key或者scope范围内满足条件的行
+func (p *Proxy) deleteTxnRows(t *Table, key []byte, scope *kvrpcpb.Scope, matches []Match) error {
+	pbMatches, err := makePBMatches(t, matches)
+	if err != nil {
+		return err
+	}
+	now := p.clock.Now()
+	dreq := &kvrpcpb.DeleteRequest{
+		Key:          key,
+		Scope:        scope,
+		WhereFilters: pbMatches,
+		Timestamp:    &timestamp.Timestamp{WallTime: now.WallTime, Logical: now.Logical},
+	}
+	_, err = p.deleteRemote(t.DbName(), t.Name(), dreq)
+	return err
+}
+
+// 超过写入窗口后不再发出写请求, 由下一轮恢复继续写入
+func checkTxnDeadline(deadline time.Time) error {
+	if !time.Now().Before(deadline) {
+		return errTxnWindowExpired
+	}
+	return nil
+}
+
+type txnLock struct {
+	txnId    string
+	lockTime int64
+}
+
+func (p *Proxy) txnLockKvs(t *Table, txnId string, keys [][]byte, lockTime int64) ([]*kvrpcpb.KeyValue, error) {
+	kvs := make([]*kvrpcpb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		kv, err := p.encodeTxnRow(t, map[string]SQLValue{
+			"k":         key,
+			"txn_id":    SQLValue(txnId),
+			"lock_time": txnIntValue(lockTime),
+		})
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+// 按key的顺序给事务修改的行加锁, 遇到其他事务的锁时先尝试清理, 无法清理时返回冲突
+// 加锁失败时释放已经加上的锁
+func (p *Proxy) lockTxnRows(txnId string, keys [][]byte, lockTime int64) error {
+	t, err := p.txnTable(txnLockTableName)
+	if err != nil {
+		return err
+	}
+	kvs, err := p.txnLockKvs(t, txnId, keys, lockTime)
+	if err != nil {
+		return err
+	}
+	groups, err := p.groupByRange(t, kvs)
+	if err != nil {
+		return err
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return bytes.Compare(groups[i][0].GetKey(), groups[j][0].GetKey()) < 0
+	})
+	for _, group := range groups {
+		if err = p.lockTxnGroup(t, txnId, group); err != nil {
+			if e := p.releaseTxnLocks(txnId, keys); e != nil {
+				log.Warn("[txn] release locks of txn %s failed(%v)", txnId, e)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Proxy) lockTxnGroup(t *Table, txnId string, group []*kvrpcpb.KeyValue) error {
+	_, dup, err := p.insertKvs(t, group, true)
+	if err != nil || len(dup) == 0 {
+		return err
+	}
+	// 批量写入时dataserver不返回冲突的key, 逐行加锁
+	for _, kv := range group {
+		if err = p.lockTxnRow(t, txnId, kv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Proxy) lockTxnRow(t *Table, txnId string, kv *kvrpcpb.KeyValue) error {
+	for i := 0; i < 3; i++ {
+		_, dup, err := p.insertKvs(t, []*kvrpcpb.KeyValue{kv}, true)
+		if err != nil || len(dup) == 0 {
+			return err
+		}
+		l, err := p.getTxnLock(t, kv.GetKey())
+		if err != nil {
+			return err
+		}
+		if l == nil {
+			continue
+		}
+		if l.txnId == txnId {
+			return nil
+		}
+		released, err := p.resolveTxnLock(t, kv.GetKey(), l)
+		if err != nil {
+			return err
+		}
+		if !released {
+			log.Info("[txn] txn %s conflicts with the lock of txn %s", txnId, l.txnId)
+			return newTxnConflictError()
+		}
+	}
+	return newTxnConflictError()
+}
+
+func (p *Proxy) getTxnLock(t *Table, lockKey []byte) (*txnLock, error) {
+	fieldList, row, err := p.getRow(t, lockKey)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	values := rowFieldMap(fieldList, row)
+	return &txnLock{txnId: txnString(values["txn_id"]), lockTime: txnInt(values["lock_time"])}, nil
+}
+
+// 释放事务在keys上的锁, 其他事务的锁不受影响
+func (p *Proxy) releaseTxnLocks(txnId string, keys [][]byte) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	t, err := p.txnTable(txnLockTableName)
+	if err != nil {
+		return err
+	}
+	kvs, err := p.txnLockKvs(t, txnId, keys, 0)
+	if err != nil {
+		return err
+	}
+	sort.Sort(KvParisSlice(kvs))
+	owner := []Match{{column: "txn_id", sqlValue: SQLValue(txnId), matchType: Equal}}
+	if len(kvs) == 1 {
+		return p.deleteTxnRows(t, kvs[0].GetKey(), nil, owner)
+	}
+	last := kvs[len(kvs)-1].GetKey()
+	scope := &kvrpcpb.Scope{Start: kvs[0].GetKey(), Limit: append(append([]byte(nil), last...), 0)}
+	return p.deleteTxnRows(t, nil, scope, owner)
+}
+
+// 处理其他事务留下的锁, 返回锁是否已经释放
+// 没有事务记录并且超时的锁先写入回滚记录再删除, 回滚记录跟加锁的gateway写入的事务记录只有一个能成功;
+// 已提交的事务超过写入窗口时认领恢复
+func (p *Proxy) resolveTxnLock(t *Table, lockKey []byte, l *txnLock) (bool, error) {
+	r, err := p.getTxnRecord(l.txnId, false)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now().UnixNano()
+	if r == nil {
+		if now-l.lockTime < int64(txnLockTTL) {
+			return false, nil
+		}
+		dup, err := p.putTxnRecord(l.txnId, 0, kvrpcpb.TxnStatus_TXN_Aborted, now, nil)
+		if err != nil {
+			return false, err
+		}
+		if dup {
+			// 事务刚好提交
+			return false, nil
+		}
+		log.Info("[txn] txn %s aborted, locked at %v", l.txnId, time.Unix(0, l.lockTime))
+		r = &txnRecordRow{status: kvrpcpb.TxnStatus_TXN_Aborted}
+	}
+	switch r.status {
+	case kvrpcpb.TxnStatus_TXN_Aborted:
+		owner := []Match{{column: "txn_id", sqlValue: SQLValue(l.txnId), matchType: Equal}}
+		return true, p.deleteTxnRows(t, lockKey, nil, owner)
+	case kvrpcpb.TxnStatus_TXN_Committed:
+		if now-r.commitTime < int64(txnApplyWindow) {
+			return false, nil
+		}
+		if err = p.recoverTxn(l.txnId); err != nil && err != errTxnWindowExpired {
+			return false, err
+		}
+		lock, err := p.getTxnLock(t, lockKey)
+		if err != nil {
+			return false, err
+		}
+		return lock == nil || lock.txnId != l.txnId, nil
+	}
+	return false, nil
+}
+
+type txnRecordRow struct {
+	status     kvrpcpb.TxnStatus
+	commitTime int64
+	record     *kvrpcpb.TxnRecord // 没有读取data列时为nil
+}
+
+// 写入事务记录(seq为0)或者认领记录, 已存在时dup为true
+func (p *Proxy) putTxnRecord(txnId string, seq int64, status kvrpcpb.TxnStatus, commitTime int64, rec *kvrpcpb.TxnRecord) (dup bool, err error) {
+	t, err := p.txnTable(txnRecordTableName)
+	if err != nil {
+		return false, err
+	}
+	values := map[string]SQLValue{
+		"txn_id":      SQLValue(txnId),
+		"seq":         txnIntValue(seq),
+		"status":      txnIntValue(int64(status)),
+		"commit_time": txnIntValue(commitTime),
+	}
+	if rec != nil {
+		data, err := proto.Marshal(rec)
+		if err != nil {
+			return false, err
+		}
+		values["data"] = data
+	}
+	kv, err := p.encodeTxnRow(t, values)
+	if err != nil {
+		return false, err
+	}
+	_, duplicateKey, err := p.insertKvs(t, []*kvrpcpb.KeyValue{kv}, true)
+	return len(duplicateKey) != 0, err
+}
+
+// 读取事务记录, 不存在时返回nil; withData为false时不读取事务的写入
+func (p *Proxy) getTxnRecord(txnId string, withData bool) (*txnRecordRow, error) {
+	t, err := p.txnTable(txnRecordTableName)
+	if err != nil {
+		return nil, err
+	}
+	cols := []*SelColumn{{col: "status"}, {col: "commit_time"}}
+	if withData {
+		cols = append(cols, &SelColumn{col: "data"})
+	}
+	fieldList, err := makeFieldList(t, cols)
+	if err != nil {
+		return nil, err
+	}
+	rowss, err := p.doSelect(t, fieldList, []Match{
+		{column: "txn_id", sqlValue: SQLValue(txnId), matchType: Equal},
+		{column: "seq", sqlValue: txnIntValue(0), matchType: Equal},
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, rows := range rowss {
+		for _, row := range rows {
+			values := rowFieldMap(fieldList, row)
+			r := &txnRecordRow{
+				status:     kvrpcpb.TxnStatus(txnInt(values["status"])),
+				commitTime: txnInt(values["commit_time"]),
+			}
+			if withData {
+				r.record = new(kvrpcpb.TxnRecord)
+				if data, ok := values["data"].([]byte); ok {
+					if err = proto.Unmarshal(data, r.record); err != nil {
+						return nil, fmt.Errorf("decode record of txn %s failed(%v)", txnId, err)
+					}
+				}
+			}
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// 删除事务记录和认领记录
+func (p *Proxy) deleteTxnRecord(txnId string) error {
+	t, err := p.txnTable(txnRecordTableName)
+	if err != nil {
+		return err
+	}
+	pbMatches, err := makePBMatches(t, []Match{{column: "txn_id", sqlValue: SQLValue(txnId), matchType: Equal}})
+	if err != nil {
+		return err
+	}
+	_, scope, err := findPKScope(t, pbMatches)
+	if err != nil {
+		return err
+	}
+	return p.deleteTxnRows(t, nil, scope, nil)
+}
+
+// 写入事务在每个表上的提交记录, finishTime为0表示还在写入
+func (p *Proxy) putTxnCommits(rec *kvrpcpb.TxnRecord, commitTime, finishTime int64) error {
+	t, err := p.txnTable(txnCommitTableName)
+	if err != nil {
+		return err
+	}
+	seen := make(map[uint64]struct{})
+	var kvs []*kvrpcpb.KeyValue
+	for _, m := range rec.GetMutations() {
+		mt := p.router.FindTable(m.GetDbName(), m.GetTableName())
+		if mt == nil {
+			continue
+		}
+		if _, ok := seen[mt.GetId()]; ok {
+			continue
+		}
+		seen[mt.GetId()] = struct{}{}
+		kv, err := p.encodeTxnRow(t, map[string]SQLValue{
+			"table_id":    SQLValue(strconv.FormatUint(mt.GetId(), 10)),
+			"txn_id":      SQLValue(rec.GetTxnId()),
+			"commit_time": txnIntValue(commitTime),
+			"finish_time": txnIntValue(finishTime),
+		})
+		if err != nil {
+			return err
+		}
+		kvs = append(kvs, kv)
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	_, err = p.putTxnRows(t, kvs, false)
+	return err
+}
+
+// 从readStart开始的读取是否可能看到只写入了一部分的事务:
+// 表上有还在写入的事务, 或者有事务在读取开始之后才写入完成
+func (p *Proxy) txnReadConflict(t *Table, readStart int64) (bool, error) {
+	// 读取期间写入完成的提交记录可能已经被清理
+	if time.Now().UnixNano()-readStart >= int64(txnCommitRetention-2*txnClockSkew) {
+		return true, nil
+	}
+	ct, err := p.txnTable(txnCommitTableName)
+	if err != nil {
+		return false, err
+	}
+	fieldList, err := makeFieldList(ct, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return false, err
+	}
+	var conflict bool
+	var stale []string
+	matches := []Match{{column: "table_id", sqlValue: SQLValue(strconv.FormatUint(t.GetId(), 10)), matchType: Equal}}
+	err = p.scanRows(ct, fieldList, matches, rowCollectorFunc(func(row *Row) error {
+		values := rowFieldMap(fieldList, row)
+		finish := txnInt(values["finish_time"])
+		if finish == 0 {
+			conflict = true
+			if time.Now().UnixNano()-txnInt(values["commit_time"]) >= int64(txnApplyWindow) {
+				stale = append(stale, txnString(values["txn_id"]))
+			}
+		} else if finish+int64(txnClockSkew) >= readStart {
+			conflict = true
+		}
+		return nil
+	}))
+	if err != nil {
+		return false, err
+	}
+	for _, id := range stale {
+		if err = p.recoverTxn(id); err != nil && err != errTxnWindowExpired {
+			log.Warn("[txn] recover txn %s failed(%v)", id, err)
+		}
+	}
+	return conflict, nil
+}
+
+// 读取完成后检查表上的提交记录, 可能看到只写入了一部分的事务时重新读取
+func (p *Proxy) consistentRead(t *Table, read func() error) error {
+	if t.DbName() == txnDBName {
+		return read()
+	}
+	for i := 0; ; i++ {
+		start := time.Now().UnixNano()
+		if err := read(); err != nil {
+			return err
+		}
+		conflict, err := p.txnReadConflict(t, start)
+		if err != nil {
+			return err
+		}
+		if !conflict {
+			return nil
+		}
+		if i >= txnReadRetries {
+			log.Warn("[txn] read %s.%s conflicts with committing transactions", t.DbName(), t.Name())
+			return ErrTxnReadConflict
+		}
+		time.Sleep(txnReadRetryInterval)
+	}
+}
+
+// 恢复超过写入窗口还没有完成的事务, 只在当前一轮恢复的写入窗口内认领并继续写入
+func (p *Proxy) recoverTxn(txnId string) error {
+	r, err := p.getTxnRecord(txnId, false)
+	if err != nil || r == nil || r.status != kvrpcpb.TxnStatus_TXN_Committed {
+		return err
+	}
+	elapsed := time.Now().UnixNano() - r.commitTime
+	slot := int64(txnApplyWindow + txnWriteDelay)
+	round := elapsed / slot
+	if round == 0 || elapsed%slot >= int64(txnApplyWindow) {
+		// 上一轮的写请求可能还在途中
+		return nil
+	}
+	deadline := time.Unix(0, r.commitTime+round*slot).Add(txnApplyWindow)
+	dup, err := p.putTxnRecord(txnId, round, kvrpcpb.TxnStatus_TXN_Invalid, time.Now().UnixNano(), nil)
+	if err != nil || dup {
+		return err
+	}
+	// 认领之前事务可能已经完成
+	if r, err = p.getTxnRecord(txnId, true); err != nil {
+		return err
+	}
+	if r == nil {
+		return p.deleteTxnRecord(txnId)
+	}
+	log.Info("[txn] recover txn %s, round %d, %d mutations", txnId, round, len(r.record.GetMutations()))
+	if err = p.rollForwardTxn(r.record, r.commitTime, deadline); err != nil {
+		log.Warn("[txn] recover txn %s round %d failed(%v)", txnId, round, err)
+		return err
+	}
+	log.Info("[txn] recover txn %s success", txnId)
+	return nil
+}
+
+// 提交点之后的写入: 提交记录, 数据行, 完成时间, 删除事务记录, 释放锁, 每一步都可以重复执行
+// 超过deadline后不再发出写请求, 返回errTxnWindowExpired
+func (p *Proxy) rollForwardTxn(rec *kvrpcpb.TxnRecord, commitTime int64, deadline time.Time) error {
+	if err := checkTxnDeadline(deadline); err != nil {
+		return err
+	}
+	if err := p.putTxnCommits(rec, commitTime, 0); err != nil {
+		return err
+	}
+	if err := p.applyTxnRecord(rec, deadline); err != nil {
+		return err
+	}
+	if err := checkTxnDeadline(deadline); err != nil {
+		return err
+	}
+	if err := p.putTxnCommits(rec, commitTime, time.Now().UnixNano()); err != nil {
+		return err
+	}
+	if err := checkTxnDeadline(deadline); err != nil {
+		return err
+	}
+	if err := p.deleteTxnRecord(rec.GetTxnId()); err != nil {
+		return err
+	}
+	if err := checkTxnDeadline(deadline); err != nil {
+		return err
+	}
+	keys := make([][]byte, 0, len(rec.GetMutations()))
+	for _, m := range rec.GetMutations() {
+		keys = append(keys, m.GetKv().GetKey())
+	}
+	return p.releaseTxnLocks(rec.GetTxnId(), keys)
+}
+
+// 后台恢复超时未完成的事务, 清理过期的记录
+// 系统表创建成功之前事务提交失败, 不影响gateway启动
+func (p *Proxy) txnWorker() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(txnCheckInterval)
+	defer ticker.Stop()
+	prepared := false
+	for {
+		if !prepared {
+			if err := p.prepareTxnTables(); err != nil {
+				log.Warn("[txn] prepare txn tables failed(%v), retry later", err)
+			} else {
+				prepared = true
+			}
+		}
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if !prepared {
+				continue
+			}
+			if err := p.checkTxnRecords(); err != nil {
+				log.Warn("[txn] check txn records failed(%v)", err)
+			}
+			if err := p.purgeTxnCommits(); err != nil {
+				log.Warn("[txn] purge txn commits failed(%v)", err)
+			}
+		}
+	}
+}
+
+// 恢复超过写入窗口的已提交事务; 回滚记录和没有事务记录的认领记录超过保留时间后删除,
+// 删除回滚记录前释放该事务剩余的锁
+func (p *Proxy) checkTxnRecords() error {
+	t, err := p.txnTable(txnRecordTableName)
+	if err != nil {
+		return err
+	}
+	fieldList, err := makeFieldList(t, []*SelColumn{{col: "txn_id"}, {col: "seq"}, {col: "status"}, {col: "commit_time"}})
+	if err != nil {
+		return err
+	}
+	type txnState struct {
+		record     bool
+		status     kvrpcpb.TxnStatus
+		commitTime int64
+		claimTime  int64
+	}
+	txns := make(map[string]*txnState)
+	err = p.scanRows(t, fieldList, nil, rowCollectorFunc(func(row *Row) error {
+		values := rowFieldMap(fieldList, row)
+		id := txnString(values["txn_id"])
+		s, ok := txns[id]
+		if !ok {
+			s = &txnState{}
+			txns[id] = s
+		}
+		if txnInt(values["seq"]) == 0 {
+			s.record = true
+			s.status = kvrpcpb.TxnStatus(txnInt(values["status"]))
+			s.commitTime = txnInt(values["commit_time"])
+		} else if ct := txnInt(values["commit_time"]); ct > s.claimTime {
+			s.claimTime = ct
+		}
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixNano()
+	for id, s := range txns {
+		switch {
+		case !s.record:
+			if now-s.claimTime >= int64(txnCommitRetention) {
+				err = p.deleteTxnRecord(id)
+			}
+		case s.status == kvrpcpb.TxnStatus_TXN_Committed:
+			if now-s.commitTime >= int64(txnApplyWindow) {
+				err = p.recoverTxn(id)
+			}
+		case s.status == kvrpcpb.TxnStatus_TXN_Aborted:
+			if now-s.commitTime >= int64(txnCommitRetention) {
+				if err = p.releaseAbortedTxnLocks(id); err == nil {
+					err = p.deleteTxnRecord(id)
+				}
+			}
+		}
+		if err != nil && err != errTxnWindowExpired {
+			log.Warn("[txn] check txn %s failed(%v)", id, err)
+		}
+	}
+	return nil
+}
+
+// 回滚的事务在哪些行上还有锁是未知的, 扫描整个锁表
+func (p *Proxy) releaseAbortedTxnLocks(txnId string) error {
+	t, err := p.txnTable(txnLockTableName)
+	if err != nil {
+		return err
+	}
+	owner := []Match{{column: "txn_id", sqlValue: SQLValue(txnId), matchType: Equal}}
+	return p.deleteTxnRows(t, nil, tableScope(t), owner)
+}
+
+// 删除写入完成超过保留时间的提交记录
+func (p *Proxy) purgeTxnCommits() error {
+	t, err := p.txnTable(txnCommitTableName)
+	if err != nil {
+		return err
+	}
+	fieldList, err := makeFieldList(t, []*SelColumn{&SelColumn{}})
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	now := time.Now().UnixNano()
+	err = p.scanRows(t, fieldList, nil, rowCollectorFunc(func(row *Row) error {
+		values := rowFieldMap(fieldList, row)
+		finish := txnInt(values["finish_time"])
+		if finish == 0 || now-finish < int64(txnCommitRetention) {
+			return nil
+		}
+		key, err := rowKey(t, fieldList, row)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = p.deleteTxnRows(t, key, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"model/pkg/timestamp"
+	"proxy/gateway-server/sqlparser"
+	"util"
+)
+
+func newTxnTestTable() *Table {
+	columns := []*columnInfo{
+		&columnInfo{name: "id", typ: metapb.DataType_BigInt, isUnsigned: true, isPK: true},
+		&columnInfo{name: "name", typ: metapb.DataType_Varchar},
+		&columnInfo{name: "balance", typ: metapb.DataType_Double},
+	}
+	return NewTable(makeTestTable(columns), nil, time.Minute)
+}
+
+func encodeTxnTestRow(t *testing.T, table *Table, values ...string) *kvrpcpb.KeyValue {
+	colMap := map[string]int{"id": 0, "name": 1, "balance": 2}
+	row := make(InsertRowValue, len(values))
+	for i, v := range values {
+		if v != "NULL" {
+			row[i] = SQLValue(v)
+		}
+	}
+	kv, err := new(Proxy).EncodeRow(table, colMap, row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kv
+}
+
+func TestTxnStatementRollback(t *testing.T) {
+	table := newTxnTestTable()
+	txn := newTxn("1", timestamp.Timestamp{WallTime: 1}, 3)
+	kv1 := encodeTxnTestRow(t, table, "1", "a", "1.5")
+	kv2 := encodeTxnTestRow(t, table, "2", "b", "2")
+
+	txn.beginStatement()
+	if err := txn.put(table, kv1, &txnBase{exists: false}); err != nil {
+		t.Fatal(err)
+	}
+	txn.endStatement(nil)
+
+	// 失败的语句不影响之前语句的写入
+	txn.beginStatement()
+	if err := txn.delete(table, kv1.GetKey(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.put(table, kv2, nil); err != nil {
+		t.Fatal(err)
+	}
+	txn.endStatement(ErrAffectRows)
+
+	if txn.Size() != 1 {
+		t.Fatalf("expected 1 mutation, actual: %d", txn.Size())
+	}
+	m := txn.get(kv1.GetKey())
+	if m == nil || m.isDelete || m.base == nil || m.base.exists {
+		t.Fatalf("unexpected mutation after statement rollback: %v", m)
+	}
+
+	// 同一行多次修改保留第一次的base
+	txn.beginStatement()
+	if err := txn.delete(table, kv1.GetKey(), &txnBase{exists: true}); err != nil {
+		t.Fatal(err)
+	}
+	txn.endStatement(nil)
+	if m := txn.get(kv1.GetKey()); !m.isDelete || m.base.exists {
+		t.Fatalf("unexpected mutation: %v", m)
+	}
+
+	txn.put(table, kv2, nil)
+	txn.put(table, encodeTxnTestRow(t, table, "3", "c", "3"), nil)
+	if err := txn.put(table, encodeTxnTestRow(t, table, "4", "d", "4"), nil); err != ErrTxnTooLarge {
+		t.Fatalf("expected too large error, actual: %v", err)
+	}
+}
+
+func TestDecodeKvRow(t *testing.T) {
+	table := newTxnTestTable()
+	kv := encodeTxnTestRow(t, table, "10", "myname", "0.0075")
+	values, err := decodeKvRow(table, kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"id": uint64(10), "name": []byte("myname"), "balance": float64(0.0075)}
+	if !rowFieldsEqual(values, expected) {
+		t.Fatalf("expected: %v, actual: %v", expected, values)
+	}
+
+	kv = encodeTxnTestRow(t, table, "11", "NULL", "1")
+	values, err = decodeKvRow(table, kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["name"] != nil {
+		t.Fatalf("expected NULL name, actual: %v", values["name"])
+	}
+}
+
+func newTxnTestProxy() (*Proxy, *Table) {
+	columns := []*columnInfo{
+		&columnInfo{name: "id", typ: metapb.DataType_BigInt, isUnsigned: true, isPK: true},
+		&columnInfo{name: "name", typ: metapb.DataType_Varchar},
+		&columnInfo{name: "balance", typ: metapb.DataType_Double},
+	}
+	db := &metapb.DataBase{Name: testDBName, Id: 1}
+	table := makeTestTable(columns)
+	start := util.EncodeStorePrefix(util.Store_Prefix_KV, table.GetId())
+	r := util.BytesPrefix(start)
+	rng := &metapb.Range{
+		Id:         1,
+		TableId:    1,
+		StartKey:   r.Start,
+		EndKey:     r.Limit,
+		RangeEpoch: &metapb.RangeEpoch{ConfVer: 1, Version: 1},
+		Peers:      []*metapb.Peer{&metapb.Peer{Id: 2, NodeId: 1}},
+	}
+	p := newTestProxy(db, table, rng)
+	return p, NewTable(table, nil, time.Minute)
+}
+
+// 返回key上的锁, 没有锁时返回nil
+func getTestTxnLock(t *testing.T, p *Proxy, key []byte) *txnLock {
+	lt, err := p.txnTable(txnLockTableName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := p.txnLockKvs(lt, "", [][]byte{key}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := p.getTxnLock(lt, kvs[0].GetKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestTxnLock(t *testing.T) {
+	p, table := newTxnTestProxy()
+	defer p.Close()
+
+	keys := [][]byte{
+		encodeTxnTestRow(t, table, "1", "a", "1").GetKey(),
+		encodeTxnTestRow(t, table, "2", "b", "2").GetKey(),
+	}
+	now := time.Now().UnixNano()
+	if err := p.lockTxnRows("t1", keys, now); err != nil {
+		t.Fatal(err)
+	}
+	// 重复加锁
+	if err := p.lockTxnRows("t1", keys, now); err != nil {
+		t.Fatal(err)
+	}
+	// 锁没有超时, 其他事务加锁冲突并且不留下锁
+	if err := p.lockTxnRows("t2", keys[1:], now); !isTxnConflict(err) {
+		t.Fatalf("expected conflict, actual: %v", err)
+	}
+	if l := getTestTxnLock(t, p, keys[1]); l == nil || l.txnId != "t1" {
+		t.Fatalf("expected lock of t1, actual: %v", l)
+	}
+	// 只释放自己的锁
+	if err := p.releaseTxnLocks("t2", keys); err != nil {
+		t.Fatal(err)
+	}
+	if l := getTestTxnLock(t, p, keys[0]); l == nil || l.txnId != "t1" {
+		t.Fatalf("expected lock of t1, actual: %v", l)
+	}
+	if err := p.releaseTxnLocks("t1", keys); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range keys {
+		if l := getTestTxnLock(t, p, key); l != nil {
+			t.Fatalf("unexpected lock: %v", l)
+		}
+	}
+
+	// 没有事务记录并且超时的锁被回滚
+	expired := now - int64(txnLockTTL) - int64(time.Second)
+	if err := p.lockTxnRows("t3", keys, expired); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.lockTxnRows("t4", keys, now); err != nil {
+		t.Fatal(err)
+	}
+	r, err := p.getTxnRecord("t3", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil || r.status != kvrpcpb.TxnStatus_TXN_Aborted {
+		t.Fatalf("expected aborted record of t3, actual: %v", r)
+	}
+	// 被回滚的事务不能再提交
+	dup, err := p.putTxnRecord("t3", 0, kvrpcpb.TxnStatus_TXN_Committed, now, nil)
+	if err != nil || !dup {
+		t.Fatalf("expected duplicate txn record, actual: %v, %v", dup, err)
+	}
+	if err = p.releaseTxnLocks("t4", keys); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.checkTxnRecords(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTxnRecover(t *testing.T) {
+	p, table := newTxnTestProxy()
+	defer p.Close()
+
+	kv := encodeTxnTestRow(t, table, "1", "a", "1")
+	keys := [][]byte{kv.GetKey()}
+	rec := &kvrpcpb.TxnRecord{
+		TxnId:    "t1",
+		Status:   kvrpcpb.TxnStatus_TXN_Committed,
+		CommitTs: &timestamp.Timestamp{WallTime: 1},
+		Mutations: []*kvrpcpb.TxnMutation{
+			&kvrpcpb.TxnMutation{DbName: testDBName, TableName: testTableName, Kv: kv},
+		},
+	}
+	// 模拟提交后写入失败的gateway
+	now := time.Now()
+	commitTime := now.Add(-txnApplyWindow - txnWriteDelay - time.Second).UnixNano()
+	if err := p.lockTxnRows("t1", keys, commitTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.putTxnRecord("t1", 0, kvrpcpb.TxnStatus_TXN_Committed, commitTime, rec); err != nil {
+		t.Fatal(err)
+	}
+
+	// 还在写入窗口内的事务不能恢复
+	if _, err := p.putTxnRecord("t2", 0, kvrpcpb.TxnStatus_TXN_Committed, now.UnixNano(), rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.recoverTxn("t2"); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := p.getTxnRecord("t2", false); err != nil || r == nil {
+		t.Fatalf("expected record of t2, actual: %v, %v", r, err)
+	}
+
+	// 其他事务加锁时恢复已提交的事务
+	if err := p.lockTxnRows("t3", keys, now.UnixNano()); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := p.getTxnRecord("t1", false); err != nil || r != nil {
+		t.Fatalf("expected recovered txn, actual: %v, %v", r, err)
+	}
+	if l := getTestTxnLock(t, p, keys[0]); l == nil || l.txnId != "t3" {
+		t.Fatalf("expected lock of t3, actual: %v", l)
+	}
+	// 恢复后的提交记录让表上的读取重试
+	conflict, err := p.txnReadConflict(table, now.UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !conflict {
+		t.Fatal("expected read conflict after recovery")
+	}
+}
+
+func TestProxyTxnCommit(t *testing.T) {
+	p, table := newTxnTestProxy()
+	defer p.Close()
+
+	stmt, err := sqlparser.Parse("replace into " + testTableName + "(id,name) values(1, 'myname'), (2, 'myname2')")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 回滚的事务不写入
+	txn := p.BeginTxn()
+	res, err := p.HandleReplace(testDBName, stmt.(*sqlparser.Replace), nil, txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AffectedRows != 2 || txn.Size() != 2 {
+		t.Fatalf("expected 2 buffered rows, actual: %d, %d", res.AffectedRows, txn.Size())
+	}
+	p.RollbackTxn(txn)
+
+	txn = p.BeginTxn()
+	if _, err = p.HandleReplace(testDBName, stmt.(*sqlparser.Replace), nil, txn); err != nil {
+		t.Fatal(err)
+	}
+	// 替换事务中已写入的行, 每行计为2行
+	res, err = p.HandleReplace(testDBName, stmt.(*sqlparser.Replace), nil, txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AffectedRows != 4 || txn.Size() != 2 {
+		t.Fatalf("expected 4 affected rows and 2 buffered rows, actual: %d, %d", res.AffectedRows, txn.Size())
+	}
+	if err = p.CommitTxn(txn); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	// 提交完成后删除事务记录并释放锁
+	if r, err := p.getTxnRecord(txn.id, false); err != nil || r != nil {
+		t.Fatalf("expected no txn record, actual: %v, %v", r, err)
+	}
+	for _, id := range []string{"1", "2"} {
+		if l := getTestTxnLock(t, p, encodeTxnTestRow(t, table, id, "NULL", "NULL").GetKey()); l != nil {
+			t.Fatalf("unexpected lock: %v", l)
+		}
+	}
+	conflict, err := p.txnReadConflict(table, time.Now().UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !conflict {
+		t.Fatal("expected read conflict right after commit")
+	}
+}
@@ -19,6 +19,7 @@
 package sqlparser
 
 import (
+	"bytes"
 	"errors"
 	"strconv"
 
@@ -212,9 +213,10 @@ func (node *Set) Format(buf *TrackedBuffer) {
 type DDL struct {
 	Action string
 	//or alter and rename
-	Ignore  string
-	Table   []byte
-	NewName []byte
+	Ignore   string
+	Table    []byte
+	NewName  []byte
+	IfExists bool
 }
 
 const (
@@ -986,13 +988,93 @@ type Show struct {
 	Section     string
 	Key         string
 	From        ValExpr
+	Table       *TableName
 	LikeOrWhere Expr
 }
 
+const (
+	AST_SHOW_DATABASES       = "databases"
+	AST_SHOW_TABLES          = "tables"
+	AST_SHOW_TABLE_STATUS    = "table status"
+	AST_SHOW_COLUMNS         = "columns"
+	AST_SHOW_INDEX           = "index"
+	AST_SHOW_CREATE_TABLE    = "create table"
+	AST_SHOW_CREATE_DATABASE = "create database"
+	AST_SHOW_VARIABLES       = "variables"
+	AST_SHOW_STATUS          = "status"
+	AST_SHOW_WARNINGS        = "warnings"
+)
+
+// NewShow 由SHOW之后的关键字和FROM/IN列表构造Show, Key为FULL/GLOBAL/SESSION修饰词
+// SHOW COLUMNS/INDEX的第一个FROM是表名, 第二个FROM是库名; 其他语句的FROM是库名
+func NewShow(words []byte, from []*TableName, likeOrWhere Expr) *Show {
+	node := &Show{LikeOrWhere: likeOrWhere}
+	fields := bytes.Fields(words)
+	if len(fields) > 1 {
+		switch string(fields[0]) {
+		case "full", "global", "session", "extended":
+			node.Key = string(fields[0])
+			fields = fields[1:]
+		}
+	}
+	node.Section = string(bytes.Join(fields, []byte(" ")))
+	switch node.Section {
+	case "schemas":
+		node.Section = AST_SHOW_DATABASES
+	case "fields":
+		node.Section = AST_SHOW_COLUMNS
+	case "indexes", "keys":
+		node.Section = AST_SHOW_INDEX
+	}
+	switch node.Section {
+	case AST_SHOW_COLUMNS, AST_SHOW_INDEX:
+		if len(from) > 0 {
+			node.Table = from[0]
+			if len(from) > 1 {
+				node.Table.Qualifier = from[1].Name
+			}
+		}
+	default:
+		if len(from) > 0 {
+			node.From = StrVal(from[0].Name)
+		}
+	}
+	return node
+}
+
 func (*Show) IStatement() {}
 
 func (node *Show) Format(buf *TrackedBuffer) {
-	buf.Fprintf("show %s %s %v %v", node.Section, node.Key, node.From, node.LikeOrWhere)
+	buf.Fprintf("show ")
+	if node.Key != "" {
+		buf.Fprintf("%s ", node.Key)
+	}
+	buf.Fprintf("%s", node.Section)
+	if node.Table != nil {
+		if node.Section == AST_SHOW_CREATE_TABLE {
+			buf.Fprintf(" %v", node.Table)
+		} else {
+			buf.Fprintf(" from %v", node.Table)
+		}
+	}
+	if node.From != nil {
+		if node.Section == AST_SHOW_CREATE_DATABASE {
+			buf.Fprintf(" %s", []byte(node.From.(StrVal)))
+		} else {
+			buf.Fprintf(" from %s", []byte(node.From.(StrVal)))
+		}
+	}
+	switch expr := node.LikeOrWhere.(type) {
+	case nil:
+	case *ComparisonExpr:
+		if expr.Left == nil && expr.Operator == AST_LIKE {
+			buf.Fprintf(" like %v", expr.Right)
+		} else {
+			buf.Fprintf(" where %v", expr)
+		}
+	default:
+		buf.Fprintf(" where %v", expr)
+	}
 }
 
 type UseDB struct {
@@ -1030,3 +1112,209 @@ func (*Describe) IStatement() {}
 func (node *Describe) Format(buf *TrackedBuffer) {
 	buf.Fprintf("describe %v", string(node.TableName))
 }
+
+type CreateIndex struct {
+	Unique  bool
+	Name    []byte
+	Table   []byte
+	Columns Columns
+}
+
+func (*CreateIndex) IStatement() {}
+
+func (node *CreateIndex) Format(buf *TrackedBuffer) {
+	var unique string
+	if node.Unique {
+		unique = "unique "
+	}
+	buf.Fprintf("create %sindex %s on %s(%v)", unique, node.Name, node.Table, node.Columns)
+}
+
+type CreateDatabase struct {
+	IfNotExists bool
+	Name        []byte
+}
+
+func (*CreateDatabase) IStatement() {}
+
+func (node *CreateDatabase) Format(buf *TrackedBuffer) {
+	buf.Fprintf("create database %s", node.Name)
+}
+
+type DropDatabase struct {
+	IfExists bool
+	Name     []byte
+}
+
+func (*DropDatabase) IStatement() {}
+
+func (node *DropDatabase) Format(buf *TrackedBuffer) {
+	buf.Fprintf("drop database %s", node.Name)
+}
+
+// CreateTable represents a CREATE TABLE statement with column definitions.
+type CreateTable struct {
+	IfNotExists bool
+	Name        []byte
+	Columns     []*ColumnDefinition
+	Constraints []*TableConstraint
+	Options     []*TableOption
+}
+
+func (*CreateTable) IStatement() {}
+
+func (node *CreateTable) Format(buf *TrackedBuffer) {
+	buf.Fprintf("create table %s(", node.Name)
+	var prefix string
+	for _, col := range node.Columns {
+		buf.Fprintf("%s%v", prefix, col)
+		prefix = ", "
+	}
+	for _, c := range node.Constraints {
+		buf.Fprintf("%s%v", prefix, c)
+		prefix = ", "
+	}
+	buf.Fprintf(")")
+	for _, opt := range node.Options {
+		buf.Fprintf(" %v", opt)
+	}
+}
+
+// ColumnDefinition is a column of CREATE TABLE or ALTER TABLE ADD COLUMN.
+// Type is lower-cased, Attrs holds the other lower-cased attribute words
+// such as unsigned and auto_increment.
+type ColumnDefinition struct {
+	Name       []byte
+	Type       []byte
+	Length     [][]byte
+	NotNull    bool
+	Null       bool
+	Default    ValExpr
+	PrimaryKey bool
+	Unique     bool
+	Attrs      [][]byte
+}
+
+func (node *ColumnDefinition) Format(buf *TrackedBuffer) {
+	buf.Fprintf("%s %s", node.Name, node.Type)
+	if len(node.Length) > 0 {
+		buf.Fprintf("(%s)", bytes.Join(node.Length, []byte(",")))
+	}
+	for _, attr := range node.Attrs {
+		buf.Fprintf(" %s", attr)
+	}
+	if node.NotNull {
+		buf.Fprintf(" not null")
+	}
+	if node.Null {
+		buf.Fprintf(" null")
+	}
+	if node.Default != nil {
+		buf.Fprintf(" default %v", node.Default)
+	}
+	if node.PrimaryKey {
+		buf.Fprintf(" primary key")
+	}
+	if node.Unique {
+		buf.Fprintf(" unique")
+	}
+}
+
+const (
+	AST_PRIMARY_KEY = "primary key"
+	AST_KEY         = "key"
+	AST_UNIQUE_KEY  = "unique key"
+)
+
+// TableConstraint is a PRIMARY KEY, KEY/INDEX or UNIQUE KEY of CREATE TABLE.
+type TableConstraint struct {
+	Type    string
+	Name    []byte
+	Columns Columns
+}
+
+func (node *TableConstraint) Format(buf *TrackedBuffer) {
+	if len(node.Name) > 0 {
+		buf.Fprintf("%s %s(%v)", node.Type, node.Name, node.Columns)
+	} else {
+		buf.Fprintf("%s(%v)", node.Type, node.Columns)
+	}
+}
+
+// TableOption is a table option of CREATE TABLE, the name is lower-cased.
+type TableOption struct {
+	Name  []byte
+	Value []byte
+}
+
+func (node *TableOption) Format(buf *TrackedBuffer) {
+	buf.Fprintf("%s=%s", node.Name, node.Value)
+}
+
+// AlterTable is ALTER TABLE ADD/DROP/CHANGE/MODIFY/RENAME/ALTER COLUMN.
+// AlterColumns holds the column changes other than ADD in statement order.
+type AlterTable struct {
+	Table        []byte
+	AddColumns   []*ColumnDefinition
+	AlterColumns []*AlterColumn
+}
+
+func (*AlterTable) IStatement() {}
+
+func (node *AlterTable) Format(buf *TrackedBuffer) {
+	buf.Fprintf("alter table %s ", node.Table)
+	prefix := ""
+	if len(node.AddColumns) > 0 {
+		buf.Fprintf("add column (")
+		for i, col := range node.AddColumns {
+			if i > 0 {
+				buf.Fprintf(", ")
+			}
+			buf.Fprintf("%v", col)
+		}
+		buf.Fprintf(")")
+		prefix = ", "
+	}
+	for _, col := range node.AlterColumns {
+		buf.Fprintf("%s%v", prefix, col)
+		prefix = ", "
+	}
+}
+
+const (
+	AST_DROP_COLUMN   = "drop column"
+	AST_RENAME_COLUMN = "rename column"
+	AST_CHANGE_COLUMN = "change column"
+	AST_MODIFY_COLUMN = "modify column"
+	AST_SET_DEFAULT   = "set default"
+	AST_DROP_DEFAULT  = "drop default"
+)
+
+// AlterColumn is a column change of ALTER TABLE, Name is the lower-cased
+// current column name. NewName is set for AST_RENAME_COLUMN and
+// AST_CHANGE_COLUMN, Definition for AST_CHANGE_COLUMN and AST_MODIFY_COLUMN,
+// Default for AST_SET_DEFAULT.
+type AlterColumn struct {
+	Action     string
+	Name       []byte
+	NewName    []byte
+	Definition *ColumnDefinition
+	Default    ValExpr
+}
+
+func (node *AlterColumn) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case AST_DROP_COLUMN:
+		buf.Fprintf("drop column %s", node.Name)
+	case AST_RENAME_COLUMN:
+		buf.Fprintf("rename column %s to %s", node.Name, node.NewName)
+	case AST_CHANGE_COLUMN:
+		buf.Fprintf("change column %s %v", node.Name, node.Definition)
+	case AST_MODIFY_COLUMN:
+		buf.Fprintf("modify column %v", node.Definition)
+	case AST_SET_DEFAULT:
+		buf.Fprintf("alter column %s set default %v", node.Name, node.Default)
+	case AST_DROP_DEFAULT:
+		buf.Fprintf("alter column %s drop default", node.Name)
+	}
+}
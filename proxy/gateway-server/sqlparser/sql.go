@@ -29,6 +29,7 @@ var (
 type yySymType struct {
 	yys         int
 	empty       struct{}
+	boolean     bool
 	statement   Statement
 	selStmt     SelectStatement
 	byt         byte
@@ -60,6 +61,14 @@ type yySymType struct {
 	insRows     InsertRows
 	updateExprs UpdateExprs
 	updateExpr  *UpdateExpr
+	createTable *CreateTable
+	colDef      *ColumnDefinition
+	colDefs     []*ColumnDefinition
+	constraint  *TableConstraint
+	tableOpt    *TableOption
+	tableOpts   []*TableOption
+	tableNames  []*TableName
+	alterTable  *AlterTable
 }
 
 const LEX_ERROR = 57346
@@ -159,8 +168,16 @@ const IGNORE = 57439
 const IF = 57440
 const UNIQUE = 57441
 const USING = 57442
-const TRUNCATE = 57443
-const DESCRIBE = 57444
+const DATABASE = 57443
+const SCHEMA = 57444
+const PRIMARY = 57445
+const ADD = 57446
+const COLUMN = 57447
+const CHANGE = 57448
+const MODIFY = 57449
+const TRUNCATE = 57450
+const DESCRIBE = 57451
+const SHOW = 57452
 
 var yyToknames = [...]string{
 	"$end",
@@ -278,8 +295,16 @@ var yyToknames = [...]string{
 	"IF",
 	"UNIQUE",
 	"USING",
+	"DATABASE",
+	"SCHEMA",
+	"PRIMARY",
+	"ADD",
+	"COLUMN",
+	"CHANGE",
+	"MODIFY",
 	"TRUNCATE",
 	"DESCRIBE",
+	"SHOW",
 	"')'",
 }
 var yyStatenames = [...]string{}
@@ -293,290 +318,378 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 262,
+	34, 293,
+	-2, 297,
+	-1, 264,
+	34, 293,
+	-2, 299,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 690
+const yyLast = 875
 
 var yyAct = [...]int{
 
-	115, 161, 112, 413, 381, 195, 283, 193, 149, 78,
-	106, 376, 123, 196, 3, 298, 241, 278, 142, 422,
-	208, 101, 113, 235, 80, 75, 118, 122, 422, 102,
-	128, 39, 40, 41, 42, 224, 65, 170, 169, 94,
-	105, 119, 120, 121, 422, 110, 126, 87, 56, 82,
-	57, 81, 69, 275, 89, 118, 122, 91, 51, 128,
-	53, 95, 394, 57, 54, 109, 163, 129, 297, 105,
-	119, 120, 121, 152, 110, 126, 349, 163, 163, 107,
-	393, 271, 392, 124, 125, 103, 238, 137, 88, 148,
-	100, 83, 424, 90, 109, 58, 129, 156, 269, 341,
-	151, 423, 85, 134, 84, 166, 362, 364, 272, 59,
-	60, 61, 124, 125, 103, 342, 343, 421, 197, 127,
-	192, 194, 198, 157, 141, 160, 291, 62, 373, 290,
-	319, 201, 292, 82, 287, 81, 82, 204, 81, 372,
-	179, 215, 206, 219, 220, 168, 212, 213, 127, 348,
-	330, 328, 140, 273, 270, 221, 79, 205, 133, 239,
-	210, 143, 144, 256, 363, 234, 427, 139, 248, 215,
-	279, 107, 247, 93, 147, 245, 255, 254, 216, 252,
-	250, 251, 257, 258, 169, 261, 262, 263, 264, 265,
-	266, 267, 268, 246, 370, 253, 308, 309, 310, 311,
-	312, 389, 313, 314, 279, 377, 333, 107, 107, 230,
-	145, 178, 177, 180, 181, 182, 183, 184, 179, 286,
-	285, 274, 276, 249, 228, 294, 293, 282, 155, 280,
-	231, 259, 170, 169, 295, 96, 288, 82, 82, 81,
-	303, 182, 183, 184, 179, 391, 301, 135, 135, 300,
-	180, 181, 182, 183, 184, 179, 245, 356, 377, 390,
-	318, 305, 357, 323, 324, 170, 169, 354, 366, 260,
-	360, 375, 355, 359, 320, 55, 358, 322, 209, 327,
-	162, 271, 82, 107, 81, 338, 164, 398, 340, 337,
-	334, 336, 209, 384, 300, 332, 335, 329, 217, 409,
-	408, 227, 229, 226, 178, 177, 180, 181, 182, 183,
-	184, 179, 407, 306, 400, 401, 163, 20, 138, 245,
-	245, 74, 347, 352, 353, 244, 202, 135, 368, 369,
-	243, 321, 281, 371, 39, 40, 41, 42, 200, 379,
-	199, 374, 237, 98, 236, 130, 244, 382, 378, 304,
-	82, 243, 385, 383, 237, 20, 21, 22, 23, 178,
-	177, 180, 181, 182, 183, 184, 179, 178, 177, 180,
-	181, 182, 183, 184, 179, 317, 167, 159, 395, 24,
-	66, 83, 367, 396, 131, 365, 345, 211, 344, 233,
-	316, 66, 232, 403, 405, 216, 212, 207, 404, 76,
-	406, 153, 150, 411, 35, 412, 382, 402, 414, 414,
-	414, 146, 415, 416, 92, 68, 64, 218, 82, 419,
-	81, 49, 50, 428, 132, 410, 397, 425, 429, 20,
-	430, 97, 326, 420, 20, 222, 29, 30, 72, 31,
-	32, 154, 70, 164, 118, 122, 388, 339, 128, 284,
-	33, 34, 387, 299, 25, 26, 28, 27, 83, 119,
-	120, 121, 351, 110, 126, 209, 36, 37, 77, 426,
-	417, 20, 118, 122, 44, 19, 128, 18, 17, 16,
-	15, 14, 13, 109, 12, 129, 83, 119, 120, 121,
-	289, 110, 126, 178, 177, 180, 181, 182, 183, 184,
-	179, 124, 125, 122, 99, 223, 128, 52, 296, 225,
-	86, 109, 302, 129, 418, 20, 83, 119, 120, 121,
-	399, 138, 126, 380, 386, 350, 331, 203, 277, 124,
-	125, 122, 117, 114, 128, 116, 346, 127, 214, 111,
-	171, 108, 361, 129, 83, 119, 120, 121, 242, 138,
-	126, 307, 240, 104, 122, 315, 165, 128, 71, 124,
-	125, 136, 38, 122, 158, 127, 128, 83, 119, 120,
-	121, 129, 138, 126, 73, 11, 83, 119, 120, 121,
-	10, 138, 126, 9, 8, 7, 6, 124, 125, 5,
-	4, 2, 1, 0, 129, 127, 177, 180, 181, 182,
-	183, 184, 179, 129, 0, 0, 0, 0, 0, 0,
-	124, 125, 0, 0, 0, 0, 0, 0, 0, 124,
-	125, 173, 175, 127, 0, 0, 0, 185, 186, 187,
-	188, 189, 190, 191, 176, 174, 172, 178, 177, 180,
-	181, 182, 183, 184, 179, 325, 127, 308, 309, 310,
-	311, 312, 43, 313, 314, 127, 0, 0, 0, 0,
-	0, 0, 178, 177, 180, 181, 182, 183, 184, 179,
-	0, 0, 0, 0, 45, 46, 47, 48, 0, 0,
-	0, 0, 0, 0, 0, 0, 63, 0, 0, 67,
+	136, 171, 133, 566, 91, 132, 487, 481, 227, 502,
+	134, 423, 193, 338, 342, 127, 428, 327, 358, 255,
+	368, 425, 339, 285, 476, 144, 261, 322, 228, 3,
+	346, 240, 163, 273, 122, 364, 225, 71, 123, 578,
+	79, 93, 578, 265, 184, 41, 42, 43, 44, 202,
+	201, 95, 578, 94, 437, 435, 436, 103, 263, 449,
+	449, 449, 105, 449, 266, 582, 357, 110, 267, 82,
+	268, 269, 558, 88, 545, 356, 117, 359, 119, 356,
+	374, 375, 376, 377, 378, 195, 379, 380, 449, 411,
+	195, 257, 195, 315, 276, 100, 108, 62, 344, 116,
+	61, 344, 62, 170, 509, 72, 99, 173, 64, 66,
+	67, 115, 179, 174, 525, 524, 57, 58, 313, 120,
+	580, 128, 523, 579, 462, 464, 198, 57, 58, 158,
+	316, 102, 104, 577, 63, 262, 264, 260, 96, 510,
+	572, 562, 560, 162, 559, 407, 224, 226, 98, 267,
+	97, 268, 269, 557, 95, 546, 94, 95, 238, 94,
+	466, 101, 247, 189, 155, 233, 472, 68, 229, 448,
+	410, 396, 230, 394, 314, 277, 118, 408, 409, 473,
+	345, 272, 463, 345, 385, 343, 180, 236, 183, 341,
+	251, 252, 413, 283, 191, 331, 244, 245, 242, 414,
+	292, 247, 200, 92, 281, 417, 350, 335, 164, 165,
+	334, 161, 72, 336, 154, 297, 511, 294, 295, 190,
+	289, 211, 584, 347, 280, 350, 237, 419, 192, 430,
+	412, 72, 128, 291, 290, 282, 214, 215, 216, 211,
+	296, 160, 166, 301, 302, 323, 305, 306, 307, 308,
+	309, 310, 311, 312, 340, 168, 348, 329, 352, 278,
+	279, 354, 323, 54, 399, 248, 318, 320, 128, 128,
+	326, 324, 202, 201, 418, 95, 95, 94, 369, 367,
+	330, 349, 332, 360, 520, 416, 361, 362, 363, 415,
+	53, 429, 56, 65, 80, 81, 59, 72, 57, 58,
+	349, 300, 366, 212, 213, 214, 215, 216, 211, 388,
+	293, 384, 303, 289, 299, 298, 371, 210, 209, 212,
+	213, 214, 215, 216, 211, 107, 95, 430, 94, 201,
+	402, 477, 406, 351, 178, 156, 389, 390, 522, 400,
+	404, 421, 121, 424, 403, 243, 477, 60, 348, 398,
+	304, 348, 393, 366, 395, 521, 128, 439, 426, 440,
+	401, 441, 340, 444, 340, 456, 431, 78, 185, 188,
+	457, 77, 76, 202, 201, 442, 447, 445, 427, 475,
+	434, 432, 460, 470, 459, 454, 21, 22, 23, 24,
+	455, 109, 458, 111, 241, 87, 452, 453, 289, 289,
+	210, 209, 212, 213, 214, 215, 216, 211, 241, 156,
+	25, 194, 340, 315, 529, 490, 479, 196, 515, 353,
+	488, 249, 21, 468, 469, 484, 424, 478, 471, 372,
+	483, 426, 187, 186, 485, 36, 474, 501, 492, 325,
+	554, 507, 482, 156, 340, 340, 506, 195, 288, 275,
+	95, 288, 516, 287, 360, 391, 287, 513, 514, 274,
+	386, 41, 42, 43, 44, 553, 552, 30, 31, 275,
+	32, 33, 210, 209, 212, 213, 214, 215, 216, 211,
+	159, 34, 35, 539, 348, 26, 27, 29, 28, 508,
+	210, 209, 212, 213, 214, 215, 216, 211, 500, 499,
+	95, 95, 369, 369, 37, 38, 39, 526, 72, 95,
+	542, 369, 527, 443, 498, 253, 540, 387, 234, 232,
+	537, 538, 231, 113, 151, 571, 547, 536, 550, 541,
+	503, 504, 505, 533, 549, 548, 551, 556, 370, 494,
+	95, 182, 369, 72, 490, 493, 340, 383, 199, 488,
+	563, 96, 535, 567, 567, 567, 244, 568, 569, 564,
+	561, 565, 382, 72, 573, 534, 482, 495, 467, 465,
+	438, 95, 433, 94, 319, 581, 139, 143, 89, 585,
+	149, 271, 270, 248, 250, 586, 239, 587, 177, 175,
+	126, 140, 141, 142, 172, 131, 147, 209, 212, 213,
+	214, 215, 216, 211, 139, 143, 169, 167, 149, 106,
+	74, 70, 51, 52, 153, 130, 544, 150, 126, 140,
+	141, 142, 21, 131, 147, 210, 209, 212, 213, 214,
+	215, 216, 211, 145, 146, 124, 575, 139, 143, 543,
+	555, 149, 45, 130, 528, 150, 491, 152, 422, 112,
+	576, 96, 140, 141, 142, 486, 131, 147, 392, 254,
+	21, 145, 146, 124, 176, 47, 48, 49, 50, 148,
+	139, 143, 85, 83, 149, 196, 130, 69, 150, 365,
+	73, 519, 317, 328, 96, 140, 141, 142, 405, 131,
+	147, 531, 532, 518, 145, 146, 451, 148, 241, 143,
+	90, 583, 149, 570, 496, 21, 21, 46, 75, 130,
+	20, 150, 96, 140, 141, 142, 19, 159, 147, 18,
+	17, 16, 143, 15, 14, 149, 13, 145, 146, 12,
+	148, 333, 114, 497, 246, 96, 140, 141, 142, 150,
+	159, 147, 258, 512, 210, 209, 212, 213, 214, 215,
+	216, 211, 420, 337, 143, 145, 146, 149, 55, 256,
+	355, 157, 150, 148, 259, 574, 530, 96, 140, 141,
+	142, 480, 159, 147, 143, 517, 450, 397, 145, 146,
+	235, 321, 143, 138, 135, 149, 137, 72, 140, 141,
+	142, 148, 446, 203, 150, 96, 140, 141, 142, 129,
+	159, 147, 374, 375, 376, 377, 378, 461, 379, 380,
+	145, 146, 286, 373, 148, 284, 125, 381, 197, 84,
+	40, 181, 150, 86, 11, 10, 9, 8, 7, 6,
+	5, 489, 4, 2, 1, 0, 0, 0, 145, 146,
+	0, 205, 207, 0, 0, 0, 148, 217, 218, 219,
+	220, 221, 222, 223, 208, 206, 204, 210, 209, 212,
+	213, 214, 215, 216, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 148,
 }
 var yyPact = [...]int{
 
-	350, -1000, -1000, 293, -1000, -1000, -1000, -1000, -1000, -1000,
+	381, -1000, -1000, 420, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 390, -50, -62, -13, 1, -1000,
-	39, -1000, -1000, -1000, 382, 346, -1000, 381, 466, 425,
-	-1000, -1000, -1000, 420, -1000, -49, 365, 459, 57, 16,
-	14, -66, -21, 346, -1000, -15, 346, -1000, 380, -74,
-	346, -74, -1000, 406, 304, -1000, -1000, -18, -1000, -1000,
-	-1000, 6, -1000, 307, 359, 395, 75, 365, 203, 533,
-	-1000, 102, -1000, 69, 70, 70, 377, 115, 346, -1000,
-	368, -1000, -38, 367, 421, 172, 346, 365, 342, 365,
-	-1000, 271, -1000, -1000, 357, 62, 175, 562, -1000, 452,
-	424, -1000, -1000, -1000, 542, 301, 299, -1000, 287, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 542,
-	-1000, 365, 347, 363, 455, 347, -1000, 284, 510, 482,
-	361, 253, -1000, 384, 50, 253, -1000, 415, -80, -1000,
-	196, -1000, 358, -1000, -1000, 355, -1000, 315, 41, -1000,
-	-1000, -1000, 291, 6, 542, -1000, -1000, 346, 144, 452,
-	452, 542, 279, 103, 542, 542, 210, 542, 542, 542,
-	542, 542, 542, 542, 542, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 562, -20, 36, -10, 562, -1000, 35,
-	6, -1000, 466, 108, 418, 303, 282, -1000, 436, 452,
-	-1000, 542, 418, 418, -1000, -1000, 51, 70, 34, -1000,
-	-1000, -1000, -1000, 170, 346, -1000, -43, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 429, 347, 347, 314, -1000,
-	268, 601, 356, 312, 47, -1000, -1000, 229, -1000, -1000,
-	-1000, 126, 418, -1000, 279, 542, 542, 418, 587, -1000,
-	411, 173, 520, -1000, 162, 162, 58, 58, 58, -1000,
-	-1000, 542, -1000, -1000, 33, 6, 32, 142, -1000, 452,
-	429, 347, 436, 428, 433, 175, 418, 346, -1000, -1000,
-	7, 19, -1000, 354, -1000, -1000, 352, -1000, -1000, 279,
-	293, 203, 31, -1000, -1000, 451, 291, 291, -1000, -1000,
-	221, 211, 230, 227, 224, 52, -1000, 351, 150, 348,
-	542, 542, -1000, 418, 136, 542, -1000, 418, -1000, 21,
-	-1000, 43, -1000, 542, 208, 149, 202, 428, -1000, 542,
-	-1000, -1000, -1000, -1000, -1000, -1000, 248, -1000, -1000, 347,
-	440, 432, 601, 145, -1000, 213, -1000, 199, -1000, -1000,
-	-1000, -1000, -27, -29, -47, -1000, -1000, -1000, 418, 418,
-	542, 418, -1000, -1000, 418, 542, -1000, 400, -1000, -1000,
-	242, -1000, 292, -1000, 279, -1000, 436, 452, 542, 452,
-	-1000, -1000, 273, 261, 260, 418, 418, 398, 542, -1000,
-	-1000, -1000, -1000, 428, 175, 236, 175, 346, 346, 346,
-	463, -1000, 403, -1, -1000, -17, -26, 347, -1000, 462,
-	92, -1000, 346, -1000, -1000, 203, -1000, 346, -1000, 346,
-	-1000,
+	-1000, -1000, -1000, -1000, -1000, 581, 182, -10, 26, 0,
+	-1000, 79, -1000, -1000, -1000, 577, 509, -1000, 576, 263,
+	700, 656, -1000, -1000, -1000, 654, -1000, -15, 544, 691,
+	104, 62, 60, -18, -18, 22, 509, -1000, -1000, -1000,
+	24, 509, -1000, 575, -17, -17, 509, -17, -1000, 624,
+	484, -1000, -1000, 3, -1000, 509, -1000, 509, 11, -1000,
+	-1000, -1000, -1000, -1000, 584, -1000, 486, 622, 585, 131,
+	544, 364, 733, -1000, 176, -1000, 128, 117, 117, 573,
+	196, 572, 509, -1000, 560, -1000, 2, 555, 644, 554,
+	278, 509, 544, 506, 544, -1000, 359, -1000, 359, -1000,
+	544, -18, 402, -1000, -1000, 529, 119, 215, 782, -1000,
+	650, 617, -1000, -1000, -1000, 761, 483, 480, -1000, 479,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	761, -1000, 544, 517, 552, 688, 517, -1000, 242, 701,
+	678, 549, 376, -1000, 551, 97, 376, 476, 639, -1000,
+	-24, -1000, 30, -1000, 548, -1000, -1000, -1000, 547, -1000,
+	430, 49, -1000, -1000, -1000, 544, 544, 761, 650, -1000,
+	359, -1000, 509, -1000, 414, 584, 761, -1000, -1000, 509,
+	231, 650, 650, 761, 441, 241, 761, 761, 291, 761,
+	761, 761, 761, 761, 761, 761, 761, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 782, -8, 48, 4, 782,
+	-1000, 556, 584, -1000, 700, 183, 550, 410, 398, -1000,
+	670, 650, -1000, 761, 550, 550, -1000, -1000, 112, 117,
+	115, -1000, -1000, 71, -1000, 178, 277, 509, 374, -1000,
+	-45, -1000, -43, -1000, -43, -1000, -1000, -43, -43, -43,
+	-1000, -1000, -1000, 655, 517, 517, 503, -1000, -1000, -1000,
+	550, 215, -1000, -1000, 384, 756, 528, 417, 101, -1000,
+	-1000, 415, -1000, -1000, -1000, 271, 550, -1000, 441, 761,
+	761, 550, 397, -1000, 637, 226, 521, -1000, 157, 157,
+	139, 139, 139, -1000, -1000, 761, -1000, -1000, 47, 584,
+	45, 200, -1000, 650, 655, 517, 670, 660, 674, 215,
+	550, 509, -1000, -1000, 53, 81, -1000, 44, 171, -1000,
+	509, 621, 509, 74, -1000, -1000, -1000, 197, 262, 164,
+	197, 538, -1000, -51, -1000, 536, 509, -1000, 509, -1000,
+	509, 474, 509, 509, -1000, 441, 420, 364, 43, -1000,
+	-1000, 685, 414, 414, -1000, -1000, 339, 319, 346, 338,
+	336, 70, -1000, 535, 34, 534, 761, 761, -1000, 550,
+	325, 761, -1000, 550, -1000, 40, -1000, 94, -1000, 761,
+	316, 275, 290, 660, -1000, 761, -1000, -1000, -1000, -1000,
+	-1000, 71, 634, -1000, 753, 619, 74, 510, 533, 697,
+	-1000, 475, 460, 459, -1000, 509, -1000, -1000, 496, 164,
+	-1000, 496, -1000, 450, -1000, -43, -41, -43, -1000, -7,
+	110, -1000, 171, 509, 509, 171, 373, -1000, -1000, 517,
+	681, 667, 756, 228, -1000, 309, -1000, 292, -1000, -1000,
+	-1000, -1000, 13, 6, 5, -1000, -1000, -1000, 550, 550,
+	761, 550, -1000, -1000, 550, 761, -1000, 618, -1000, -1000,
+	369, -1000, 669, 178, 171, -1000, -1000, -1000, -1000, 497,
+	-1000, -1000, -1000, -1000, 531, -1000, 518, -1000, 491, 517,
+	517, 444, -1000, -1000, -1000, -1000, 496, -1000, 517, 509,
+	611, 588, 29, 171, 171, 441, -1000, 670, 650, 761,
+	650, -1000, -1000, 427, 426, 401, 550, 550, 613, 761,
+	-1000, -1000, -1000, -1000, -1000, -1000, 27, 18, 16, 517,
+	-1000, 15, -1000, 753, -1000, 509, -1000, -1000, 660, 215,
+	368, 215, 509, 509, 509, 696, -1000, -1000, 489, -1000,
+	-1000, 14, -1000, -1000, 171, 620, 7, -1000, -3, -6,
+	517, -61, -1000, -1000, -1000, 694, 148, -1000, 509, -1000,
+	-1000, 364, -1000, -1000, 509, -1000, 509, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 592, 591, 13, 590, 589, 586, 585, 584, 583,
-	580, 575, 652, 574, 564, 562, 558, 275, 21, 29,
-	556, 555, 553, 552, 16, 551, 548, 25, 542, 3,
-	20, 10, 541, 540, 15, 539, 7, 22, 5, 536,
-	535, 12, 533, 2, 532, 528, 17, 527, 526, 525,
-	524, 6, 523, 4, 520, 1, 514, 23, 512, 11,
-	9, 24, 173, 510, 509, 508, 507, 505, 0, 8,
-	504, 18, 124, 490, 484, 482, 481, 480, 479, 478,
-	477, 475, 474,
+	0, 834, 833, 28, 832, 830, 829, 828, 827, 826,
+	825, 824, 642, 823, 821, 820, 819, 347, 34, 38,
+	818, 817, 816, 815, 23, 813, 812, 73, 807, 3,
+	31, 15, 799, 793, 35, 5, 36, 10, 8, 792,
+	786, 25, 784, 2, 783, 781, 27, 780, 777, 776,
+	775, 17, 771, 7, 766, 12, 765, 33, 20, 24,
+	4, 41, 764, 760, 759, 263, 18, 14, 21, 16,
+	758, 325, 106, 753, 13, 752, 743, 742, 26, 733,
+	6, 22, 30, 19, 9, 11, 0, 1, 732, 32,
+	143, 731, 729, 726, 724, 723, 721, 720, 719, 716,
+	710, 708, 99, 44, 707,
 }
 var yyR1 = [...]int{
 
 	0, 1, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 3,
-	3, 3, 4, 4, 77, 77, 5, 6, 7, 7,
-	7, 7, 7, 7, 72, 72, 71, 71, 71, 73,
-	73, 73, 73, 14, 14, 14, 74, 74, 75, 76,
-	78, 81, 79, 80, 8, 8, 8, 9, 9, 9,
-	10, 11, 11, 11, 82, 12, 13, 13, 15, 15,
-	15, 15, 15, 16, 16, 18, 18, 19, 19, 19,
-	22, 22, 20, 20, 20, 23, 23, 24, 24, 24,
-	24, 21, 21, 21, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 26, 26, 26, 27, 27, 28, 28,
-	28, 28, 29, 29, 30, 30, 31, 31, 31, 31,
-	31, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-	32, 33, 33, 33, 33, 33, 33, 33, 34, 34,
-	39, 39, 37, 37, 41, 38, 38, 36, 36, 36,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	3, 3, 3, 4, 4, 95, 95, 5, 6, 7,
+	7, 7, 7, 7, 7, 90, 90, 89, 89, 89,
+	91, 91, 91, 91, 14, 14, 14, 92, 92, 93,
+	94, 96, 99, 100, 100, 100, 100, 100, 101, 101,
+	101, 101, 102, 102, 102, 103, 103, 103, 97, 98,
+	8, 8, 8, 8, 9, 9, 9, 9, 10, 11,
+	11, 11, 11, 104, 12, 13, 13, 15, 15, 15,
+	15, 15, 16, 16, 18, 18, 19, 19, 19, 22,
+	22, 20, 20, 20, 23, 23, 24, 24, 24, 24,
+	21, 21, 21, 25, 25, 25, 25, 25, 25, 25,
+	25, 25, 26, 26, 26, 27, 27, 28, 28, 28,
+	28, 29, 29, 30, 30, 31, 31, 31, 31, 31,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	33, 33, 33, 33, 33, 33, 33, 34, 34, 39,
+	39, 37, 37, 41, 38, 38, 36, 36, 36, 36,
 	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
-	36, 36, 36, 36, 40, 40, 42, 42, 42, 44,
-	47, 47, 45, 45, 46, 48, 48, 43, 43, 43,
-	35, 35, 35, 35, 49, 49, 50, 50, 51, 51,
-	52, 52, 53, 54, 54, 54, 55, 55, 55, 55,
-	56, 56, 56, 57, 57, 58, 58, 59, 59, 60,
-	60, 61, 61, 62, 62, 63, 63, 17, 17, 64,
-	64, 64, 64, 64, 65, 65, 66, 66, 67, 67,
-	68, 69, 70, 70,
+	36, 36, 36, 40, 40, 42, 42, 42, 44, 47,
+	47, 45, 45, 46, 48, 48, 43, 43, 43, 35,
+	35, 35, 35, 49, 49, 50, 50, 51, 51, 52,
+	52, 53, 54, 54, 54, 55, 55, 55, 55, 56,
+	56, 56, 57, 57, 58, 58, 59, 59, 60, 60,
+	61, 61, 71, 71, 72, 72, 65, 65, 73, 73,
+	73, 73, 76, 76, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 75, 79, 79, 79, 80,
+	80, 80, 81, 81, 81, 67, 67, 68, 68, 85,
+	85, 83, 83, 83, 82, 82, 82, 82, 84, 84,
+	84, 69, 69, 77, 77, 78, 78, 78, 78, 78,
+	78, 78, 78, 66, 66, 17, 17, 62, 62, 62,
+	62, 62, 63, 63, 70, 70, 64, 64, 86, 87,
+	88, 88,
 }
 var yyR2 = [...]int{
 
 	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 5,
-	12, 3, 8, 8, 6, 6, 8, 7, 3, 4,
-	4, 6, 4, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 0, 1, 3, 1, 2, 1, 1,
-	5, 2, 2, 4, 5, 8, 4, 6, 7, 4,
-	5, 4, 5, 5, 0, 2, 0, 2, 1, 2,
-	1, 1, 1, 0, 1, 1, 3, 1, 2, 3,
-	1, 1, 0, 1, 2, 1, 3, 3, 3, 3,
-	5, 0, 1, 2, 1, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 3, 1, 1, 3, 0, 5,
-	5, 5, 1, 3, 0, 2, 1, 3, 3, 2,
-	3, 3, 3, 4, 3, 4, 5, 6, 3, 4,
-	2, 1, 1, 1, 1, 1, 1, 1, 2, 1,
-	1, 3, 3, 1, 3, 1, 3, 1, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 3,
-	4, 5, 4, 1, 1, 1, 1, 1, 1, 5,
-	0, 1, 1, 2, 4, 0, 2, 1, 3, 5,
-	1, 1, 1, 1, 0, 3, 0, 2, 0, 3,
-	1, 3, 2, 0, 1, 1, 0, 2, 4, 4,
-	0, 2, 4, 0, 3, 1, 3, 0, 5, 1,
-	3, 3, 3, 0, 2, 0, 3, 0, 1, 1,
-	1, 1, 1, 1, 0, 1, 0, 1, 0, 2,
-	1, 0, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	5, 12, 3, 8, 8, 6, 6, 8, 7, 3,
+	4, 4, 6, 4, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 0, 1, 3, 1, 2, 1,
+	1, 5, 2, 4, 4, 5, 4, 5, 1, 1,
+	1, 2, 0, 3, 3, 0, 2, 2, 2, 4,
+	8, 5, 11, 4, 5, 6, 7, 4, 5, 4,
+	4, 5, 5, 0, 2, 0, 2, 1, 2, 1,
+	1, 1, 0, 1, 1, 3, 1, 2, 3, 1,
+	1, 0, 1, 2, 1, 3, 3, 3, 3, 5,
+	0, 1, 2, 1, 1, 2, 3, 2, 3, 2,
+	2, 2, 1, 3, 1, 1, 3, 0, 5, 5,
+	5, 1, 3, 0, 2, 1, 3, 3, 2, 3,
+	3, 3, 4, 3, 4, 5, 6, 3, 4, 2,
+	1, 1, 1, 1, 1, 1, 1, 2, 1, 1,
+	3, 3, 1, 3, 1, 3, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 3, 4,
+	5, 4, 1, 1, 1, 1, 1, 1, 5, 0,
+	1, 1, 2, 4, 0, 2, 1, 3, 5, 1,
+	1, 1, 1, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 4, 0, 3, 1, 3, 0, 5, 1, 3,
+	3, 3, 0, 2, 0, 3, 1, 1, 1, 1,
+	3, 3, 1, 3, 2, 3, 2, 3, 3, 3,
+	2, 3, 4, 3, 4, 2, 0, 3, 5, 1,
+	2, 1, 5, 5, 6, 1, 1, 0, 1, 0,
+	1, 0, 2, 3, 3, 4, 3, 2, 1, 1,
+	1, 0, 1, 1, 3, 3, 5, 3, 5, 4,
+	3, 6, 5, 0, 1, 0, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 1, 0, 2, 1, 0,
+	0, 1,
 }
 var yyChk = [...]int{
 
 	-1000, -1, -2, -3, -4, -5, -6, -7, -8, -9,
-	-10, -11, -74, -75, -76, -77, -78, -79, -80, -81,
-	5, 6, 7, 8, 29, 104, 105, 107, 106, 86,
-	87, 89, 90, 100, 101, 54, 116, 117, -15, 41,
-	42, 43, 44, -12, -82, -12, -12, -12, -12, 31,
-	32, 108, -66, 110, 114, -17, 110, 112, 108, 108,
-	109, 110, 88, -12, 34, -68, 34, -12, 34, -3,
-	17, -16, 18, -13, -17, -27, 34, 9, -60, 99,
-	-61, -43, -68, 34, 88, 88, -63, 113, 109, -68,
-	108, -68, 34, -62, 113, -68, -62, 25, 39, -70,
-	108, -18, -19, 79, -22, 34, -31, -36, -32, 59,
-	39, -35, -43, -37, -42, -68, -40, -44, 20, 35,
-	36, 37, 21, -41, 77, 78, 40, 113, 24, 61,
-	38, 25, 29, 83, -27, 45, 28, -36, 39, 65,
-	83, -72, -71, 91, 92, -72, 34, 59, -68, -69,
-	34, -69, 111, 34, 20, 56, -68, -27, -14, 35,
-	-27, -55, 9, 45, 15, -20, -68, 19, 83, 58,
-	57, -33, 74, 59, 73, 60, 72, 76, 75, 82,
-	77, 78, 79, 80, 81, 65, 66, 67, 68, 69,
-	70, 71, -31, -36, -31, -38, -3, -36, -36, 39,
-	39, -41, 39, -47, -36, -27, -60, 34, -30, 10,
-	-61, 103, -36, -36, 56, -68, 34, 45, 33, 93,
-	94, -69, 20, -67, 115, -64, 107, 105, 28, 106,
-	13, 34, 34, 34, -69, -57, 29, 39, 45, 118,
-	-23, -24, -26, 39, 34, -41, -19, -36, -68, 79,
-	-31, -31, -36, -37, 74, 73, 60, -36, -36, 21,
-	59, -36, -36, -36, -36, -36, -36, -36, -36, 118,
-	118, 45, 118, 118, -18, 18, -18, -45, -46, 62,
-	-57, 29, -30, -51, 13, -31, -36, 83, -71, -73,
-	95, 92, 98, 56, -68, -69, -65, 111, -34, 24,
-	-3, -60, -58, -43, 35, -30, 45, -25, 46, 47,
-	48, 49, 50, 52, 53, -21, 34, 19, -24, 83,
-	45, 102, -37, -36, -36, 58, 21, -36, 118, -18,
-	118, -48, -46, 64, -31, -34, -60, -51, -55, 14,
-	-68, 92, 96, 97, 34, 34, -39, -37, 118, 45,
+	-10, -11, -92, -93, -94, -95, -96, -97, -98, -99,
+	-100, 5, 6, 7, 8, 29, 104, 105, 107, 106,
+	86, 87, 89, 90, 100, 101, 54, 123, 124, 125,
+	-15, 41, 42, 43, 44, -12, -104, -12, -12, -12,
+	-12, 31, 32, 108, -65, -70, 110, 116, 117, 114,
+	-17, 110, 112, 108, 108, -65, 109, 110, 88, -12,
+	34, -86, 34, -12, 34, -101, 109, 108, 104, -86,
+	31, 32, -3, 17, -16, 18, -13, -17, -27, 34,
+	9, -60, 99, -61, -43, -86, 34, 88, 88, -72,
+	113, -72, 109, -86, 108, -86, 34, -71, 113, -71,
+	-86, -71, 25, 39, -88, 108, -102, -86, -102, -86,
+	108, -65, -18, -19, 79, -22, 34, -31, -36, -32,
+	59, 39, -35, -43, -37, -42, -86, -40, -44, 20,
+	35, 36, 37, 21, -41, 77, 78, 40, 113, 24,
+	61, 38, 25, 29, 83, -27, 45, 28, -36, 39,
+	65, 83, -90, -89, 91, 92, -90, 34, 59, 34,
+	-86, -87, 34, -87, 111, 34, 20, 34, 56, -86,
+	-27, -14, 35, -27, -103, 9, 74, 73, 10, -103,
+	-102, -27, -72, -55, 9, 45, 15, -20, -86, 19,
+	83, 58, 57, -33, 74, 59, 73, 60, 72, 76,
+	75, 82, 77, 78, 79, 80, 81, 65, 66, 67,
+	68, 69, 70, 71, -31, -36, -31, -38, -3, -36,
+	-36, 39, 39, -41, 39, -47, -36, -27, -60, 34,
+	-30, 10, -61, 103, -36, -36, 56, -86, 34, 45,
+	33, 93, 94, 39, 20, -83, -64, 115, -77, -62,
+	107, -78, 105, 28, 106, 13, 34, 119, 121, 122,
+	34, 34, -87, -57, 29, 39, 45, 126, -27, -27,
+	-36, -31, -103, -86, -23, -24, -26, 39, 34, -41,
+	-19, -36, -86, 79, -31, -31, -36, -37, 74, 73,
+	60, -36, -36, 21, 59, -36, -36, -36, -36, -36,
+	-36, -36, -36, 126, 126, 45, 126, 126, -18, 18,
+	-18, -45, -46, 62, -57, 29, -30, -51, 13, -31,
+	-36, 83, -89, -91, 95, 92, 98, -73, -74, -81,
+	-86, 118, -67, 114, 27, 109, -82, 45, -86, 103,
+	28, 56, -86, 45, -87, -63, 120, 111, -66, 120,
+	-66, -66, -66, -66, -34, 24, -3, -60, -58, -43,
+	35, -30, 45, -25, 46, 47, 48, 49, 50, 52,
+	53, -21, 34, 19, -24, 83, 45, 102, -37, -36,
+	-36, 58, 21, -36, 126, -18, 126, -48, -46, 64,
+	-31, -34, -60, -51, -55, 14, -86, 92, 96, 97,
+	126, 45, 59, 21, 28, 118, 114, 34, 103, 56,
+	-75, -86, 27, -85, -86, -68, -67, -82, -69, 29,
+	65, -69, -82, 34, -78, 106, 107, 105, 34, -86,
+	-86, -86, -74, 39, -86, -74, -39, -37, 126, 45,
 	-49, 11, -24, -24, 46, 51, 46, 51, 46, 46,
-	46, -28, 54, 112, 55, 34, 118, 34, -36, -36,
-	58, -36, 118, 85, -36, 63, -59, 56, -59, -55,
-	-52, -53, -36, -69, 45, -43, -50, 12, 14, 56,
-	46, 46, 109, 109, 109, -36, -36, 26, 45, -54,
-	22, 23, -37, -51, -31, -38, -31, 39, 39, 39,
-	27, -53, -55, -29, -68, -29, -29, 7, -56, 16,
-	30, 118, 45, 118, 118, -60, 7, 74, -68, -68,
-	-68,
+	46, -28, 54, 112, 55, 34, 126, 34, -36, -36,
+	58, -36, 126, 85, -36, 63, -59, 56, -59, -55,
+	-52, -53, -36, -83, -74, -81, 21, -80, -35, 78,
+	-86, 27, -68, 35, 29, 34, 7, -79, 39, 39,
+	39, -85, -84, 34, 35, 36, -69, -84, 39, 111,
+	29, 106, -76, -74, -74, 45, -43, -50, 12, 14,
+	56, 46, 46, 109, 109, 109, -36, -36, 26, 45,
+	-54, 22, 23, 36, 34, 34, 36, -58, -58, 39,
+	-84, -58, -86, 28, 28, 45, 126, -37, -51, -31,
+	-38, -31, 39, 39, 39, 27, -53, 126, 45, 126,
+	126, -58, 126, -80, -74, -55, -29, -86, -29, -29,
+	7, 36, 126, -87, -56, 16, 30, 126, 45, 126,
+	126, -60, 126, 7, 74, -86, -86, -86,
 }
 var yyDef = [...]int{
 
 	0, -2, 1, 2, 3, 4, 5, 6, 7, 8,
 	9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
-	64, 64, 64, 64, 64, 226, 217, 0, 0, 46,
-	0, 48, 49, 64, 0, 0, 64, 0, 0, 68,
-	70, 71, 72, 73, 66, 217, 0, 0, 0, 0,
-	0, 215, 0, 0, 227, 0, 0, 218, 0, 213,
-	0, 213, 47, 0, 0, 52, 230, 232, 51, 21,
-	69, 0, 74, 65, 0, 0, 106, 0, 28, 0,
-	209, 0, 177, 230, 0, 0, 0, 0, 0, 231,
-	0, 231, 0, 0, 0, 0, 0, 0, 43, 0,
-	233, 196, 75, 77, 82, 230, 80, 81, 116, 0,
-	0, 147, 148, 149, 0, 177, 0, 163, 0, 180,
-	181, 182, 183, 143, 166, 167, 168, 164, 165, 170,
-	67, 0, 0, 0, 114, 0, 29, 30, 0, 0,
-	0, 32, 34, 0, 0, 33, 231, 0, 228, 56,
-	0, 59, 0, 61, 214, 0, 231, 203, 0, 44,
-	53, 19, 0, 0, 0, 78, 83, 0, 0, 0,
+	19, 83, 83, 83, 83, 83, 304, 295, 0, 0,
+	47, 0, 49, 50, 83, 0, 0, 83, 0, 0,
+	0, 87, 89, 90, 91, 92, 85, 295, 0, 0,
+	0, 0, 0, 234, 234, 0, 0, 236, 237, 305,
+	0, 0, 296, 0, 232, 232, 0, 232, 48, 0,
+	0, 68, 308, 310, 52, 62, 62, 0, 0, 58,
+	59, 60, 22, 88, 0, 93, 84, 0, 0, 125,
+	0, 29, 0, 228, 0, 196, 308, 0, 0, 0,
+	0, 0, 0, 309, 0, 309, 0, 0, 0, 0,
+	0, 0, 0, 44, 0, 311, 65, 61, 65, 62,
+	0, 234, 215, 94, 96, 101, 308, 99, 100, 135,
+	0, 0, 166, 167, 168, 0, 196, 0, 182, 0,
+	199, 200, 201, 202, 162, 185, 186, 187, 183, 184,
+	189, 86, 0, 0, 0, 133, 0, 30, 31, 0,
+	0, 0, 33, 35, 0, 0, 34, 0, 0, 271,
+	306, 73, 0, 77, 0, 79, 233, 80, 0, 309,
+	222, 0, 45, 69, 53, 0, 0, 0, 0, 54,
+	65, 56, 0, 20, 0, 0, 0, 97, 102, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 131, 132, 133, 134, 135,
-	136, 137, 119, 0, 0, 0, 0, 145, 158, 0,
-	0, 130, 0, 0, 171, 203, 114, 107, 188, 0,
-	210, 0, 145, 211, 212, 178, 230, 0, 0, 37,
-	38, 54, 216, 0, 0, 231, 224, 219, 220, 221,
-	222, 223, 60, 62, 63, 0, 0, 0, 0, 50,
-	114, 85, 91, 0, 103, 105, 76, 197, 84, 79,
-	117, 118, 121, 122, 0, 0, 0, 124, 0, 128,
-	0, 150, 151, 152, 153, 154, 155, 156, 157, 120,
-	142, 0, 144, 159, 0, 0, 0, 175, 172, 0,
-	0, 0, 188, 196, 0, 115, 31, 0, 35, 36,
-	0, 0, 42, 0, 229, 57, 0, 225, 24, 0,
-	139, 25, 0, 205, 45, 184, 0, 0, 94, 95,
-	0, 0, 0, 0, 0, 108, 92, 0, 0, 0,
-	0, 0, 123, 125, 0, 0, 129, 146, 160, 0,
-	162, 0, 173, 0, 0, 207, 207, 196, 27, 0,
-	179, 39, 40, 41, 231, 58, 138, 140, 204, 0,
-	186, 0, 86, 89, 96, 0, 98, 0, 100, 101,
-	102, 87, 0, 0, 0, 93, 88, 104, 198, 199,
-	0, 126, 161, 169, 176, 0, 22, 0, 23, 26,
-	189, 190, 193, 55, 0, 206, 188, 0, 0, 0,
-	97, 99, 0, 0, 0, 127, 174, 0, 0, 192,
-	194, 195, 141, 196, 187, 185, 90, 0, 0, 0,
-	0, 191, 200, 0, 112, 0, 0, 0, 20, 0,
-	0, 109, 0, 110, 111, 208, 201, 0, 113, 0,
-	202,
+	0, 0, 0, 0, 0, 0, 0, 150, 151, 152,
+	153, 154, 155, 156, 138, 0, 0, 0, 0, 164,
+	177, 0, 0, 149, 0, 0, 190, 222, 133, 126,
+	207, 0, 229, 0, 164, 230, 231, 197, 308, 0,
+	0, 38, 39, 0, 235, 71, 0, 0, 74, 309,
+	302, 283, -2, 298, -2, 300, 301, 293, 293, 293,
+	78, 81, 82, 0, 0, 0, 0, 51, 63, 64,
+	66, 67, 55, 57, 133, 104, 110, 0, 122, 124,
+	95, 216, 103, 98, 136, 137, 140, 141, 0, 0,
+	0, 143, 0, 147, 0, 169, 170, 171, 172, 173,
+	174, 175, 176, 139, 161, 0, 163, 178, 0, 0,
+	0, 194, 191, 0, 0, 0, 207, 215, 0, 134,
+	32, 0, 36, 37, 0, 0, 43, 0, 238, 239,
+	0, 0, 269, 267, 265, 266, 272, 0, 281, 281,
+	0, 0, 307, 0, 75, 0, 0, 303, 0, 294,
+	0, 0, 0, 0, 25, 0, 158, 26, 0, 224,
+	46, 203, 0, 0, 113, 114, 0, 0, 0, 0,
+	0, 127, 111, 0, 0, 0, 0, 0, 142, 144,
+	0, 0, 148, 165, 179, 0, 181, 0, 192, 0,
+	0, 226, 226, 215, 28, 0, 198, 40, 41, 42,
+	271, 0, 0, 246, 0, 0, 267, 250, 0, 0,
+	244, 256, 0, 0, 270, 269, 268, 273, 0, 281,
+	282, 0, 277, 0, 284, 293, 0, 293, 76, 0,
+	0, 287, 285, 0, 0, 290, 157, 159, 223, 0,
+	205, 0, 105, 108, 115, 0, 117, 0, 119, 120,
+	121, 106, 0, 0, 0, 112, 107, 123, 217, 218,
+	0, 145, 180, 188, 195, 0, 23, 0, 24, 27,
+	208, 209, 212, 70, 240, 241, 245, 247, 259, 0,
+	261, 248, 249, 251, 0, 253, 0, 255, 0, 0,
+	0, 0, 274, 278, 279, 280, 0, 276, 0, 0,
+	0, 0, 0, 242, 289, 0, 225, 207, 0, 0,
+	0, 116, 118, 0, 0, 0, 146, 193, 0, 0,
+	211, 213, 214, 260, 252, 254, 0, 0, 0, 0,
+	275, 0, 288, 0, 292, 0, 286, 160, 215, 206,
+	204, 109, 0, 0, 0, 0, 210, 257, 0, 262,
+	263, 0, 309, 291, 243, 219, 0, 131, 0, 0,
+	0, 0, 264, 72, 21, 0, 0, 128, 0, 129,
+	130, 227, 258, 220, 0, 132, 0, 221,
 }
 var yyTok1 = [...]int{
 
@@ -584,7 +697,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 81, 76, 3,
-	39, 118, 79, 77, 45, 78, 83, 80, 3, 3,
+	39, 126, 79, 77, 45, 78, 83, 80, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	66, 65, 67, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -606,7 +719,7 @@ var yyTok2 = [...]int{
 	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117,
+	117, 118, 119, 120, 121, 122, 123, 124, 125,
 }
 var yyTok3 = [...]int{
 	0,
@@ -951,43 +1064,43 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:200
+		//line sql.y:228
 		{
 			SetParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:206
+		//line sql.y:234
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:228
+		//line sql.y:257
 		{
 			yyVAL.selStmt = &SimpleSelect{Comments: Comments(yyDollar[2].bytes2), Distinct: yyDollar[3].str, SelectExprs: yyDollar[4].selectExprs, Limit: yyDollar[5].limit}
 		}
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line sql.y:232
+		//line sql.y:261
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Distinct: yyDollar[3].str, SelectExprs: yyDollar[4].selectExprs, From: yyDollar[6].tableExprs, Where: NewWhere(AST_WHERE, yyDollar[7].boolExpr), GroupBy: GroupBy(yyDollar[8].valExprs), Having: NewWhere(AST_HAVING, yyDollar[9].boolExpr), OrderBy: yyDollar[10].orderBy, Limit: yyDollar[11].limit, Lock: yyDollar[12].str}
 		}
-	case 21:
+	case 22:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:236
+		//line sql.y:265
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
 		}
-	case 22:
+	case 23:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:243
+		//line sql.y:272
 		{
 			yyVAL.statement = &Insert{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[5].tableName, Columns: yyDollar[6].columns, Rows: yyDollar[7].insRows, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:247
+		//line sql.y:276
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[7].updateExprs))
@@ -997,15 +1110,15 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[5].tableName, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:259
+		//line sql.y:288
 		{
 			yyVAL.statement = &Replace{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[4].tableName, Columns: yyDollar[5].columns, Rows: yyDollar[6].insRows}
 		}
-	case 25:
+	case 26:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:263
+		//line sql.y:292
 		{
 			cols := make(Columns, 0, len(yyDollar[6].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[6].updateExprs))
@@ -1015,39 +1128,39 @@ yydefault:
 			}
 			yyVAL.statement = &Replace{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[4].tableName, Columns: cols, Rows: Values{vals}}
 		}
-	case 26:
+	case 27:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:276
+		//line sql.y:305
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[3].tableName, Exprs: yyDollar[5].updateExprs, Where: NewWhere(AST_WHERE, yyDollar[6].boolExpr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 27:
+	case 28:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:282
+		//line sql.y:311
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[4].tableName, Where: NewWhere(AST_WHERE, yyDollar[5].boolExpr), OrderBy: yyDollar[6].orderBy, Limit: yyDollar[7].limit}
 		}
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:288
+		//line sql.y:317
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].updateExprs}
 		}
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:292
+		//line sql.y:321
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: UpdateExprs{&UpdateExpr{Name: &ColName{Name: []byte("names")}, Expr: StrVal("default")}}}
 		}
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:296
+		//line sql.y:325
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: UpdateExprs{&UpdateExpr{Name: &ColName{Name: []byte("names")}, Expr: yyDollar[4].valExpr}}}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:300
+		//line sql.y:329
 		{
 			yyVAL.statement = &Set{
 				Comments: Comments(yyDollar[2].bytes2),
@@ -1061,9 +1174,9 @@ yydefault:
 				},
 			}
 		}
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:314
+		//line sql.y:343
 		{
 			yyVAL.statement = &Set{
 				Exprs: UpdateExprs{
@@ -1073,9 +1186,9 @@ yydefault:
 				},
 			}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:324
+		//line sql.y:353
 		{
 			yyVAL.statement = &Set{
 				Exprs: UpdateExprs{
@@ -1085,697 +1198,809 @@ yydefault:
 				},
 			}
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:350
+		//line sql.y:379
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:354
+		//line sql.y:383
 		{
 			yyVAL.bytes2 = [][]byte{yyDollar[1].bytes}
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:358
+		//line sql.y:387
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[3].bytes)
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:364
+		//line sql.y:393
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:368
+		//line sql.y:397
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:375
+		//line sql.y:404
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:381
+		//line sql.y:410
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:387
+		//line sql.y:416
 		{
 			yyVAL.statement = &Admin{Command: yyDollar[2].bytes, Args: yyDollar[4].bytes2}
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:393
+		//line sql.y:422
 		{
 			yyVAL.statement = &Describe{TableName: yyDollar[2].bytes}
 		}
-	case 52:
+	case 53:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:428
+		{
+			yyVAL.statement = NewShow(yyDollar[2].bytes, yyDollar[3].tableNames, yyDollar[4].boolExpr)
+		}
+	case 54:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:432
+		{
+			yyVAL.statement = NewShow([]byte(AST_SHOW_INDEX), yyDollar[3].tableNames, yyDollar[4].boolExpr)
+		}
+	case 55:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:436
+		{
+			// TABLE STATUS
+			yyVAL.statement = NewShow(append([]byte("table "), yyDollar[3].bytes...), yyDollar[4].tableNames, yyDollar[5].boolExpr)
+		}
+	case 56:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:441
+		{
+			yyVAL.statement = &Show{Section: AST_SHOW_CREATE_TABLE, Table: yyDollar[4].tableName}
+		}
+	case 57:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:445
+		{
+			yyVAL.statement = &Show{Section: AST_SHOW_CREATE_DATABASE, From: StrVal(yyDollar[5].bytes)}
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:451
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:455
+		{
+			yyVAL.bytes = []byte("global")
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:459
+		{
+			yyVAL.bytes = []byte("session")
+		}
+	case 61:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:463
+		{
+			yyVAL.bytes = append(append(yyDollar[1].bytes, ' '), yyDollar[2].bytes...)
+		}
+	case 62:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:468
+		{
+			yyVAL.tableNames = nil
+		}
+	case 63:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:472
+		{
+			yyVAL.tableNames = append(yyDollar[1].tableNames, yyDollar[3].tableName)
+		}
+	case 64:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:476
+		{
+			yyVAL.tableNames = append(yyDollar[1].tableNames, yyDollar[3].tableName)
+		}
+	case 65:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:481
+		{
+			yyVAL.boolExpr = nil
+		}
+	case 66:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:485
+		{
+			yyVAL.boolExpr = &ComparisonExpr{Operator: AST_LIKE, Right: yyDollar[2].valExpr}
+		}
+	case 67:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:489
+		{
+			yyVAL.boolExpr = yyDollar[2].boolExpr
+		}
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:399
+		//line sql.y:495
 		{
 			yyVAL.statement = &UseDB{DB: string(yyDollar[2].bytes)}
 		}
-	case 53:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:405
+		//line sql.y:501
 		{
 			yyVAL.statement = &Truncate{Comments: Comments(yyDollar[2].bytes2), TableOpt: yyDollar[3].str, Table: yyDollar[4].tableName}
 		}
-	case 54:
+	case 70:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		//line sql.y:507
+		{
+			yyDollar[6].createTable.IfNotExists = yyDollar[3].boolean
+			yyDollar[6].createTable.Name = yyDollar[4].bytes
+			yyDollar[6].createTable.Options = yyDollar[8].tableOpts
+			yyVAL.statement = yyDollar[6].createTable
+		}
+	case 71:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:411
+		//line sql.y:514
 		{
-			yyVAL.statement = &DDL{Action: AST_CREATE, NewName: yyDollar[4].bytes}
+			yyVAL.statement = &CreateDatabase{IfNotExists: yyDollar[3].boolean, Name: yyDollar[4].bytes}
 		}
-	case 55:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:415
+	case 72:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line sql.y:518
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AST_ALTER, Table: yyDollar[7].bytes, NewName: yyDollar[7].bytes}
+			yyVAL.statement = &CreateIndex{Unique: yyDollar[2].boolean, Name: yyDollar[4].bytes, Table: yyDollar[7].bytes, Columns: yyDollar[9].columns}
 		}
-	case 56:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:420
+		//line sql.y:522
 		{
 			yyVAL.statement = &DDL{Action: AST_CREATE, NewName: yyDollar[3].bytes}
 		}
-	case 57:
+	case 74:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:528
+		{
+			yyDollar[5].alterTable.Table = yyDollar[4].bytes
+			yyVAL.statement = yyDollar[5].alterTable
+		}
+	case 75:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:426
+		//line sql.y:533
 		{
 			yyVAL.statement = &DDL{Action: AST_ALTER, Ignore: yyDollar[2].str, Table: yyDollar[4].bytes, NewName: yyDollar[4].bytes}
 		}
-	case 58:
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:430
+		//line sql.y:537
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: AST_RENAME, Ignore: yyDollar[2].str, Table: yyDollar[4].bytes, NewName: yyDollar[7].bytes}
 		}
-	case 59:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:435
+		//line sql.y:542
 		{
 			yyVAL.statement = &DDL{Action: AST_ALTER, Table: yyDollar[3].bytes, NewName: yyDollar[3].bytes}
 		}
-	case 60:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:441
+		//line sql.y:548
 		{
 			yyVAL.statement = &DDL{Action: AST_RENAME, Table: yyDollar[3].bytes, NewName: yyDollar[5].bytes}
 		}
-	case 61:
+	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:447
+		//line sql.y:554
 		{
-			yyVAL.statement = &DDL{Action: AST_DROP, Table: yyDollar[4].bytes}
+			yyVAL.statement = &DDL{Action: AST_DROP, Table: yyDollar[4].bytes, IfExists: yyDollar[3].boolean}
 		}
-	case 62:
+	case 80:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:558
+		{
+			yyVAL.statement = &DropDatabase{IfExists: yyDollar[3].boolean, Name: yyDollar[4].bytes}
+		}
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:451
+		//line sql.y:562
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AST_ALTER, Table: yyDollar[5].bytes, NewName: yyDollar[5].bytes}
 		}
-	case 63:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:456
+		//line sql.y:567
 		{
 			yyVAL.statement = &DDL{Action: AST_DROP, Table: yyDollar[4].bytes}
 		}
-	case 64:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:461
+		//line sql.y:572
 		{
 			SetAllowComments(yylex, true)
 		}
-	case 65:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:465
+		//line sql.y:576
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			SetAllowComments(yylex, false)
 		}
-	case 66:
+	case 85:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:471
+		//line sql.y:582
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 67:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:475
+		//line sql.y:586
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 68:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:481
+		//line sql.y:592
 		{
 			yyVAL.str = AST_UNION
 		}
-	case 69:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:485
+		//line sql.y:596
 		{
 			yyVAL.str = AST_UNION_ALL
 		}
-	case 70:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:489
+		//line sql.y:600
 		{
 			yyVAL.str = AST_SET_MINUS
 		}
-	case 71:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:493
+		//line sql.y:604
 		{
 			yyVAL.str = AST_EXCEPT
 		}
-	case 72:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:497
+		//line sql.y:608
 		{
 			yyVAL.str = AST_INTERSECT
 		}
-	case 73:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:502
+		//line sql.y:613
 		{
 			yyVAL.str = ""
 		}
-	case 74:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:506
+		//line sql.y:617
 		{
 			yyVAL.str = AST_DISTINCT
 		}
-	case 75:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:512
+		//line sql.y:623
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 76:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:516
+		//line sql.y:627
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 77:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:522
+		//line sql.y:633
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 78:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:526
+		//line sql.y:637
 		{
 			yyVAL.selectExpr = &NonStarExpr{Expr: yyDollar[1].expr, As: yyDollar[2].bytes}
 		}
-	case 79:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:530
+		//line sql.y:641
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: yyDollar[1].bytes}
 		}
-	case 80:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:536
+		//line sql.y:647
 		{
 			yyVAL.expr = yyDollar[1].boolExpr
 		}
-	case 81:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:540
+		//line sql.y:651
 		{
 			yyVAL.expr = yyDollar[1].valExpr
 		}
-	case 82:
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:545
+		//line sql.y:656
 		{
 			yyVAL.bytes = nil
 		}
-	case 83:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:549
+		//line sql.y:660
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 84:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:553
+		//line sql.y:664
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 85:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:559
+		//line sql.y:670
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 86:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:563
+		//line sql.y:674
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 87:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:569
+		//line sql.y:680
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].smTableExpr, As: yyDollar[2].bytes, Hints: yyDollar[3].indexHints}
 		}
-	case 88:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:573
+		//line sql.y:684
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Expr: yyDollar[2].tableExpr}
 		}
-	case 89:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:577
+		//line sql.y:688
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 90:
+	case 109:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:581
+		//line sql.y:692
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, On: yyDollar[5].boolExpr}
 		}
-	case 91:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:586
+		//line sql.y:697
 		{
 			yyVAL.bytes = nil
 		}
-	case 92:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:590
+		//line sql.y:701
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 93:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:594
+		//line sql.y:705
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 94:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:600
+		//line sql.y:711
 		{
 			yyVAL.str = AST_JOIN
 		}
-	case 95:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:604
+		//line sql.y:715
 		{
 			yyVAL.str = AST_STRAIGHT_JOIN
 		}
-	case 96:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:608
+		//line sql.y:719
 		{
 			yyVAL.str = AST_LEFT_JOIN
 		}
-	case 97:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:612
+		//line sql.y:723
 		{
 			yyVAL.str = AST_LEFT_JOIN
 		}
-	case 98:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:616
+		//line sql.y:727
 		{
 			yyVAL.str = AST_RIGHT_JOIN
 		}
-	case 99:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:620
+		//line sql.y:731
 		{
 			yyVAL.str = AST_RIGHT_JOIN
 		}
-	case 100:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:624
+		//line sql.y:735
 		{
 			yyVAL.str = AST_JOIN
 		}
-	case 101:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:628
+		//line sql.y:739
 		{
 			yyVAL.str = AST_CROSS_JOIN
 		}
-	case 102:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:632
+		//line sql.y:743
 		{
 			yyVAL.str = AST_NATURAL_JOIN
 		}
-	case 103:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:638
+		//line sql.y:749
 		{
 			yyVAL.smTableExpr = &TableName{Name: yyDollar[1].bytes}
 		}
-	case 104:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:642
+		//line sql.y:753
 		{
 			yyVAL.smTableExpr = &TableName{Qualifier: yyDollar[1].bytes, Name: yyDollar[3].bytes}
 		}
-	case 105:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:646
+		//line sql.y:757
 		{
 			yyVAL.smTableExpr = yyDollar[1].subquery
 		}
-	case 106:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:652
+		//line sql.y:763
 		{
 			yyVAL.tableName = &TableName{Name: yyDollar[1].bytes}
 		}
-	case 107:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:656
+		//line sql.y:767
 		{
 			yyVAL.tableName = &TableName{Qualifier: yyDollar[1].bytes, Name: yyDollar[3].bytes}
 		}
-	case 108:
+	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:661
+		//line sql.y:772
 		{
 			yyVAL.indexHints = nil
 		}
-	case 109:
+	case 128:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:665
+		//line sql.y:776
 		{
 			yyVAL.indexHints = &IndexHints{Type: AST_USE, Indexes: yyDollar[4].bytes2}
 		}
-	case 110:
+	case 129:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:669
+		//line sql.y:780
 		{
 			yyVAL.indexHints = &IndexHints{Type: AST_IGNORE, Indexes: yyDollar[4].bytes2}
 		}
-	case 111:
+	case 130:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:673
+		//line sql.y:784
 		{
 			yyVAL.indexHints = &IndexHints{Type: AST_FORCE, Indexes: yyDollar[4].bytes2}
 		}
-	case 112:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:679
+		//line sql.y:790
 		{
 			yyVAL.bytes2 = [][]byte{yyDollar[1].bytes}
 		}
-	case 113:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:683
+		//line sql.y:794
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[3].bytes)
 		}
-	case 114:
+	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:688
+		//line sql.y:799
 		{
 			yyVAL.boolExpr = nil
 		}
-	case 115:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:692
+		//line sql.y:803
 		{
 			yyVAL.boolExpr = yyDollar[2].boolExpr
 		}
-	case 117:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:699
+		//line sql.y:810
 		{
 			yyVAL.boolExpr = &AndExpr{Left: yyDollar[1].boolExpr, Right: yyDollar[3].boolExpr}
 		}
-	case 118:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:703
+		//line sql.y:814
 		{
 			yyVAL.boolExpr = &OrExpr{Left: yyDollar[1].boolExpr, Right: yyDollar[3].boolExpr}
 		}
-	case 119:
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:707
+		//line sql.y:818
 		{
 			yyVAL.boolExpr = &NotExpr{Expr: yyDollar[2].boolExpr}
 		}
-	case 120:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:711
+		//line sql.y:822
 		{
 			yyVAL.boolExpr = &ParenBoolExpr{Expr: yyDollar[2].boolExpr}
 		}
-	case 121:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:717
+		//line sql.y:828
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: yyDollar[2].str, Right: yyDollar[3].valExpr}
 		}
-	case 122:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:721
+		//line sql.y:832
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: AST_IN, Right: yyDollar[3].tuple}
 		}
-	case 123:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:725
+		//line sql.y:836
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: AST_NOT_IN, Right: yyDollar[4].tuple}
 		}
-	case 124:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:729
+		//line sql.y:840
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: AST_LIKE, Right: yyDollar[3].valExpr}
 		}
-	case 125:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:733
+		//line sql.y:844
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: AST_NOT_LIKE, Right: yyDollar[4].valExpr}
 		}
-	case 126:
+	case 145:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:737
+		//line sql.y:848
 		{
 			yyVAL.boolExpr = &RangeCond{Left: yyDollar[1].valExpr, Operator: AST_BETWEEN, From: yyDollar[3].valExpr, To: yyDollar[5].valExpr}
 		}
-	case 127:
+	case 146:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:741
+		//line sql.y:852
 		{
 			yyVAL.boolExpr = &RangeCond{Left: yyDollar[1].valExpr, Operator: AST_NOT_BETWEEN, From: yyDollar[4].valExpr, To: yyDollar[6].valExpr}
 		}
-	case 128:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:745
+		//line sql.y:856
 		{
 			yyVAL.boolExpr = &NullCheck{Operator: AST_IS_NULL, Expr: yyDollar[1].valExpr}
 		}
-	case 129:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:749
+		//line sql.y:860
 		{
 			yyVAL.boolExpr = &NullCheck{Operator: AST_IS_NOT_NULL, Expr: yyDollar[1].valExpr}
 		}
-	case 130:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:753
+		//line sql.y:864
 		{
 			yyVAL.boolExpr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 131:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:759
+		//line sql.y:870
 		{
 			yyVAL.str = AST_EQ
 		}
-	case 132:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:763
+		//line sql.y:874
 		{
 			yyVAL.str = AST_LT
 		}
-	case 133:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:767
+		//line sql.y:878
 		{
 			yyVAL.str = AST_GT
 		}
-	case 134:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:771
+		//line sql.y:882
 		{
 			yyVAL.str = AST_LE
 		}
-	case 135:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:775
+		//line sql.y:886
 		{
 			yyVAL.str = AST_GE
 		}
-	case 136:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:779
+		//line sql.y:890
 		{
 			yyVAL.str = AST_NE
 		}
-	case 137:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:783
+		//line sql.y:894
 		{
 			yyVAL.str = AST_NSE
 		}
-	case 138:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:789
+		//line sql.y:900
 		{
 			yyVAL.insRows = yyDollar[2].values
 		}
-	case 139:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:793
+		//line sql.y:904
 		{
 			yyVAL.insRows = yyDollar[1].selStmt
 		}
-	case 140:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:799
+		//line sql.y:910
 		{
 			yyVAL.values = Values{yyDollar[1].tuple}
 		}
-	case 141:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:803
+		//line sql.y:914
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].tuple)
 		}
-	case 142:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:809
+		//line sql.y:920
 		{
 			yyVAL.tuple = ValTuple(yyDollar[2].valExprs)
 		}
-	case 143:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:813
+		//line sql.y:924
 		{
 			yyVAL.tuple = yyDollar[1].subquery
 		}
-	case 144:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:819
+		//line sql.y:930
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 145:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:825
+		//line sql.y:936
 		{
 			yyVAL.valExprs = ValExprs{yyDollar[1].valExpr}
 		}
-	case 146:
+	case 165:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:829
+		//line sql.y:940
 		{
 			yyVAL.valExprs = append(yyDollar[1].valExprs, yyDollar[3].valExpr)
 		}
-	case 147:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:835
+		//line sql.y:946
 		{
 			yyVAL.valExpr = yyDollar[1].valExpr
 		}
-	case 148:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:839
+		//line sql.y:950
 		{
 			yyVAL.valExpr = yyDollar[1].colName
 		}
-	case 149:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:843
+		//line sql.y:954
 		{
 			yyVAL.valExpr = yyDollar[1].tuple
 		}
-	case 150:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:847
+		//line sql.y:958
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_BITAND, Right: yyDollar[3].valExpr}
 		}
-	case 151:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:851
+		//line sql.y:962
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_BITOR, Right: yyDollar[3].valExpr}
 		}
-	case 152:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:855
+		//line sql.y:966
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_BITXOR, Right: yyDollar[3].valExpr}
 		}
-	case 153:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:859
+		//line sql.y:970
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_PLUS, Right: yyDollar[3].valExpr}
 		}
-	case 154:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:863
+		//line sql.y:974
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_MINUS, Right: yyDollar[3].valExpr}
 		}
-	case 155:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:867
+		//line sql.y:978
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_MULT, Right: yyDollar[3].valExpr}
 		}
-	case 156:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:871
+		//line sql.y:982
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_DIV, Right: yyDollar[3].valExpr}
 		}
-	case 157:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:875
+		//line sql.y:986
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: AST_MOD, Right: yyDollar[3].valExpr}
 		}
-	case 158:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:879
+		//line sql.y:990
 		{
 			if num, ok := yyDollar[2].valExpr.(NumVal); ok {
 				switch yyDollar[1].byt {
@@ -1790,267 +2015,267 @@ yydefault:
 				yyVAL.valExpr = &UnaryExpr{Operator: yyDollar[1].byt, Expr: yyDollar[2].valExpr}
 			}
 		}
-	case 159:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:894
+		//line sql.y:1005
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].bytes}
 		}
-	case 160:
+	case 179:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:898
+		//line sql.y:1009
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].bytes, Exprs: yyDollar[3].selectExprs}
 		}
-	case 161:
+	case 180:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:902
+		//line sql.y:1013
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].bytes, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 162:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:906
+		//line sql.y:1017
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].bytes, Exprs: yyDollar[3].selectExprs}
 		}
-	case 163:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:910
+		//line sql.y:1021
 		{
 			yyVAL.valExpr = yyDollar[1].caseExpr
 		}
-	case 164:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:916
+		//line sql.y:1027
 		{
 			yyVAL.bytes = IF_BYTES
 		}
-	case 165:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:920
+		//line sql.y:1031
 		{
 			yyVAL.bytes = VALUES_BYTES
 		}
-	case 166:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:926
+		//line sql.y:1037
 		{
 			yyVAL.byt = AST_UPLUS
 		}
-	case 167:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:930
+		//line sql.y:1041
 		{
 			yyVAL.byt = AST_UMINUS
 		}
-	case 168:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:934
+		//line sql.y:1045
 		{
 			yyVAL.byt = AST_TILDA
 		}
-	case 169:
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:940
+		//line sql.y:1051
 		{
 			yyVAL.caseExpr = &CaseExpr{Expr: yyDollar[2].valExpr, Whens: yyDollar[3].whens, Else: yyDollar[4].valExpr}
 		}
-	case 170:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:945
+		//line sql.y:1056
 		{
 			yyVAL.valExpr = nil
 		}
-	case 171:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:949
+		//line sql.y:1060
 		{
 			yyVAL.valExpr = yyDollar[1].valExpr
 		}
-	case 172:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:955
+		//line sql.y:1066
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 173:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:959
+		//line sql.y:1070
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 174:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:965
+		//line sql.y:1076
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].boolExpr, Val: yyDollar[4].valExpr}
 		}
-	case 175:
+	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:970
+		//line sql.y:1081
 		{
 			yyVAL.valExpr = nil
 		}
-	case 176:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:974
+		//line sql.y:1085
 		{
 			yyVAL.valExpr = yyDollar[2].valExpr
 		}
-	case 177:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:980
+		//line sql.y:1091
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].bytes}
 		}
-	case 178:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:984
+		//line sql.y:1095
 		{
 			yyVAL.colName = &ColName{Qualifier: yyDollar[1].bytes, Name: yyDollar[3].bytes}
 		}
-	case 179:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:988
+		//line sql.y:1099
 		{
 			yyVAL.colName = &ColName{Qualifier: yyDollar[3].bytes, Name: yyDollar[5].bytes}
 		}
-	case 180:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:994
+		//line sql.y:1105
 		{
 			yyVAL.valExpr = StrVal(yyDollar[1].bytes)
 		}
-	case 181:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:998
+		//line sql.y:1109
 		{
 			yyVAL.valExpr = NumVal(yyDollar[1].bytes)
 		}
-	case 182:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1002
+		//line sql.y:1113
 		{
 			yyVAL.valExpr = ValArg(yyDollar[1].bytes)
 		}
-	case 183:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1006
+		//line sql.y:1117
 		{
 			yyVAL.valExpr = &NullVal{}
 		}
-	case 184:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1011
+		//line sql.y:1122
 		{
 			yyVAL.valExprs = nil
 		}
-	case 185:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1015
+		//line sql.y:1126
 		{
 			yyVAL.valExprs = yyDollar[3].valExprs
 		}
-	case 186:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1020
+		//line sql.y:1131
 		{
 			yyVAL.boolExpr = nil
 		}
-	case 187:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1024
+		//line sql.y:1135
 		{
 			yyVAL.boolExpr = yyDollar[2].boolExpr
 		}
-	case 188:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1029
+		//line sql.y:1140
 		{
 			yyVAL.orderBy = nil
 		}
-	case 189:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1033
+		//line sql.y:1144
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 190:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1039
+		//line sql.y:1150
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 191:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1043
+		//line sql.y:1154
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 192:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1049
+		//line sql.y:1160
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].valExpr, Direction: yyDollar[2].str}
 		}
-	case 193:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1054
+		//line sql.y:1165
 		{
 			yyVAL.str = AST_ASC
 		}
-	case 194:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1058
+		//line sql.y:1169
 		{
 			yyVAL.str = AST_ASC
 		}
-	case 195:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1062
+		//line sql.y:1173
 		{
 			yyVAL.str = AST_DESC
 		}
-	case 196:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1067
+		//line sql.y:1178
 		{
 			yyVAL.limit = nil
 		}
-	case 197:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1071
+		//line sql.y:1182
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].valExpr}
 		}
-	case 198:
+	case 217:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1075
+		//line sql.y:1186
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].valExpr, Rowcount: yyDollar[4].valExpr}
 		}
-	case 199:
+	case 218:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1079
+		//line sql.y:1190
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].valExpr, Rowcount: yyDollar[2].valExpr}
 		}
-	case 200:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1084
+		//line sql.y:1195
 		{
 			yyVAL.str = ""
 		}
-	case 201:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1088
+		//line sql.y:1199
 		{
 			yyVAL.str = AST_FOR_UPDATE
 		}
-	case 202:
+	case 221:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1092
+		//line sql.y:1203
 		{
 			if !bytes.Equal(yyDollar[3].bytes, SHARE) {
 				yylex.Error("expecting share")
@@ -2062,189 +2287,541 @@ yydefault:
 			}
 			yyVAL.str = AST_SHARE_MODE
 		}
-	case 203:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1105
+		//line sql.y:1216
 		{
 			yyVAL.columns = nil
 		}
-	case 204:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1109
+		//line sql.y:1220
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 205:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1115
+		//line sql.y:1226
 		{
 			yyVAL.columns = Columns{&NonStarExpr{Expr: yyDollar[1].colName}}
 		}
-	case 206:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1119
+		//line sql.y:1230
 		{
 			yyVAL.columns = append(yyVAL.columns, &NonStarExpr{Expr: yyDollar[3].colName})
 		}
-	case 207:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1124
+		//line sql.y:1235
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 208:
+	case 227:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1128
+		//line sql.y:1239
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 209:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1134
+		//line sql.y:1245
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 210:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1138
+		//line sql.y:1249
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 211:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1144
+		//line sql.y:1255
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].valExpr}
 		}
-	case 212:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1148
+		//line sql.y:1259
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: StrVal("ON")}
 		}
-	case 213:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1153
+		//line sql.y:1264
+		{
+			yyVAL.boolean = false
+		}
+	case 233:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1266
+		{
+			yyVAL.boolean = true
+		}
+	case 234:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1269
+		{
+			yyVAL.boolean = false
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1271
+		{
+			yyVAL.boolean = true
+		}
+	case 236:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1275
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 214:
+	case 237:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1277
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1281
+		{
+			yyVAL.createTable = &CreateTable{Columns: []*ColumnDefinition{yyDollar[1].colDef}}
+		}
+	case 239:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1285
+		{
+			yyVAL.createTable = &CreateTable{Constraints: []*TableConstraint{yyDollar[1].constraint}}
+		}
+	case 240:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1289
+		{
+			yyDollar[1].createTable.Columns = append(yyDollar[1].createTable.Columns, yyDollar[3].colDef)
+			yyVAL.createTable = yyDollar[1].createTable
+		}
+	case 241:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1294
+		{
+			yyDollar[1].createTable.Constraints = append(yyDollar[1].createTable.Constraints, yyDollar[3].constraint)
+			yyVAL.createTable = yyDollar[1].createTable
+		}
+	case 242:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1301
+		{
+			yyVAL.colDefs = []*ColumnDefinition{yyDollar[1].colDef}
+		}
+	case 243:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1305
+		{
+			yyVAL.colDefs = append(yyDollar[1].colDefs, yyDollar[3].colDef)
+		}
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1311
+		{
+			yyDollar[2].colDef.Name = yyDollar[1].bytes
+			yyVAL.colDef = yyDollar[2].colDef
+		}
+	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1316
+		{
+			yyDollar[1].colDef.NotNull = true
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1321
+		{
+			yyDollar[1].colDef.Null = true
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 247:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1326
+		{
+			yyDollar[1].colDef.Default = yyDollar[3].valExpr
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1331
+		{
+			yyDollar[1].colDef.PrimaryKey = true
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 249:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1336
+		{
+			yyDollar[1].colDef.Unique = true
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1155
+		//line sql.y:1341
+		{
+			yyDollar[1].colDef.Attrs = append(yyDollar[1].colDef.Attrs, bytes.ToLower(yyDollar[2].bytes))
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1346
+		{
+			// COMMENT 'xxx'
+			yyDollar[1].colDef.Attrs = append(yyDollar[1].colDef.Attrs, bytes.ToLower(yyDollar[2].bytes))
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 252:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1352
+		{
+			// CHARACTER SET xxx
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 253:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1357
+		{
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 254:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1361
+		{
+			yyDollar[1].colDef.Attrs = append(yyDollar[1].colDef.Attrs, []byte("on update"))
+			yyVAL.colDef = yyDollar[1].colDef
+		}
+	case 255:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1368
+		{
+			yyVAL.colDef = &ColumnDefinition{Type: yyDollar[1].bytes, Length: yyDollar[2].bytes2}
+		}
+	case 256:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1373
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 257:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1377
+		{
+			yyVAL.bytes2 = [][]byte{yyDollar[2].bytes}
+		}
+	case 258:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1381
+		{
+			yyVAL.bytes2 = [][]byte{yyDollar[2].bytes, yyDollar[4].bytes}
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1387
+		{
+			yyVAL.valExpr = yyDollar[1].valExpr
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1391
+		{
+			yyVAL.valExpr = NumVal(append([]byte("-"), yyDollar[2].bytes...))
+		}
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1395
+		{
+			yyVAL.valExpr = &ColName{Name: yyDollar[1].bytes}
+		}
+	case 262:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1401
+		{
+			yyVAL.constraint = &TableConstraint{Type: AST_PRIMARY_KEY, Columns: yyDollar[4].columns}
+		}
+	case 263:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1405
+		{
+			yyVAL.constraint = &TableConstraint{Type: AST_KEY, Name: yyDollar[2].bytes, Columns: yyDollar[4].columns}
+		}
+	case 264:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line sql.y:1409
+		{
+			yyVAL.constraint = &TableConstraint{Type: AST_UNIQUE_KEY, Name: yyDollar[3].bytes, Columns: yyDollar[5].columns}
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1415
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 215:
+	case 266:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1417
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 267:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1158
+		//line sql.y:1420
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 216:
+	case 268:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1422
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 269:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1425
+		{
+			yyVAL.bytes = nil
+		}
+	case 270:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1429
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 271:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1434
+		{
+			yyVAL.tableOpts = nil
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1438
+		{
+			yyVAL.tableOpts = append(yyDollar[1].tableOpts, yyDollar[2].tableOpt)
+		}
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1160
+		//line sql.y:1442
+		{
+			yyVAL.tableOpts = append(yyDollar[1].tableOpts, yyDollar[3].tableOpt)
+		}
+	case 274:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1448
+		{
+			yyVAL.tableOpt = &TableOption{Name: yyDollar[1].bytes, Value: yyDollar[3].bytes}
+		}
+	case 275:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1452
+		{
+			// CHARACTER SET = xxx
+			yyVAL.tableOpt = &TableOption{Name: append(yyDollar[1].bytes, " set"...), Value: yyDollar[4].bytes}
+		}
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1457
+		{
+			yyVAL.tableOpt = &TableOption{Name: []byte("collate"), Value: yyDollar[3].bytes}
+		}
+	case 277:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1461
+		{
+			yyVAL.tableOpt = yyDollar[2].tableOpt
+		}
+	case 281:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1471
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 217:
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1473
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1477
+		{
+			yyVAL.alterTable = yyDollar[1].alterTable
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1481
+		{
+			yyVAL.alterTable = yyDollar[1].alterTable
+			yyVAL.alterTable.AddColumns = append(yyVAL.alterTable.AddColumns, yyDollar[3].alterTable.AddColumns...)
+			yyVAL.alterTable.AlterColumns = append(yyVAL.alterTable.AlterColumns, yyDollar[3].alterTable.AlterColumns...)
+		}
+	case 285:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1489
+		{
+			yyVAL.alterTable = &AlterTable{AddColumns: []*ColumnDefinition{yyDollar[3].colDef}}
+		}
+	case 286:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1493
+		{
+			yyVAL.alterTable = &AlterTable{AddColumns: yyDollar[4].colDefs}
+		}
+	case 287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1497
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_DROP_COLUMN, Name: yyDollar[3].bytes}}}
+		}
+	case 288:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1501
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_RENAME_COLUMN, Name: yyDollar[3].bytes, NewName: yyDollar[5].bytes}}}
+		}
+	case 289:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1505
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_CHANGE_COLUMN, Name: yyDollar[3].bytes, NewName: yyDollar[4].colDef.Name, Definition: yyDollar[4].colDef}}}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1509
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_MODIFY_COLUMN, Name: yyDollar[3].colDef.Name, Definition: yyDollar[3].colDef}}}
+		}
+	case 291:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line sql.y:1513
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_SET_DEFAULT, Name: yyDollar[3].bytes, Default: yyDollar[6].valExpr}}}
+		}
+	case 292:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1517
+		{
+			yyVAL.alterTable = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_DROP_DEFAULT, Name: yyDollar[3].bytes}}}
+		}
+	case 293:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1163
+		//line sql.y:1522
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1524
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 295:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1527
 		{
 			yyVAL.str = ""
 		}
-	case 218:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1165
+		//line sql.y:1529
 		{
 			yyVAL.str = AST_IGNORE
 		}
-	case 219:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1169
+		//line sql.y:1533
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 220:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1171
+		//line sql.y:1535
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 221:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1173
+		//line sql.y:1537
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 222:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1175
+		//line sql.y:1539
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 223:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1177
+		//line sql.y:1541
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 224:
+	case 302:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1180
+		//line sql.y:1544
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 225:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1182
+		//line sql.y:1546
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 226:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1185
+		//line sql.y:1549
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.boolean = false
 		}
-	case 227:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1187
+		//line sql.y:1551
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.boolean = true
 		}
-	case 228:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1190
+		//line sql.y:1554
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 229:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1192
+		//line sql.y:1556
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 230:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1196
+		//line sql.y:1560
 		{
 			yyVAL.bytes = bytes.ToLower(yyDollar[1].bytes)
 		}
-	case 231:
+	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1201
+		//line sql.y:1565
 		{
 			ForceEOF(yylex)
 		}
-	case 232:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1206
+		//line sql.y:1570
 		{
 			yyVAL.str = ""
 		}
-	case 233:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1210
+		//line sql.y:1574
 		{
 			yyVAL.str = AST_TABLE
 		}
@@ -44,6 +44,7 @@ var (
 
 %union {
   empty       struct{}
+  boolean     bool
   statement   Statement
   selStmt     SelectStatement
   byt         byte
@@ -75,6 +76,14 @@ var (
   insRows     InsertRows
   updateExprs UpdateExprs
   updateExpr  *UpdateExpr
+  createTable *CreateTable
+  colDef      *ColumnDefinition
+  colDefs     []*ColumnDefinition
+  constraint  *TableConstraint
+  tableOpt    *TableOption
+  tableOpts   []*TableOption
+  tableNames  []*TableName
+  alterTable  *AlterTable
 }
 
 %token LEX_ERROR
@@ -124,6 +133,7 @@ var (
 // DDL Tokens
 %token <empty> CREATE ALTER DROP RENAME
 %token <empty> TABLE INDEX VIEW TO IGNORE IF UNIQUE USING
+%token <empty> DATABASE SCHEMA PRIMARY ADD COLUMN CHANGE MODIFY
 
 // truncate 
 %token <empty> TRUNCATE
@@ -131,6 +141,9 @@ var (
 // describe
 %token <empty> DESCRIBE
 
+// show
+%token <empty> SHOW
+
 %start any_command
 
 %type <statement> command
@@ -179,7 +192,18 @@ var (
 %type <updateExprs> on_dup_opt
 %type <updateExprs> update_list
 %type <updateExpr> update_expression
-%type <empty> exists_opt not_exists_opt non_rename_operation to_opt constraint_opt using_opt
+%type <empty> non_rename_operation to_opt using_opt database_or_schema column_opt key_or_index key_or_index_opt equal_opt
+%type <boolean> constraint_opt exists_opt not_exists_opt
+%type <createTable> table_element_list
+%type <colDef> column_definition column_type
+%type <colDefs> column_definition_list
+%type <alterTable> alter_spec_list alter_spec
+%type <bytes2> length_opt
+%type <valExpr> default_value
+%type <constraint> table_constraint
+%type <tableOpt> table_option
+%type <tableOpts> table_option_list
+%type <bytes> table_option_value sql_id_opt
 %type <bytes> sql_id
 %type <empty> force_eof
 %type <str> table_opt
@@ -192,6 +216,10 @@ var (
 %type <statement> truncate_statement
 
 %type <statement> describe_statement 
+%type <statement> show_statement
+%type <bytes> show_words
+%type <tableNames> show_from_list
+%type <boolExpr> like_or_where_opt
 
 %%
 
@@ -222,6 +250,7 @@ command:
 | use_statement
 | truncate_statement
 | describe_statement
+| show_statement
 
 select_statement:
   SELECT comment_opt distinct_opt select_expression_list limit_opt
@@ -394,6 +423,73 @@ describe_statement:
     $$ = &Describe{TableName: $2}
   }
 
+show_statement:
+  SHOW show_words show_from_list like_or_where_opt
+  {
+    $$ = NewShow($2, $3, $4)
+  }
+| SHOW INDEX show_from_list like_or_where_opt
+  {
+    $$ = NewShow([]byte(AST_SHOW_INDEX), $3, $4)
+  }
+| SHOW TABLE sql_id show_from_list like_or_where_opt
+  {
+    // TABLE STATUS
+    $$ = NewShow(append([]byte("table "), $3...), $4, $5)
+  }
+| SHOW CREATE TABLE dml_table_expression
+  {
+    $$ = &Show{Section: AST_SHOW_CREATE_TABLE, Table: $4}
+  }
+| SHOW CREATE database_or_schema not_exists_opt sql_id
+  {
+    $$ = &Show{Section: AST_SHOW_CREATE_DATABASE, From: StrVal($5)}
+  }
+
+show_words:
+  sql_id
+  {
+    $$ = $1
+  }
+| GLOBAL
+  {
+    $$ = []byte("global")
+  }
+| SESSION
+  {
+    $$ = []byte("session")
+  }
+| show_words sql_id
+  {
+    $$ = append(append($1, ' '), $2...)
+  }
+
+show_from_list:
+  {
+    $$ = nil
+  }
+| show_from_list FROM dml_table_expression
+  {
+    $$ = append($1, $3)
+  }
+| show_from_list IN dml_table_expression
+  {
+    $$ = append($1, $3)
+  }
+
+like_or_where_opt:
+  {
+    $$ = nil
+  }
+| LIKE value_expression
+  {
+    $$ = &ComparisonExpr{Operator: AST_LIKE, Right: $2}
+  }
+| WHERE boolean_expression
+  {
+    $$ = $2
+  }
+
 use_statement:
   USE sql_id
   {
@@ -407,14 +503,20 @@ truncate_statement:
   }
 
 create_statement:
-  CREATE TABLE not_exists_opt ID force_eof
+  CREATE TABLE not_exists_opt ID '(' table_element_list ')' table_option_list
   {
-    $$ = &DDL{Action: AST_CREATE, NewName: $4}
+    $6.IfNotExists = $3
+    $6.Name = $4
+    $6.Options = $8
+    $$ = $6
   }
-| CREATE constraint_opt INDEX sql_id using_opt ON ID force_eof
+| CREATE database_or_schema not_exists_opt ID table_option_list
   {
-    // Change this to an alter statement
-    $$ = &DDL{Action: AST_ALTER, Table: $7, NewName: $7}
+    $$ = &CreateDatabase{IfNotExists: $3, Name: $4}
+  }
+| CREATE constraint_opt INDEX sql_id using_opt ON ID '(' column_list ')' force_eof
+  {
+    $$ = &CreateIndex{Unique: $2, Name: $4, Table: $7, Columns: $9}
   }
 | CREATE VIEW sql_id force_eof
   {
@@ -422,7 +524,12 @@ create_statement:
   }
 
 alter_statement:
-  ALTER ignore_opt TABLE ID non_rename_operation force_eof
+  ALTER ignore_opt TABLE ID alter_spec_list
+  {
+    $5.Table = $4
+    $$ = $5
+  }
+| ALTER ignore_opt TABLE ID non_rename_operation force_eof
   {
     $$ = &DDL{Action: AST_ALTER, Ignore: $2, Table: $4, NewName: $4}
   }
@@ -445,7 +552,11 @@ rename_statement:
 drop_statement:
   DROP TABLE exists_opt ID
   {
-    $$ = &DDL{Action: AST_DROP, Table: $4}
+    $$ = &DDL{Action: AST_DROP, Table: $4, IfExists: $3}
+  }
+| DROP database_or_schema exists_opt ID
+  {
+    $$ = &DropDatabase{IfExists: $3, Name: $4}
   }
 | DROP INDEX sql_id ON ID
   {
@@ -1150,13 +1261,266 @@ update_expression:
   }
 
 exists_opt:
-  { $$ = struct{}{} }
+  { $$ = false }
 | IF EXISTS
-  { $$ = struct{}{} }
+  { $$ = true }
 
 not_exists_opt:
-  { $$ = struct{}{} }
+  { $$ = false }
 | IF NOT EXISTS
+  { $$ = true }
+
+database_or_schema:
+  DATABASE
+  { $$ = struct{}{} }
+| SCHEMA
+  { $$ = struct{}{} }
+
+table_element_list:
+  column_definition
+  {
+    $$ = &CreateTable{Columns: []*ColumnDefinition{$1}}
+  }
+| table_constraint
+  {
+    $$ = &CreateTable{Constraints: []*TableConstraint{$1}}
+  }
+| table_element_list ',' column_definition
+  {
+    $1.Columns = append($1.Columns, $3)
+    $$ = $1
+  }
+| table_element_list ',' table_constraint
+  {
+    $1.Constraints = append($1.Constraints, $3)
+    $$ = $1
+  }
+
+column_definition_list:
+  column_definition
+  {
+    $$ = []*ColumnDefinition{$1}
+  }
+| column_definition_list ',' column_definition
+  {
+    $$ = append($1, $3)
+  }
+
+column_definition:
+  sql_id column_type
+  {
+    $2.Name = $1
+    $$ = $2
+  }
+| column_definition NOT NULL
+  {
+    $1.NotNull = true
+    $$ = $1
+  }
+| column_definition NULL
+  {
+    $1.Null = true
+    $$ = $1
+  }
+| column_definition DEFAULT default_value
+  {
+    $1.Default = $3
+    $$ = $1
+  }
+| column_definition PRIMARY KEY
+  {
+    $1.PrimaryKey = true
+    $$ = $1
+  }
+| column_definition UNIQUE key_or_index_opt
+  {
+    $1.Unique = true
+    $$ = $1
+  }
+| column_definition ID
+  {
+    $1.Attrs = append($1.Attrs, bytes.ToLower($2))
+    $$ = $1
+  }
+| column_definition ID STRING
+  {
+    // COMMENT 'xxx'
+    $1.Attrs = append($1.Attrs, bytes.ToLower($2))
+    $$ = $1
+  }
+| column_definition ID SET ID
+  {
+    // CHARACTER SET xxx
+    $$ = $1
+  }
+| column_definition COLLATE ID
+  {
+    $$ = $1
+  }
+| column_definition ON UPDATE ID
+  {
+    $1.Attrs = append($1.Attrs, []byte("on update"))
+    $$ = $1
+  }
+
+column_type:
+  sql_id length_opt
+  {
+    $$ = &ColumnDefinition{Type: $1, Length: $2}
+  }
+
+length_opt:
+  {
+    $$ = nil
+  }
+| '(' NUMBER ')'
+  {
+    $$ = [][]byte{$2}
+  }
+| '(' NUMBER ',' NUMBER ')'
+  {
+    $$ = [][]byte{$2, $4}
+  }
+
+default_value:
+  value
+  {
+    $$ = $1
+  }
+| '-' NUMBER
+  {
+    $$ = NumVal(append([]byte("-"), $2...))
+  }
+| sql_id
+  {
+    $$ = &ColName{Name: $1}
+  }
+
+table_constraint:
+  PRIMARY KEY '(' column_list ')'
+  {
+    $$ = &TableConstraint{Type: AST_PRIMARY_KEY, Columns: $4}
+  }
+| key_or_index sql_id_opt '(' column_list ')'
+  {
+    $$ = &TableConstraint{Type: AST_KEY, Name: $2, Columns: $4}
+  }
+| UNIQUE key_or_index_opt sql_id_opt '(' column_list ')'
+  {
+    $$ = &TableConstraint{Type: AST_UNIQUE_KEY, Name: $3, Columns: $5}
+  }
+
+key_or_index:
+  KEY
+  { $$ = struct{}{} }
+| INDEX
+  { $$ = struct{}{} }
+
+key_or_index_opt:
+  { $$ = struct{}{} }
+| key_or_index
+  { $$ = struct{}{} }
+
+sql_id_opt:
+  {
+    $$ = nil
+  }
+| sql_id
+  {
+    $$ = $1
+  }
+
+table_option_list:
+  {
+    $$ = nil
+  }
+| table_option_list table_option
+  {
+    $$ = append($1, $2)
+  }
+| table_option_list ',' table_option
+  {
+    $$ = append($1, $3)
+  }
+
+table_option:
+  sql_id equal_opt table_option_value
+  {
+    $$ = &TableOption{Name: $1, Value: $3}
+  }
+| sql_id SET equal_opt table_option_value
+  {
+    // CHARACTER SET = xxx
+    $$ = &TableOption{Name: append($1, " set"...), Value: $4}
+  }
+| COLLATE equal_opt table_option_value
+  {
+    $$ = &TableOption{Name: []byte("collate"), Value: $3}
+  }
+| DEFAULT table_option
+  {
+    $$ = $2
+  }
+
+table_option_value:
+  ID
+| STRING
+| NUMBER
+
+equal_opt:
+  { $$ = struct{}{} }
+| '='
+  { $$ = struct{}{} }
+
+alter_spec_list:
+  alter_spec
+  {
+    $$ = $1
+  }
+| alter_spec_list ',' alter_spec
+  {
+    $$ = $1
+    $$.AddColumns = append($$.AddColumns, $3.AddColumns...)
+    $$.AlterColumns = append($$.AlterColumns, $3.AlterColumns...)
+  }
+
+alter_spec:
+  ADD column_opt column_definition
+  {
+    $$ = &AlterTable{AddColumns: []*ColumnDefinition{$3}}
+  }
+| ADD column_opt '(' column_definition_list ')'
+  {
+    $$ = &AlterTable{AddColumns: $4}
+  }
+| DROP column_opt sql_id
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_DROP_COLUMN, Name: $3}}}
+  }
+| RENAME COLUMN sql_id TO sql_id
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_RENAME_COLUMN, Name: $3, NewName: $5}}}
+  }
+| CHANGE column_opt sql_id column_definition
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_CHANGE_COLUMN, Name: $3, NewName: $4.Name, Definition: $4}}}
+  }
+| MODIFY column_opt column_definition
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_MODIFY_COLUMN, Name: $3.Name, Definition: $3}}}
+  }
+| ALTER column_opt sql_id SET DEFAULT default_value
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_SET_DEFAULT, Name: $3, Default: $6}}}
+  }
+| ALTER column_opt sql_id DROP DEFAULT
+  {
+    $$ = &AlterTable{AlterColumns: []*AlterColumn{{Action: AST_DROP_DEFAULT, Name: $3}}}
+  }
+
+column_opt:
+  { $$ = struct{}{} }
+| COLUMN
   { $$ = struct{}{} }
 
 ignore_opt:
@@ -1182,9 +1546,9 @@ to_opt:
   { $$ = struct{}{} }
 
 constraint_opt:
-  { $$ = struct{}{} }
+  { $$ = false }
 | UNIQUE
-  { $$ = struct{}{} }
+  { $$ = true }
 
 using_opt:
   { $$ = struct{}{} }
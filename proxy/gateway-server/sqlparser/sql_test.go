@@ -74,3 +74,180 @@ func TestDescribe(t *testing.T) {
 		t.Fatalf("expected tableName=abc, actual: %v", desc.TableName)
 	}
 }
+
+func TestCreateIndex(t *testing.T) {
+	stmt, err := Parse("create index idx_name on user (name, age)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ci, ok := stmt.(*CreateIndex)
+	if !ok {
+		t.Fatalf("unexpected statement %T", stmt)
+	}
+	if ci.Unique || string(ci.Name) != "idx_name" || string(ci.Table) != "user" || len(ci.Columns) != 2 {
+		t.Fatalf("unexpected create index: %s", String(ci))
+	}
+
+	stmt, err = Parse("create unique index idx_name on user (name)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stmt.(*CreateIndex).Unique {
+		t.Fatal("expect unique index")
+	}
+}
+
+func TestCreateTable(t *testing.T) {
+	sql := "create table if not exists user (" +
+		"id bigint(20) unsigned not null auto_increment comment 'id', " +
+		"name varchar(64) character set utf8 default null, " +
+		"score double(10,2) default -1, " +
+		"primary key (id), key idx_name (name)" +
+		") engine=InnoDB default charset=utf8 split_keys='10,20'"
+	stmt, err := Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, ok := stmt.(*CreateTable)
+	if !ok {
+		t.Fatalf("unexpected statement %T", stmt)
+	}
+	if !ct.IfNotExists || string(ct.Name) != "user" || len(ct.Columns) != 3 || len(ct.Constraints) != 2 || len(ct.Options) != 3 {
+		t.Fatalf("unexpected create table: %s", String(ct))
+	}
+	id := ct.Columns[0]
+	if string(id.Type) != "bigint" || !id.NotNull || len(id.Attrs) != 3 || string(id.Attrs[0]) != "unsigned" {
+		t.Fatalf("unexpected column: %s", String(id))
+	}
+	if len(ct.Columns[2].Length) != 2 || String(ct.Columns[2].Default) != "-1" {
+		t.Fatalf("unexpected column: %s", String(ct.Columns[2]))
+	}
+	if ct.Constraints[0].Type != AST_PRIMARY_KEY || ct.Constraints[1].Type != AST_KEY {
+		t.Fatalf("unexpected constraints: %s", String(ct))
+	}
+	if string(ct.Options[2].Name) != "split_keys" || string(ct.Options[2].Value) != "10,20" {
+		t.Fatalf("unexpected options: %s", String(ct))
+	}
+}
+
+func TestDDL(t *testing.T) {
+	stmt, err := Parse("create database if not exists db1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cd, ok := stmt.(*CreateDatabase); !ok || !cd.IfNotExists || string(cd.Name) != "db1" {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+
+	stmt, err = Parse("drop schema db1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dd, ok := stmt.(*DropDatabase); !ok || dd.IfExists || string(dd.Name) != "db1" {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+
+	stmt, err = Parse("drop table if exists user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ddl, ok := stmt.(*DDL); !ok || ddl.Action != AST_DROP || !ddl.IfExists || string(ddl.Table) != "user" {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+
+	stmt, err = Parse("alter table user add column age int not null default 0, add (email varchar(128), phone varchar(32))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at, ok := stmt.(*AlterTable)
+	if !ok || string(at.Table) != "user" || len(at.AddColumns) != 3 {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+	if string(at.AddColumns[0].Name) != "age" || !at.AddColumns[0].NotNull {
+		t.Fatalf("unexpected column %s", String(at.AddColumns[0]))
+	}
+
+	stmt, err = Parse("alter table user drop column Age, rename column email to mail, change phone mobile varchar(32) null, " +
+		"modify name varchar(64) not null default '', alter column score set default -1, alter nick drop default, add city varchar(32)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at, ok = stmt.(*AlterTable)
+	if !ok || len(at.AddColumns) != 1 || len(at.AlterColumns) != 6 {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+	expects := []struct {
+		action  string
+		name    string
+		newName string
+	}{
+		{AST_DROP_COLUMN, "age", ""},
+		{AST_RENAME_COLUMN, "email", "mail"},
+		{AST_CHANGE_COLUMN, "phone", "mobile"},
+		{AST_MODIFY_COLUMN, "name", ""},
+		{AST_SET_DEFAULT, "score", ""},
+		{AST_DROP_DEFAULT, "nick", ""},
+	}
+	for i, e := range expects {
+		ac := at.AlterColumns[i]
+		if ac.Action != e.action || string(ac.Name) != e.name || string(ac.NewName) != e.newName {
+			t.Fatalf("unexpected alter column %s", String(ac))
+		}
+	}
+	if !at.AlterColumns[2].Definition.Null || !at.AlterColumns[3].Definition.NotNull || String(at.AlterColumns[4].Default) != "-1" {
+		t.Fatalf("unexpected statement %s", String(stmt))
+	}
+	if String(stmt) != "alter table user add column (city varchar(32)), drop column age, rename column email to mail, "+
+		"change column phone mobile varchar(32) null, modify column name varchar(64) not null default '', "+
+		"alter column score set default -1, alter column nick drop default" {
+		t.Fatalf("unexpected format %s", String(stmt))
+	}
+}
+
+func TestShow(t *testing.T) {
+	tests := []struct {
+		sql     string
+		section string
+		key     string
+		table   string
+		db      string
+		output  string
+	}{
+		{"show databases", AST_SHOW_DATABASES, "", "", "", "show databases"},
+		{"show schemas like 'a%'", AST_SHOW_DATABASES, "", "", "", "show databases like 'a%'"},
+		{"show full tables from db1 where table_type = 'BASE TABLE'", AST_SHOW_TABLES, "full", "", "db1", "show full tables from db1 where table_type = 'BASE TABLE'"},
+		{"show full columns from user from db1 like 'n%'", AST_SHOW_COLUMNS, "full", "user", "db1", "show full columns from db1.user like 'n%'"},
+		{"show fields in db1.user", AST_SHOW_COLUMNS, "", "user", "db1", "show columns from db1.user"},
+		{"show index from user", AST_SHOW_INDEX, "", "user", "", "show index from user"},
+		{"show keys from user", AST_SHOW_INDEX, "", "user", "", "show index from user"},
+		{"show create table db1.user", AST_SHOW_CREATE_TABLE, "", "user", "db1", "show create table db1.user"},
+		{"show global variables like 'version%'", AST_SHOW_VARIABLES, "global", "", "", "show global variables like 'version%'"},
+		{"show status", AST_SHOW_STATUS, "", "", "", "show status"},
+		{"show table status from db1", AST_SHOW_TABLE_STATUS, "", "", "db1", "show table status from db1"},
+	}
+	for _, tt := range tests {
+		stmt, err := Parse(tt.sql)
+		if err != nil {
+			t.Fatalf("parse %s: %v", tt.sql, err)
+		}
+		show, ok := stmt.(*Show)
+		if !ok {
+			t.Fatalf("unexpected statement %T", stmt)
+		}
+		if show.Section != tt.section || show.Key != tt.key {
+			t.Errorf("%s: unexpected section %q key %q", tt.sql, show.Section, show.Key)
+		}
+		var table, db string
+		if show.Table != nil {
+			table, db = string(show.Table.Name), string(show.Table.Qualifier)
+		} else if show.From != nil {
+			db = string(show.From.(StrVal))
+		}
+		if table != tt.table || db != tt.db {
+			t.Errorf("%s: unexpected table %q db %q", tt.sql, table, db)
+		}
+		if out := String(show); out != tt.output {
+			t.Errorf("%s: unexpected format %q", tt.sql, out)
+		}
+	}
+}
@@ -119,6 +119,14 @@ var keywords = map[string]int{
 	"unique": UNIQUE,
 	"using":  USING,
 
+	"database": DATABASE,
+	"schema":   SCHEMA,
+	"primary":  PRIMARY,
+	"add":      ADD,
+	"column":   COLUMN,
+	"change":   CHANGE,
+	"modify":   MODIFY,
+
 	"begin":    BEGIN,
 	"rollback": ROLLBACK,
 	"commit":   COMMIT,
@@ -148,6 +156,7 @@ var keywords = map[string]int{
 
 	// for fbase
 	"describe": DESCRIBE,
+	"show":     SHOW,
 }
 
 // Lex returns the next token form the Tokenizer.
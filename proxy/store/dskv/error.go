@@ -11,3 +11,6 @@ var (
 
 	ErrAffectRows = errors.New("affect rows is not equal")
 )
+
+// dataserver返回的主键冲突错误码, 检查主键冲突的写入整批失败
+const CodeDuplicate = 11
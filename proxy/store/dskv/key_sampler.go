@@ -0,0 +1,103 @@
+package dskv
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"model/pkg/metapb"
+	"model/pkg/mspb"
+	"pkg-go/ms_client"
+	"util/log"
+
+	"golang.org/x/net/context"
+)
+
+// 每个上报周期每个range最多保存的抽样key数
+const maxKeySamplesPerRange = 64
+
+// 按比例抽样发往每个range的请求key, 定期上报给master, master按请求key的分布选择按负载分裂的分裂点
+type keySampler struct {
+	rate    uint64
+	counter uint64
+
+	lock    sync.Mutex
+	samples map[RangeVerID][][]byte
+}
+
+// 为nil时不抽样
+var defaultKeySampler *keySampler
+
+// rate为抽样比例, 每rate个请求抽样一个key, 为0时不抽样
+// 需要在开始处理请求前调用
+func StartKeySampler(ctx context.Context, cli client.Client, rate int, interval time.Duration) {
+	if rate <= 0 || interval <= 0 {
+		log.Info("key sampler is disabled")
+		return
+	}
+	s := &keySampler{
+		rate:    uint64(rate),
+		samples: make(map[RangeVerID][][]byte),
+	}
+	defaultKeySampler = s
+	go s.run(ctx, cli, interval)
+}
+
+func sampleKey(l *KeyLocation, key []byte) {
+	if defaultKeySampler == nil || l == nil {
+		return
+	}
+	defaultKeySampler.sample(l.Region, key)
+}
+
+func (s *keySampler) sample(id RangeVerID, key []byte) {
+	if atomic.AddUint64(&s.counter, 1)%s.rate != 0 {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := s.samples[id]
+	if len(keys) >= maxKeySamplesPerRange {
+		return
+	}
+	k := make([]byte, len(key))
+	copy(k, key)
+	s.samples[id] = append(keys, k)
+}
+
+// 取出当前周期的抽样并清空
+func (s *keySampler) take() []*mspb.RangeKeySamples {
+	s.lock.Lock()
+	samples := s.samples
+	s.samples = make(map[RangeVerID][][]byte)
+	s.lock.Unlock()
+
+	var ret []*mspb.RangeKeySamples
+	for id, keys := range samples {
+		ret = append(ret, &mspb.RangeKeySamples{
+			RangeId:    id.Id,
+			RangeEpoch: &metapb.RangeEpoch{ConfVer: id.ConfVer, Version: id.Cer},
+			Keys:       keys,
+		})
+	}
+	return ret
+}
+
+func (s *keySampler) run(ctx context.Context, cli client.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			samples := s.take()
+			if len(samples) == 0 {
+				continue
+			}
+			if err := cli.ReportKeySamples(samples); err != nil {
+				log.Warn("report key samples of %d ranges failed, err %v", len(samples), err)
+			}
+		}
+	}
+}
@@ -334,6 +334,7 @@ func (p *KvProxy) do(bo *Backoffer, req *Request, key []byte) (resp *Response, l
 			continue
 		}
 		// 请求成功
+		sampleKey(l, key)
 		return
 	}
 
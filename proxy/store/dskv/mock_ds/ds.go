@@ -58,6 +58,9 @@ type DsRpcServer struct {
 	rngs        map[uint64]*metapb.Range
 
 	store       engine.Driver
+	// 按行保存数据的表
+	sqlLock     sync.Mutex
+	tables      map[uint64]*metapb.Table
 	//msAddr      []string
 	//cli         client.Client
 }
@@ -68,7 +71,8 @@ func NewDsRpcServer(addr string, path string) *DsRpcServer {
 		os.Exit(-1)
 		return nil
 	}
-	return &DsRpcServer{rpcAddr: addr, conns: make(map[uint64]net.Conn), rngs: make(map[uint64]*metapb.Range), store:store}
+	return &DsRpcServer{rpcAddr: addr, conns: make(map[uint64]net.Conn), rngs: make(map[uint64]*metapb.Range), store:store,
+		tables: make(map[uint64]*metapb.Table)}
 }
 
 func (svr *DsRpcServer) SetRange(r *metapb.Range) {
@@ -216,6 +220,8 @@ func (svr *DsRpcServer) insert(msg *dsClient.Message) {
 	err := proto.Unmarshal(msg.GetData(), req)
 	if err != nil {
 		resp = &kvrpcpb.DsInsertResponse{Header: &kvrpcpb.ResponseHeader{Error: &errorpb.Error{Message: "insert failed"}}}
+	} else if rows := req.GetReq().GetRows(); len(rows) > 0 && svr.findTable(rows[0].GetKey()) != nil {
+		resp = &kvrpcpb.DsInsertResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: svr.sqlInsert(svr.findTable(rows[0].GetKey()), req.GetReq())}
 	} else {
 		// TODO insert
 		resp = &kvrpcpb.DsInsertResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: &kvrpcpb.InsertResponse{Code: 0, AffectedKeys: uint64(len(req.GetReq().GetRows()))}}
@@ -225,12 +231,44 @@ func (svr *DsRpcServer) insert(msg *dsClient.Message) {
 	msg.SetData(data)
 }
 
+// 没有注册的表insert没有保存数据, 查询总是返回空结果
 func (svr *DsRpcServer) query(msg *dsClient.Message) {
-
+	var resp *kvrpcpb.DsSelectResponse
+	req := new(kvrpcpb.DsSelectRequest)
+	err := proto.Unmarshal(msg.GetData(), req)
+	if err != nil {
+		resp = &kvrpcpb.DsSelectResponse{Header: &kvrpcpb.ResponseHeader{Error: &errorpb.Error{Message: "select failed"}}}
+	} else if t := svr.findTable(requestKey(req.GetReq().GetKey(), req.GetReq().GetScope())); t != nil {
+		resp = &kvrpcpb.DsSelectResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: svr.sqlSelect(t, req.GetReq())}
+	} else {
+		resp = &kvrpcpb.DsSelectResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: &kvrpcpb.SelectResponse{Code: 0}}
+	}
+	data, _ := proto.Marshal(resp)
+	msg.SetMsgType(0x12)
+	msg.SetData(data)
 }
 
 func (svr *DsRpcServer) delete(msg *dsClient.Message) {
+	var resp *kvrpcpb.DsDeleteResponse
+	req := new(kvrpcpb.DsDeleteRequest)
+	err := proto.Unmarshal(msg.GetData(), req)
+	if err != nil {
+		resp = &kvrpcpb.DsDeleteResponse{Header: &kvrpcpb.ResponseHeader{Error: &errorpb.Error{Message: "delete failed"}}}
+	} else if t := svr.findTable(requestKey(req.GetReq().GetKey(), req.GetReq().GetScope())); t != nil {
+		resp = &kvrpcpb.DsDeleteResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: svr.sqlDelete(t, req.GetReq())}
+	} else {
+		resp = &kvrpcpb.DsDeleteResponse{Header: &kvrpcpb.ResponseHeader{}, Resp: &kvrpcpb.DeleteResponse{Code: 0}}
+	}
+	data, _ := proto.Marshal(resp)
+	msg.SetMsgType(0x12)
+	msg.SetData(data)
+}
 
+func requestKey(key []byte, scope *kvrpcpb.Scope) []byte {
+	if len(key) > 0 {
+		return key
+	}
+	return scope.GetStart()
 }
 
 func (svr *DsRpcServer) kvSet(msg *dsClient.Message) {
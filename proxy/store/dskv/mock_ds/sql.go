@@ -0,0 +1,252 @@
+package mock_ds
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"model/pkg/kvrpcpb"
+	"model/pkg/metapb"
+	"util"
+	"util/encoding"
+)
+
+// 主键冲突错误码, 与dataserver一致
+const codeDuplicate = 11
+
+// SetTable 注册表结构, 注册过的表按行保存数据并支持insert, select, delete
+// 注册时清空该表已有的数据; 只支持按列查询和等值条件过滤
+func (svr *DsRpcServer) SetTable(t *metapb.Table) {
+	svr.sqlLock.Lock()
+	defer svr.sqlLock.Unlock()
+	svr.tables[t.GetId()] = t
+	start := util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId())
+	limit := util.EncodeStorePrefix(util.Store_Prefix_KV, t.GetId()+1)
+	iter := svr.store.NewIterator(start, limit)
+	batch := svr.store.NewBatch()
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+	iter.Release()
+	batch.Commit()
+}
+
+func (svr *DsRpcServer) findTable(key []byte) *metapb.Table {
+	_, tableId, err := util.DecodeStorePrefix(key)
+	if err != nil {
+		return nil
+	}
+	svr.sqlLock.Lock()
+	defer svr.sqlLock.Unlock()
+	return svr.tables[tableId]
+}
+
+func (svr *DsRpcServer) sqlInsert(t *metapb.Table, req *kvrpcpb.InsertRequest) *kvrpcpb.InsertResponse {
+	svr.sqlLock.Lock()
+	defer svr.sqlLock.Unlock()
+	if req.GetCheckDuplicate() {
+		for _, row := range req.GetRows() {
+			if _, err := svr.store.Get(row.GetKey()); err == nil {
+				return &kvrpcpb.InsertResponse{Code: codeDuplicate, DuplicateKey: row.GetKey()}
+			}
+		}
+	}
+	batch := svr.store.NewBatch()
+	for _, row := range req.GetRows() {
+		batch.Put(row.GetKey(), row.GetValue())
+	}
+	if err := batch.Commit(); err != nil {
+		return &kvrpcpb.InsertResponse{Code: 1}
+	}
+	return &kvrpcpb.InsertResponse{AffectedKeys: uint64(len(req.GetRows()))}
+}
+
+// 遍历key或者scope中满足条件的行
+func (svr *DsRpcServer) sqlScan(t *metapb.Table, key []byte, scope *kvrpcpb.Scope, filters []*kvrpcpb.Match,
+	f func(key []byte, row map[uint64]interface{}) bool) error {
+	visit := func(k, v []byte) (bool, error) {
+		row, err := decodeRow(t, k, v)
+		if err != nil {
+			return false, err
+		}
+		ok, err := matchRow(row, filters)
+		if err != nil || !ok {
+			return true, err
+		}
+		return f(append([]byte(nil), k...), row), nil
+	}
+	if len(key) > 0 {
+		value, err := svr.store.Get(key)
+		if err != nil {
+			return nil
+		}
+		_, err = visit(key, value)
+		return err
+	}
+	iter := svr.store.NewIterator(scope.GetStart(), scope.GetLimit())
+	defer iter.Release()
+	for iter.Next() {
+		next, err := visit(iter.Key(), iter.Value())
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return nil
+}
+
+func (svr *DsRpcServer) sqlSelect(t *metapb.Table, req *kvrpcpb.SelectRequest) *kvrpcpb.SelectResponse {
+	svr.sqlLock.Lock()
+	defer svr.sqlLock.Unlock()
+	resp := &kvrpcpb.SelectResponse{}
+	var skipped uint64
+	err := svr.sqlScan(t, req.GetKey(), req.GetScope(), req.GetWhereFilters(), func(key []byte, row map[uint64]interface{}) bool {
+		if limit := req.GetLimit(); limit != nil {
+			if skipped < limit.GetOffset() {
+				skipped++
+				return true
+			}
+			if limit.GetCount() > 0 && uint64(len(resp.Rows)) >= limit.GetCount() {
+				return false
+			}
+		}
+		var fields []byte
+		for _, f := range req.GetFieldList() {
+			fields = encodeField(fields, row[f.GetColumn().GetId()])
+		}
+		resp.Rows = append(resp.Rows, &kvrpcpb.Row{Key: key, Fields: fields})
+		return true
+	})
+	if err != nil {
+		return &kvrpcpb.SelectResponse{Code: 1}
+	}
+	resp.Offset = skipped
+	return resp
+}
+
+func (svr *DsRpcServer) sqlDelete(t *metapb.Table, req *kvrpcpb.DeleteRequest) *kvrpcpb.DeleteResponse {
+	svr.sqlLock.Lock()
+	defer svr.sqlLock.Unlock()
+	var keys [][]byte
+	err := svr.sqlScan(t, req.GetKey(), req.GetScope(), req.GetWhereFilters(), func(key []byte, row map[uint64]interface{}) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if err != nil {
+		return &kvrpcpb.DeleteResponse{Code: 1}
+	}
+	batch := svr.store.NewBatch()
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+	if err = batch.Commit(); err != nil {
+		return &kvrpcpb.DeleteResponse{Code: 1}
+	}
+	return &kvrpcpb.DeleteResponse{AffectedKeys: uint64(len(keys))}
+}
+
+// 按列ID解码一行, 包括主键列
+func decodeRow(t *metapb.Table, key, value []byte) (map[uint64]interface{}, error) {
+	row := make(map[uint64]interface{})
+	buf := key[9:]
+	var err error
+	for _, col := range t.GetColumns() {
+		if col.GetPrimaryKey() == 0 {
+			continue
+		}
+		var v interface{}
+		switch col.DataType {
+		case metapb.DataType_Tinyint, metapb.DataType_Smallint, metapb.DataType_Int, metapb.DataType_BigInt:
+			if col.Unsigned {
+				buf, v, err = encoding.DecodeUvarintAscending(buf)
+			} else {
+				buf, v, err = encoding.DecodeVarintAscending(buf)
+			}
+		case metapb.DataType_Float, metapb.DataType_Double:
+			buf, v, err = encoding.DecodeFloatAscending(buf)
+		default:
+			buf, v, err = encoding.DecodeBytesAscending(buf, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		row[col.GetId()] = v
+	}
+	for len(value) > 0 {
+		_, _, colID, _, err := encoding.DecodeValueTag(value)
+		if err != nil {
+			return nil, err
+		}
+		var col *metapb.Column
+		for _, c := range t.GetColumns() {
+			if c.GetId() == uint64(colID) {
+				col = c
+			}
+		}
+		if col == nil {
+			_, n, err := encoding.PeekValueLength(value)
+			if err != nil {
+				return nil, err
+			}
+			value = value[n:]
+			continue
+		}
+		var v interface{}
+		if value, v, err = util.DecodeColumnValue(value, col); err != nil {
+			return nil, err
+		}
+		row[col.GetId()] = v
+	}
+	return row, nil
+}
+
+// 同dataserver, 查询结果的列值不编码列ID
+func encodeField(buf []byte, v interface{}) []byte {
+	switch v := v.(type) {
+	case int64:
+		return encoding.EncodeIntValue(buf, encoding.NoColumnID, v)
+	case uint64:
+		return encoding.EncodeIntValue(buf, encoding.NoColumnID, int64(v))
+	case float64:
+		return encoding.EncodeFloatValue(buf, encoding.NoColumnID, v)
+	case []byte:
+		return encoding.EncodeBytesValue(buf, encoding.NoColumnID, v)
+	default:
+		return encoding.EncodeNullValue(buf, encoding.NoColumnID)
+	}
+}
+
+func formatField(v interface{}) []byte {
+	switch v := v.(type) {
+	case int64:
+		return strconv.AppendInt(nil, v, 10)
+	case uint64:
+		return strconv.AppendUint(nil, v, 10)
+	case float64:
+		return strconv.AppendFloat(nil, v, 'f', -1, 64)
+	case []byte:
+		return v
+	}
+	return nil
+}
+
+func matchRow(row map[uint64]interface{}, filters []*kvrpcpb.Match) (bool, error) {
+	for _, m := range filters {
+		v := row[m.GetColumn().GetId()]
+		switch m.GetMatchType() {
+		case kvrpcpb.MatchType_Equal:
+			if v == nil || !bytes.Equal(formatField(v), m.GetThreshold()) {
+				return false, nil
+			}
+		case kvrpcpb.MatchType_NotEqual:
+			if v == nil || bytes.Equal(formatField(v), m.GetThreshold()) {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("unsupported match type(%v)", m.GetMatchType())
+		}
+	}
+	return true, nil
+}
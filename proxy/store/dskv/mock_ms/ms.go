@@ -120,6 +120,11 @@ func (c *Cluster) ReportSplit(ctx context.Context, req *mspb.ReportSplitRequest)
 	return nil, nil
 }
 
+func (c *Cluster) ReportKeySamples(ctx context.Context, req *mspb.ReportKeySamplesRequest) (*mspb.ReportKeySamplesResponse, error) {
+	resp := &mspb.ReportKeySamplesResponse{Header: &mspb.ResponseHeader{}}
+	return resp, nil
+}
+
 func (c *Cluster) NodeLogin(ctx context.Context, req *mspb.NodeLoginRequest) (*mspb.NodeLoginResponse, error) {
 	resp := &mspb.NodeLoginResponse{Header: &mspb.ResponseHeader{}}
 	return resp, nil
@@ -209,6 +214,10 @@ func (c *Cluster) AddColumn(ctx context.Context, req *mspb.AddColumnRequest) (*m
 	return resp, nil
 }
 
+func (c *Cluster) AlterColumn(ctx context.Context, req *mspb.AlterColumnRequest) (*mspb.AlterColumnResponse, error) {
+	return nil, nil
+}
+
 func (c *Cluster) CreateDatabase(ctx context.Context, req *mspb.CreateDatabaseRequest) (*mspb.CreateDatabaseResponse, error) {
 	return nil, nil
 }
@@ -217,6 +226,30 @@ func (c *Cluster) CreateTable(ctx context.Context, req *mspb.CreateTableRequest)
 	return nil, nil
 }
 
+func (c *Cluster) DeleteDatabase(ctx context.Context, req *mspb.DeleteDatabaseRequest) (*mspb.DeleteDatabaseResponse, error) {
+	return nil, nil
+}
+
+func (c *Cluster) DeleteTable(ctx context.Context, req *mspb.DeleteTableRequest) (*mspb.DeleteTableResponse, error) {
+	return nil, nil
+}
+
+func (c *Cluster) CreateIndex(ctx context.Context, req *mspb.CreateIndexRequest) (*mspb.CreateIndexResponse, error) {
+	return nil, nil
+}
+
+func (c *Cluster) SetIndexState(ctx context.Context, req *mspb.SetIndexStateRequest) (*mspb.SetIndexStateResponse, error) {
+	return nil, nil
+}
+
+func (c *Cluster) GetDatabases(ctx context.Context, req *mspb.GetDatabasesRequest) (*mspb.GetDatabasesResponse, error) {
+	return nil, nil
+}
+
+func (c *Cluster) GetTables(ctx context.Context, req *mspb.GetTablesRequest) (*mspb.GetTablesResponse, error) {
+	return nil, nil
+}
+
 
 type HttpReply httpReply
 
@@ -144,6 +144,22 @@ func (c *RangeCache) ListRegionIDsInKeyRange(bo *Backoffer, startKey, endKey []b
 	return regionIDs, nil
 }
 
+// ListKeyLocationsInKeyRange lists locations of regions in [start_key,end_key).
+func (c *RangeCache) ListKeyLocationsInKeyRange(bo *Backoffer, startKey, endKey []byte) (locations []*KeyLocation, err error) {
+	for {
+		curRegion, err := c.LocateKey(bo, startKey)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, curRegion)
+		if len(curRegion.EndKey) == 0 || bytes.Compare(curRegion.EndKey, endKey) >= 0 {
+			break
+		}
+		startKey = curRegion.EndKey
+	}
+	return locations, nil
+}
+
 // DropRegion removes a cached Region.
 func (c *RangeCache) DropRegion(id RangeVerID) {
 	c.mu.Lock()
@@ -39,6 +39,10 @@ func (p *KvProxy) SqlInsert(req *kvrpcpb.InsertRequest, scope *kvrpcpb.Scope) ([
 			return nil, err
 		}
 		resps = append(resps, resp)
+		if resp.GetCode() == CodeDuplicate {
+			// 主键冲突, 不再写入后面的range
+			break
+		}
 	}
 	if len(resps) == 0 {
 		log.Warn("SqlInsert: should not enter into here")
@@ -79,6 +83,10 @@ func (p *KvProxy) Insert(req *kvrpcpb.InsertRequest, key []byte) (*kvrpcpb.Inser
 		log.Error("nodeId:%d,requst:[%v],respose exception, respose:[%v] ",nodeId,req , response)
 		return nil,nil,ErrAffectRows
 	}
+	if response != nil && response.GetCode() == CodeDuplicate {
+		// 检查主键冲突时由调用方处理
+		return response, l, nil
+	}
 	if response == nil || response.GetCode() >0 {
 		var nodeId uint64 = 0
 		l, err = p.RangeCache.LocateKey(bo, key)
@@ -188,7 +188,7 @@ func (b *Work) prepare() {
 		Id:     3,
 		DbId:   2,
 		Columns:    []*metapb.Column{
-			{Name: "user_name", Id:uint64(1), DataType: metapb.DataType_Varchar, PrimaryKey:uint64(1), Index:true },
+			{Name: "user_name", Id:uint64(1), DataType: metapb.DataType_Varchar, PrimaryKey:uint64(1)},
 			{Name: "pass_word", Id:uint64(2), DataType: metapb.DataType_Varchar, Index:true},
 			{Name: "real_name", Id:uint64(3), DataType: metapb.DataType_Varchar, Index:true},
 		},
@@ -470,7 +470,6 @@ func CreateTable(name string) {
 	columns := make([]*metapb.Column, 3)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "user_name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -535,7 +534,6 @@ func CreateTable1() {
 	columns := make([]*metapb.Column, 2)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "id",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -544,7 +542,6 @@ func CreateTable1() {
 
 	column = &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -592,7 +589,6 @@ func CreateTable2() {
 	columns := make([]*metapb.Column, 3)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "user_name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -658,7 +654,6 @@ func CreateTable3() {
 	columns := make([]*metapb.Column, 3)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "user_name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -729,7 +724,6 @@ func CreateTable4(dbname, tablename string) {
 	columns := make([]*metapb.Column, 3)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "user_name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -818,7 +812,6 @@ func CreateTable5(dbname, tablename string) {
 	columns := make([]*metapb.Column, 4)
 	column := &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "h",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -827,7 +820,6 @@ func CreateTable5(dbname, tablename string) {
 
 	column = &metapb.Column{
 		DataType:   metapb.DataType_Varchar,
-		Index:      true,
 		Name:       "user_name",
 		PrimaryKey: 1,
 		Nullable:   false,
@@ -122,11 +122,11 @@ func (s *Server) Run() {
 		l = netutil.LimitListener(l, s.connLimit)
 	}
 
+	s.l = l
 	err = http.Serve(l, s)
-	if err != nil {
+	if err != nil && !s.isClosed() {
 		log.Fatal("http.listenAndServe failed: %s", err.Error())
 	}
-	s.l = l
 	return
 }
 